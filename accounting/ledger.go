@@ -0,0 +1,469 @@
+package accounting
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// forwardingQueryBatch is the maximum number of forwarding events
+	// we'll fetch from the forwarding log at once.
+	forwardingQueryBatch = 10000
+)
+
+// EntryType denotes the type of a ledger Entry.
+type EntryType uint8
+
+const (
+	// OnChainReceive is an on-chain transaction that credited the wallet,
+	// and isn't related to any of our channels.
+	OnChainReceive EntryType = iota
+
+	// OnChainSend is an on-chain transaction that debited the wallet, and
+	// isn't related to any of our channels.
+	OnChainSend
+
+	// ChannelOpen is the funding transaction of one of our channels that
+	// was paid for by the wallet.
+	ChannelOpen
+
+	// ChannelClose is the closing transaction of one of our channels that
+	// paid out our balance directly to the wallet.
+	ChannelClose
+
+	// Sweep is a transaction published by the sweeper, which sweeps the
+	// outputs of a force closed channel back into the wallet.
+	Sweep
+
+	// ForwardFee is the fee income of a payment we forwarded.
+	ForwardFee
+
+	// PaymentSent is an outgoing off-chain payment.
+	PaymentSent
+
+	// PaymentReceived is a settled invoice.
+	PaymentReceived
+)
+
+// String returns a human readable string for the entry type.
+func (t EntryType) String() string {
+	switch t {
+	case OnChainReceive:
+		return "onchain_receive"
+	case OnChainSend:
+		return "onchain_send"
+	case ChannelOpen:
+		return "channel_open"
+	case ChannelClose:
+		return "channel_close"
+	case Sweep:
+		return "sweep"
+	case ForwardFee:
+		return "forward_fee"
+	case PaymentSent:
+		return "payment_sent"
+	case PaymentReceived:
+		return "payment_received"
+	default:
+		return "unknown"
+	}
+}
+
+// Entry is a single entry in the ledger of the node. Each entry references
+// the record in the wallet or channeldb that it was created from.
+type Entry struct {
+	// Timestamp is the time at which the entry was recorded. For on-chain
+	// transactions this is the timestamp of the block including the
+	// transaction, or the time it was created if it's unconfirmed.
+	Timestamp time.Time
+
+	// Type is the type of the entry.
+	Type EntryType
+
+	// Amount is the net change in our balance in millisatoshis, including
+	// any fees paid. A positive amount credits our balance, while a
+	// negative amount debits it.
+	Amount int64
+
+	// Fee is the fee paid by us as part of the entry, which is already
+	// included in Amount.
+	Fee lnwire.MilliSatoshi
+
+	// Reference identifies the source record of the entry. For on-chain
+	// entries this is the hash of the transaction within the wallet, for
+	// payments and invoices the payment hash, and for forwarding events
+	// the timestamp in nanoseconds under which the event is stored in
+	// the forwarding log.
+	Reference string
+
+	// ChannelPoint is the channel the entry relates to. It's only set for
+	// ChannelOpen and ChannelClose entries.
+	ChannelPoint *wire.OutPoint
+}
+
+// Config houses the sources the ledger is constructed from.
+type Config struct {
+	// ChanDB is the database our channels, payments, invoices and
+	// forwarding events are stored in.
+	ChanDB *channeldb.DB
+
+	// ListTransactions returns all transactions relevant to the wallet.
+	ListTransactions func() ([]*lnwallet.TransactionDetail, error)
+
+	// IsSweepTx returns whether the transaction with the given hash was
+	// published by the sweeper.
+	IsSweepTx func(chainhash.Hash) (bool, error)
+
+	// SweepTxFee returns the fee paid by the sweep transaction with the
+	// given hash, as recorded by the sweeper. The returned boolean is
+	// false if the fee is unknown.
+	SweepTxFee func(chainhash.Hash) (btcutil.Amount, bool, error)
+
+	// GetBlock returns the block with the given hash. It's used to look
+	// up the outputs of channel closing transactions.
+	GetBlock func(*chainhash.Hash) (*wire.MsgBlock, error)
+}
+
+// Ledger returns all entries recorded between the given start and end times,
+// inclusive, ordered by their timestamp.
+func Ledger(cfg *Config, start, end time.Time) ([]*Entry, error) {
+	inRange := func(t time.Time) bool {
+		return !t.Before(start) && !t.After(end)
+	}
+
+	onChain, err := onChainEntries(cfg, inRange)
+	if err != nil {
+		return nil, err
+	}
+	forwards, err := forwardEntries(cfg.ChanDB, start, end)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := paymentEntries(cfg.ChanDB, inRange)
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := invoiceEntries(cfg.ChanDB, inRange)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	entries = append(entries, onChain...)
+	entries = append(entries, forwards...)
+	entries = append(entries, payments...)
+	entries = append(entries, invoices...)
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+
+	return entries, nil
+}
+
+// channelTx is an on-chain transaction related to one of our channels.
+type channelTx struct {
+	entryType EntryType
+	chanPoint wire.OutPoint
+	capacity  btcutil.Amount
+}
+
+// fetchChannelTxs returns the funding and closing transactions of all our
+// channels, both open and closed, keyed by their hash.
+func fetchChannelTxs(db *channeldb.DB) (map[chainhash.Hash]channelTx, error) {
+	channelTxs := make(map[chainhash.Hash]channelTx)
+
+	openChannels, err := db.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range openChannels {
+		chanPoint := channel.FundingOutpoint
+		channelTxs[chanPoint.Hash] = channelTx{
+			entryType: ChannelOpen,
+			chanPoint: chanPoint,
+			capacity:  channel.Capacity,
+		}
+	}
+
+	closedChannels, err := db.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+	for _, summary := range closedChannels {
+		channelTxs[summary.ChanPoint.Hash] = channelTx{
+			entryType: ChannelOpen,
+			chanPoint: summary.ChanPoint,
+			capacity:  summary.Capacity,
+		}
+		channelTxs[summary.ClosingTXID] = channelTx{
+			entryType: ChannelClose,
+			chanPoint: summary.ChanPoint,
+			capacity:  summary.Capacity,
+		}
+	}
+
+	return channelTxs, nil
+}
+
+// onChainEntries returns the entries for all wallet transactions within the
+// time range.
+func onChainEntries(cfg *Config, inRange func(time.Time) bool) ([]*Entry,
+	error) {
+
+	txs, err := cfg.ListTransactions()
+	if err != nil {
+		return nil, err
+	}
+	channelTxs, err := fetchChannelTxs(cfg.ChanDB)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fee of a closing transaction is paid by the initiator of the
+	// channel, which is us if the wallet paid for its funding transaction.
+	initiated := make(map[chainhash.Hash]struct{})
+	for _, tx := range txs {
+		chanTx, ok := channelTxs[tx.Hash]
+		if ok && chanTx.entryType == ChannelOpen && tx.Value < 0 {
+			initiated[tx.Hash] = struct{}{}
+		}
+	}
+
+	var entries []*Entry
+	for _, tx := range txs {
+		timestamp := time.Unix(tx.Timestamp, 0)
+		if !inRange(timestamp) {
+			continue
+		}
+
+		isSweep, err := cfg.IsSweepTx(tx.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := classifyTx(tx, channelTxs, isSweep)
+
+		// The wallet doesn't know the fees of closing and sweep
+		// transactions, as it didn't fund their inputs, so we'll
+		// derive them from the channel and the sweeper instead.
+		switch entry.Type {
+		case ChannelClose:
+			chanTx := channelTxs[tx.Hash]
+			_, ok := initiated[chanTx.chanPoint.Hash]
+			if !ok {
+				break
+			}
+
+			fee, err := closeFee(cfg, tx, chanTx.capacity)
+			if err != nil {
+				return nil, err
+			}
+			entry.Fee = lnwire.NewMSatFromSatoshis(fee)
+
+		case Sweep:
+			fee, known, err := cfg.SweepTxFee(tx.Hash)
+			if err != nil {
+				return nil, err
+			}
+			if known {
+				entry.Fee = lnwire.NewMSatFromSatoshis(fee)
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// closeFee returns the fee paid by the given closing transaction of a channel
+// with the given capacity, which is the capacity minus the value of the
+// transaction's outputs. As the wallet doesn't know the outputs of the
+// transaction that don't pay to it, the transaction is looked up in the block
+// confirming it. The fee of unconfirmed closing transactions is unknown, in
+// which case zero is returned.
+func closeFee(cfg *Config, tx *lnwallet.TransactionDetail,
+	capacity btcutil.Amount) (btcutil.Amount, error) {
+
+	if tx.BlockHash == nil {
+		return 0, nil
+	}
+
+	block, err := cfg.GetBlock(tx.BlockHash)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, blockTx := range block.Transactions {
+		if blockTx.TxHash() != tx.Hash {
+			continue
+		}
+
+		var outputValue btcutil.Amount
+		for _, txOut := range blockTx.TxOut {
+			outputValue += btcutil.Amount(txOut.Value)
+		}
+		if outputValue >= capacity {
+			return 0, nil
+		}
+
+		return capacity - outputValue, nil
+	}
+
+	return 0, fmt.Errorf("closing tx %v not found in block %v", tx.Hash,
+		tx.BlockHash)
+}
+
+// classifyTx creates the ledger entry for the given wallet transaction,
+// determining its type from the known channel transactions and whether it
+// was published by the sweeper.
+func classifyTx(tx *lnwallet.TransactionDetail,
+	channelTxs map[chainhash.Hash]channelTx, isSweep bool) *Entry {
+
+	entry := &Entry{
+		Timestamp: time.Unix(tx.Timestamp, 0),
+		Amount:    int64(tx.Value) * 1000,
+		Reference: tx.Hash.String(),
+	}
+
+	// The wallet only knows the fee of a transaction if it funded all of
+	// its inputs, in which case we were the ones to pay it. The fees of
+	// closing and sweep transactions are determined by the caller.
+	if tx.TotalFees > 0 {
+		entry.Fee = lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(tx.TotalFees),
+		)
+	}
+
+	chanTx, isChannelTx := channelTxs[tx.Hash]
+	switch {
+	case isChannelTx:
+		entry.Type = chanTx.entryType
+		chanPoint := chanTx.chanPoint
+		entry.ChannelPoint = &chanPoint
+
+	case isSweep:
+		entry.Type = Sweep
+
+	case tx.Value < 0:
+		entry.Type = OnChainSend
+
+	default:
+		entry.Type = OnChainReceive
+	}
+
+	return entry
+}
+
+// forwardEntries returns the fee income of all forwarding events within the
+// time range.
+func forwardEntries(db *channeldb.DB, start, end time.Time) ([]*Entry,
+	error) {
+
+	var (
+		entries []*Entry
+		offset  uint32
+	)
+	for {
+		timeSlice, err := db.ForwardingLog().Query(
+			channeldb.ForwardingEventQuery{
+				StartTime:    start,
+				EndTime:      end,
+				IndexOffset:  offset,
+				NumMaxEvents: forwardingQueryBatch,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			fee := int64(event.AmtIn) - int64(event.AmtOut)
+			ref := strconv.FormatInt(event.Timestamp.UnixNano(), 10)
+
+			entries = append(entries, &Entry{
+				Timestamp: event.Timestamp,
+				Type:      ForwardFee,
+				Amount:    fee,
+				Reference: ref,
+			})
+		}
+
+		if len(timeSlice.ForwardingEvents) < forwardingQueryBatch {
+			return entries, nil
+		}
+		offset = timeSlice.LastIndexOffset
+	}
+}
+
+// paymentEntries returns the entries for all outgoing payments within the
+// time range.
+func paymentEntries(db *channeldb.DB, inRange func(time.Time) bool) ([]*Entry,
+	error) {
+
+	payments, err := db.FetchAllPayments()
+	if err != nil && err != channeldb.ErrNoPaymentsCreated {
+		return nil, err
+	}
+
+	var entries []*Entry
+	for _, payment := range payments {
+		if !inRange(payment.CreationDate) {
+			continue
+		}
+
+		paymentHash := sha256.Sum256(payment.PaymentPreimage[:])
+		entries = append(entries, &Entry{
+			Timestamp: payment.CreationDate,
+			Type:      PaymentSent,
+			Amount:    -int64(payment.Terms.Value + payment.Fee),
+			Fee:       payment.Fee,
+			Reference: hex.EncodeToString(paymentHash[:]),
+		})
+	}
+
+	return entries, nil
+}
+
+// invoiceEntries returns the entries for all invoices that were settled within
+// the time range.
+func invoiceEntries(db *channeldb.DB, inRange func(time.Time) bool) ([]*Entry,
+	error) {
+
+	invoices, err := db.FetchAllInvoices(false)
+	if err != nil && err != channeldb.ErrNoInvoicesCreated {
+		return nil, err
+	}
+
+	var entries []*Entry
+	for _, invoice := range invoices {
+		if invoice.Terms.State != channeldb.ContractSettled {
+			continue
+		}
+		if !inRange(invoice.SettleDate) {
+			continue
+		}
+
+		paymentHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+		entries = append(entries, &Entry{
+			Timestamp: invoice.SettleDate,
+			Type:      PaymentReceived,
+			Amount:    int64(invoice.AmtPaid),
+			Reference: hex.EncodeToString(paymentHash[:]),
+		})
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,301 @@
+package accounting
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestLedger tests that the ledger contains an entry for each record within
+// the time range, ordered by timestamp.
+func TestLedger(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "accounting")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := channeldb.Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Unix(time.Now().Unix(), 0)
+	start := now.Add(-time.Hour)
+	end := now.Add(time.Hour)
+	old := start.Add(-time.Hour)
+
+	// We'll add a settled invoice, which should show up as a received
+	// payment, and an open one, which shouldn't.
+	var preimage [32]byte
+	preimage[0] = 1
+	invoice := &channeldb.Invoice{
+		CreationDate: now,
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: preimage,
+			Value:           5000,
+		},
+	}
+	if _, err := db.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+	invoiceHash := sha256.Sum256(preimage[:])
+	if _, err := db.SettleInvoice(invoiceHash, 5500); err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+
+	preimage[0] = 2
+	openInvoice := &channeldb.Invoice{
+		CreationDate: now,
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: preimage,
+			Value:           5000,
+		},
+	}
+	if _, err := db.AddInvoice(openInvoice); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	preimage[0] = 3
+	payment := &channeldb.OutgoingPayment{
+		Invoice: channeldb.Invoice{
+			CreationDate: now.Add(-time.Minute),
+			Terms: channeldb.ContractTerm{
+				Value: 1000,
+			},
+		},
+		Fee:             10,
+		PaymentPreimage: preimage,
+	}
+	if err := db.AddPayment(payment); err != nil {
+		t.Fatalf("unable to add payment: %v", err)
+	}
+	paymentHash := sha256.Sum256(preimage[:])
+
+	// Only the forwarding event within the time range should be included.
+	err = db.ForwardingLog().AddForwardingEvents([]channeldb.ForwardingEvent{
+		{
+			Timestamp: old,
+			AmtIn:     2100,
+			AmtOut:    2000,
+		},
+		{
+			Timestamp: now.Add(-2 * time.Minute),
+			AmtIn:     1100,
+			AmtOut:    1000,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to add forwarding events: %v", err)
+	}
+
+	receiveTx := &lnwallet.TransactionDetail{
+		Hash:      chainhash.Hash{1},
+		Value:     20000,
+		Timestamp: now.Add(-3 * time.Minute).Unix(),
+	}
+	sendTx := &lnwallet.TransactionDetail{
+		Hash:      chainhash.Hash{2},
+		Value:     -10000,
+		Timestamp: now.Add(-4 * time.Minute).Unix(),
+		TotalFees: 500,
+	}
+	sweepTx := &lnwallet.TransactionDetail{
+		Hash:      chainhash.Hash{3},
+		Value:     3000,
+		Timestamp: now.Add(-5 * time.Minute).Unix(),
+	}
+	oldTx := &lnwallet.TransactionDetail{
+		Hash:      chainhash.Hash{4},
+		Value:     1000,
+		Timestamp: old.Unix(),
+	}
+
+	cfg := &Config{
+		ChanDB: db,
+		ListTransactions: func() ([]*lnwallet.TransactionDetail,
+			error) {
+
+			return []*lnwallet.TransactionDetail{
+				receiveTx, sendTx, sweepTx, oldTx,
+			}, nil
+		},
+		IsSweepTx: func(hash chainhash.Hash) (bool, error) {
+			return hash == sweepTx.Hash, nil
+		},
+		SweepTxFee: func(hash chainhash.Hash) (btcutil.Amount, bool,
+			error) {
+
+			return 150, hash == sweepTx.Hash, nil
+		},
+	}
+
+	entries, err := Ledger(cfg, start, end)
+	if err != nil {
+		t.Fatalf("unable to create ledger: %v", err)
+	}
+
+	expected := []Entry{
+		{
+			Type:      Sweep,
+			Amount:    3000000,
+			Fee:       150000,
+			Reference: sweepTx.Hash.String(),
+		},
+		{
+			Type:      OnChainSend,
+			Amount:    -10000000,
+			Fee:       500000,
+			Reference: sendTx.Hash.String(),
+		},
+		{
+			Type:      OnChainReceive,
+			Amount:    20000000,
+			Reference: receiveTx.Hash.String(),
+		},
+		{
+			Type:   ForwardFee,
+			Amount: 100,
+		},
+		{
+			Type:      PaymentSent,
+			Amount:    -1010,
+			Fee:       10,
+			Reference: hex.EncodeToString(paymentHash[:]),
+		},
+		{
+			Type:      PaymentReceived,
+			Amount:    5500,
+			Reference: hex.EncodeToString(invoiceHash[:]),
+		},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected),
+			len(entries))
+	}
+	for i, entry := range entries {
+		exp := expected[i]
+		if entry.Type != exp.Type || entry.Amount != exp.Amount ||
+			entry.Fee != exp.Fee {
+
+			t.Fatalf("entry %d: expected %v of %v (fee %v), got "+
+				"%v of %v (fee %v)", i, exp.Type, exp.Amount,
+				exp.Fee, entry.Type, entry.Amount, entry.Fee)
+		}
+		if exp.Reference != "" && entry.Reference != exp.Reference {
+			t.Fatalf("entry %d: expected reference %v, got %v", i,
+				exp.Reference, entry.Reference)
+		}
+	}
+}
+
+// TestClassifyChannelTx tests that wallet transactions that fund or close
+// one of our channels are recognized as such.
+func TestClassifyChannelTx(t *testing.T) {
+	t.Parallel()
+
+	chanPoint := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+	closingTxid := chainhash.Hash{2}
+	channelTxs := map[chainhash.Hash]channelTx{
+		chanPoint.Hash: {
+			entryType: ChannelOpen,
+			chanPoint: chanPoint,
+		},
+		closingTxid: {
+			entryType: ChannelClose,
+			chanPoint: chanPoint,
+		},
+	}
+
+	fundingTx := &lnwallet.TransactionDetail{
+		Hash:      chanPoint.Hash,
+		Value:     -100000,
+		TotalFees: 200,
+	}
+	entry := classifyTx(fundingTx, channelTxs, false)
+	if entry.Type != ChannelOpen {
+		t.Fatalf("expected %v, got %v", ChannelOpen, entry.Type)
+	}
+	if entry.Fee != lnwire.NewMSatFromSatoshis(200) {
+		t.Fatalf("expected fee of 200 sat, got %v", entry.Fee)
+	}
+	if entry.ChannelPoint == nil || *entry.ChannelPoint != chanPoint {
+		t.Fatalf("expected channel point %v, got %v", chanPoint,
+			entry.ChannelPoint)
+	}
+
+	closingTx := &lnwallet.TransactionDetail{
+		Hash:  closingTxid,
+		Value: 50000,
+	}
+	entry = classifyTx(closingTx, channelTxs, false)
+	if entry.Type != ChannelClose {
+		t.Fatalf("expected %v, got %v", ChannelClose, entry.Type)
+	}
+	if entry.ChannelPoint == nil || *entry.ChannelPoint != chanPoint {
+		t.Fatalf("expected channel point %v, got %v", chanPoint,
+			entry.ChannelPoint)
+	}
+}
+
+// TestCloseFee tests that the fee of a closing transaction is derived from the
+// capacity of the channel and the outputs of the transaction within the block
+// confirming it.
+func TestCloseFee(t *testing.T) {
+	t.Parallel()
+
+	closingTx := wire.NewMsgTx(2)
+	closingTx.AddTxOut(&wire.TxOut{Value: 60000})
+	closingTx.AddTxOut(&wire.TxOut{Value: 39000})
+
+	blockHash := chainhash.Hash{9}
+	cfg := &Config{
+		GetBlock: func(hash *chainhash.Hash) (*wire.MsgBlock, error) {
+			if *hash != blockHash {
+				t.Fatalf("unexpected block %v", hash)
+			}
+
+			return &wire.MsgBlock{
+				Transactions: []*wire.MsgTx{
+					wire.NewMsgTx(2), closingTx,
+				},
+			}, nil
+		},
+	}
+
+	tx := &lnwallet.TransactionDetail{
+		Hash:      closingTx.TxHash(),
+		Value:     60000,
+		BlockHash: &blockHash,
+	}
+	fee, err := closeFee(cfg, tx, 100000)
+	if err != nil {
+		t.Fatalf("unable to determine close fee: %v", err)
+	}
+	if fee != 1000 {
+		t.Fatalf("expected fee of 1000 sat, got %v", fee)
+	}
+
+	// The fee of an unconfirmed closing transaction is unknown.
+	tx.BlockHash = nil
+	fee, err = closeFee(cfg, tx, 100000)
+	if err != nil {
+		t.Fatalf("unable to determine close fee: %v", err)
+	}
+	if fee != 0 {
+		t.Fatalf("expected unknown fee, got %v", fee)
+	}
+}
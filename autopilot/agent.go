@@ -142,6 +142,11 @@ type Agent struct {
 	// time.
 	chanOpenFailures chan *chanOpenFailureUpdate
 
+	// heuristicUpdates is a channel where updates from active heuristics
+	// will be sent. This channel will be buffered to ensure we have at
+	// most one pending update of this type to handle at a given time.
+	heuristicUpdates chan *heuristicUpdate
+
 	// totalBalance is the total number of satoshis the backing wallet is
 	// known to control at any given instance. This value will be updated
 	// when the agent receives external balance update signals.
@@ -181,6 +186,7 @@ func New(cfg Config, initialState []Channel) (*Agent, error) {
 		nodeUpdates:        make(chan *nodeUpdates, 1),
 		chanOpenFailures:   make(chan *chanOpenFailureUpdate, 1),
 		pendingOpenUpdates: make(chan *chanPendingOpenUpdate, 1),
+		heuristicUpdates:   make(chan *heuristicUpdate, 1),
 		failedNodes:        make(map[NodeID]struct{}),
 		pendingConns:       make(map[NodeID]struct{}),
 		pendingOpens:       make(map[NodeID]Channel),
@@ -249,6 +255,13 @@ type chanPendingOpenUpdate struct{}
 // a previous channel open failed, and that it might be possible to try again.
 type chanOpenFailureUpdate struct{}
 
+// heuristicUpdate is a type of external state update that indicates that the
+// scores given by the agent's heuristic may have changed, for instance as new
+// external scores were set.
+type heuristicUpdate struct {
+	heuristic AttachmentHeuristic
+}
+
 // chanCloseUpdate is a type of external state update that indicates that the
 // backing Lightning Node has closed a previously open channel.
 type chanCloseUpdate struct {
@@ -307,6 +320,16 @@ func (a *Agent) OnChannelOpenFailure() {
 	}
 }
 
+// OnHeuristicUpdate is a callback that should be executed each time the
+// scores given by the agent's heuristic may have changed, such that the agent
+// can re-evaluate where to open channels.
+func (a *Agent) OnHeuristicUpdate(h AttachmentHeuristic) {
+	select {
+	case a.heuristicUpdates <- &heuristicUpdate{heuristic: h}:
+	default:
+	}
+}
+
 // OnChannelClose is a callback that should be executed each time a prior
 // channel has been closed for any reason. This includes regular
 // closes, force closes, and channel breaches.
@@ -459,6 +482,13 @@ func (a *Agent) controller() {
 			log.Infof("Node updates received, assessing " +
 				"need for more channels")
 
+		// The scores given by our heuristic may have changed, so
+		// we'll consider opening channels to the newly favored nodes
+		// if we haven't stabilized.
+		case update := <-a.heuristicUpdates:
+			log.Infof("Heuristic %v updated, assessing need "+
+				"for more channels", update.heuristic.Name())
+
 		// The agent has been signalled to exit, so we'll bail out
 		// immediately.
 		case <-a.quit:
@@ -35,6 +35,10 @@ type mockHeuristic struct {
 	quit chan struct{}
 }
 
+func (m *mockHeuristic) Name() string {
+	return "mock"
+}
+
 func (m *mockHeuristic) NeedMoreChans(chans []Channel,
 	balance btcutil.Amount) (btcutil.Amount, uint32, bool) {
 
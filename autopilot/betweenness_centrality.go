@@ -0,0 +1,220 @@
+package autopilot
+
+import (
+	"net"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// BetweennessCentrality is an implementation of the AttachmentHeuristic
+// interface that scores nodes according to their betweenness centrality
+// within the channel graph. The betweenness centrality of a node is the
+// fraction of shortest paths between all pairs of other nodes that pass
+// through it. As a result, this heuristic favors nodes that connect otherwise
+// distant parts of the graph, rather than nodes that merely have many
+// channels.
+type BetweennessCentrality struct {
+	constraints *HeuristicConstraints
+}
+
+// NewBetweennessCentrality creates a new instance of the BetweennessCentrality
+// heuristic given the constraints the created channels must adhere to.
+func NewBetweennessCentrality(
+	cfg *HeuristicConstraints) *BetweennessCentrality {
+
+	return &BetweennessCentrality{
+		constraints: cfg,
+	}
+}
+
+// A compile time assertion to ensure BetweennessCentrality meets the
+// AttachmentHeuristic interface.
+var _ AttachmentHeuristic = (*BetweennessCentrality)(nil)
+
+// Name returns the name of this heuristic.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (b *BetweennessCentrality) Name() string {
+	return "betweenness"
+}
+
+// NeedMoreChans is a predicate that should return true if, given the passed
+// parameters, and its internal state, more channels should be opened within
+// the channel graph. If the heuristic decides that we do indeed need more
+// channels, then the second argument returned will represent the amount of
+// additional funds to be used towards creating channels.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (b *BetweennessCentrality) NeedMoreChans(channels []Channel,
+	funds btcutil.Amount) (btcutil.Amount, uint32, bool) {
+
+	// We'll try to open more channels as long as the constraints allow it.
+	availableFunds, availableChans := b.constraints.availableChans(
+		channels, funds,
+	)
+	return availableFunds, availableChans, availableChans > 0
+}
+
+// NodeScores is a method that given the current channel graph, current set of
+// local channels and funds available, scores the given nodes according the the
+// preference of opening a channel with them.
+//
+// The betweenness centrality of each node in the graph is computed using
+// Brandes' algorithm, treating the graph as undirected and unweighted.
+//
+// The returned scores will be in the range [0.0, 1.0], where the most central
+// node in the graph receives a score of 1.0.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (b *BetweennessCentrality) NodeScores(g ChannelGraph, chans []Channel,
+	fundsAvailable btcutil.Amount, nodes map[NodeID]struct{}) (
+	map[NodeID]*AttachmentDirective, error) {
+
+	// We'll start by assigning an index to each node in the graph, and
+	// building its adjacency list. Parallel channels between the same
+	// pair of nodes are only counted once.
+	var (
+		nodeIndex = make(map[NodeID]int)
+		neighbors []map[NodeID]struct{}
+		addrs     = make(map[NodeID][]net.Addr)
+	)
+	indexOf := func(nID NodeID) int {
+		idx, ok := nodeIndex[nID]
+		if !ok {
+			idx = len(neighbors)
+			nodeIndex[nID] = idx
+			neighbors = append(neighbors, make(map[NodeID]struct{}))
+		}
+		return idx
+	}
+	if err := g.ForEachNode(func(n Node) error {
+		nID := NodeID(n.PubKey())
+		idx := indexOf(nID)
+
+		if _, ok := nodes[nID]; ok {
+			addrs[nID] = n.Addrs()
+		}
+
+		return n.ForEachChannel(func(e ChannelEdge) error {
+			peer := NodeID(e.Peer.PubKey())
+			if peer == nID {
+				return nil
+			}
+
+			indexOf(peer)
+			neighbors[idx][peer] = struct{}{}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	adjacency := make([][]int, len(neighbors))
+	for idx, peers := range neighbors {
+		for peer := range peers {
+			adjacency[idx] = append(adjacency[idx], nodeIndex[peer])
+		}
+	}
+
+	centrality := betweennessCentrality(adjacency)
+
+	// We'll normalize the centrality of each node by the maximum
+	// centrality found in the graph. If no node lies on any shortest
+	// path, then we cannot determine any preferences, so we return,
+	// indicating all candidates get a score of zero.
+	var maxCentrality float64
+	for _, c := range centrality {
+		if c > maxCentrality {
+			maxCentrality = c
+		}
+	}
+	if maxCentrality == 0 {
+		return nil, nil
+	}
+
+	scores := make(map[NodeID]float64)
+	for nID := range nodes {
+		idx, ok := nodeIndex[nID]
+		if !ok {
+			continue
+		}
+
+		scores[nID] = centrality[idx] / maxCentrality
+	}
+
+	return b.constraints.candidateDirectives(
+		chans, fundsAvailable, scores, addrs,
+	), nil
+}
+
+// betweennessCentrality computes the betweenness centrality of each vertex of
+// the undirected graph described by the passed adjacency lists, using Brandes'
+// algorithm. The returned centralities aren't normalized.
+func betweennessCentrality(adjacency [][]int) []float64 {
+	numNodes := len(adjacency)
+	centrality := make([]float64, numNodes)
+
+	var (
+		stack        = make([]int, 0, numNodes)
+		queue        = make([]int, 0, numNodes)
+		predecessors = make([][]int, numNodes)
+		numPaths     = make([]float64, numNodes)
+		distance     = make([]int, numNodes)
+		dependency   = make([]float64, numNodes)
+	)
+	for source := 0; source < numNodes; source++ {
+		stack = stack[:0]
+		queue = queue[:0]
+		for i := 0; i < numNodes; i++ {
+			predecessors[i] = predecessors[i][:0]
+			numPaths[i] = 0
+			distance[i] = -1
+			dependency[i] = 0
+		}
+		numPaths[source] = 1
+		distance[source] = 0
+
+		// First, we'll do a breadth first search from the source,
+		// counting the number of shortest paths to each vertex, and
+		// recording their predecessors along those paths.
+		queue = append(queue, source)
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			stack = append(stack, v)
+
+			for _, w := range adjacency[v] {
+				if distance[w] < 0 {
+					distance[w] = distance[v] + 1
+					queue = append(queue, w)
+				}
+				if distance[w] == distance[v]+1 {
+					numPaths[w] += numPaths[v]
+					predecessors[w] = append(
+						predecessors[w], v,
+					)
+				}
+			}
+		}
+
+		// Then we'll accumulate the dependencies of the source on each
+		// vertex, visiting them in order of non-increasing distance.
+		for i := len(stack) - 1; i >= 0; i-- {
+			w := stack[i]
+			for _, v := range predecessors[w] {
+				dependency[v] += numPaths[v] / numPaths[w] *
+					(1 + dependency[w])
+			}
+			if w != source {
+				centrality[w] += dependency[w]
+			}
+		}
+	}
+
+	// As the graph is undirected, each shortest path was counted twice.
+	for i := range centrality {
+		centrality[i] /= 2
+	}
+
+	return centrality
+}
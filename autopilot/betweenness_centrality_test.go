@@ -0,0 +1,187 @@
+package autopilot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+)
+
+// TestBetweennessCentralityMetric ensures that the betweenness centrality of
+// each vertex is computed correctly for a few simple graphs.
+func TestBetweennessCentralityMetric(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		adjacency  [][]int
+		centrality []float64
+	}{
+		{
+			// 0 - 1 - 2 - 3
+			name: "path",
+			adjacency: [][]int{
+				{1}, {0, 2}, {1, 3}, {2},
+			},
+			centrality: []float64{0, 2, 2, 0},
+		},
+		{
+			// 1, 2 and 3 are all connected to 0.
+			name: "star",
+			adjacency: [][]int{
+				{1, 2, 3}, {0}, {0}, {0},
+			},
+			centrality: []float64{3, 0, 0, 0},
+		},
+		{
+			// 0 - 1 - 3 and 0 - 2 - 3, so the two shortest paths
+			// between 0 and 3 are split evenly across 1 and 2.
+			name: "square",
+			adjacency: [][]int{
+				{1, 2}, {0, 3}, {0, 3}, {1, 2},
+			},
+			centrality: []float64{0.5, 0.5, 0.5, 0.5},
+		},
+		{
+			name:       "disconnected",
+			adjacency:  [][]int{{}, {}},
+			centrality: []float64{0, 0},
+		},
+	}
+
+	for _, testCase := range testCases {
+		centrality := betweennessCentrality(testCase.adjacency)
+		if !reflect.DeepEqual(centrality, testCase.centrality) {
+			t.Fatalf("%v: expected centrality %v, got %v",
+				testCase.name, testCase.centrality, centrality)
+		}
+	}
+}
+
+// pathGraph creates a graph consisting of numNodes nodes connected in a line,
+// and returns their public keys in order.
+func pathGraph(t *testing.T, g testGraph, numNodes int) []*btcec.PublicKey {
+	const chanCapacity = btcutil.SatoshiPerBitcoin
+
+	nodes := make([]*btcec.PublicKey, numNodes)
+	for i := range nodes {
+		var err error
+		nodes[i], err = g.addRandNode()
+		if err != nil {
+			t.Fatalf("unable to add random node: %v", err)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		_, _, err = g.addRandChannel(nodes[i-1], nodes[i], chanCapacity)
+		if err != nil {
+			t.Fatalf("unable to generate channel: %v", err)
+		}
+	}
+
+	return nodes
+}
+
+// TestBetweennessCentralityNodeScores ensures that the BetweennessCentrality
+// heuristic gives the highest score to the most central node, and skips the
+// nodes that aren't on any shortest path.
+func TestBetweennessCentralityNodeScores(t *testing.T) {
+	t.Parallel()
+
+	const (
+		minChanSize = 0
+		maxChanSize = btcutil.Amount(btcutil.SatoshiPerBitcoin)
+		walletFunds = btcutil.SatoshiPerBitcoin * 10
+	)
+
+	constraints := &HeuristicConstraints{
+		MinChanSize: minChanSize,
+		MaxChanSize: maxChanSize,
+		ChanLimit:   3,
+		Allocation:  0.5,
+	}
+
+	for _, graph := range chanGraphs {
+		success := t.Run(graph.name, func(t1 *testing.T) {
+			graph, cleanup, err := graph.genFunc()
+			if err != nil {
+				t1.Fatalf("unable to create graph: %v", err)
+			}
+			if cleanup != nil {
+				defer cleanup()
+			}
+
+			// We'll create the path a - b - c - d - e, such that c
+			// is the most central node, followed by b and d.
+			pubs := pathGraph(t1, graph, 5)
+
+			nodes := make(map[NodeID]struct{})
+			for _, pub := range pubs {
+				nodes[NewNodeID(pub)] = struct{}{}
+			}
+
+			heuristic := NewBetweennessCentrality(constraints)
+			candidates, err := heuristic.NodeScores(
+				graph, nil, walletFunds, nodes,
+			)
+			if err != nil {
+				t1.Fatalf("unable to get node scores: %v", err)
+			}
+
+			// b and d lie on 3 shortest paths, while c lies on 4.
+			// The endpoints of the path aren't on any, so they
+			// shouldn't be scored.
+			expScores := map[NodeID]float64{
+				NewNodeID(pubs[1]): 0.75,
+				NewNodeID(pubs[2]): 1.0,
+				NewNodeID(pubs[3]): 0.75,
+			}
+			if len(candidates) != len(expScores) {
+				t1.Fatalf("expected %d candidates, got %d",
+					len(expScores), len(candidates))
+			}
+			for nID, expScore := range expScores {
+				candidate, ok := candidates[nID]
+				if !ok {
+					t1.Fatalf("node %x not scored", nID[:])
+				}
+				if candidate.Score != expScore {
+					t1.Fatalf("expected score %v, got %v",
+						expScore, candidate.Score)
+				}
+				if candidate.ChanAmt != maxChanSize {
+					t1.Fatalf("expected chan size %v, "+
+						"got %v", maxChanSize,
+						candidate.ChanAmt)
+				}
+				if len(candidate.Addrs) == 0 {
+					t1.Fatalf("expected node to have " +
+						"available addresses, didn't")
+				}
+			}
+
+			// If we already have a channel with the most central
+			// node, it should no longer be a candidate.
+			chans := []Channel{{Node: NewNodeID(pubs[2])}}
+			candidates, err = heuristic.NodeScores(
+				graph, chans, walletFunds, nodes,
+			)
+			if err != nil {
+				t1.Fatalf("unable to get node scores: %v", err)
+			}
+			if _, ok := candidates[NewNodeID(pubs[2])]; ok {
+				t1.Fatalf("existing peer should not be scored")
+			}
+			if len(candidates) != 2 {
+				t1.Fatalf("expected 2 candidates, got %d",
+					len(candidates))
+			}
+		})
+		if !success {
+			break
+		}
+	}
+}
@@ -0,0 +1,495 @@
+package autopilot
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultPruneInterval is the default interval at which the channel
+	// pruner evaluates our channels.
+	DefaultPruneInterval = time.Hour
+
+	// DefaultPrunePeriod is the default period over which a channel must
+	// have been inactive or unprofitable before it's pruned.
+	DefaultPrunePeriod = 30 * 24 * time.Hour
+
+	// DefaultPruneMinUptime is the default fraction of the prune period
+	// the peer of a channel must have been online for the channel to not
+	// be pruned.
+	DefaultPruneMinUptime = 0.5
+
+	// DefaultMaxClosesPerInterval is the default number of channels the
+	// channel pruner will close within a single evaluation round.
+	DefaultMaxClosesPerInterval = 1
+)
+
+// PrunableChannel is the view of one of our channels that the ChannelPruner
+// uses to decide whether it should be closed.
+type PrunableChannel struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Node is the peer that the channel has been established with.
+	Node NodeID
+
+	// Capacity is the capacity of the channel.
+	Capacity btcutil.Amount
+
+	// IsInitiator is true if we opened the channel. Only channels we
+	// opened ourselves are considered for pruning, as the capital locked
+	// within channels opened by our peers isn't ours to free up.
+	IsInitiator bool
+
+	// NumPendingHTLCs is the number of HTLCs currently active within the
+	// channel. Channels with pending HTLCs can't be closed cooperatively.
+	NumPendingHTLCs int
+
+	// Age is the time that has passed since the funding transaction of
+	// the channel confirmed. Only channels that have been open for the
+	// entire prune period are considered for pruning.
+	Age time.Duration
+}
+
+// PruneCandidate records the outcome of evaluating a single channel for
+// pruning.
+type PruneCandidate struct {
+	// ChanPoint is the funding outpoint of the evaluated channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel ID of the evaluated channel.
+	ChanID lnwire.ShortChannelID
+
+	// Node is the peer of the evaluated channel.
+	Node NodeID
+
+	// Capacity is the capacity of the evaluated channel.
+	Capacity btcutil.Amount
+
+	// Uptime is the fraction of the prune period the peer was online.
+	Uptime float64
+
+	// NumForwards is the number of payments forwarded through the channel
+	// within the prune period, in either direction.
+	NumForwards uint64
+
+	// FeesEarned is the total amount of fees earned by forwards through
+	// the channel within the prune period, in either direction.
+	FeesEarned lnwire.MilliSatoshi
+
+	// Reason is a human readable explanation of why the channel should be
+	// pruned.
+	Reason string
+
+	// Closed is true if the channel pruner initiated the closure of the
+	// channel. This is never the case in dry-run mode.
+	Closed bool
+
+	// CloseError is set if the channel pruner failed to close the
+	// channel, or refrained from doing so.
+	CloseError string
+}
+
+// PruneReport is the outcome of a single evaluation round of the channel
+// pruner.
+type PruneReport struct {
+	// Timestamp is the time the evaluation took place.
+	Timestamp time.Time
+
+	// DryRun is true if the channel pruner was configured to not actually
+	// close any channels.
+	DryRun bool
+
+	// Candidates are the channels that were found to be inactive or
+	// unprofitable.
+	Candidates []*PruneCandidate
+}
+
+// PrunerConfig houses all the items that the ChannelPruner needs to carry out
+// its duties.
+type PrunerConfig struct {
+	// Ticker fires each time our channels should be evaluated.
+	Ticker ticker.Ticker
+
+	// Period is the period over which a channel must have been inactive
+	// or unprofitable before it's pruned. A channel must have been open,
+	// and the connection history of its peer tracked, for the entire
+	// period before it's considered.
+	Period time.Duration
+
+	// MinUptime is the minimum fraction of the period the peer of a
+	// channel must have been online for the channel to not be pruned.
+	MinUptime float64
+
+	// MinFeesEarned is the minimum amount of fees a channel must have
+	// earned within the period to be considered profitable. If zero, only
+	// channels without any forwards at all are considered inactive.
+	MinFeesEarned lnwire.MilliSatoshi
+
+	// MaxClosesPerInterval is the maximum number of channels that will be
+	// closed within a single evaluation round.
+	MaxClosesPerInterval uint32
+
+	// DryRun, if true, makes the pruner only report the channels it would
+	// close, without closing them.
+	DryRun bool
+
+	// FetchChannels returns a snapshot of all our open channels.
+	FetchChannels func() ([]*PrunableChannel, error)
+
+	// ForwardingEvents returns all forwarding events that occurred within
+	// the passed time range.
+	ForwardingEvents func(start, end time.Time) ([]channeldb.ForwardingEvent,
+		error)
+
+	// FetchPeerHistories returns the persisted connection history of all
+	// peers we've been connected to, keyed by their public keys.
+	FetchPeerHistories func() (map[[33]byte]*channeldb.PeerHistory, error)
+
+	// ChanController is used to cooperatively close channels.
+	ChanController ChannelController
+
+	// Now returns the current time.
+	Now func() time.Time
+}
+
+// ChannelPruner is a subsystem that periodically identifies channels that
+// have been inactive or unprofitable for a configurable period, using the
+// forwarding log and the persisted uptime of our peers. Those channels are
+// then cooperatively closed in order to free up the capital locked within
+// them, although no more than a configured number per interval. Channels whose
+// peer is offline can't be closed cooperatively, so they're only reported.
+// The pruner can also run in dry-run mode, where it only reports which
+// channels it would close.
+type ChannelPruner struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *PrunerConfig
+
+	// closing is the set of channels whose closure we've initiated, but
+	// that are still open. It's only accessed by the main pruning
+	// goroutine.
+	closing map[wire.OutPoint]struct{}
+
+	// report is the outcome of the latest evaluation round.
+	report    *PruneReport
+	reportMtx sync.RWMutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewChannelPruner creates a new channel pruner backed by the passed config.
+func NewChannelPruner(cfg *PrunerConfig) (*ChannelPruner, error) {
+	if cfg.Period <= 0 {
+		return nil, fmt.Errorf("prune period must be positive")
+	}
+	if cfg.MinUptime < 0 || cfg.MinUptime > 1 {
+		return nil, fmt.Errorf("min uptime must be in the range [0, 1]")
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	return &ChannelPruner{
+		cfg:     cfg,
+		closing: make(map[wire.OutPoint]struct{}),
+		quit:    make(chan struct{}),
+	}, nil
+}
+
+// Start launches the goroutine that periodically evaluates our channels.
+func (p *ChannelPruner) Start() error {
+	if !atomic.CompareAndSwapUint32(&p.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Channel pruner starting, period=%v, min_uptime=%v, "+
+		"min_fees=%v, dry_run=%v", p.cfg.Period, p.cfg.MinUptime,
+		p.cfg.MinFeesEarned, p.cfg.DryRun)
+
+	p.cfg.Ticker.Resume()
+
+	p.wg.Add(1)
+	go p.pruner()
+
+	return nil
+}
+
+// Stop signals the channel pruner to exit, and waits for it to do so.
+func (p *ChannelPruner) Stop() error {
+	if !atomic.CompareAndSwapUint32(&p.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Channel pruner shutting down")
+
+	p.cfg.Ticker.Pause()
+
+	close(p.quit)
+	p.wg.Wait()
+
+	return nil
+}
+
+// pruner is the main goroutine of the channel pruner. Each time the ticker
+// fires, all channels are evaluated.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *ChannelPruner) pruner() {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case <-p.cfg.Ticker.Ticks():
+			if err := p.evaluateChannels(); err != nil {
+				log.Errorf("Unable to evaluate channels for "+
+					"pruning: %v", err)
+			}
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// channelStats houses the forwarding statistics of a single channel.
+type channelStats struct {
+	numForwards uint64
+	feesEarned  lnwire.MilliSatoshi
+}
+
+// evaluateChannels performs a single evaluation round over all of our
+// channels, closing those that have been inactive or unprofitable.
+func (p *ChannelPruner) evaluateChannels() error {
+	channels, err := p.cfg.FetchChannels()
+	if err != nil {
+		return err
+	}
+
+	histories, err := p.cfg.FetchPeerHistories()
+	if err != nil {
+		return err
+	}
+
+	now := p.cfg.Now()
+	periodStart := now.Add(-p.cfg.Period)
+
+	// First, we'll forget about the channels we were closing that are no
+	// longer open.
+	openChans := make(map[wire.OutPoint]struct{}, len(channels))
+	for _, channel := range channels {
+		openChans[channel.ChanPoint] = struct{}{}
+	}
+	for chanPoint := range p.closing {
+		if _, ok := openChans[chanPoint]; !ok {
+			delete(p.closing, chanPoint)
+		}
+	}
+
+	// Then, we'll tally up the forwards through each channel within the
+	// period. The fees of each forward are attributed to both the
+	// incoming and outgoing channel, as both were required for it.
+	events, err := p.cfg.ForwardingEvents(periodStart, now)
+	if err != nil {
+		return err
+	}
+	stats := make(map[lnwire.ShortChannelID]*channelStats)
+	addForward := func(chanID lnwire.ShortChannelID,
+		fee lnwire.MilliSatoshi) {
+
+		s, ok := stats[chanID]
+		if !ok {
+			s = &channelStats{}
+			stats[chanID] = s
+		}
+		s.numForwards++
+		s.feesEarned += fee
+	}
+	for _, event := range events {
+		var fee lnwire.MilliSatoshi
+		if event.AmtIn > event.AmtOut {
+			fee = event.AmtIn - event.AmtOut
+		}
+		addForward(event.IncomingChanID, fee)
+		addForward(event.OutgoingChanID, fee)
+	}
+
+	report := &PruneReport{
+		Timestamp: now,
+		DryRun:    p.cfg.DryRun,
+	}
+	var numCloses uint32
+	for _, channel := range channels {
+		// Channels we're already closing don't need to be evaluated
+		// again.
+		if _, ok := p.closing[channel.ChanPoint]; ok {
+			continue
+		}
+
+		history := histories[channel.Node]
+		candidate := p.evaluateChannel(
+			channel, history, stats[channel.ChanID], now,
+			periodStart,
+		)
+		if candidate == nil {
+			continue
+		}
+
+		switch {
+		case p.cfg.DryRun:
+
+		// Rather than attempting to close the channel each round, we'll
+		// only report it until its peer comes back online, as it can't
+		// be closed cooperatively otherwise.
+		case !history.Online():
+			candidate.CloseError = "peer is offline"
+
+		case channel.NumPendingHTLCs > 0:
+			candidate.CloseError = "channel has pending htlcs"
+
+		case p.cfg.MaxClosesPerInterval != 0 &&
+			numCloses >= p.cfg.MaxClosesPerInterval:
+
+			candidate.CloseError = "max closes per interval reached"
+
+		default:
+			err := p.cfg.ChanController.CloseChannel(
+				&channel.ChanPoint,
+			)
+			if err != nil {
+				candidate.CloseError = err.Error()
+				break
+			}
+
+			log.Infof("Closed ChannelPoint(%v): %v",
+				channel.ChanPoint, candidate.Reason)
+
+			candidate.Closed = true
+			p.closing[channel.ChanPoint] = struct{}{}
+			numCloses++
+		}
+
+		log.Debugf("Prune candidate ChannelPoint(%v): %v, closed=%v",
+			channel.ChanPoint, candidate.Reason, candidate.Closed)
+
+		report.Candidates = append(report.Candidates, candidate)
+	}
+
+	sort.Slice(report.Candidates, func(i, j int) bool {
+		return report.Candidates[i].ChanID.ToUint64() <
+			report.Candidates[j].ChanID.ToUint64()
+	})
+
+	p.reportMtx.Lock()
+	p.report = report
+	p.reportMtx.Unlock()
+
+	return nil
+}
+
+// peerUptime estimates the fraction of the period starting at periodStart
+// that the peer was online, based on its connection history. As the history
+// only tracks the peer's uptime over its entire lifetime, that is used as the
+// estimate, unless the peer has been offline since before the period started.
+func peerUptime(history *channeldb.PeerHistory, now,
+	periodStart time.Time) float64 {
+
+	if !history.Online() && history.LastFlap.Before(periodStart) {
+		return 0
+	}
+
+	lifetime := history.Lifetime(now)
+	if lifetime == 0 {
+		return 0
+	}
+
+	uptime := float64(history.TotalUptime(now)) / float64(lifetime)
+	if uptime > 1 {
+		uptime = 1
+	}
+
+	return uptime
+}
+
+// evaluateChannel determines whether the channel should be pruned, given the
+// connection history of its peer. If so, a prune candidate is returned,
+// otherwise nil.
+func (p *ChannelPruner) evaluateChannel(channel *PrunableChannel,
+	history *channeldb.PeerHistory, stats *channelStats, now,
+	periodStart time.Time) *PruneCandidate {
+
+	// Channels opened by our peers are never pruned.
+	if !channel.IsInitiator {
+		return nil
+	}
+
+	// We'll only consider channels that have been open, and whose peer's
+	// uptime we've tracked, for the entire period, as we wouldn't be able
+	// to judge them otherwise. This also ensures that newly opened
+	// channels get a chance to prove themselves.
+	if channel.Age < p.cfg.Period {
+		return nil
+	}
+	if history == nil || history.Lifetime(now) < p.cfg.Period {
+		return nil
+	}
+
+	uptime := peerUptime(history, now, periodStart)
+
+	if stats == nil {
+		stats = &channelStats{}
+	}
+
+	candidate := &PruneCandidate{
+		ChanPoint:   channel.ChanPoint,
+		ChanID:      channel.ChanID,
+		Node:        channel.Node,
+		Capacity:    channel.Capacity,
+		Uptime:      uptime,
+		NumForwards: stats.numForwards,
+		FeesEarned:  stats.feesEarned,
+	}
+
+	switch {
+	case uptime < p.cfg.MinUptime:
+		candidate.Reason = fmt.Sprintf("peer uptime %.2f below "+
+			"minimum of %.2f", uptime, p.cfg.MinUptime)
+
+	case stats.numForwards == 0:
+		candidate.Reason = fmt.Sprintf("no forwards within %v",
+			p.cfg.Period)
+
+	case stats.feesEarned < p.cfg.MinFeesEarned:
+		candidate.Reason = fmt.Sprintf("earned %v in fees within %v, "+
+			"below minimum of %v", stats.feesEarned, p.cfg.Period,
+			p.cfg.MinFeesEarned)
+
+	default:
+		return nil
+	}
+
+	return candidate
+}
+
+// Report returns the outcome of the latest evaluation round, or nil if no
+// evaluation has taken place yet.
+func (p *ChannelPruner) Report() *PruneReport {
+	p.reportMtx.RLock()
+	defer p.reportMtx.RUnlock()
+
+	return p.report
+}
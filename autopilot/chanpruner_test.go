@@ -0,0 +1,265 @@
+package autopilot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// mockCloseChanController is a ChannelController that records the channels
+// it's asked to close.
+type mockCloseChanController struct {
+	mockChanController
+
+	closed []wire.OutPoint
+}
+
+func (m *mockCloseChanController) CloseChannel(chanPoint *wire.OutPoint) error {
+	m.closed = append(m.closed, *chanPoint)
+	return nil
+}
+
+var _ ChannelController = (*mockCloseChanController)(nil)
+
+// pruneTestChannel returns a prunable channel that is uniquely identified by
+// the given index.
+func pruneTestChannel(i int, initiator bool, numHtlcs int,
+	age time.Duration) *PrunableChannel {
+
+	return &PrunableChannel{
+		ChanPoint:       wire.OutPoint{Index: uint32(i)},
+		ChanID:          lnwire.NewShortChanIDFromInt(uint64(i)),
+		Node:            NodeID{byte(i)},
+		Capacity:        btcutil.SatoshiPerBitcoin,
+		IsInitiator:     initiator,
+		NumPendingHTLCs: numHtlcs,
+		Age:             age,
+	}
+}
+
+// TestChannelPrunerEvaluation ensures that the channel pruner only considers
+// channels that have been open, and whose peer's uptime has been tracked, for
+// the entire prune period, identifies channels that are inactive,
+// unprofitable or whose peer has poor uptime, and closes them while
+// respecting the limit on closes per interval. Channels whose peer is offline
+// should only be reported, as they can't be closed cooperatively.
+func TestChannelPrunerEvaluation(t *testing.T) {
+	t.Parallel()
+
+	const (
+		period  = 3 * time.Hour
+		minFees = lnwire.MilliSatoshi(100)
+	)
+
+	var (
+		profitable   = pruneTestChannel(1, true, 0, period)
+		inactive     = pruneTestChannel(2, true, 0, period)
+		offline      = pruneTestChannel(3, true, 0, period)
+		remote       = pruneTestChannel(4, false, 0, period)
+		pending      = pruneTestChannel(5, true, 1, period)
+		unprofitable = pruneTestChannel(6, true, 0, period)
+		flaky        = pruneTestChannel(7, true, 0, period)
+		young        = pruneTestChannel(8, true, 0, period-time.Hour)
+		untracked    = pruneTestChannel(9, true, 0, period)
+		otherChanID  = lnwire.NewShortChanIDFromInt(100)
+	)
+	channels := []*PrunableChannel{
+		profitable, inactive, offline, remote, pending, unprofitable,
+		flaky, young, untracked,
+	}
+
+	forward := func(chanID lnwire.ShortChannelID,
+		fee lnwire.MilliSatoshi) channeldb.ForwardingEvent {
+
+		return channeldb.ForwardingEvent{
+			IncomingChanID: chanID,
+			OutgoingChanID: otherChanID,
+			AmtIn:          10000 + fee,
+			AmtOut:         10000,
+		}
+	}
+	events := []channeldb.ForwardingEvent{
+		forward(profitable.ChanID, 1000),
+		forward(offline.ChanID, 1000),
+		forward(unprofitable.ChanID, 10),
+		forward(flaky.ChanID, 1000),
+	}
+
+	newPruner := func(dryRun bool) (*ChannelPruner,
+		*mockCloseChanController, *time.Time) {
+
+		controller := &mockCloseChanController{}
+		now := time.Unix(1000000, 0)
+
+		// Most peers have been online ever since we first connected to
+		// them, which was exactly one period ago. The offline peer
+		// went offline before the period started, while the flaky
+		// peer has only been online for the last hour out of four.
+		histories := make(map[[33]byte]*channeldb.PeerHistory)
+		for _, channel := range channels {
+			histories[channel.Node] = &channeldb.PeerHistory{
+				FirstSeen:   now.Add(-period),
+				OnlineSince: now.Add(-period),
+			}
+		}
+		histories[offline.Node] = &channeldb.PeerHistory{
+			FlapCount: 2,
+			LastFlap:  now.Add(-2 * period),
+			FirstSeen: now.Add(-3 * period),
+			Uptime:    period,
+		}
+		histories[flaky.Node] = &channeldb.PeerHistory{
+			FlapCount:   3,
+			LastFlap:    now.Add(-time.Hour),
+			FirstSeen:   now.Add(-4 * time.Hour),
+			OnlineSince: now.Add(-time.Hour),
+		}
+		delete(histories, untracked.Node)
+
+		pruner, err := NewChannelPruner(&PrunerConfig{
+			Ticker:               ticker.MockNew(time.Hour),
+			Period:               period,
+			MinUptime:            0.5,
+			MinFeesEarned:        minFees,
+			MaxClosesPerInterval: 2,
+			DryRun:               dryRun,
+			FetchChannels: func() ([]*PrunableChannel, error) {
+				return channels, nil
+			},
+			ForwardingEvents: func(start, end time.Time) (
+				[]channeldb.ForwardingEvent, error) {
+
+				return events, nil
+			},
+			FetchPeerHistories: func() (
+				map[[33]byte]*channeldb.PeerHistory, error) {
+
+				return histories, nil
+			},
+			ChanController: controller,
+			Now: func() time.Time {
+				return now
+			},
+		})
+		if err != nil {
+			t.Fatalf("unable to create pruner: %v", err)
+		}
+
+		return pruner, controller, &now
+	}
+
+	pruner, controller, now := newPruner(false)
+	if err := pruner.evaluateChannels(); err != nil {
+		t.Fatalf("unable to evaluate channels: %v", err)
+	}
+
+	// The channel that earned enough fees, the one opened by the remote
+	// party, the one that hasn't been open for the entire period and the
+	// one whose peer has no history should be kept. Of the remaining
+	// channels, only the first two whose peer is online and that have no
+	// pending HTLCs should be closed.
+	expCandidates := []struct {
+		channel *PrunableChannel
+		closed  bool
+	}{
+		{inactive, true},
+		{offline, false},
+		{pending, false},
+		{unprofitable, true},
+		{flaky, false},
+	}
+	report := pruner.Report()
+	if len(report.Candidates) != len(expCandidates) {
+		t.Fatalf("expected %d candidates, got %d",
+			len(expCandidates), len(report.Candidates))
+	}
+	for i, exp := range expCandidates {
+		candidate := report.Candidates[i]
+		if candidate.ChanPoint != exp.channel.ChanPoint {
+			t.Fatalf("expected candidate %v, got %v",
+				exp.channel.ChanPoint, candidate.ChanPoint)
+		}
+		if candidate.Closed != exp.closed {
+			t.Fatalf("expected %v closed=%v, got %v",
+				candidate.ChanPoint, exp.closed,
+				candidate.Closed)
+		}
+		if !candidate.Closed && candidate.CloseError == "" {
+			t.Fatalf("expected reason for not closing %v",
+				candidate.ChanPoint)
+		}
+	}
+	if report.Candidates[1].Uptime != 0 {
+		t.Fatalf("expected uptime 0 for offline peer, got %v",
+			report.Candidates[1].Uptime)
+	}
+	if report.Candidates[3].FeesEarned != 10 {
+		t.Fatalf("expected 10 msat fees earned, got %v",
+			report.Candidates[3].FeesEarned)
+	}
+	if report.Candidates[4].Uptime != 0.25 {
+		t.Fatalf("expected uptime 0.25 for flaky peer, got %v",
+			report.Candidates[4].Uptime)
+	}
+
+	expClosed := []wire.OutPoint{inactive.ChanPoint, unprofitable.ChanPoint}
+	if len(controller.closed) != len(expClosed) {
+		t.Fatalf("expected %d closed channels, got %d",
+			len(expClosed), len(controller.closed))
+	}
+	for i, chanPoint := range expClosed {
+		if controller.closed[i] != chanPoint {
+			t.Fatalf("expected %v to be closed, got %v",
+				chanPoint, controller.closed[i])
+		}
+	}
+
+	// After another interval, the channels already being closed shouldn't
+	// be closed again, leaving room for the channel with the flaky peer
+	// to be closed. The channel whose peer is offline still shouldn't be
+	// closed.
+	*now = now.Add(time.Hour)
+	if err := pruner.evaluateChannels(); err != nil {
+		t.Fatalf("unable to evaluate channels: %v", err)
+	}
+	expClosed = append(expClosed, flaky.ChanPoint)
+	if len(controller.closed) != len(expClosed) {
+		t.Fatalf("expected %d closed channels, got %d",
+			len(expClosed), len(controller.closed))
+	}
+	if controller.closed[2] != flaky.ChanPoint {
+		t.Fatalf("expected %v to be closed, got %v",
+			flaky.ChanPoint, controller.closed[2])
+	}
+
+	// In dry-run mode, the same candidates should be reported, but none
+	// of them closed.
+	pruner, controller, _ = newPruner(true)
+	if err := pruner.evaluateChannels(); err != nil {
+		t.Fatalf("unable to evaluate channels: %v", err)
+	}
+
+	report = pruner.Report()
+	if !report.DryRun {
+		t.Fatalf("expected dry-run report")
+	}
+	if len(report.Candidates) != len(expCandidates) {
+		t.Fatalf("expected %d candidates, got %d",
+			len(expCandidates), len(report.Candidates))
+	}
+	for _, candidate := range report.Candidates {
+		if candidate.Closed {
+			t.Fatalf("channel %v closed in dry-run mode",
+				candidate.ChanPoint)
+		}
+	}
+	if len(controller.closed) != 0 {
+		t.Fatalf("expected no closed channels, got %d",
+			len(controller.closed))
+	}
+}
@@ -0,0 +1,315 @@
+package autopilot
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// weightSumEpsilon is the tolerance we allow when checking that the weights
+// of a combined heuristic sum to 1.0, to account for rounding errors.
+const weightSumEpsilon = 1e-6
+
+// WeightedHeuristic is a tuple that associates a weight to an
+// AttachmentHeuristic. This is used to determining a node's final score when
+// querying several heuristics for scores.
+type WeightedHeuristic struct {
+	// Weight is this AttachmentHeuristic's relative weight factor. It
+	// should be between 0.0 and 1.0.
+	Weight float64
+
+	AttachmentHeuristic
+}
+
+// WeightedCombAttachment is an implementation of the AttachmentHeuristic
+// interface that combines the scores given by several sub-heuristics into one.
+// The scores of each sub-heuristic are normalized to the range [0.0, 1.0]
+// before being weighted, such that heuristics using different scales can be
+// blended. The weights may be modified at runtime.
+type WeightedCombAttachment struct {
+	constraints *HeuristicConstraints
+
+	heuristics []*WeightedHeuristic
+
+	sync.RWMutex
+}
+
+// NewWeightedCombAttachment creates a new instance of a WeightedCombAttachment
+// given the constraints the created channels must adhere to, and the weighted
+// sub-heuristics to combine. The weights must sum to 1.0.
+func NewWeightedCombAttachment(cfg *HeuristicConstraints,
+	h ...*WeightedHeuristic) (*WeightedCombAttachment, error) {
+
+	weights := make(map[string]float64, len(h))
+	for _, heuristic := range h {
+		if _, ok := weights[heuristic.Name()]; ok {
+			return nil, fmt.Errorf("heuristic %v specified more "+
+				"than once", heuristic.Name())
+		}
+		weights[heuristic.Name()] = heuristic.Weight
+	}
+	if err := validateWeights(weights); err != nil {
+		return nil, err
+	}
+
+	return &WeightedCombAttachment{
+		constraints: cfg,
+		heuristics:  h,
+	}, nil
+}
+
+// A compile time assertion to ensure WeightedCombAttachment meets the
+// AttachmentHeuristic and ScoreSettable interfaces.
+var _ AttachmentHeuristic = (*WeightedCombAttachment)(nil)
+var _ ScoreSettable = (*WeightedCombAttachment)(nil)
+
+// Name returns the name of this heuristic.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (c *WeightedCombAttachment) Name() string {
+	return "weightedcomb"
+}
+
+// Weights returns the current weight of each of the combined sub-heuristics,
+// keyed by their names.
+func (c *WeightedCombAttachment) Weights() map[string]float64 {
+	c.RLock()
+	defer c.RUnlock()
+
+	weights := make(map[string]float64, len(c.heuristics))
+	for _, h := range c.heuristics {
+		weights[h.Name()] = h.Weight
+	}
+
+	return weights
+}
+
+// SetWeights replaces the weights of the combined sub-heuristics. Each of the
+// given names must identify one of the sub-heuristics, and the weights must
+// sum to 1.0. Sub-heuristics that aren't found among the weights are given a
+// weight of zero, deactivating them.
+func (c *WeightedCombAttachment) SetWeights(weights map[string]float64) error {
+	if err := validateWeights(weights); err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	known := make(map[string]struct{}, len(c.heuristics))
+	for _, h := range c.heuristics {
+		known[h.Name()] = struct{}{}
+	}
+	for name := range weights {
+		if _, ok := known[name]; !ok {
+			return fmt.Errorf("unknown heuristic %v", name)
+		}
+	}
+
+	for _, h := range c.heuristics {
+		h.Weight = weights[h.Name()]
+	}
+
+	log.Infof("Autopilot heuristic weights set to %v", weights)
+
+	return nil
+}
+
+// NeedMoreChans is a predicate that should return true if, given the passed
+// parameters, and its internal state, more channels should be opened within
+// the channel graph. If the heuristic decides that we do indeed need more
+// channels, then the second argument returned will represent the amount of
+// additional funds to be used towards creating channels.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (c *WeightedCombAttachment) NeedMoreChans(channels []Channel,
+	funds btcutil.Amount) (btcutil.Amount, uint32, bool) {
+
+	// We'll try to open more channels as long as the constraints allow it.
+	availableFunds, availableChans := c.constraints.availableChans(
+		channels, funds,
+	)
+	return availableFunds, availableChans, availableChans > 0
+}
+
+// NodeScores is a method that given the current channel graph, current set of
+// local channels and funds available, scores the given nodes according the the
+// preference of opening a channel with them.
+//
+// The scores of each sub-heuristic with a non-zero weight are first
+// normalized by the maximum score it gave, and then combined as a weighted
+// sum. As the weights sum to 1.0, the returned scores will be in the range
+// [0.0, 1.0].
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (c *WeightedCombAttachment) NodeScores(g ChannelGraph, chans []Channel,
+	fundsAvailable btcutil.Amount, nodes map[NodeID]struct{}) (
+	map[NodeID]*AttachmentDirective, error) {
+
+	c.RLock()
+	defer c.RUnlock()
+
+	combined := make(map[NodeID]*AttachmentDirective)
+	for _, h := range c.heuristics {
+		if h.Weight == 0 {
+			continue
+		}
+
+		scores, err := h.NodeScores(g, chans, fundsAvailable, nodes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get scores from "+
+				"heuristic %v: %v", h.Name(), err)
+		}
+
+		var maxScore float64
+		for _, directive := range scores {
+			if directive.Score > maxScore {
+				maxScore = directive.Score
+			}
+		}
+		if maxScore == 0 {
+			continue
+		}
+
+		log.Tracef("Heuristic %v scored %d nodes", h.Name(),
+			len(scores))
+
+		for nID, directive := range scores {
+			score := h.Weight * directive.Score / maxScore
+
+			// If another heuristic already scored this node,
+			// we'll just add to its score, as the channel size
+			// and addresses are the same.
+			if d, ok := combined[nID]; ok {
+				d.Score += score
+				continue
+			}
+
+			combined[nID] = &AttachmentDirective{
+				NodeID:  directive.NodeID,
+				ChanAmt: directive.ChanAmt,
+				Addrs:   directive.Addrs,
+				Score:   score,
+			}
+		}
+	}
+
+	return combined, nil
+}
+
+// SetNodeScores is used to set the internal map from NodeIDs to scores. The
+// passed scores must be in the range [0, 1.0]. The scores are forwarded to
+// each of the sub-heuristics that can be targeted, and the returned boolean
+// indicates whether any of them was the targeted heuristic.
+//
+// NOTE: This is a part of the ScoreSettable interface.
+func (c *WeightedCombAttachment) SetNodeScores(targetHeuristic string,
+	newScores map[NodeID]float64) (bool, error) {
+
+	c.RLock()
+	defer c.RUnlock()
+
+	var found bool
+	for _, h := range c.heuristics {
+		s, ok := h.AttachmentHeuristic.(ScoreSettable)
+		if !ok {
+			continue
+		}
+
+		applied, err := s.SetNodeScores(targetHeuristic, newScores)
+		if err != nil {
+			return false, err
+		}
+
+		found = found || applied
+	}
+
+	return found, nil
+}
+
+// subHeuristics returns the sub-heuristics combined by this heuristic.
+func (c *WeightedCombAttachment) subHeuristics() []AttachmentHeuristic {
+	c.RLock()
+	defer c.RUnlock()
+
+	heuristics := make([]AttachmentHeuristic, 0, len(c.heuristics))
+	for _, h := range c.heuristics {
+		heuristics = append(heuristics, h.AttachmentHeuristic)
+	}
+
+	return heuristics
+}
+
+// validateWeights ensures that each of the given weights is within the range
+// [0.0, 1.0], and that they sum to 1.0.
+func validateWeights(weights map[string]float64) error {
+	var sum float64
+	for name, weight := range weights {
+		if math.IsNaN(weight) || weight < 0 || weight > 1.0 {
+			return fmt.Errorf("weight %v of heuristic %v is not "+
+				"in the range [0, 1.0]", weight, name)
+		}
+		sum += weight
+	}
+
+	if math.Abs(sum-1.0) > weightSumEpsilon {
+		return fmt.Errorf("sum of heuristic weights must be 1.0, "+
+			"was %v", sum)
+	}
+
+	return nil
+}
+
+// availableHeuristics returns a new instance of each of the heuristics that
+// can be combined by the autopilot agent, all adhering to the given
+// constraints.
+func availableHeuristics(cfg *HeuristicConstraints) []AttachmentHeuristic {
+	return []AttachmentHeuristic{
+		NewConstrainedPrefAttachment(cfg),
+		NewBetweennessCentrality(cfg),
+		NewExternalScoreAttachment(cfg),
+		NewRandomAttachment(cfg),
+	}
+}
+
+// AvailableHeuristics returns the sorted names of all heuristics that can be
+// combined by the autopilot agent.
+func AvailableHeuristics() []string {
+	var names []string
+	for _, h := range availableHeuristics(&HeuristicConstraints{}) {
+		names = append(names, h.Name())
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// NewCombinedHeuristic creates a WeightedCombAttachment combining all
+// available heuristics using the given weights, keyed by the names of the
+// heuristics. Heuristics that aren't found among the weights are given a
+// weight of zero, which allows activating them at runtime using SetWeights.
+func NewCombinedHeuristic(cfg *HeuristicConstraints,
+	weights map[string]float64) (*WeightedCombAttachment, error) {
+
+	heuristics := availableHeuristics(cfg)
+
+	known := make(map[string]struct{}, len(heuristics))
+	weighted := make([]*WeightedHeuristic, 0, len(heuristics))
+	for _, h := range heuristics {
+		known[h.Name()] = struct{}{}
+		weighted = append(weighted, &WeightedHeuristic{
+			Weight:              weights[h.Name()],
+			AttachmentHeuristic: h,
+		})
+	}
+	for name := range weights {
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("unknown heuristic %v", name)
+		}
+	}
+
+	return NewWeightedCombAttachment(cfg, weighted...)
+}
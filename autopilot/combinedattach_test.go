@@ -0,0 +1,192 @@
+package autopilot
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// TestWeightedCombAttachmentWeights ensures that the weights of a combined
+// heuristic are validated both on creation and when modified.
+func TestWeightedCombAttachmentWeights(t *testing.T) {
+	t.Parallel()
+
+	constraints := &HeuristicConstraints{}
+
+	invalidWeights := []map[string]float64{
+		// An unknown heuristic.
+		{"unknown": 1.0},
+
+		// Weights not summing to 1.0.
+		{"preferential": 0.5},
+		{"preferential": 0.5, "betweenness": 0.6},
+
+		// A negative weight.
+		{"preferential": 1.5, "betweenness": -0.5},
+
+		// A weight that isn't a number.
+		{"preferential": 1.0, "betweenness": math.NaN()},
+		{"preferential": math.NaN()},
+	}
+	for _, weights := range invalidWeights {
+		_, err := NewCombinedHeuristic(constraints, weights)
+		if err == nil {
+			t.Fatalf("expected weights %v to be rejected", weights)
+		}
+	}
+
+	heuristic, err := NewCombinedHeuristic(
+		constraints, map[string]float64{"preferential": 1.0},
+	)
+	if err != nil {
+		t.Fatalf("unable to create heuristic: %v", err)
+	}
+
+	// All available heuristics should be part of the combination, with
+	// the ones not specified being deactivated.
+	expWeights := map[string]float64{
+		"preferential":  1.0,
+		"betweenness":   0,
+		"externalscore": 0,
+		"random":        0,
+	}
+	if !reflect.DeepEqual(heuristic.Weights(), expWeights) {
+		t.Fatalf("expected weights %v, got %v", expWeights,
+			heuristic.Weights())
+	}
+
+	for _, weights := range invalidWeights {
+		if err := heuristic.SetWeights(weights); err == nil {
+			t.Fatalf("expected weights %v to be rejected", weights)
+		}
+	}
+
+	newWeights := map[string]float64{
+		"betweenness": 0.3,
+		"random":      0.7,
+	}
+	if err := heuristic.SetWeights(newWeights); err != nil {
+		t.Fatalf("unable to set weights: %v", err)
+	}
+	expWeights = map[string]float64{
+		"preferential":  0,
+		"betweenness":   0.3,
+		"externalscore": 0,
+		"random":        0.7,
+	}
+	if !reflect.DeepEqual(heuristic.Weights(), expWeights) {
+		t.Fatalf("expected weights %v, got %v", expWeights,
+			heuristic.Weights())
+	}
+}
+
+// TestWeightedCombAttachmentNodeScores ensures that the combined heuristic
+// blends the normalized scores of its sub-heuristics according to their
+// weights, and that external scores are forwarded to the targeted
+// sub-heuristic.
+func TestWeightedCombAttachmentNodeScores(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxChanSize = btcutil.Amount(btcutil.SatoshiPerBitcoin)
+		walletFunds = btcutil.SatoshiPerBitcoin * 10
+	)
+
+	constraints := &HeuristicConstraints{
+		MaxChanSize: maxChanSize,
+		ChanLimit:   3,
+		Allocation:  0.5,
+	}
+
+	graph := newMemChannelGraph()
+
+	// We'll create the path a - b - c, such that only b has a non-zero
+	// betweenness centrality.
+	pubs := pathGraph(t, graph, 3)
+	a, b, c := NewNodeID(pubs[0]), NewNodeID(pubs[1]), NewNodeID(pubs[2])
+	nodes := map[NodeID]struct{}{
+		a: {}, b: {}, c: {},
+	}
+
+	heuristic, err := NewCombinedHeuristic(
+		constraints, map[string]float64{
+			"betweenness":   0.5,
+			"externalscore": 0.5,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to create heuristic: %v", err)
+	}
+
+	// Only targeting the external score heuristic should succeed.
+	externalScores := map[NodeID]float64{
+		a: 0.2,
+	}
+	found, err := heuristic.SetNodeScores("betweenness", externalScores)
+	if err != nil {
+		t.Fatalf("unable to set scores: %v", err)
+	}
+	if found {
+		t.Fatalf("betweenness heuristic shouldn't accept scores")
+	}
+	found, err = heuristic.SetNodeScores("externalscore", externalScores)
+	if err != nil {
+		t.Fatalf("unable to set scores: %v", err)
+	}
+	if !found {
+		t.Fatalf("external score heuristic should accept scores")
+	}
+
+	// Scores outside of the range [0, 1.0], or that aren't a number,
+	// should be rejected.
+	for _, score := range []float64{2, -1, math.NaN()} {
+		_, err = heuristic.SetNodeScores(
+			"externalscore", map[NodeID]float64{a: score},
+		)
+		if err == nil {
+			t.Fatalf("expected invalid score %v to be rejected",
+				score)
+		}
+	}
+
+	// As the scores of each heuristic are normalized, a should get the
+	// full weight of the external score heuristic, and b the full weight
+	// of the betweenness heuristic.
+	candidates, err := heuristic.NodeScores(graph, nil, walletFunds, nodes)
+	if err != nil {
+		t.Fatalf("unable to get node scores: %v", err)
+	}
+	expScores := map[NodeID]float64{
+		a: 0.5,
+		b: 0.5,
+	}
+	scores := make(map[NodeID]float64)
+	for nID, candidate := range candidates {
+		scores[nID] = candidate.Score
+	}
+	if !reflect.DeepEqual(scores, expScores) {
+		t.Fatalf("expected scores %v, got %v", expScores, scores)
+	}
+
+	// If we switch to the random heuristic entirely, all nodes should be
+	// given the same score.
+	err = heuristic.SetWeights(map[string]float64{"random": 1.0})
+	if err != nil {
+		t.Fatalf("unable to set weights: %v", err)
+	}
+	candidates, err = heuristic.NodeScores(graph, nil, walletFunds, nodes)
+	if err != nil {
+		t.Fatalf("unable to get node scores: %v", err)
+	}
+	if len(candidates) != len(nodes) {
+		t.Fatalf("expected %d candidates, got %d", len(nodes),
+			len(candidates))
+	}
+	for _, candidate := range candidates {
+		if candidate.Score != 1.0 {
+			t.Fatalf("expected score 1.0, got %v", candidate.Score)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package autopilot
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// ExternalScoreAttachment is an implementation of the AttachmentHeuristic
+// interface that allows an external source to provide it with node scores.
+// This makes it possible to steer the autopilot agent using analytics that
+// aren't available within lnd itself.
+type ExternalScoreAttachment struct {
+	constraints *HeuristicConstraints
+
+	// nodeScores is the set of scores most recently set externally. The
+	// scores aren't persisted, so they must be set again after a restart.
+	nodeScores map[NodeID]float64
+
+	sync.Mutex
+}
+
+// NewExternalScoreAttachment creates a new instance of an
+// ExternalScoreAttachment given the constraints the created channels must
+// adhere to.
+func NewExternalScoreAttachment(
+	cfg *HeuristicConstraints) *ExternalScoreAttachment {
+
+	return &ExternalScoreAttachment{
+		constraints: cfg,
+	}
+}
+
+// A compile time assertion to ensure ExternalScoreAttachment meets the
+// AttachmentHeuristic and ScoreSettable interfaces.
+var _ AttachmentHeuristic = (*ExternalScoreAttachment)(nil)
+var _ ScoreSettable = (*ExternalScoreAttachment)(nil)
+
+// Name returns the name of this heuristic.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (s *ExternalScoreAttachment) Name() string {
+	return "externalscore"
+}
+
+// SetNodeScores is used to set the internal map from NodeIDs to scores. The
+// passed scores must be in the range [0, 1.0]. The first parameter is the
+// name of the targeted heuristic, to allow recursively targeting specific
+// sub-heuristics. The returned boolean indicates whether the targeted
+// heuristic was found.
+//
+// NOTE: This is a part of the ScoreSettable interface.
+func (s *ExternalScoreAttachment) SetNodeScores(targetHeuristic string,
+	newScores map[NodeID]float64) (bool, error) {
+
+	// Return if this heuristic wasn't targeted.
+	if targetHeuristic != s.Name() {
+		return false, nil
+	}
+
+	// Since there is a requirement that all score are in the range [0,
+	// 1.0], we validate them before setting the internal list.
+	for nID, score := range newScores {
+		if math.IsNaN(score) || score < 0 || score > 1.0 {
+			return false, fmt.Errorf("invalid score %v for "+
+				"nodeID %x", score, nID[:])
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.nodeScores = newScores
+	log.Tracef("Setting %v external scores", len(s.nodeScores))
+
+	return true, nil
+}
+
+// NeedMoreChans is a predicate that should return true if, given the passed
+// parameters, and its internal state, more channels should be opened within
+// the channel graph. If the heuristic decides that we do indeed need more
+// channels, then the second argument returned will represent the amount of
+// additional funds to be used towards creating channels.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (s *ExternalScoreAttachment) NeedMoreChans(channels []Channel,
+	funds btcutil.Amount) (btcutil.Amount, uint32, bool) {
+
+	// We'll try to open more channels as long as the constraints allow it.
+	availableFunds, availableChans := s.constraints.availableChans(
+		channels, funds,
+	)
+	return availableFunds, availableChans, availableChans > 0
+}
+
+// NodeScores is a method that given the current channel graph, current set of
+// local channels and funds available, scores the given nodes according the the
+// preference of opening a channel with them.
+//
+// The scores returned are the ones most recently set externally, in the range
+// [0.0, 1.0]. Nodes that haven't been given a score are implicitly given a
+// score of zero.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (s *ExternalScoreAttachment) NodeScores(g ChannelGraph, chans []Channel,
+	fundsAvailable btcutil.Amount, nodes map[NodeID]struct{}) (
+	map[NodeID]*AttachmentDirective, error) {
+
+	s.Lock()
+	scores := make(map[NodeID]float64)
+	for nID := range nodes {
+		if score, ok := s.nodeScores[nID]; ok {
+			scores[nID] = score
+		}
+	}
+	s.Unlock()
+
+	// If none of the nodes have been given a score, there's no need to
+	// traverse the graph.
+	if len(scores) == 0 {
+		return nil, nil
+	}
+
+	scored := make(map[NodeID]struct{}, len(scores))
+	for nID := range scores {
+		scored[nID] = struct{}{}
+	}
+	addrs, err := nodeAddrs(g, scored)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.constraints.candidateDirectives(
+		chans, fundsAvailable, scores, addrs,
+	), nil
+}
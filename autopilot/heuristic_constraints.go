@@ -1,6 +1,8 @@
 package autopilot
 
 import (
+	"net"
+
 	"github.com/btcsuite/btcutil"
 )
 
@@ -74,3 +76,84 @@ func (h *HeuristicConstraints) availableChans(channels []Channel,
 	fundsAvailable := targetAllocation - totalChanAllocation
 	return fundsAvailable, numAdditionalChans
 }
+
+// chanSize returns the size of the channel the autopilot agent should create
+// given the amount of funds available. Zero is returned if the funds don't
+// suffice for a channel adhering to the constraints.
+func (h *HeuristicConstraints) chanSize(
+	fundsAvailable btcutil.Amount) btcutil.Amount {
+
+	// As channel size we'll use the maximum channel size available.
+	chanSize := h.MaxChanSize
+	if fundsAvailable-chanSize < 0 {
+		chanSize = fundsAvailable
+	}
+
+	if chanSize <= 0 || chanSize < h.MinChanSize {
+		return 0
+	}
+
+	return chanSize
+}
+
+// candidateDirectives turns the given set of node scores into attachment
+// directives adhering to the constraints. Nodes we already have a channel
+// with, nodes without any known addresses and nodes without a positive score
+// are skipped, which implicitly gives them a score of zero.
+func (h *HeuristicConstraints) candidateDirectives(chans []Channel,
+	fundsAvailable btcutil.Amount, scores map[NodeID]float64,
+	addrs map[NodeID][]net.Addr) map[NodeID]*AttachmentDirective {
+
+	// If the amount is too small, we don't want to attempt opening any
+	// channel at all.
+	chanSize := h.chanSize(fundsAvailable)
+	if chanSize == 0 {
+		return nil
+	}
+
+	existingPeers := make(map[NodeID]struct{})
+	for _, c := range chans {
+		existingPeers[c.Node] = struct{}{}
+	}
+
+	candidates := make(map[NodeID]*AttachmentDirective)
+	for nID, score := range scores {
+		if _, ok := existingPeers[nID]; ok {
+			continue
+		}
+		if len(addrs[nID]) == 0 || score <= 0 {
+			continue
+		}
+
+		candidates[nID] = &AttachmentDirective{
+			NodeID:  nID,
+			ChanAmt: chanSize,
+			Addrs:   addrs[nID],
+			Score:   score,
+		}
+	}
+
+	return candidates
+}
+
+// nodeAddrs returns the known addresses of each of the given nodes within the
+// channel graph.
+func nodeAddrs(g ChannelGraph,
+	nodes map[NodeID]struct{}) (map[NodeID][]net.Addr, error) {
+
+	addrs := make(map[NodeID][]net.Addr)
+	err := g.ForEachNode(func(n Node) error {
+		nID := NodeID(n.PubKey())
+		if _, ok := nodes[nID]; !ok {
+			return nil
+		}
+
+		addrs[nID] = n.Addrs()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return addrs, nil
+}
@@ -111,6 +111,11 @@ type AttachmentDirective struct {
 // the interface is to allow an auto-pilot agent to decide if it needs more
 // channels, and if so, which exact channels should be opened.
 type AttachmentHeuristic interface {
+	// Name returns the name of this heuristic. The name is used to
+	// identify the heuristic when combining it with others, or when
+	// targeting it externally.
+	Name() string
+
 	// NeedMoreChans is a predicate that should return true if, given the
 	// passed parameters, and its internal state, more channels should be
 	// opened within the channel graph. If the heuristic decides that we do
@@ -140,6 +145,17 @@ type AttachmentHeuristic interface {
 		map[NodeID]*AttachmentDirective, error)
 }
 
+// ScoreSettable is an interface that indicates that the scores returned by the
+// heuristic can be mutated by an external caller.
+type ScoreSettable interface {
+	// SetNodeScores is used to set the internal map from NodeIDs to
+	// scores. The passed scores must be in the range [0, 1.0]. The first
+	// parameter is the name of the targeted heuristic, to allow
+	// recursively targeting specific sub-heuristics. The returned boolean
+	// indicates whether the targeted heuristic was found.
+	SetNodeScores(string, map[NodeID]float64) (bool, error)
+}
+
 // ChannelController is a simple interface that allows an auto-pilot agent to
 // open a channel within the graph to a target peer, close targeted channels,
 // or add/remove funds from existing channels via a splice in/out mechanisms.
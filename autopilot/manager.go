@@ -1,6 +1,8 @@
 package autopilot
 
 import (
+	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
 
@@ -10,6 +12,16 @@ import (
 	"github.com/lightningnetwork/lnd/routing"
 )
 
+// ErrHeuristicNotCombined is returned when attempting to query or modify the
+// weights of the agent's heuristics, while the agent isn't configured with a
+// WeightedCombAttachment heuristic.
+var ErrHeuristicNotCombined = errors.New("autopilot heuristic is not a " +
+	"weighted combination of heuristics")
+
+// HeuristicScores is a map that maps the names of heuristics to the scores
+// they give to a set of nodes.
+type HeuristicScores map[string]map[NodeID]float64
+
 // ManagerCfg houses a set of values and methods that is passed to the Manager
 // for it to properly manage its autopilot agent.
 type ManagerCfg struct {
@@ -32,6 +44,11 @@ type ManagerCfg struct {
 	// SubscribeTopology is used to get a subscription for topology changes
 	// on the network.
 	SubscribeTopology func() (*routing.TopologyClient, error)
+
+	// PrunerCfg is the config of the channel pruner that is run alongside
+	// the autopilot agent, closing inactive and unprofitable channels. If
+	// nil, channels won't be pruned.
+	PrunerCfg *PrunerConfig
 }
 
 // Manager is struct that manages an autopilot agent, making it possible to
@@ -48,6 +65,11 @@ type Manager struct {
 	// disabled.
 	pilot *Agent
 
+	// pruner is the channel pruner run alongside the current autopilot
+	// agent. A reference is kept after the agent is disabled, such that
+	// its latest report remains available.
+	pruner *ChannelPruner
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 	sync.Mutex
@@ -139,6 +161,22 @@ func (m *Manager) StartAgent() error {
 		return err
 	}
 
+	// If channel pruning is enabled, we'll launch a new channel pruner
+	// alongside the agent.
+	if m.cfg.PrunerCfg != nil {
+		pruner, err := NewChannelPruner(m.cfg.PrunerCfg)
+		if err == nil {
+			err = pruner.Start()
+		}
+		if err != nil {
+			graphSubscription.Cancel()
+			txnSubscription.Cancel()
+			pilot.Stop()
+			return err
+		}
+		m.pruner = pruner
+	}
+
 	m.pilot = pilot
 
 	// We'll launch a goroutine to provide the agent with notifications
@@ -259,6 +297,12 @@ func (m *Manager) StopAgent() error {
 		return err
 	}
 
+	if m.pruner != nil {
+		if err := m.pruner.Stop(); err != nil {
+			return err
+		}
+	}
+
 	// Make sure to nil the current agent, indicating it is no longer
 	// active.
 	m.pilot = nil
@@ -267,3 +311,171 @@ func (m *Manager) StopAgent() error {
 
 	return nil
 }
+
+// combinedHeuristic returns the heuristic of the autopilot agent as a
+// WeightedCombAttachment, if it is one.
+func (m *Manager) combinedHeuristic() (*WeightedCombAttachment, error) {
+	h, ok := m.cfg.PilotCfg.Heuristic.(*WeightedCombAttachment)
+	if !ok {
+		return nil, ErrHeuristicNotCombined
+	}
+
+	return h, nil
+}
+
+// HeuristicWeights returns the current weights of the heuristics combined by
+// the autopilot agent, keyed by their names.
+func (m *Manager) HeuristicWeights() (map[string]float64, error) {
+	h, err := m.combinedHeuristic()
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Weights(), nil
+}
+
+// SetHeuristicWeights modifies the weights of the heuristics combined by the
+// autopilot agent. The change takes effect immediately, also for an already
+// active agent.
+func (m *Manager) SetHeuristicWeights(weights map[string]float64) error {
+	h, err := m.combinedHeuristic()
+	if err != nil {
+		return err
+	}
+
+	if err := h.SetWeights(weights); err != nil {
+		return err
+	}
+
+	m.notifyHeuristicUpdate(h)
+
+	return nil
+}
+
+// notifyHeuristicUpdate notifies the autopilot agent, if active, that the
+// scores given by its heuristic may have changed.
+func (m *Manager) notifyHeuristicUpdate(h AttachmentHeuristic) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.pilot != nil {
+		m.pilot.OnHeuristicUpdate(h)
+	}
+}
+
+// QueryHeuristics returns the scores the autopilot agent's heuristic would
+// give to the given nodes when opening a channel of the maximum size. If the
+// agent's heuristic is a combination of heuristics, the scores of each
+// sub-heuristic are returned as well. If a heuristic name is given, only the
+// scores of that heuristic are returned. If localState is true, nodes we
+// already have channels with will be given a score of zero, as the agent
+// wouldn't open another channel to them.
+func (m *Manager) QueryHeuristics(nodes []NodeID, localState bool,
+	heuristic string) (HeuristicScores, error) {
+
+	pilotCfg := m.cfg.PilotCfg
+
+	// We'll query the agent's heuristic itself, in addition to each of
+	// its sub-heuristics.
+	heuristics := []AttachmentHeuristic{pilotCfg.Heuristic}
+	if h, ok := pilotCfg.Heuristic.(*WeightedCombAttachment); ok {
+		heuristics = append(heuristics, h.subHeuristics()...)
+	}
+
+	if heuristic != "" {
+		var found bool
+		for _, h := range heuristics {
+			if h.Name() == heuristic {
+				heuristics = []AttachmentHeuristic{h}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("heuristic %v not found",
+				heuristic)
+		}
+	}
+
+	var chans []Channel
+	if localState {
+		var err error
+		chans, err = m.cfg.ChannelState()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nodeSet := make(map[NodeID]struct{}, len(nodes))
+	for _, nID := range nodes {
+		nodeSet[nID] = struct{}{}
+	}
+
+	// As channel size we'll use the maximum channel size, such that the
+	// scores don't depend on the funds currently available.
+	chanSize := pilotCfg.Constraints.MaxChanSize
+
+	report := make(HeuristicScores)
+	for _, h := range heuristics {
+		scores, err := h.NodeScores(
+			pilotCfg.Graph, chans, chanSize, nodeSet,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get scores from "+
+				"heuristic %v: %v", h.Name(), err)
+		}
+
+		// Nodes not found among the scores are implicitly given a
+		// score of zero.
+		report[h.Name()] = make(map[NodeID]float64, len(nodes))
+		for _, nID := range nodes {
+			var score float64
+			if directive, ok := scores[nID]; ok {
+				score = directive.Score
+			}
+			report[h.Name()][nID] = score
+		}
+	}
+
+	return report, nil
+}
+
+// SetNodeScores sets the scores of the given nodes for the heuristic with the
+// given name. The heuristic must accept external scores. If the autopilot
+// agent is active, it will re-evaluate where to open channels.
+func (m *Manager) SetNodeScores(heuristic string,
+	scores map[NodeID]float64) error {
+
+	h, ok := m.cfg.PilotCfg.Heuristic.(ScoreSettable)
+	if !ok {
+		return fmt.Errorf("autopilot heuristic doesn't accept " +
+			"external scores")
+	}
+
+	applied, err := h.SetNodeScores(heuristic, scores)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return fmt.Errorf("heuristic %v not found or doesn't accept "+
+			"external scores", heuristic)
+	}
+
+	m.notifyHeuristicUpdate(m.cfg.PilotCfg.Heuristic)
+
+	return nil
+}
+
+// PruneReport returns the outcome of the latest evaluation round of the
+// channel pruner. Nil is returned if channel pruning is disabled, or no
+// evaluation has taken place yet.
+func (m *Manager) PruneReport() *PruneReport {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.pruner == nil {
+		return nil
+	}
+
+	return m.pruner.Report()
+}
@@ -0,0 +1,109 @@
+package autopilot
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// TestManagerQueryAndSetScores ensures that the Manager reports the scores of
+// the agent's heuristic and each of its sub-heuristics, and that external
+// scores set through it are reflected within those scores.
+func TestManagerQueryAndSetScores(t *testing.T) {
+	t.Parallel()
+
+	constraints := &HeuristicConstraints{
+		MaxChanSize: btcutil.SatoshiPerBitcoin,
+		ChanLimit:   3,
+		Allocation:  0.5,
+	}
+
+	heuristic, err := NewCombinedHeuristic(
+		constraints, map[string]float64{
+			"betweenness":   0.5,
+			"externalscore": 0.5,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to create heuristic: %v", err)
+	}
+
+	// We'll create the path a - b - c, and pretend we already have a
+	// channel with b.
+	graph := newMemChannelGraph()
+	pubs := pathGraph(t, graph, 3)
+	a, b, c := NewNodeID(pubs[0]), NewNodeID(pubs[1]), NewNodeID(pubs[2])
+
+	manager, err := NewManager(&ManagerCfg{
+		PilotCfg: &Config{
+			Heuristic:   heuristic,
+			Graph:       graph,
+			Constraints: constraints,
+		},
+		ChannelState: func() ([]Channel, error) {
+			return []Channel{{Node: b}}, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create manager: %v", err)
+	}
+
+	// Setting scores for a heuristic that doesn't accept them should
+	// fail.
+	scores := map[NodeID]float64{c: 1.0}
+	if err := manager.SetNodeScores("betweenness", scores); err == nil {
+		t.Fatalf("expected setting betweenness scores to fail")
+	}
+	if err := manager.SetNodeScores("externalscore", scores); err != nil {
+		t.Fatalf("unable to set scores: %v", err)
+	}
+
+	nodes := []NodeID{a, b, c}
+	report, err := manager.QueryHeuristics(nodes, false, "")
+	if err != nil {
+		t.Fatalf("unable to query heuristics: %v", err)
+	}
+
+	// The combined heuristic along with each of the available heuristics
+	// should have been queried.
+	if len(report) != len(AvailableHeuristics())+1 {
+		t.Fatalf("expected %d heuristics to be queried, got %d",
+			len(AvailableHeuristics())+1, len(report))
+	}
+
+	expScores := map[string]map[NodeID]float64{
+		"weightedcomb":  {a: 0, b: 0.5, c: 0.5},
+		"betweenness":   {a: 0, b: 1.0, c: 0},
+		"externalscore": {a: 0, b: 0, c: 1.0},
+	}
+	for name, exp := range expScores {
+		for nID, expScore := range exp {
+			if report[name][nID] != expScore {
+				t.Fatalf("expected %v score %v for node %x, "+
+					"got %v", name, expScore, nID[:],
+					report[name][nID])
+			}
+		}
+	}
+
+	// When taking the local channel state into account, b should no
+	// longer be scored, as we already have a channel with it. We'll also
+	// only query a single heuristic.
+	report, err = manager.QueryHeuristics(nodes, true, "betweenness")
+	if err != nil {
+		t.Fatalf("unable to query heuristics: %v", err)
+	}
+	if len(report) != 1 {
+		t.Fatalf("expected a single heuristic to be queried, got %d",
+			len(report))
+	}
+	if report["betweenness"][b] != 0 {
+		t.Fatalf("existing peer should be given a score of zero, "+
+			"got %v", report["betweenness"][b])
+	}
+
+	// Querying an unknown heuristic should fail.
+	if _, err := manager.QueryHeuristics(nodes, false, "foo"); err == nil {
+		t.Fatalf("expected querying unknown heuristic to fail")
+	}
+}
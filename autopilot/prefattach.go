@@ -42,6 +42,13 @@ func NewConstrainedPrefAttachment(
 // AttachmentHeuristic interface.
 var _ AttachmentHeuristic = (*ConstrainedPrefAttachment)(nil)
 
+// Name returns the name of this heuristic.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (p *ConstrainedPrefAttachment) Name() string {
+	return "preferential"
+}
+
 // NeedMoreChans is a predicate that should return true if, given the passed
 // parameters, and its internal state, more channels should be opened within
 // the channel graph. If the heuristic decides that we do indeed need more
@@ -0,0 +1,77 @@
+package autopilot
+
+import (
+	"github.com/btcsuite/btcutil"
+)
+
+// RandomAttachment is an implementation of the AttachmentHeuristic interface
+// that gives every eligible node the same score, such that the autopilot
+// agent chooses uniformly at random among them. It doesn't take the structure
+// of the graph into account at all, and is mostly useful as a baseline to
+// compare other heuristics against, or to add some randomness to a combined
+// heuristic.
+type RandomAttachment struct {
+	constraints *HeuristicConstraints
+}
+
+// NewRandomAttachment creates a new instance of the RandomAttachment heuristic
+// given the constraints the created channels must adhere to.
+func NewRandomAttachment(cfg *HeuristicConstraints) *RandomAttachment {
+	return &RandomAttachment{
+		constraints: cfg,
+	}
+}
+
+// A compile time assertion to ensure RandomAttachment meets the
+// AttachmentHeuristic interface.
+var _ AttachmentHeuristic = (*RandomAttachment)(nil)
+
+// Name returns the name of this heuristic.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (r *RandomAttachment) Name() string {
+	return "random"
+}
+
+// NeedMoreChans is a predicate that should return true if, given the passed
+// parameters, and its internal state, more channels should be opened within
+// the channel graph. If the heuristic decides that we do indeed need more
+// channels, then the second argument returned will represent the amount of
+// additional funds to be used towards creating channels.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (r *RandomAttachment) NeedMoreChans(channels []Channel,
+	funds btcutil.Amount) (btcutil.Amount, uint32, bool) {
+
+	// We'll try to open more channels as long as the constraints allow it.
+	availableFunds, availableChans := r.constraints.availableChans(
+		channels, funds,
+	)
+	return availableFunds, availableChans, availableChans > 0
+}
+
+// NodeScores is a method that given the current channel graph, current set of
+// local channels and funds available, scores the given nodes according the the
+// preference of opening a channel with them.
+//
+// Every node we're able to connect to receives a score of 1.0.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (r *RandomAttachment) NodeScores(g ChannelGraph, chans []Channel,
+	fundsAvailable btcutil.Amount, nodes map[NodeID]struct{}) (
+	map[NodeID]*AttachmentDirective, error) {
+
+	addrs, err := nodeAddrs(g, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[NodeID]float64, len(nodes))
+	for nID := range nodes {
+		scores[nID] = 1.0
+	}
+
+	return r.constraints.candidateDirectives(
+		chans, fundsAvailable, scores, addrs,
+	), nil
+}
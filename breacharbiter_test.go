@@ -1334,15 +1334,16 @@ func createTestArbiter(t *testing.T, contractBreaches chan *ContractBreachEvent,
 	// Assemble our test arbiter.
 	notifier := makeMockSpendNotifier()
 	ba := newBreachArbiter(&BreachConfig{
-		CloseLink:          func(_ *wire.OutPoint, _ htlcswitch.ChannelCloseType) {},
-		DB:                 db,
-		Estimator:          lnwallet.NewStaticFeeEstimator(12500, 0),
-		GenSweepScript:     func() ([]byte, error) { return nil, nil },
-		ContractBreaches:   contractBreaches,
-		Signer:             signer,
-		Notifier:           notifier,
-		PublishTransaction: func(_ *wire.MsgTx) error { return nil },
-		Store:              store,
+		CloseLink:                  func(_ *wire.OutPoint, _ htlcswitch.ChannelCloseType) {},
+		DB:                         db,
+		Estimator:                  lnwallet.NewStaticFeeEstimator(12500, 0),
+		GenSweepScript:             func() ([]byte, error) { return nil, nil },
+		ContractBreaches:           contractBreaches,
+		Signer:                     signer,
+		Notifier:                   notifier,
+		PublishTransaction:         func(_ *wire.MsgTx) error { return nil },
+		Store:                      store,
+		NotifyFullyResolvedChannel: func(wire.OutPoint) {},
 	})
 
 	if err := ba.Start(); err != nil {
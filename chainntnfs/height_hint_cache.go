@@ -6,8 +6,8 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
-	bolt "github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 )
 
 const (
@@ -106,7 +106,7 @@ func NewHeightHintCache(db *channeldb.DB) (*HeightHintCache, error) {
 // initBuckets ensures that the primary buckets used by the circuit are
 // initialized so that we can assume their existence after startup.
 func (c *HeightHintCache) initBuckets() error {
-	return c.db.Update(func(tx *bolt.Tx) error {
+	return c.db.Update(func(tx kvdb.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(spendHintBucket)
 		if err != nil {
 			return err
@@ -125,7 +125,7 @@ func (c *HeightHintCache) CommitSpendHint(height uint32, ops ...wire.OutPoint) e
 
 	Log.Tracef("Updating spend hint to height %d for %v", height, ops)
 
-	return c.db.Batch(func(tx *bolt.Tx) error {
+	return c.db.Batch(func(tx kvdb.Tx) error {
 		spendHints := tx.Bucket(spendHintBucket)
 		if spendHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -158,7 +158,7 @@ func (c *HeightHintCache) CommitSpendHint(height uint32, ops ...wire.OutPoint) e
 // cache for the outpoint.
 func (c *HeightHintCache) QuerySpendHint(op wire.OutPoint) (uint32, error) {
 	var hint uint32
-	err := c.db.View(func(tx *bolt.Tx) error {
+	err := c.db.View(func(tx kvdb.Tx) error {
 		spendHints := tx.Bucket(spendHintBucket)
 		if spendHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -191,7 +191,7 @@ func (c *HeightHintCache) PurgeSpendHint(ops ...wire.OutPoint) error {
 
 	Log.Tracef("Removing spend hints for %v", ops)
 
-	return c.db.Batch(func(tx *bolt.Tx) error {
+	return c.db.Batch(func(tx kvdb.Tx) error {
 		spendHints := tx.Bucket(spendHintBucket)
 		if spendHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -222,7 +222,7 @@ func (c *HeightHintCache) CommitConfirmHint(height uint32, txids ...chainhash.Ha
 
 	Log.Tracef("Updating confirm hints to height %d for %v", height, txids)
 
-	return c.db.Batch(func(tx *bolt.Tx) error {
+	return c.db.Batch(func(tx kvdb.Tx) error {
 		confirmHints := tx.Bucket(confirmHintBucket)
 		if confirmHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -255,7 +255,7 @@ func (c *HeightHintCache) CommitConfirmHint(height uint32, txids ...chainhash.Ha
 // the cache for the transaction hash.
 func (c *HeightHintCache) QueryConfirmHint(txid chainhash.Hash) (uint32, error) {
 	var hint uint32
-	err := c.db.View(func(tx *bolt.Tx) error {
+	err := c.db.View(func(tx kvdb.Tx) error {
 		confirmHints := tx.Bucket(confirmHintBucket)
 		if confirmHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -289,7 +289,7 @@ func (c *HeightHintCache) PurgeConfirmHint(txids ...chainhash.Hash) error {
 
 	Log.Tracef("Removing confirm hints for %v", txids)
 
-	return c.db.Batch(func(tx *bolt.Tx) error {
+	return c.db.Batch(func(tx kvdb.Tx) error {
 		confirmHints := tx.Bucket(confirmHintBucket)
 		if confirmHints == nil {
 			return ErrCorruptedHeightHintCache
@@ -145,20 +145,26 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 	switch registeredChains.PrimaryChain() {
 	case bitcoinChain:
 		cc.routingPolicy = htlcswitch.ForwardingPolicy{
-			MinHTLC:       cfg.Bitcoin.MinHTLC,
-			BaseFee:       cfg.Bitcoin.BaseFee,
-			FeeRate:       cfg.Bitcoin.FeeRate,
-			TimeLockDelta: cfg.Bitcoin.TimeLockDelta,
+			MinHTLC:            cfg.Bitcoin.MinHTLC,
+			BaseFee:            cfg.Bitcoin.BaseFee,
+			FeeRate:            cfg.Bitcoin.FeeRate,
+			TimeLockDelta:      cfg.Bitcoin.TimeLockDelta,
+			MaxForwardAmt:      cfg.Bitcoin.MaxForwardAmt,
+			MaxPendingForwards: cfg.Bitcoin.MaxPendingForwards,
+			MaxCltvDelta:       cfg.Bitcoin.MaxCltvDelta,
 		}
 		cc.feeEstimator = lnwallet.NewStaticFeeEstimator(
 			defaultBitcoinStaticFeePerKW, 0,
 		)
 	case litecoinChain:
 		cc.routingPolicy = htlcswitch.ForwardingPolicy{
-			MinHTLC:       cfg.Litecoin.MinHTLC,
-			BaseFee:       cfg.Litecoin.BaseFee,
-			FeeRate:       cfg.Litecoin.FeeRate,
-			TimeLockDelta: cfg.Litecoin.TimeLockDelta,
+			MinHTLC:            cfg.Litecoin.MinHTLC,
+			BaseFee:            cfg.Litecoin.BaseFee,
+			FeeRate:            cfg.Litecoin.FeeRate,
+			TimeLockDelta:      cfg.Litecoin.TimeLockDelta,
+			MaxForwardAmt:      cfg.Litecoin.MaxForwardAmt,
+			MaxPendingForwards: cfg.Litecoin.MaxPendingForwards,
+			MaxCltvDelta:       cfg.Litecoin.MaxCltvDelta,
 		}
 		cc.feeEstimator = lnwallet.NewStaticFeeEstimator(
 			defaultLitecoinStaticFeePerKW, 0,
@@ -0,0 +1,317 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// accountBucket is the name of the bucket that stores all virtual
+	// accounts. Each account is keyed by its ID, which is a
+	// monotonically increasing uint64 taken from the bucket's sequence.
+	//
+	// maps: accountID => Account
+	accountBucket = []byte("accounts")
+
+	// accountInvoiceBucket is the name of the bucket that indexes the
+	// invoices created on behalf of an account, such that the account
+	// can be credited once the invoice is settled. An invoice's entry is
+	// removed once the account has been credited.
+	//
+	// maps: payHash => accountID
+	accountInvoiceBucket = []byte("account-invoices")
+)
+
+// Account is a virtual account that has its own balance, independent of the
+// balance of the node. Invoices created on behalf of an account credit the
+// account once they're settled, while payments made on behalf of an account
+// are debited from it, including fees.
+type Account struct {
+	// ID uniquely identifies the account.
+	ID uint64
+
+	// Label is an optional description of the account.
+	Label string
+
+	// Balance is the current balance of the account.
+	Balance lnwire.MilliSatoshi
+
+	// CreationDate is the time the account was created.
+	CreationDate time.Time
+}
+
+// AddAccount creates a new account with the given label and initial balance.
+// The created account, along with its newly assigned ID, is returned.
+func (d *DB) AddAccount(label string,
+	balance lnwire.MilliSatoshi) (*Account, error) {
+
+	account := &Account{
+		Label:        label,
+		Balance:      balance,
+		CreationDate: time.Unix(0, time.Now().UnixNano()),
+	}
+	err := d.Update(func(tx kvdb.Tx) error {
+		accounts, err := tx.CreateBucketIfNotExists(accountBucket)
+		if err != nil {
+			return err
+		}
+
+		account.ID, err = accounts.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		return putAccount(accounts, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// FetchAccount returns the account with the given ID. If the account doesn't
+// exist, ErrAccountNotFound is returned.
+func (d *DB) FetchAccount(id uint64) (*Account, error) {
+	var account *Account
+	err := d.View(func(tx kvdb.Tx) error {
+		accounts := tx.Bucket(accountBucket)
+		if accounts == nil {
+			return ErrAccountNotFound
+		}
+
+		var err error
+		account, err = fetchAccount(accounts, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// FetchAllAccounts returns all accounts, ordered by their ID.
+func (d *DB) FetchAllAccounts() ([]*Account, error) {
+	var accounts []*Account
+	err := d.View(func(tx kvdb.Tx) error {
+		accountsBucket := tx.Bucket(accountBucket)
+		if accountsBucket == nil {
+			return nil
+		}
+
+		return accountsBucket.ForEach(func(k, v []byte) error {
+			account, err := deserializeAccount(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			account.ID = byteOrder.Uint64(k)
+
+			accounts = append(accounts, account)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// CreditAccount adds the given amount to the balance of the account with the
+// given ID, returning the updated account.
+func (d *DB) CreditAccount(id uint64,
+	amt lnwire.MilliSatoshi) (*Account, error) {
+
+	var account *Account
+	err := d.Update(func(tx kvdb.Tx) error {
+		accounts := tx.Bucket(accountBucket)
+		if accounts == nil {
+			return ErrAccountNotFound
+		}
+
+		var err error
+		account, err = creditAccount(accounts, id, amt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// DebitAccount subtracts the given amount from the balance of the account
+// with the given ID, returning the updated account. If the balance of the
+// account is insufficient, ErrAccountInsufficientBalance is returned and the
+// balance is left untouched.
+func (d *DB) DebitAccount(id uint64,
+	amt lnwire.MilliSatoshi) (*Account, error) {
+
+	var account *Account
+	err := d.Update(func(tx kvdb.Tx) error {
+		accounts := tx.Bucket(accountBucket)
+		if accounts == nil {
+			return ErrAccountNotFound
+		}
+
+		var err error
+		account, err = fetchAccount(accounts, id)
+		if err != nil {
+			return err
+		}
+
+		if amt > account.Balance {
+			return ErrAccountInsufficientBalance
+		}
+		account.Balance -= amt
+
+		return putAccount(accounts, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// AddAccountInvoice associates the invoice with the given payment hash with
+// the account with the given ID. Once the invoice is settled, the amount paid
+// to it is credited to the account. An invoice can only be associated with a
+// single account.
+func (d *DB) AddAccountInvoice(id uint64, paymentHash [32]byte) error {
+	return d.Update(func(tx kvdb.Tx) error {
+		accounts := tx.Bucket(accountBucket)
+		if accounts == nil {
+			return ErrAccountNotFound
+		}
+		if _, err := fetchAccount(accounts, id); err != nil {
+			return err
+		}
+
+		accountInvoices, err := tx.CreateBucketIfNotExists(
+			accountInvoiceBucket,
+		)
+		if err != nil {
+			return err
+		}
+		if accountInvoices.Get(paymentHash[:]) != nil {
+			return ErrDuplicateAccountInvoice
+		}
+
+		var idBytes [8]byte
+		byteOrder.PutUint64(idBytes[:], id)
+
+		return accountInvoices.Put(paymentHash[:], idBytes[:])
+	})
+}
+
+// creditAccountInvoice credits the amount paid to the invoice with the given
+// payment hash to the account it is associated with, if any. The association
+// is removed afterwards, such that the account is credited at most once.
+func creditAccountInvoice(tx kvdb.Tx, paymentHash [32]byte,
+	amtPaid lnwire.MilliSatoshi) error {
+
+	accountInvoices := tx.Bucket(accountInvoiceBucket)
+	if accountInvoices == nil {
+		return nil
+	}
+	idBytes := accountInvoices.Get(paymentHash[:])
+	if idBytes == nil {
+		return nil
+	}
+
+	accounts := tx.Bucket(accountBucket)
+	if accounts == nil {
+		return ErrAccountNotFound
+	}
+	_, err := creditAccount(accounts, byteOrder.Uint64(idBytes), amtPaid)
+	if err != nil {
+		return err
+	}
+
+	return accountInvoices.Delete(paymentHash[:])
+}
+
+// creditAccount adds the given amount to the balance of the account with the
+// given ID within the accounts bucket.
+func creditAccount(accounts kvdb.Bucket, id uint64,
+	amt lnwire.MilliSatoshi) (*Account, error) {
+
+	account, err := fetchAccount(accounts, id)
+	if err != nil {
+		return nil, err
+	}
+	account.Balance += amt
+
+	if err := putAccount(accounts, account); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// fetchAccount retrieves the account with the given ID from the accounts
+// bucket.
+func fetchAccount(accounts kvdb.Bucket, id uint64) (*Account, error) {
+	var idBytes [8]byte
+	byteOrder.PutUint64(idBytes[:], id)
+
+	accountBytes := accounts.Get(idBytes[:])
+	if accountBytes == nil {
+		return nil, ErrAccountNotFound
+	}
+
+	account, err := deserializeAccount(bytes.NewReader(accountBytes))
+	if err != nil {
+		return nil, err
+	}
+	account.ID = id
+
+	return account, nil
+}
+
+// putAccount stores the given account within the accounts bucket, keyed by
+// its ID.
+func putAccount(accounts kvdb.Bucket, account *Account) error {
+	var idBytes [8]byte
+	byteOrder.PutUint64(idBytes[:], account.ID)
+
+	var b bytes.Buffer
+	if err := serializeAccount(&b, account); err != nil {
+		return err
+	}
+
+	return accounts.Put(idBytes[:], b.Bytes())
+}
+
+// serializeAccount writes the account to the given writer. The ID of the
+// account isn't included, as it's used as the account's key.
+func serializeAccount(w io.Writer, account *Account) error {
+	return WriteElements(w,
+		account.Balance, uint64(account.CreationDate.UnixNano()),
+		[]byte(account.Label),
+	)
+}
+
+// deserializeAccount reads an account from the given reader.
+func deserializeAccount(r io.Reader) (*Account, error) {
+	var (
+		account      Account
+		creationDate uint64
+		label        []byte
+	)
+	err := ReadElements(r, &account.Balance, &creationDate, &label)
+	if err != nil {
+		return nil, err
+	}
+
+	account.CreationDate = time.Unix(0, int64(creationDate))
+	account.Label = string(label)
+
+	return &account, nil
+}
@@ -0,0 +1,123 @@
+package channeldb
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestAccountBalances tests that accounts can be created, funded and debited,
+// and that settling an invoice associated with an account credits the
+// account exactly once.
+func TestAccountBalances(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Before any account has been created, none should be found.
+	if _, err := db.FetchAccount(1); err != ErrAccountNotFound {
+		t.Fatalf("expected ErrAccountNotFound, got %v", err)
+	}
+
+	account, err := db.AddAccount("app", 1000)
+	if err != nil {
+		t.Fatalf("unable to add account: %v", err)
+	}
+	other, err := db.AddAccount("other app", 0)
+	if err != nil {
+		t.Fatalf("unable to add account: %v", err)
+	}
+	if account.ID == other.ID {
+		t.Fatalf("expected accounts to have distinct IDs")
+	}
+
+	accounts, err := db.FetchAllAccounts()
+	if err != nil {
+		t.Fatalf("unable to fetch accounts: %v", err)
+	}
+	if len(accounts) != 2 {
+		t.Fatalf("expected 2 accounts, got %d", len(accounts))
+	}
+	if accounts[0].Label != "app" || accounts[0].Balance != 1000 {
+		t.Fatalf("unexpected account: %v", accounts[0])
+	}
+	if !accounts[0].CreationDate.Equal(account.CreationDate) {
+		t.Fatalf("expected creation date %v, got %v",
+			account.CreationDate, accounts[0].CreationDate)
+	}
+
+	// Debiting more than the balance should fail, and leave the balance
+	// untouched.
+	_, err = db.DebitAccount(account.ID, 1001)
+	if err != ErrAccountInsufficientBalance {
+		t.Fatalf("expected ErrAccountInsufficientBalance, got %v", err)
+	}
+	account, err = db.DebitAccount(account.ID, 400)
+	if err != nil {
+		t.Fatalf("unable to debit account: %v", err)
+	}
+	if account.Balance != 600 {
+		t.Fatalf("expected balance 600, got %v", account.Balance)
+	}
+
+	account, err = db.CreditAccount(account.ID, 400)
+	if err != nil {
+		t.Fatalf("unable to credit account: %v", err)
+	}
+	if account.Balance != 1000 {
+		t.Fatalf("expected balance 1000, got %v", account.Balance)
+	}
+
+	// We'll now add an invoice on behalf of the account.
+	invoice, err := randInvoice(lnwire.NewMSatFromSatoshis(10))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+	payHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+
+	if err := db.AddAccountInvoice(42, payHash); err != ErrAccountNotFound {
+		t.Fatalf("expected ErrAccountNotFound, got %v", err)
+	}
+	if err := db.AddAccountInvoice(account.ID, payHash); err != nil {
+		t.Fatalf("unable to add account invoice: %v", err)
+	}
+	err = db.AddAccountInvoice(other.ID, payHash)
+	if err != ErrDuplicateAccountInvoice {
+		t.Fatalf("expected ErrDuplicateAccountInvoice, got %v", err)
+	}
+
+	// Settling the invoice should credit the account with the amount
+	// paid. Settling it a second time shouldn't credit it again.
+	const amtPaid = 10500
+	for i := 0; i < 2; i++ {
+		if _, err := db.SettleInvoice(payHash, amtPaid); err != nil {
+			t.Fatalf("unable to settle invoice: %v", err)
+		}
+
+		account, err = db.FetchAccount(account.ID)
+		if err != nil {
+			t.Fatalf("unable to fetch account: %v", err)
+		}
+		if account.Balance != 1000+amtPaid {
+			t.Fatalf("expected balance %v, got %v", 1000+amtPaid,
+				account.Balance)
+		}
+	}
+
+	// The other account should be unaffected.
+	other, err = db.FetchAccount(other.ID)
+	if err != nil {
+		t.Fatalf("unable to fetch account: %v", err)
+	}
+	if other.Balance != 0 {
+		t.Fatalf("expected balance 0, got %v", other.Balance)
+	}
+}
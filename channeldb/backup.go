@@ -0,0 +1,324 @@
+package channeldb
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+)
+
+var (
+	// backupMagic is written at the start of every database backup, and
+	// allows us to reject files that aren't backups early.
+	backupMagic = [4]byte{'l', 'n', 'd', 'b'}
+
+	// ErrInvalidBackup is returned when attempting to read a backup from a
+	// stream that doesn't contain a database backup.
+	ErrInvalidBackup = fmt.Errorf("stream doesn't contain a channel " +
+		"database backup")
+
+	// ErrBackupUnsupported is returned when attempting to back up a
+	// database whose backend is unable to write consistent snapshots.
+	ErrBackupUnsupported = fmt.Errorf("database backend doesn't support " +
+		"backups")
+
+	// ErrOutdatedBackup is returned when attempting to restore a backup
+	// that is older than the database it would replace. Restoring an
+	// outdated channel state can lead to a loss of funds, as the remote
+	// party may punish us for broadcasting a revoked state.
+	ErrOutdatedBackup = fmt.Errorf("backup is older than the current " +
+		"database")
+
+	// ErrDatabaseInUse is returned when attempting to restore a backup
+	// while the existing database is held open by another process, such
+	// as a running lnd instance.
+	ErrDatabaseInUse = fmt.Errorf("database in use, lnd must be shut " +
+		"down before restoring a backup")
+)
+
+const (
+	// backupVersion is the version of the backup format written by
+	// Backup.
+	backupVersion uint16 = 0
+
+	// restoreOpenTimeout is the maximum time we'll wait for the existing
+	// database to be released by another process before refusing to
+	// restore a backup.
+	restoreOpenTimeout = time.Second
+
+	// restoreTempSuffix is appended to the path of the database to obtain
+	// the path of the temporary file a backup is restored into.
+	restoreTempSuffix = ".restore"
+)
+
+// BackupHeader is the metadata written in front of every database backup. It
+// describes the point in time the backup was taken at.
+type BackupHeader struct {
+	// DBVersion is the schema version of the backed up database.
+	DBVersion uint32
+
+	// BestHeight is the height of the best block known to the node at the
+	// time of the backup.
+	BestHeight uint32
+
+	// BestHash is the hash of the best block known to the node at the
+	// time of the backup.
+	BestHash chainhash.Hash
+
+	// Timestamp is the time at which the backup was taken.
+	Timestamp time.Time
+}
+
+// Backup writes a consistent, point-in-time backup of the entire database to
+// w. The backup is preceded by a header holding the version of the database,
+// along with the passed best block, which should be the best block known to
+// the node. The backup is taken within a single read transaction, so it may
+// be taken while the database is in use.
+//
+// NOTE: As the read transaction is held open until the entire backup has been
+// written, slow writers may delay database writers that need to grow the
+// database file.
+func (d *DB) Backup(w io.Writer, bestHash *chainhash.Hash,
+	bestHeight uint32) (*BackupHeader, error) {
+
+	var header *BackupHeader
+	err := d.View(func(tx kvdb.Tx) error {
+		snapshotTx, ok := tx.(kvdb.SnapshotTx)
+		if !ok {
+			return ErrBackupUnsupported
+		}
+
+		// We read the version within the same transaction as the
+		// snapshot, to ensure they match.
+		meta := &Meta{}
+		if err := fetchMeta(meta, tx); err != nil {
+			return err
+		}
+
+		header = &BackupHeader{
+			DBVersion:  meta.DbVersionNumber,
+			BestHeight: bestHeight,
+			BestHash:   *bestHash,
+			Timestamp:  time.Unix(time.Now().Unix(), 0),
+		}
+		if err := writeBackupHeader(w, header); err != nil {
+			return err
+		}
+
+		_, err := snapshotTx.WriteTo(w)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// ReadBackupHeader reads the header of a database backup from r, leaving r
+// positioned at the start of the backed up database.
+func ReadBackupHeader(r io.Reader) (*BackupHeader, error) {
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, ErrInvalidBackup
+	}
+	if magic != backupMagic {
+		return nil, ErrInvalidBackup
+	}
+
+	var version uint16
+	if err := ReadElements(r, &version); err != nil {
+		return nil, err
+	}
+	if version != backupVersion {
+		return nil, fmt.Errorf("unknown backup version: %v", version)
+	}
+
+	var (
+		header    BackupHeader
+		timestamp uint64
+	)
+	err := ReadElements(r,
+		&header.DBVersion, &header.BestHeight, &header.BestHash,
+		&timestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	header.Timestamp = time.Unix(int64(timestamp), 0)
+
+	return &header, nil
+}
+
+// writeBackupHeader writes the magic, format version and header of a database
+// backup to w.
+func writeBackupHeader(w io.Writer, header *BackupHeader) error {
+	if _, err := w.Write(backupMagic[:]); err != nil {
+		return err
+	}
+
+	return WriteElements(w,
+		backupVersion, header.DBVersion, header.BestHeight,
+		header.BestHash, uint64(header.Timestamp.Unix()),
+	)
+}
+
+// RestoreBackup restores the database backup read from r into the database
+// directory dbPath, replacing any existing database. Unless force is set, the
+// backup is refused if it's older than the existing database, meaning it was
+// either taken at an earlier schema version, or at a block height below the
+// height the existing database has synced the channel graph to. Backups
+// taken by a newer version of lnd are always refused. The header of the
+// restored backup is returned.
+//
+// NOTE: The database must not be opened by anyone else while the backup is
+// being restored. If it's held open by another process when the restore
+// starts, ErrDatabaseInUse is returned.
+func RestoreBackup(dbPath string, r io.Reader,
+	force bool) (*BackupHeader, error) {
+
+	header, err := ReadBackupHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	latestVersion := getLatestDBVersion(dbVersions)
+	if header.DBVersion > latestVersion {
+		return nil, fmt.Errorf("backup has database version %v, "+
+			"latest known version is %v", header.DBVersion,
+			latestVersion)
+	}
+
+	path := filepath.Join(dbPath, dbName)
+	if fileExists(path) {
+		err := checkExistingDB(path, header, force)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := os.MkdirAll(dbPath, 0700); err != nil {
+		return nil, err
+	}
+
+	// We'll first write the backup into a temporary file, so we never end
+	// up with a partially restored database.
+	tempPath := path + restoreTempSuffix
+	if err := writeRestoredDB(tempPath, r, header); err != nil {
+		os.Remove(tempPath)
+		return nil, err
+	}
+
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// checkExistingDB returns ErrDatabaseInUse if the existing database stored at
+// path is held open by another process. Unless force is set, ErrOutdatedBackup
+// is returned if the backup with the given header is older than the database.
+func checkExistingDB(path string, header *BackupHeader, force bool) error {
+	backend, err := kvdb.GetBoltBackendWithTimeout(
+		path, restoreOpenTimeout,
+	)
+	switch {
+	case err == kvdb.ErrTimeout:
+		return ErrDatabaseInUse
+
+	// A database that can't be opened, e.g. as it's corrupted, may still
+	// be replaced forcefully.
+	case err != nil && force:
+		return nil
+
+	case err != nil:
+		return err
+	}
+	defer backend.Close()
+
+	if force {
+		return nil
+	}
+
+	return backend.View(func(tx kvdb.Tx) error {
+		meta := &Meta{}
+		err := fetchMeta(meta, tx)
+		switch {
+		// An uninitialized database can safely be replaced.
+		case err == ErrMetaNotFound:
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		if header.DBVersion < meta.DbVersionNumber {
+			return ErrOutdatedBackup
+		}
+
+		_, pruneHeight, err := fetchPruneTip(tx)
+		switch {
+		case err == ErrGraphNotFound || err == ErrGraphNeverPruned:
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		if header.BestHeight < pruneHeight {
+			return ErrOutdatedBackup
+		}
+
+		return nil
+	})
+}
+
+// writeRestoredDB writes the backed up database read from r to path, and
+// verifies that the result is a database matching the backup header.
+func writeRestoredDB(path string, r io.Reader, header *BackupHeader) error {
+	f, err := os.OpenFile(
+		path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	backend, err := kvdb.Open(kvdb.BoltBackendName, path)
+	if err != nil {
+		return fmt.Errorf("unable to open restored database: %v", err)
+	}
+	defer backend.Close()
+
+	meta := &Meta{}
+	err = backend.View(func(tx kvdb.Tx) error {
+		return fetchMeta(meta, tx)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to read restored database: %v", err)
+	}
+	if meta.DbVersionNumber != header.DBVersion {
+		return fmt.Errorf("restored database has version %v, backup "+
+			"header claims %v", meta.DbVersionNumber,
+			header.DBVersion)
+	}
+
+	return nil
+}
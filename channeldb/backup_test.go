@@ -0,0 +1,116 @@
+package channeldb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// TestBackupRestore asserts that a database backup can be restored into an
+// empty directory, and that restoring a backup older than the existing
+// database is refused unless forced.
+func TestBackupRestore(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	if err := state.FullSync(); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	bestHash := chainhash.Hash{1}
+	const bestHeight = 100
+
+	var backup bytes.Buffer
+	header, err := cdb.Backup(&backup, &bestHash, bestHeight)
+	if err != nil {
+		t.Fatalf("unable to back up database: %v", err)
+	}
+	if header.BestHash != bestHash || header.BestHeight != bestHeight {
+		t.Fatalf("unexpected header: %v", header)
+	}
+	backupBytes := backup.Bytes()
+
+	// A stream that doesn't hold a backup should be rejected.
+	restoreDir, err := ioutil.TempDir("", "channeldb-restore")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(restoreDir)
+
+	_, err = RestoreBackup(
+		restoreDir, bytes.NewReader([]byte("channel.db")), false,
+	)
+	if err != ErrInvalidBackup {
+		t.Fatalf("expected ErrInvalidBackup, got: %v", err)
+	}
+
+	// Restoring into an empty directory should recreate the database
+	// along with the channel.
+	restored, err := RestoreBackup(
+		restoreDir, bytes.NewReader(backupBytes), false,
+	)
+	if err != nil {
+		t.Fatalf("unable to restore backup: %v", err)
+	}
+	if *restored != *header {
+		t.Fatalf("restored header mismatch: expected %v, got %v",
+			header, restored)
+	}
+
+	restoredDB, err := Open(restoreDir)
+	if err != nil {
+		t.Fatalf("unable to open restored database: %v", err)
+	}
+	channels, err := restoredDB.FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 1 ||
+		channels[0].FundingOutpoint != state.FundingOutpoint {
+
+		t.Fatalf("restored database doesn't contain channel")
+	}
+
+	// Once the restored database has synced the graph beyond the height
+	// of the backup, restoring the backup again should be refused.
+	pruneHash := chainhash.Hash{2}
+	_, err = restoredDB.ChannelGraph().PruneGraph(
+		nil, &pruneHash, bestHeight+1,
+	)
+	if err != nil {
+		t.Fatalf("unable to prune graph: %v", err)
+	}
+
+	// The backup can't be restored while the database is open.
+	_, err = RestoreBackup(restoreDir, bytes.NewReader(backupBytes), false)
+	if err != ErrDatabaseInUse {
+		t.Fatalf("expected ErrDatabaseInUse, got: %v", err)
+	}
+
+	if err := restoredDB.Close(); err != nil {
+		t.Fatalf("unable to close restored database: %v", err)
+	}
+
+	_, err = RestoreBackup(restoreDir, bytes.NewReader(backupBytes), false)
+	if err != ErrOutdatedBackup {
+		t.Fatalf("expected ErrOutdatedBackup, got: %v", err)
+	}
+
+	// Unless the restore is forced.
+	_, err = RestoreBackup(restoreDir, bytes.NewReader(backupBytes), true)
+	if err != nil {
+		t.Fatalf("unable to force restore of backup: %v", err)
+	}
+}
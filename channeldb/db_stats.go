@@ -0,0 +1,179 @@
+package channeldb
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+)
+
+// BucketStats summarizes the contents of a set of buckets within the
+// database.
+type BucketStats struct {
+	// NumKeys is the number of key/value pairs stored within the buckets.
+	NumKeys uint64
+
+	// NumBuckets is the number of buckets, including all nested buckets.
+	NumBuckets uint64
+
+	// Size is the total size of all keys and values stored within the
+	// buckets, in bytes. This excludes any overhead of the backend.
+	Size uint64
+}
+
+// DBStats breaks down the contents of the database by the subsystem storing
+// them.
+type DBStats struct {
+	// FileSize is the size of the database file in bytes. This is only
+	// known for databases stored within a local bolt file.
+	FileSize int64
+
+	// Graph summarizes the nodes, edges and metadata of the channel
+	// graph.
+	Graph BucketStats
+
+	// OpenChannels summarizes the state of all open channels, excluding
+	// their revocation logs.
+	OpenChannels BucketStats
+
+	// RevocationLogs summarizes the revocation logs of all open
+	// channels, which hold a record of every prior channel state.
+	RevocationLogs BucketStats
+
+	// ClosedChannels summarizes the summaries of all closed channels, along
+	// with the archive records of those that have been reaped.
+	ClosedChannels BucketStats
+
+	// ForwardingPackages summarizes the forwarding packages of all
+	// channels.
+	ForwardingPackages BucketStats
+
+	// Invoices summarizes all invoices along with their indexes.
+	Invoices BucketStats
+
+	// Payments summarizes all outgoing payments along with their
+	// statuses.
+	Payments BucketStats
+
+	// ForwardingLog summarizes the log of all completed forwarding
+	// events.
+	ForwardingLog BucketStats
+}
+
+// FetchStats walks the entire database, and returns a breakdown of its
+// contents by subsystem.
+func (d *DB) FetchStats() (*DBStats, error) {
+	stats := &DBStats{}
+
+	err := d.View(func(tx kvdb.Tx) error {
+		// The revocation logs are stored within the bucket of their
+		// channel, so we redirect them while walking the open channel
+		// bucket.
+		chanOverrides := map[string]*BucketStats{
+			string(revocationLogBucket): &stats.RevocationLogs,
+		}
+
+		subsystems := []struct {
+			buckets   [][]byte
+			stats     *BucketStats
+			overrides map[string]*BucketStats
+		}{
+			{
+				buckets: [][]byte{
+					nodeBucket, edgeBucket, graphMetaBucket,
+				},
+				stats: &stats.Graph,
+			},
+			{
+				buckets:   [][]byte{openChannelBucket},
+				stats:     &stats.OpenChannels,
+				overrides: chanOverrides,
+			},
+			{
+				buckets: [][]byte{
+					closedChannelBucket,
+					archivedChannelBucket,
+				},
+				stats: &stats.ClosedChannels,
+			},
+			{
+				buckets: [][]byte{fwdPackagesKey},
+				stats:   &stats.ForwardingPackages,
+			},
+			{
+				buckets: [][]byte{invoiceBucket},
+				stats:   &stats.Invoices,
+			},
+			{
+				buckets: [][]byte{
+					paymentBucket, paymentStatusBucket,
+				},
+				stats: &stats.Payments,
+			},
+			{
+				buckets: [][]byte{forwardingLogBucket},
+				stats:   &stats.ForwardingLog,
+			},
+		}
+
+		for _, subsystem := range subsystems {
+			for _, name := range subsystem.buckets {
+				bucket := tx.Bucket(name)
+				if bucket == nil {
+					continue
+				}
+
+				err := addBucketStats(
+					subsystem.stats, bucket,
+					subsystem.overrides,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The file size is only known if the database is stored within a
+	// local bolt file.
+	if d.dbPath != "" {
+		info, err := os.Stat(filepath.Join(d.dbPath, dbName))
+		if err != nil {
+			return nil, err
+		}
+		stats.FileSize = info.Size()
+	}
+
+	return stats, nil
+}
+
+// addBucketStats recursively accumulates the contents of the passed bucket
+// into the given stats. Nested buckets whose name is found within the
+// overrides map are accumulated into the mapped stats instead.
+func addBucketStats(stats *BucketStats, bucket kvdb.Bucket,
+	overrides map[string]*BucketStats) error {
+
+	stats.NumBuckets++
+
+	return bucket.ForEach(func(k, v []byte) error {
+		nested := bucket.Bucket(k)
+		if nested == nil {
+			stats.NumKeys++
+			stats.Size += uint64(len(k) + len(v))
+			return nil
+		}
+
+		target := stats
+		if override, ok := overrides[string(k)]; ok {
+			target = override
+		}
+		target.Size += uint64(len(k))
+
+		return addBucketStats(target, nested, overrides)
+	})
+}
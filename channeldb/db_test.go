@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -147,3 +148,141 @@ func TestFetchClosedChannelForID(t *testing.T) {
 		t.Fatalf("expected ErrClosedChannelNotFound, instead got: %v", err)
 	}
 }
+
+// TestFetchStats asserts that the database stats attribute the contents of
+// the database to the correct subsystems.
+func TestFetchStats(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Initially, none of the subsystems should hold any keys.
+	stats, err := cdb.FetchStats()
+	if err != nil {
+		t.Fatalf("unable to fetch stats: %v", err)
+	}
+	if stats.FileSize == 0 {
+		t.Fatalf("expected non-zero file size")
+	}
+	if stats.Invoices.NumKeys != 0 || stats.OpenChannels.NumKeys != 0 {
+		t.Fatalf("expected empty subsystems, got %v", spew.Sdump(stats))
+	}
+
+	// Add an invoice along with an open channel whose state has been
+	// updated once, such that its revocation log holds an entry.
+	invoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := cdb.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	if err := channel.FullSync(); err != nil {
+		t.Fatalf("unable to save channel state: %v", err)
+	}
+
+	commitDiff := &CommitDiff{
+		Commitment: channel.RemoteCommitment,
+		CommitSig: &lnwire.CommitSig{
+			ChanID:    lnwire.ChannelID(key),
+			CommitSig: wireSig,
+		},
+		LogUpdates:        []LogUpdate{},
+		OpenedCircuitKeys: []CircuitKey{},
+		ClosedCircuitKeys: []CircuitKey{},
+	}
+	commitDiff.Commitment.CommitHeight = 1
+	if err := channel.AppendRemoteCommitChain(commitDiff); err != nil {
+		t.Fatalf("unable to add to commit chain: %v", err)
+	}
+
+	fwdPkg := NewFwdPkg(channel.ShortChanID(), 0, nil, nil)
+	if err := channel.AdvanceCommitChainTail(fwdPkg); err != nil {
+		t.Fatalf("unable to append to revocation log: %v", err)
+	}
+
+	stats, err = cdb.FetchStats()
+	if err != nil {
+		t.Fatalf("unable to fetch stats: %v", err)
+	}
+	if stats.Invoices.NumKeys == 0 {
+		t.Fatalf("invoice not accounted for")
+	}
+	if stats.OpenChannels.NumKeys == 0 {
+		t.Fatalf("open channel not accounted for")
+	}
+	if stats.RevocationLogs.NumKeys != 1 {
+		t.Fatalf("expected 1 revocation log entry, got %v",
+			stats.RevocationLogs.NumKeys)
+	}
+	if stats.ForwardingPackages.NumKeys == 0 {
+		t.Fatalf("forwarding package not accounted for")
+	}
+	if stats.Payments.NumKeys != 0 {
+		t.Fatalf("expected no payments, got %v", stats.Payments.NumKeys)
+	}
+}
+
+// TestCompact asserts that compacting the database preserves its contents.
+func TestCompact(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// Compacting a database that doesn't exist yet should be a noop.
+	if _, _, err := Compact(tempDirName); err != nil {
+		t.Fatalf("unable to compact missing db: %v", err)
+	}
+
+	cdb, err := Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to create channeldb: %v", err)
+	}
+
+	const numInvoices = 100
+	for i := 0; i < numInvoices; i++ {
+		invoice, err := randInvoice(1000)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		if _, err := cdb.AddInvoice(invoice); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+	}
+
+	if err := cdb.Close(); err != nil {
+		t.Fatalf("unable to close channeldb: %v", err)
+	}
+
+	if _, _, err := Compact(tempDirName); err != nil {
+		t.Fatalf("unable to compact db: %v", err)
+	}
+
+	cdb, err = Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open compacted channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	invoices, err := cdb.FetchAllInvoices(false)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	if len(invoices) != numInvoices {
+		t.Fatalf("expected %v invoices, got %v", numInvoices,
+			len(invoices))
+	}
+}
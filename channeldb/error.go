@@ -35,6 +35,14 @@ var (
 	// payment hash already exists.
 	ErrDuplicateInvoice = fmt.Errorf("invoice with payment hash already exists")
 
+	// ErrInvoiceAlreadySettled is returned when attempting to cancel an
+	// invoice that has already been settled.
+	ErrInvoiceAlreadySettled = fmt.Errorf("invoice already settled")
+
+	// ErrInvoiceAlreadyCanceled is returned when attempting to pay to an
+	// invoice that has already been canceled.
+	ErrInvoiceAlreadyCanceled = fmt.Errorf("invoice already canceled")
+
 	// ErrNoPaymentsCreated is returned when bucket of payments hasn't been
 	// created.
 	ErrNoPaymentsCreated = fmt.Errorf("there are no existing payments")
@@ -83,6 +91,15 @@ var (
 	// channel id can't be added because it already exist.
 	ErrEdgeAlreadyExist = fmt.Errorf("edge already exist")
 
+	// ErrZombieEdge is returned when the target chanID has been pruned
+	// from the graph as a zombie and is now recorded within the zombie
+	// index.
+	ErrZombieEdge = fmt.Errorf("edge marked as zombie")
+
+	// ErrZombieEdgeNotFound is returned when attempting to remove a
+	// chanID that isn't marked as a zombie from the zombie index.
+	ErrZombieEdgeNotFound = fmt.Errorf("edge not found in zombie index")
+
 	// ErrNodeAliasNotFound is returned when alias for node can't be found.
 	ErrNodeAliasNotFound = fmt.Errorf("alias for node not found")
 
@@ -97,6 +114,24 @@ var (
 	// ErrNoForwardingEvents is returned in the case that a query fails due
 	// to the log not having any recorded events.
 	ErrNoForwardingEvents = fmt.Errorf("no recorded forwarding events")
+
+	// ErrPeerHistoryNotFound is returned when attempting to look up the
+	// connection history of a peer we've never been connected to.
+	ErrPeerHistoryNotFound = fmt.Errorf("no connection history for peer")
+
+	// ErrAccountNotFound is returned when attempting to look up an
+	// account that doesn't exist.
+	ErrAccountNotFound = fmt.Errorf("unable to locate account")
+
+	// ErrAccountInsufficientBalance is returned when attempting to debit
+	// more than the balance of an account.
+	ErrAccountInsufficientBalance = fmt.Errorf("insufficient account " +
+		"balance")
+
+	// ErrDuplicateAccountInvoice is returned when attempting to associate
+	// an invoice with an account, while it's already associated with one.
+	ErrDuplicateAccountInvoice = fmt.Errorf("invoice already associated " +
+		"with an account")
 )
 
 // ErrTooManyExtraOpaqueBytes creates an error which should be returned if the
@@ -17,8 +17,8 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -837,7 +837,7 @@ func TestGraphTraversal(t *testing.T) {
 
 	// Iterate over each node as returned by the graph, if all nodes are
 	// reached, then the map created above should be empty.
-	err = graph.ForEachNode(nil, func(_ *bbolt.Tx, node *LightningNode) error {
+	err = graph.ForEachNode(nil, func(_ kvdb.Tx, node *LightningNode) error {
 		delete(nodeIndex, node.Alias)
 		return nil
 	})
@@ -933,7 +933,7 @@ func TestGraphTraversal(t *testing.T) {
 	// Finally, we want to test the ability to iterate over all the
 	// outgoing channels for a particular node.
 	numNodeChans := 0
-	err = firstNode.ForEachChannel(nil, func(_ *bbolt.Tx, _ *ChannelEdgeInfo,
+	err = firstNode.ForEachChannel(nil, func(_ kvdb.Tx, _ *ChannelEdgeInfo,
 		outEdge, inEdge *ChannelEdgePolicy) error {
 
 		// All channels between first and second node should have fully
@@ -1006,7 +1006,7 @@ func assertNumChans(t *testing.T, graph *ChannelGraph, n int) {
 
 func assertNumNodes(t *testing.T, graph *ChannelGraph, n int) {
 	numNodes := 0
-	err := graph.ForEachNode(nil, func(_ *bbolt.Tx, _ *LightningNode) error {
+	err := graph.ForEachNode(nil, func(_ kvdb.Tx, _ *LightningNode) error {
 		numNodes++
 		return nil
 	})
@@ -2015,7 +2015,7 @@ func TestIncompleteChannelPolicies(t *testing.T) {
 
 	checkPolicies := func(node *LightningNode, expectedIn, expectedOut bool) {
 		calls := 0
-		node.ForEachChannel(nil, func(_ *bbolt.Tx, _ *ChannelEdgeInfo,
+		node.ForEachChannel(nil, func(_ kvdb.Tx, _ *ChannelEdgeInfo,
 			outEdge, inEdge *ChannelEdgePolicy) error {
 
 			if !expectedOut && outEdge != nil {
@@ -2148,7 +2148,7 @@ func TestChannelEdgePruningUpdateIndexDeletion(t *testing.T) {
 			timestampSet[t] = struct{}{}
 		}
 
-		err := db.View(func(tx *bbolt.Tx) error {
+		err := db.View(func(tx kvdb.Tx) error {
 			edges := tx.Bucket(edgeBucket)
 			if edges == nil {
 				return ErrGraphNoEdgesFound
@@ -2158,7 +2158,15 @@ func TestChannelEdgePruningUpdateIndexDeletion(t *testing.T) {
 				return ErrGraphNoEdgesFound
 			}
 
-			numEntries := edgeUpdateIndex.Stats().KeyN
+			var numEntries int
+			err := edgeUpdateIndex.ForEach(func(_, _ []byte) error {
+				numEntries++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
 			expectedEntries := len(timestampSet)
 			if numEntries != expectedEntries {
 				return fmt.Errorf("expected %v entries in the "+
@@ -2594,6 +2602,98 @@ func TestNodeIsPublic(t *testing.T) {
 	)
 }
 
+// TestGraphZombieIndex ensures that we can mark edges correctly as zombie/live,
+// and that zombie edges are reported as such when fetched from the graph.
+func TestGraphZombieIndex(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	graph := db.ChannelGraph()
+
+	node1, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test vertex: %v", err)
+	}
+	node2, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test vertex: %v", err)
+	}
+
+	edge, _ := createEdge(1, 0, 0, 0, node1, node2)
+
+	// If the graph is not aware of the edge, then it should not be a
+	// zombie.
+	isZombie, _, _ := graph.IsZombieEdge(edge.ChannelID)
+	if isZombie {
+		t.Fatal("expected edge to not be marked as zombie")
+	}
+
+	// If we mark the edge as a zombie, then we should expect to see it
+	// within the index along with the node keys of the channel.
+	err = graph.MarkEdgeZombie(
+		edge.ChannelID, edge.NodeKey1Bytes, edge.NodeKey2Bytes,
+	)
+	if err != nil {
+		t.Fatalf("unable to mark edge as zombie: %v", err)
+	}
+	isZombie, pubKey1, pubKey2 := graph.IsZombieEdge(edge.ChannelID)
+	if !isZombie {
+		t.Fatal("expected edge to be marked as zombie")
+	}
+	if pubKey1 != edge.NodeKey1Bytes {
+		t.Fatalf("expected pubKey1 %x, got %x", edge.NodeKey1Bytes,
+			pubKey1)
+	}
+	if pubKey2 != edge.NodeKey2Bytes {
+		t.Fatalf("expected pubKey2 %x, got %x", edge.NodeKey2Bytes,
+			pubKey2)
+	}
+
+	numZombies, err := graph.NumZombies()
+	if err != nil {
+		t.Fatalf("unable to query number of zombies: %v", err)
+	}
+	if numZombies != 1 {
+		t.Fatalf("expected 1 zombie, got %v", numZombies)
+	}
+
+	// Fetching the edge should result in ErrZombieEdge, along with the
+	// node keys needed to validate an update reviving it.
+	info, _, _, err := graph.FetchChannelEdgesByID(edge.ChannelID)
+	if err != ErrZombieEdge {
+		t.Fatalf("expected ErrZombieEdge, got %v", err)
+	}
+	if info.NodeKey1Bytes != edge.NodeKey1Bytes ||
+		info.NodeKey2Bytes != edge.NodeKey2Bytes {
+
+		t.Fatalf("zombie edge info has unexpected node keys")
+	}
+
+	// Similarly, if we mark the same edge as live, we should no longer see
+	// it within the index.
+	if err := graph.MarkEdgeLive(edge.ChannelID); err != nil {
+		t.Fatalf("unable to mark edge as live: %v", err)
+	}
+	isZombie, _, _ = graph.IsZombieEdge(edge.ChannelID)
+	if isZombie {
+		t.Fatal("expected edge to not be marked as zombie")
+	}
+	_, _, _, err = graph.FetchChannelEdgesByID(edge.ChannelID)
+	if err != ErrEdgeNotFound {
+		t.Fatalf("expected ErrEdgeNotFound, got %v", err)
+	}
+
+	// Marking an edge that isn't a zombie as live should fail.
+	err = graph.MarkEdgeLive(edge.ChannelID)
+	if err != ErrZombieEdgeNotFound {
+		t.Fatalf("expected ErrZombieEdgeNotFound, got %v", err)
+	}
+}
+
 // compareNodes is used to compare two LightningNodes while excluding the
 // Features struct, which cannot be compared as the semantics for reserializing
 // the featuresMap have not been defined.
@@ -0,0 +1,169 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// inboundLimitsBucket is the name of the bucket that stores the
+	// inbound forwarding limits set by the operator at runtime. The limits
+	// of a particular channel are keyed by its channel point, while the
+	// limits that apply to all other channels are stored under
+	// globalInboundLimitsKey.
+	//
+	// maps: chanPoint => InboundLimits
+	inboundLimitsBucket = []byte("inbound-limits")
+
+	// globalInboundLimitsKey is the key under which the inbound limits
+	// that apply to all channels without limits of their own are stored.
+	globalInboundLimitsKey = []byte("global")
+)
+
+// InboundLimits are the operator defined limits enforced on HTLCs that arrive
+// over a channel and are to be forwarded. A value of zero for any of the
+// limits means that the limit isn't enforced.
+type InboundLimits struct {
+	// MaxForwardAmt is the largest amount we'll forward for an HTLC.
+	MaxForwardAmt lnwire.MilliSatoshi
+
+	// MaxPendingForwards is the maximum number of HTLCs the channel's
+	// peer may have pending forwarding through us at once.
+	MaxPendingForwards uint32
+
+	// MaxCltvDelta is the maximum number of blocks, relative to the
+	// current height, the timelock of an HTLC may be set to.
+	MaxCltvDelta uint32
+}
+
+// PutInboundLimits stores the inbound limits for the channel identified by
+// the passed channel point. If the channel point is nil, then the limits are
+// stored as the global limits, which apply to all channels without limits of
+// their own.
+func (d *DB) PutInboundLimits(chanPoint *wire.OutPoint,
+	limits *InboundLimits) error {
+
+	key, err := inboundLimitsKey(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	return d.Update(func(tx kvdb.Tx) error {
+		limitsBucket, err := tx.CreateBucketIfNotExists(
+			inboundLimitsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeInboundLimits(&b, limits); err != nil {
+			return err
+		}
+
+		return limitsBucket.Put(key, b.Bytes())
+	})
+}
+
+// FetchInboundLimits returns the global inbound limits, along with the limits
+// of all channels that have limits of their own. The global limits are nil if
+// they've never been set.
+func (d *DB) FetchInboundLimits() (*InboundLimits,
+	map[wire.OutPoint]*InboundLimits, error) {
+
+	var (
+		globalLimits *InboundLimits
+		chanLimits   = make(map[wire.OutPoint]*InboundLimits)
+	)
+	err := d.View(func(tx kvdb.Tx) error {
+		limitsBucket := tx.Bucket(inboundLimitsBucket)
+		if limitsBucket == nil {
+			return nil
+		}
+
+		return limitsBucket.ForEach(func(k, v []byte) error {
+			limits, err := deserializeInboundLimits(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			if bytes.Equal(k, globalInboundLimitsKey) {
+				globalLimits = limits
+				return nil
+			}
+
+			var chanPoint wire.OutPoint
+			err = readOutpoint(bytes.NewReader(k), &chanPoint)
+			if err != nil {
+				return err
+			}
+			chanLimits[chanPoint] = limits
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return globalLimits, chanLimits, nil
+}
+
+// deleteInboundLimits removes the inbound limits of the channel identified by
+// the passed channel point, if any.
+func deleteInboundLimits(tx kvdb.Tx, chanPoint *wire.OutPoint) error {
+	limitsBucket := tx.Bucket(inboundLimitsBucket)
+	if limitsBucket == nil {
+		return nil
+	}
+
+	key, err := inboundLimitsKey(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	return limitsBucket.Delete(key)
+}
+
+// inboundLimitsKey returns the key under which the inbound limits of the
+// passed channel point are stored, or the global key if it's nil.
+func inboundLimitsKey(chanPoint *wire.OutPoint) ([]byte, error) {
+	if chanPoint == nil {
+		return globalInboundLimitsKey, nil
+	}
+
+	var b bytes.Buffer
+	if err := writeOutpoint(&b, chanPoint); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// serializeInboundLimits writes the inbound limits to the given writer.
+func serializeInboundLimits(w io.Writer, limits *InboundLimits) error {
+	return WriteElements(w,
+		limits.MaxForwardAmt, limits.MaxPendingForwards,
+		limits.MaxCltvDelta,
+	)
+}
+
+// deserializeInboundLimits reads inbound limits from the given reader.
+func deserializeInboundLimits(r io.Reader) (*InboundLimits, error) {
+	var limits InboundLimits
+	err := ReadElements(r,
+		&limits.MaxForwardAmt, &limits.MaxPendingForwards,
+		&limits.MaxCltvDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &limits, nil
+}
@@ -0,0 +1,96 @@
+package channeldb
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+// TestInboundLimits tests that the global and per channel inbound limits are
+// stored separately, and that a channel's limits are removed once it's
+// closed.
+func TestInboundLimits(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Before any limits have been set, none should be returned.
+	globalLimits, chanLimits, err := cdb.FetchInboundLimits()
+	if err != nil {
+		t.Fatalf("unable to fetch inbound limits: %v", err)
+	}
+	if globalLimits != nil || len(chanLimits) != 0 {
+		t.Fatalf("expected no inbound limits, got %v and %v",
+			globalLimits, chanLimits)
+	}
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	global := &InboundLimits{MaxCltvDelta: 100}
+	if err := cdb.PutInboundLimits(nil, global); err != nil {
+		t.Fatalf("unable to put inbound limits: %v", err)
+	}
+	chanPoint := state.FundingOutpoint
+	channel := &InboundLimits{
+		MaxForwardAmt:      1000,
+		MaxPendingForwards: 5,
+		MaxCltvDelta:       50,
+	}
+	if err := cdb.PutInboundLimits(&chanPoint, channel); err != nil {
+		t.Fatalf("unable to put inbound limits: %v", err)
+	}
+
+	globalLimits, chanLimits, err = cdb.FetchInboundLimits()
+	if err != nil {
+		t.Fatalf("unable to fetch inbound limits: %v", err)
+	}
+	if !reflect.DeepEqual(globalLimits, global) {
+		t.Fatalf("expected global limits %v, got %v", global,
+			globalLimits)
+	}
+	if len(chanLimits) != 1 ||
+		!reflect.DeepEqual(chanLimits[chanPoint], channel) {
+
+		t.Fatalf("expected limits %v for %v, got %v", channel,
+			chanPoint, chanLimits)
+	}
+
+	// Once the channel is closed, only the global limits should remain.
+	summary := &ChannelCloseSummary{
+		ChanPoint:       chanPoint,
+		RemotePub:       state.IdentityPub,
+		Capacity:        state.Capacity,
+		CloseType:       CooperativeClose,
+		IsPending:       true,
+		LocalChanConfig: state.LocalChanCfg,
+	}
+	if err := state.CloseChannel(summary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	globalLimits, chanLimits, err = cdb.FetchInboundLimits()
+	if err != nil {
+		t.Fatalf("unable to fetch inbound limits: %v", err)
+	}
+	if !reflect.DeepEqual(globalLimits, global) {
+		t.Fatalf("expected global limits %v, got %v", global,
+			globalLimits)
+	}
+	if len(chanLimits) != 0 {
+		t.Fatalf("expected no channel limits, got %v", chanLimits)
+	}
+}
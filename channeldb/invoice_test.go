@@ -106,7 +106,7 @@ func TestInvoiceWorkflow(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to fetch invoice: %v", err)
 	}
-	if !dbInvoice2.Terms.Settled {
+	if dbInvoice2.Terms.State != ContractSettled {
 		t.Fatalf("invoice should now be settled but isn't")
 	}
 	if dbInvoice2.SettleDate.IsZero() {
@@ -348,7 +348,7 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 	// We'll update what we expect the settle invoice to be so that our
 	// comparison below has the correct assumption.
 	invoice.SettleIndex = 1
-	invoice.Terms.Settled = true
+	invoice.Terms.State = ContractSettled
 	invoice.AmtPaid = amt
 	invoice.SettleDate = dbInvoice.SettleDate
 
@@ -645,3 +645,241 @@ func TestQueryInvoices(t *testing.T) {
 		}
 	}
 }
+
+// TestCancelInvoice tests that an open invoice can be canceled, that a
+// canceled invoice is still settled once an HTLC paying to it is, and that a
+// settled invoice can no longer be canceled.
+func TestCancelInvoice(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+	payHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+
+	// Canceling the open invoice should succeed, and should be
+	// idempotent.
+	for i := 0; i < 2; i++ {
+		dbInvoice, err := db.CancelInvoice(payHash)
+		if err != nil {
+			t.Fatalf("unable to cancel invoice: %v", err)
+		}
+		if dbInvoice.Terms.State != ContractCanceled {
+			t.Fatalf("expected invoice to be canceled, got %v",
+				dbInvoice.Terms.State)
+		}
+	}
+
+	// The canceled invoice should no longer be returned as pending.
+	pending, err := db.FetchAllInvoices(true)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("expected no pending invoices, got %d", len(pending))
+	}
+
+	// An HTLC paying to the invoice may have been accepted before it was
+	// canceled, so settling the canceled invoice should succeed.
+	dbInvoice, err := db.SettleInvoice(payHash, amt)
+	if err != nil {
+		t.Fatalf("unable to settle canceled invoice: %v", err)
+	}
+	if dbInvoice.Terms.State != ContractSettled {
+		t.Fatalf("expected invoice to be settled, got %v",
+			dbInvoice.Terms.State)
+	}
+	if dbInvoice.SettleIndex == 0 {
+		t.Fatalf("expected settle index to be assigned")
+	}
+
+	// Finally, a settled invoice should no longer be cancelable.
+	settled, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(settled); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+	settledHash := sha256.Sum256(settled.Terms.PaymentPreimage[:])
+	if _, err := db.SettleInvoice(settledHash, amt); err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+	_, err = db.CancelInvoice(settledHash)
+	if err != ErrInvoiceAlreadySettled {
+		t.Fatalf("expected ErrInvoiceAlreadySettled, got: %v", err)
+	}
+}
+
+// TestDeleteCanceledInvoices tests that only canceled invoices created before
+// the given cutoff are deleted, along with their index entries.
+func TestDeleteCanceledInvoices(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	cutoff := time.Unix(1000, 0)
+	amt := lnwire.NewMSatFromSatoshis(1000)
+
+	// We'll add an old and a recent invoice that will both be canceled,
+	// along with an old invoice that will be settled, and an old invoice
+	// that remains open.
+	var hashes [4][32]byte
+	creationDates := []time.Time{
+		cutoff.Add(-time.Second),
+		cutoff,
+		cutoff.Add(-time.Second),
+		cutoff.Add(-time.Second),
+	}
+	for i, creationDate := range creationDates {
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		invoice.CreationDate = creationDate
+		if _, err := db.AddInvoice(invoice); err != nil {
+			t.Fatalf("unable to add invoice %v", err)
+		}
+		hashes[i] = sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+	}
+
+	for _, hash := range hashes[:2] {
+		if _, err := db.CancelInvoice(hash); err != nil {
+			t.Fatalf("unable to cancel invoice: %v", err)
+		}
+	}
+	if _, err := db.SettleInvoice(hashes[2], amt); err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+
+	numDeleted, err := db.DeleteCanceledInvoices(cutoff)
+	if err != nil {
+		t.Fatalf("unable to delete canceled invoices: %v", err)
+	}
+	if numDeleted != 1 {
+		t.Fatalf("expected 1 deleted invoice, got %d", numDeleted)
+	}
+
+	// Only the old canceled invoice should be gone, from both the invoice
+	// index and the add index.
+	if _, err := db.LookupInvoice(hashes[0]); err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got: %v", err)
+	}
+	for _, hash := range hashes[1:] {
+		if _, err := db.LookupInvoice(hash); err != nil {
+			t.Fatalf("unable to fetch invoice: %v", err)
+		}
+	}
+
+	added, err := db.InvoicesAddedSince(0)
+	if err != nil {
+		t.Fatalf("unable to query invoices: %v", err)
+	}
+	if len(added) != 3 {
+		t.Fatalf("expected 3 invoices in add index, got %d", len(added))
+	}
+}
+
+// TestInvoicePaymentRequestParams tests that the payment request parameters
+// of an invoice are stored along side it, and are returned by all lookups.
+func TestInvoicePaymentRequestParams(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// We'll add one invoice without any parameters, as if it was added
+	// by an older version, along with one invoice carrying parameters.
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	legacy, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(legacy); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	invoice.Params = &PaymentRequestParams{
+		FinalCltvDelta: 144,
+		Expiry:         time.Hour,
+		FallbackAddr:   "bcrt1qfallback",
+		RouteHints: [][]HopHint{
+			{
+				{
+					NodeID:                    pubKey,
+					ChannelID:                 12345,
+					FeeBaseMSat:               1000,
+					FeeProportionalMillionths: 1,
+					CLTVExpiryDelta:           40,
+				},
+				{
+					NodeID:          pubKey,
+					ChannelID:       54321,
+					CLTVExpiryDelta: 144,
+				},
+			},
+		},
+	}
+	if _, err := db.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+
+	legacyHash := sha256.Sum256(legacy.Terms.PaymentPreimage[:])
+	dbLegacy, err := db.LookupInvoice(legacyHash)
+	if err != nil {
+		t.Fatalf("unable to fetch invoice: %v", err)
+	}
+	if dbLegacy.Params != nil {
+		t.Fatalf("expected no params, got %v",
+			spew.Sdump(dbLegacy.Params))
+	}
+
+	// The parameters should be returned when looking up the invoice, and
+	// should survive the invoice being settled.
+	payHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+	if _, err := db.SettleInvoice(payHash, amt); err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+	dbInvoice, err := db.LookupInvoice(payHash)
+	if err != nil {
+		t.Fatalf("unable to fetch invoice: %v", err)
+	}
+	if !reflect.DeepEqual(invoice.Params, dbInvoice.Params) {
+		t.Fatalf("params mismatch: expected %v, got %v",
+			spew.Sdump(invoice.Params), spew.Sdump(dbInvoice.Params))
+	}
+
+	invoices, err := db.FetchAllInvoices(false)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	if len(invoices) != 2 {
+		t.Fatalf("expected 2 invoices, got %d", len(invoices))
+	}
+	if !reflect.DeepEqual(invoice.Params, invoices[1].Params) {
+		t.Fatalf("params mismatch: expected %v, got %v",
+			spew.Sdump(invoice.Params), spew.Sdump(invoices[1].Params))
+	}
+}
@@ -8,8 +8,9 @@ import (
 	"io"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -56,6 +57,16 @@ var (
 	//
 	//   settleIndexNo => invoiceKey
 	settleIndexBucket = []byte("invoice-settle-index")
+
+	// invoiceParamsBucket is the name of the sub-bucket within the
+	// invoiceBucket which stores the payment request parameters of each
+	// invoice. The parameters are stored separately from the invoice
+	// itself, as the serialized invoice is also embedded within outgoing
+	// payments. Invoices added before this bucket was introduced have no
+	// entry within it.
+	//
+	// maps: invoiceKey => PaymentRequestParams
+	invoiceParamsBucket = []byte("invoice-params")
 )
 
 const (
@@ -67,6 +78,10 @@ const (
 	// within the database along side incoming/outgoing invoices.
 	MaxReceiptSize = 1024
 
+	// MaxFallbackAddrSize is the maximum size of the encoded fallback
+	// address stored along side an invoice.
+	MaxFallbackAddrSize = 100
+
 	// MaxPaymentRequestSize is the max size of a payment request for
 	// this invoice.
 	// TODO(halseth): determine the max length payment request when field
@@ -74,6 +89,39 @@ const (
 	MaxPaymentRequestSize = 4096
 )
 
+// ContractState describes the state an invoice is in.
+type ContractState uint8
+
+const (
+	// ContractOpen means the invoice has only been created, and can still
+	// be paid.
+	ContractOpen ContractState = 0
+
+	// ContractSettled means an HTLC paying to the invoice has been
+	// settled, and the invoice has been paid.
+	ContractSettled ContractState = 1
+
+	// ContractCanceled means the invoice has been canceled, either
+	// explicitly or because it expired. A canceled invoice can no longer
+	// be paid, but may still move to settled if an HTLC paying to it was
+	// accepted before it was canceled.
+	ContractCanceled ContractState = 2
+)
+
+// String returns a human readable identifier for the ContractState.
+func (c ContractState) String() string {
+	switch c {
+	case ContractOpen:
+		return "Open"
+	case ContractSettled:
+		return "Settled"
+	case ContractCanceled:
+		return "Canceled"
+	}
+
+	return "Unknown"
+}
+
 // ContractTerm is a companion struct to the Invoice struct. This struct houses
 // the necessary conditions required before the invoice can be considered fully
 // settled by the payee.
@@ -87,9 +135,53 @@ type ContractTerm struct {
 	// which can be satisfied by the above preimage.
 	Value lnwire.MilliSatoshi
 
-	// Settled indicates if this particular contract term has been fully
-	// settled by the payer.
-	Settled bool
+	// State describes the state the invoice is in. Invoices start out
+	// open, and move to either settled or canceled. Only settled is
+	// final, as a canceled invoice is still settled if an HTLC paying to
+	// it that was accepted before it was canceled is settled.
+	State ContractState
+}
+
+// HopHint is a routing hint encoded within the payment request of an invoice,
+// describing a channel which can be used to reach the payee.
+type HopHint struct {
+	// NodeID is the public key of the node at the start of the channel.
+	NodeID *btcec.PublicKey
+
+	// ChannelID is the short channel ID of the channel.
+	ChannelID uint64
+
+	// FeeBaseMSat is the base fee of the channel in millisatoshis.
+	FeeBaseMSat uint32
+
+	// FeeProportionalMillionths is the fee rate, in millionths of a
+	// satoshi, for every satoshi sent through the channel.
+	FeeProportionalMillionths uint32
+
+	// CLTVExpiryDelta is the time-lock delta of the channel.
+	CLTVExpiryDelta uint16
+}
+
+// PaymentRequestParams houses the parameters that were encoded within the
+// payment request of an invoice, besides its amount, description and payment
+// hash. These are stored along side the invoice, such that they can be
+// retrieved without decoding the payment request.
+type PaymentRequestParams struct {
+	// FinalCltvDelta is the minimum CLTV delta the HTLC paying to the
+	// invoice must carry.
+	FinalCltvDelta uint32
+
+	// Expiry is the duration after the creation date of the invoice after
+	// which it expires.
+	Expiry time.Duration
+
+	// FallbackAddr is the encoded on-chain address the payer may fall back
+	// to, or an empty string if none was included.
+	FallbackAddr string
+
+	// RouteHints is the set of route hints that were included, each of
+	// which is a list of hop hints leading to the payee.
+	RouteHints [][]HopHint
 }
 
 // Invoice is a payment invoice generated by a payee in order to request
@@ -98,8 +190,9 @@ type ContractTerm struct {
 // existing financial system within PayPal, etc.  Invoices are added to the
 // database when a payment is requested, then can be settled manually once the
 // payment is received at the upper layer. For record keeping purposes,
-// invoices are never deleted from the database, instead a bit is toggled
-// denoting the invoice has been fully settled. Within the database, all
+// settled invoices are never deleted from the database, instead their state
+// is updated to denote the invoice has been fully settled. Only canceled
+// invoices may be deleted, as they were never paid. Within the database, all
 // invoices must have a unique payment hash which is generated by taking the
 // sha256 of the payment preimage.
 type Invoice struct {
@@ -155,6 +248,11 @@ type Invoice struct {
 	// that the invoice originally didn't specify an amount, or the sender
 	// overpaid.
 	AmtPaid lnwire.MilliSatoshi
+
+	// Params holds the parameters encoded within the payment request of
+	// the invoice. This is nil for invoices that were added before these
+	// parameters were stored.
+	Params *PaymentRequestParams
 }
 
 func validateInvoice(i *Invoice) error {
@@ -172,6 +270,13 @@ func validateInvoice(i *Invoice) error {
 			"provided was %v", MaxPaymentRequestSize,
 			len(i.PaymentRequest))
 	}
+	if i.Params != nil &&
+		len(i.Params.FallbackAddr) > MaxFallbackAddrSize {
+
+		return fmt.Errorf("max length of fallback address is %v, "+
+			"length provided was %v", MaxFallbackAddrSize,
+			len(i.Params.FallbackAddr))
+	}
 	return nil
 }
 
@@ -185,7 +290,7 @@ func (d *DB) AddInvoice(newInvoice *Invoice) (uint64, error) {
 	}
 
 	var invoiceAddIndex uint64
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 		if err != nil {
 			return err
@@ -265,7 +370,7 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 	var startIndex [8]byte
 	byteOrder.PutUint64(startIndex[:], sinceAddIndex)
 
-	err := d.DB.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -320,7 +425,7 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 // terms of the payment.
 func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 	var invoice Invoice
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -355,12 +460,12 @@ func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 }
 
 // FetchAllInvoices returns all invoices currently stored within the database.
-// If the pendingOnly param is true, then only unsettled invoices will be
-// returned, skipping all invoices that are fully settled.
+// If the pendingOnly param is true, then only open invoices will be returned,
+// skipping all invoices that are either settled or canceled.
 func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 	var invoices []Invoice
 
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoiceB := tx.Bucket(invoiceBucket)
 		if invoiceB == nil {
 			return ErrNoInvoicesCreated
@@ -380,7 +485,12 @@ func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 				return err
 			}
 
-			if pendingOnly && invoice.Terms.Settled {
+			invoice.Params, err = fetchInvoiceParams(k, invoiceB)
+			if err != nil {
+				return err
+			}
+
+			if pendingOnly && invoice.Terms.State != ContractOpen {
 				return nil
 			}
 
@@ -408,8 +518,8 @@ type InvoiceQuery struct {
 	// starting from the add index.
 	NumMaxInvoices uint64
 
-	// PendingOnly, if set, returns unsettled invoices starting from the
-	// add index.
+	// PendingOnly, if set, returns open invoices starting from the add
+	// index.
 	PendingOnly bool
 
 	// Reversed, if set, indicates that the invoices returned should start
@@ -449,7 +559,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 		InvoiceQuery: q,
 	}
 
-	err := d.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		// If the bucket wasn't found, then there aren't any invoices
 		// within the database yet, so we can simply exit.
 		invoices := tx.Bucket(invoiceBucket)
@@ -463,7 +573,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 
 		// keyForIndex is a helper closure that retrieves the invoice
 		// key for the given add index of an invoice.
-		keyForIndex := func(c *bbolt.Cursor, index uint64) []byte {
+		keyForIndex := func(c kvdb.Cursor, index uint64) []byte {
 			var keyIndex [8]byte
 			byteOrder.PutUint64(keyIndex[:], index)
 			_, invoiceKey := c.Seek(keyIndex[:])
@@ -472,7 +582,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 
 		// nextKey is a helper closure to determine what the next
 		// invoice key is when iterating over the invoice add index.
-		nextKey := func(c *bbolt.Cursor) ([]byte, []byte) {
+		nextKey := func(c kvdb.Cursor) ([]byte, []byte) {
 			if q.Reversed {
 				return c.Prev()
 			}
@@ -526,9 +636,11 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 				return err
 			}
 
-			// Skip any settled invoices if the caller is only
-			// interested in unsettled.
-			if q.PendingOnly && invoice.Terms.Settled {
+			// Skip any settled or canceled invoices if the caller
+			// is only interested in open invoices.
+			if q.PendingOnly &&
+				invoice.Terms.State != ContractOpen {
+
 				continue
 			}
 
@@ -568,12 +680,13 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 // SettleInvoice attempts to mark an invoice corresponding to the passed
 // payment hash as fully settled. If an invoice matching the passed payment
 // hash doesn't existing within the database, then the action will fail with a
-// "not found" error.
+// "not found" error. As this is only to be called once an HTLC paying to the
+// invoice has been settled, canceled invoices are settled as well.
 func (d *DB) SettleInvoice(paymentHash [32]byte,
 	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
 
 	var settledInvoice *Invoice
-	err := d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx kvdb.Tx) error {
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 		if err != nil {
 			return err
@@ -605,6 +718,15 @@ func (d *DB) SettleInvoice(paymentHash [32]byte,
 			return err
 		}
 
+		// If the invoice was created on behalf of an account, the
+		// amount paid is credited to it within the same transaction.
+		// Duplicate settles won't credit the account again, as its
+		// association with the invoice is removed once credited.
+		err = creditAccountInvoice(tx, paymentHash, invoice.AmtPaid)
+		if err != nil {
+			return err
+		}
+
 		settledInvoice = invoice
 		return nil
 	})
@@ -615,6 +737,148 @@ func (d *DB) SettleInvoice(paymentHash [32]byte,
 	return settledInvoice, nil
 }
 
+// CancelInvoice attempts to cancel the open invoice corresponding to the passed
+// payment hash. Once canceled, HTLCs paying to the invoice are to be rejected.
+// Canceling an invoice that has already been settled fails with
+// ErrInvoiceAlreadySettled, while canceling an invoice twice is a noop.
+func (d *DB) CancelInvoice(paymentHash [32]byte) (*Invoice, error) {
+	var canceledInvoice *Invoice
+	err := d.Update(func(tx kvdb.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrInvoiceNotFound
+		}
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			return ErrInvoiceNotFound
+		}
+
+		// Check the invoice index to see if an invoice paying to this
+		// hash exists within the DB.
+		invoiceNum := invoiceIndex.Get(paymentHash[:])
+		if invoiceNum == nil {
+			return ErrInvoiceNotFound
+		}
+
+		invoice, err := cancelInvoice(invoices, invoiceNum)
+		if err != nil {
+			return err
+		}
+
+		canceledInvoice = invoice
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return canceledInvoice, nil
+}
+
+// DeleteCanceledInvoices deletes all canceled invoices that were created
+// before the passed time, along with their index entries. Settled and open
+// invoices are never deleted. The number of deleted invoices is returned.
+func (d *DB) DeleteCanceledInvoices(createdBefore time.Time) (int, error) {
+	var numDeleted int
+	err := d.Update(func(tx kvdb.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return nil
+		}
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			return nil
+		}
+		addIndex := invoices.Bucket(addIndexBucket)
+		if addIndex == nil {
+			return nil
+		}
+
+		// We'll first collect all invoices to delete, as we can't
+		// modify the bucket while iterating over it.
+		type deletion struct {
+			invoiceNum []byte
+			invoice    Invoice
+		}
+		var deletions []deletion
+		err := invoices.ForEach(func(k, v []byte) error {
+			// Skip any sub-buckets.
+			if v == nil {
+				return nil
+			}
+
+			invoice, err := deserializeInvoice(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if invoice.Terms.State != ContractCanceled {
+				return nil
+			}
+			if !invoice.CreationDate.Before(createdBefore) {
+				return nil
+			}
+
+			invoiceNum := make([]byte, len(k))
+			copy(invoiceNum, k)
+			deletions = append(deletions, deletion{
+				invoiceNum: invoiceNum,
+				invoice:    invoice,
+			})
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, del := range deletions {
+			paymentHash := sha256.Sum256(
+				del.invoice.Terms.PaymentPreimage[:],
+			)
+			if err := invoiceIndex.Delete(paymentHash[:]); err != nil {
+				return err
+			}
+
+			var seqNoBytes [8]byte
+			byteOrder.PutUint64(seqNoBytes[:], del.invoice.AddIndex)
+			if err := addIndex.Delete(seqNoBytes[:]); err != nil {
+				return err
+			}
+
+			if err := invoices.Delete(del.invoiceNum); err != nil {
+				return err
+			}
+
+			// A canceled invoice will never credit the account it
+			// was created on behalf of.
+			accountInvoices := tx.Bucket(accountInvoiceBucket)
+			if accountInvoices != nil {
+				err := accountInvoices.Delete(paymentHash[:])
+				if err != nil {
+					return err
+				}
+			}
+
+			params := invoices.Bucket(invoiceParamsBucket)
+			if params == nil {
+				continue
+			}
+			if err := params.Delete(del.invoiceNum); err != nil {
+				return err
+			}
+		}
+
+		numDeleted = len(deletions)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numDeleted, nil
+}
+
 // InvoicesSettledSince can be used by callers to catch up any settled invoices
 // they missed within the settled invoice time series. We'll return all known
 // settled invoice that have a settle index higher than the passed
@@ -634,7 +898,7 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 	var startIndex [8]byte
 	byteOrder.PutUint64(startIndex[:], sinceSettleIndex)
 
-	err := d.DB.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx kvdb.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -676,7 +940,7 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 	return settledInvoices, nil
 }
 
-func putInvoice(invoices, invoiceIndex, addIndex *bbolt.Bucket,
+func putInvoice(invoices, invoiceIndex, addIndex kvdb.Bucket,
 	i *Invoice, invoiceNum uint32) (uint64, error) {
 
 	// Create the invoice key which is just the big-endian representation
@@ -731,6 +995,26 @@ func putInvoice(invoices, invoiceIndex, addIndex *bbolt.Bucket,
 		return 0, err
 	}
 
+	// If the invoice carries its payment request parameters, we'll store
+	// them within their own bucket.
+	if i.Params == nil {
+		return nextAddSeqNo, nil
+	}
+
+	params, err := invoices.CreateBucketIfNotExists(invoiceParamsBucket)
+	if err != nil {
+		return 0, err
+	}
+
+	var paramsBuf bytes.Buffer
+	if err := serializePaymentRequestParams(&paramsBuf, i.Params); err != nil {
+		return 0, err
+	}
+
+	if err := params.Put(invoiceKey[:], paramsBuf.Bytes()); err != nil {
+		return 0, err
+	}
+
 	return nextAddSeqNo, nil
 }
 
@@ -773,7 +1057,7 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		return err
 	}
 
-	if err := binary.Write(w, byteOrder, i.Terms.Settled); err != nil {
+	if err := binary.Write(w, byteOrder, i.Terms.State); err != nil {
 		return err
 	}
 
@@ -790,7 +1074,7 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 	return nil
 }
 
-func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
+func fetchInvoice(invoiceNum []byte, invoices kvdb.Bucket) (Invoice, error) {
 	invoiceBytes := invoices.Get(invoiceNum)
 	if invoiceBytes == nil {
 		return Invoice{}, ErrInvoiceNotFound
@@ -798,7 +1082,110 @@ func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
 
 	invoiceReader := bytes.NewReader(invoiceBytes)
 
-	return deserializeInvoice(invoiceReader)
+	invoice, err := deserializeInvoice(invoiceReader)
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	invoice.Params, err = fetchInvoiceParams(invoiceNum, invoices)
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	return invoice, nil
+}
+
+// fetchInvoiceParams fetches the payment request parameters of the invoice
+// with the given key. If none were stored for the invoice, nil is returned.
+func fetchInvoiceParams(invoiceNum []byte,
+	invoices kvdb.Bucket) (*PaymentRequestParams, error) {
+
+	params := invoices.Bucket(invoiceParamsBucket)
+	if params == nil {
+		return nil, nil
+	}
+
+	paramsBytes := params.Get(invoiceNum)
+	if paramsBytes == nil {
+		return nil, nil
+	}
+
+	return deserializePaymentRequestParams(bytes.NewReader(paramsBytes))
+}
+
+func serializePaymentRequestParams(w io.Writer,
+	p *PaymentRequestParams) error {
+
+	err := WriteElements(w,
+		p.FinalCltvDelta, uint64(p.Expiry), []byte(p.FallbackAddr),
+		uint16(len(p.RouteHints)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, routeHint := range p.RouteHints {
+		err := WriteElement(w, uint16(len(routeHint)))
+		if err != nil {
+			return err
+		}
+
+		for _, h := range routeHint {
+			err := WriteElements(w,
+				h.NodeID, h.ChannelID, h.FeeBaseMSat,
+				h.FeeProportionalMillionths, h.CLTVExpiryDelta,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func deserializePaymentRequestParams(r io.Reader) (*PaymentRequestParams,
+	error) {
+
+	var (
+		p             PaymentRequestParams
+		expiry        uint64
+		fallbackAddr  []byte
+		numRouteHints uint16
+	)
+	err := ReadElements(r,
+		&p.FinalCltvDelta, &expiry, &fallbackAddr, &numRouteHints,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.Expiry = time.Duration(expiry)
+	p.FallbackAddr = string(fallbackAddr)
+
+	if numRouteHints > 0 {
+		p.RouteHints = make([][]HopHint, numRouteHints)
+	}
+	for i := range p.RouteHints {
+		var numHops uint16
+		if err := ReadElement(r, &numHops); err != nil {
+			return nil, err
+		}
+
+		routeHint := make([]HopHint, numHops)
+		for j := range routeHint {
+			h := &routeHint[j]
+			err := ReadElements(r,
+				&h.NodeID, &h.ChannelID, &h.FeeBaseMSat,
+				&h.FeeProportionalMillionths, &h.CLTVExpiryDelta,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		p.RouteHints[i] = routeHint
+	}
+
+	return &p, nil
 }
 
 func deserializeInvoice(r io.Reader) (Invoice, error) {
@@ -845,7 +1232,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	}
 	invoice.Terms.Value = lnwire.MilliSatoshi(byteOrder.Uint64(scratch[:]))
 
-	if err := binary.Read(r, byteOrder, &invoice.Terms.Settled); err != nil {
+	if err := binary.Read(r, byteOrder, &invoice.Terms.State); err != nil {
 		return invoice, err
 	}
 
@@ -862,7 +1249,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	return invoice, nil
 }
 
-func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
+func settleInvoice(invoices, settleIndex kvdb.Bucket, invoiceNum []byte,
 	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
 
 	invoice, err := fetchInvoice(invoiceNum, invoices)
@@ -871,8 +1258,11 @@ func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
 	}
 
 	// Add idempotency to duplicate settles, return here to avoid
-	// overwriting the previous info.
-	if invoice.Terms.Settled {
+	// overwriting the previous info. Canceled invoices are settled like
+	// open ones, as an invoice is only settled once an HTLC paying to it
+	// has been settled, which may happen if it expired after the HTLC was
+	// accepted.
+	if invoice.Terms.State == ContractSettled {
 		return &invoice, nil
 	}
 
@@ -891,7 +1281,7 @@ func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
 	}
 
 	invoice.AmtPaid = amtPaid
-	invoice.Terms.Settled = true
+	invoice.Terms.State = ContractSettled
 	invoice.SettleDate = time.Now()
 	invoice.SettleIndex = nextSettleSeqNo
 
@@ -906,3 +1296,34 @@ func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
 
 	return &invoice, nil
 }
+
+func cancelInvoice(invoices kvdb.Bucket, invoiceNum []byte) (*Invoice, error) {
+	invoice, err := fetchInvoice(invoiceNum, invoices)
+	if err != nil {
+		return nil, err
+	}
+
+	switch invoice.Terms.State {
+	// Add idempotency to duplicate cancels.
+	case ContractCanceled:
+		return &invoice, nil
+
+	// A settled invoice has already been paid, so it can't be canceled
+	// anymore.
+	case ContractSettled:
+		return nil, ErrInvoiceAlreadySettled
+	}
+
+	invoice.Terms.State = ContractCanceled
+
+	var buf bytes.Buffer
+	if err := serializeInvoice(&buf, &invoice); err != nil {
+		return nil, err
+	}
+
+	if err := invoices.Put(invoiceNum[:], buf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return &invoice, nil
+}
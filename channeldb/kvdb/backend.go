@@ -0,0 +1,92 @@
+package kvdb
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Driver describes a backend implementation that can be opened by name
+// through Open.
+type Driver struct {
+	// Name is the unique name of the backend, such as BoltBackendName.
+	Name string
+
+	// Open opens a new instance of the backend. The meaning of the passed
+	// arguments is specific to each backend.
+	Open func(args ...interface{}) (Backend, error)
+}
+
+var (
+	driversMtx sync.RWMutex
+	drivers    = make(map[string]*Driver)
+)
+
+// RegisterDriver adds a backend driver to the set of available backends. An
+// error is returned if a driver with the same name was already registered.
+func RegisterDriver(driver Driver) error {
+	driversMtx.Lock()
+	defer driversMtx.Unlock()
+
+	if _, ok := drivers[driver.Name]; ok {
+		return fmt.Errorf("kvdb driver %v already registered",
+			driver.Name)
+	}
+
+	drivers[driver.Name] = &driver
+	return nil
+}
+
+// SupportedDrivers returns the names of all registered backend drivers.
+func SupportedDrivers() []string {
+	driversMtx.RLock()
+	defer driversMtx.RUnlock()
+
+	names := make([]string, 0, len(drivers))
+	for name := range drivers {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// Open opens an instance of the backend registered under the given name,
+// passing along the backend specific arguments.
+func Open(name string, args ...interface{}) (Backend, error) {
+	driversMtx.RLock()
+	driver, ok := drivers[name]
+	driversMtx.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown kvdb backend %v, supported "+
+			"backends: %v", name, SupportedDrivers())
+	}
+
+	return driver.Open(args...)
+}
+
+// openBoltDriver parses the arguments of the bolt driver, which expects the
+// path to the database file as its sole argument.
+func openBoltDriver(args ...interface{}) (Backend, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid number of arguments to bolt "+
+			"backend: expected 1, got %v", len(args))
+	}
+
+	path, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("first argument to bolt backend is " +
+			"not a path string")
+	}
+
+	return GetBoltBackend(path)
+}
+
+func init() {
+	err := RegisterDriver(Driver{
+		Name: BoltBackendName,
+		Open: openBoltDriver,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("unable to register bolt driver: %v", err))
+	}
+}
@@ -0,0 +1,299 @@
+package kvdb
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/coreos/bbolt"
+)
+
+const (
+	// BoltBackendName is the name of the bbolt backend, the default
+	// backend used by lnd.
+	BoltBackendName = "bolt"
+
+	// boltFilePermission is the permission the database file is created
+	// with.
+	boltFilePermission = 0600
+)
+
+// boltBackend is a Backend backed by a local bbolt database file.
+type boltBackend struct {
+	db *bbolt.DB
+}
+
+// A compile time check to ensure boltBackend implements the Backend
+// interface.
+var _ Backend = (*boltBackend)(nil)
+
+// GetBoltBackend opens the bbolt database stored at the given path, creating
+// the database file and any missing parent directories if necessary.
+func GetBoltBackend(path string) (Backend, error) {
+	return GetBoltBackendWithTimeout(path, 0)
+}
+
+// GetBoltBackendWithTimeout opens the bbolt database stored at the given path
+// like GetBoltBackend. If the database is held open by another process and
+// the timeout is non-zero, ErrTimeout is returned once the timeout expires,
+// rather than waiting for the database to be released indefinitely.
+func GetBoltBackendWithTimeout(path string,
+	timeout time.Duration) (Backend, error) {
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	db, err := bbolt.Open(
+		path, boltFilePermission, &bbolt.Options{Timeout: timeout},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltBackend{db: db}, nil
+}
+
+// Begin starts a new transaction.
+//
+// NOTE: Part of the Backend interface.
+func (b *boltBackend) Begin(writable bool) (Tx, error) {
+	tx, err := b.db.Begin(writable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltTx{tx: tx}, nil
+}
+
+// View executes the passed function within a managed read-only transaction.
+//
+// NOTE: Part of the Backend interface.
+func (b *boltBackend) View(f func(tx Tx) error) error {
+	return b.db.View(func(tx *bbolt.Tx) error {
+		return f(&boltTx{tx: tx})
+	})
+}
+
+// Update executes the passed function within a managed read-write
+// transaction.
+//
+// NOTE: Part of the Backend interface.
+func (b *boltBackend) Update(f func(tx Tx) error) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		return f(&boltTx{tx: tx})
+	})
+}
+
+// Batch executes the passed function within a read-write transaction that may
+// be shared with concurrent Batch calls.
+//
+// NOTE: Part of the Backend interface.
+func (b *boltBackend) Batch(f func(tx Tx) error) error {
+	return b.db.Batch(func(tx *bbolt.Tx) error {
+		return f(&boltTx{tx: tx})
+	})
+}
+
+// Close closes the underlying database file.
+//
+// NOTE: Part of the Backend interface.
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
+
+// boltTx wraps a bbolt transaction.
+type boltTx struct {
+	tx *bbolt.Tx
+}
+
+// A compile time check to ensure boltTx implements the Tx and SnapshotTx
+// interfaces.
+var _ Tx = (*boltTx)(nil)
+var _ SnapshotTx = (*boltTx)(nil)
+
+// Bucket retrieves the top-level bucket with the given name.
+//
+// NOTE: Part of the Tx interface.
+func (t *boltTx) Bucket(name []byte) Bucket {
+	return wrapBoltBucket(t.tx.Bucket(name))
+}
+
+// CreateBucket creates a new top-level bucket with the given name.
+//
+// NOTE: Part of the Tx interface.
+func (t *boltTx) CreateBucket(name []byte) (Bucket, error) {
+	bucket, err := t.tx.CreateBucket(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapBoltBucket(bucket), nil
+}
+
+// CreateBucketIfNotExists creates a new top-level bucket with the given name
+// if it doesn't exist yet.
+//
+// NOTE: Part of the Tx interface.
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	bucket, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapBoltBucket(bucket), nil
+}
+
+// DeleteBucket deletes the top-level bucket with the given name.
+//
+// NOTE: Part of the Tx interface.
+func (t *boltTx) DeleteBucket(name []byte) error {
+	return t.tx.DeleteBucket(name)
+}
+
+// Writable returns whether the transaction can be used to modify the
+// database.
+//
+// NOTE: Part of the Tx interface.
+func (t *boltTx) Writable() bool {
+	return t.tx.Writable()
+}
+
+// Commit writes all changes made within the transaction to disk.
+//
+// NOTE: Part of the Tx interface.
+func (t *boltTx) Commit() error {
+	return t.tx.Commit()
+}
+
+// Rollback discards all changes made within the transaction.
+//
+// NOTE: Part of the Tx interface.
+func (t *boltTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+// WriteTo writes a copy of the entire database file, as seen by the
+// transaction, to w.
+//
+// NOTE: Part of the SnapshotTx interface.
+func (t *boltTx) WriteTo(w io.Writer) (int64, error) {
+	return t.tx.WriteTo(w)
+}
+
+// boltBucket wraps a bbolt bucket.
+type boltBucket struct {
+	bucket *bbolt.Bucket
+}
+
+// A compile time check to ensure boltBucket implements the Bucket interface.
+var _ Bucket = (*boltBucket)(nil)
+
+// wrapBoltBucket wraps the passed bbolt bucket. A nil bucket results in a nil
+// interface value, such that callers can keep checking for the existence of a
+// bucket by comparing it against nil.
+func wrapBoltBucket(bucket *bbolt.Bucket) Bucket {
+	if bucket == nil {
+		return nil
+	}
+
+	return &boltBucket{bucket: bucket}
+}
+
+// Bucket retrieves the nested bucket with the given name.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) Bucket(name []byte) Bucket {
+	return wrapBoltBucket(b.bucket.Bucket(name))
+}
+
+// CreateBucket creates a new nested bucket with the given name.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) CreateBucket(name []byte) (Bucket, error) {
+	bucket, err := b.bucket.CreateBucket(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapBoltBucket(bucket), nil
+}
+
+// CreateBucketIfNotExists creates a new nested bucket with the given name if
+// it doesn't exist yet.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	bucket, err := b.bucket.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapBoltBucket(bucket), nil
+}
+
+// DeleteBucket deletes the nested bucket with the given name.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) DeleteBucket(name []byte) error {
+	return b.bucket.DeleteBucket(name)
+}
+
+// Get returns the value stored under the given key.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) Get(key []byte) []byte {
+	return b.bucket.Get(key)
+}
+
+// Put stores the given value under the given key.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) Put(key, value []byte) error {
+	return b.bucket.Put(key, value)
+}
+
+// Delete removes the given key from the bucket.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) Delete(key []byte) error {
+	return b.bucket.Delete(key)
+}
+
+// ForEach executes the passed function for every key/value pair within the
+// bucket.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) ForEach(fn func(k, v []byte) error) error {
+	return b.bucket.ForEach(fn)
+}
+
+// Cursor returns a new cursor over the bucket.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) Cursor() Cursor {
+	return b.bucket.Cursor()
+}
+
+// Sequence returns the current value of the bucket's sequence.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) Sequence() uint64 {
+	return b.bucket.Sequence()
+}
+
+// NextSequence increments the bucket's sequence and returns the new value.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) NextSequence() (uint64, error) {
+	return b.bucket.NextSequence()
+}
+
+// SetSequence overwrites the bucket's sequence with the given value.
+//
+// NOTE: Part of the Bucket interface.
+func (b *boltBucket) SetSequence(v uint64) error {
+	return b.bucket.SetSequence(v)
+}
@@ -0,0 +1,205 @@
+package kvdb
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/coreos/bbolt"
+)
+
+const (
+	// compactTxMaxSize is the maximum number of bytes written to the
+	// compacted database within a single transaction. Splitting the copy
+	// into several transactions bounds the memory used while compacting
+	// large databases.
+	compactTxMaxSize = 64 * 1024 * 1024
+
+	// compactTempSuffix is appended to the path of the database to obtain
+	// the path of the temporary file the database is compacted into.
+	compactTempSuffix = ".compact"
+)
+
+// CompactBoltDB compacts the bolt database stored at the given path. All
+// buckets and key/value pairs are copied into a fresh file, which then
+// atomically replaces the original file. As bbolt never shrinks its database
+// file, this is the only way to reclaim the space of deleted data. The sizes
+// of the database file before and after the compaction are returned.
+//
+// NOTE: The database must not be opened by anyone else while it's being
+// compacted.
+func CompactBoltDB(path string) (int64, int64, error) {
+	srcInfo, err := os.Stat(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	src, err := bbolt.Open(path, boltFilePermission, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer src.Close()
+
+	tempPath := path + compactTempSuffix
+	if err := os.Remove(tempPath); err != nil && !os.IsNotExist(err) {
+		return 0, 0, err
+	}
+
+	dst, err := bbolt.Open(tempPath, boltFilePermission, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := compactBolt(dst, src); err != nil {
+		dst.Close()
+		os.Remove(tempPath)
+		return 0, 0, fmt.Errorf("unable to compact %v: %v", path, err)
+	}
+
+	if err := dst.Close(); err != nil {
+		os.Remove(tempPath)
+		return 0, 0, err
+	}
+
+	dstInfo, err := os.Stat(tempPath)
+	if err != nil {
+		os.Remove(tempPath)
+		return 0, 0, err
+	}
+
+	// With the copy complete, we'll close the source database and swap in
+	// the compacted file.
+	if err := src.Close(); err != nil {
+		os.Remove(tempPath)
+		return 0, 0, err
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return 0, 0, err
+	}
+
+	return srcInfo.Size(), dstInfo.Size(), nil
+}
+
+// compactBolt copies all buckets and key/value pairs of the source database
+// into the destination database, committing a new transaction whenever
+// compactTxMaxSize bytes have been written.
+func compactBolt(dst, src *bbolt.DB) error {
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The transaction is replaced whenever it is committed, so we
+		// roll back whichever one is open once we're done.
+		tx.Rollback()
+	}()
+
+	var size int64
+	copyEntry := func(path [][]byte, k, v []byte, seq uint64) error {
+		// Commit the current transaction if this write would exceed
+		// the maximum size.
+		sz := int64(len(k) + len(v))
+		if size+sz > compactTxMaxSize {
+			if err := tx.Commit(); err != nil {
+				return err
+			}
+
+			tx, err = dst.Begin(true)
+			if err != nil {
+				return err
+			}
+			size = 0
+		}
+		size += sz
+
+		return copyBoltEntry(tx, path, k, v, seq)
+	}
+
+	err = src.View(func(srcTx *bbolt.Tx) error {
+		return srcTx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+			return walkBoltBucket(
+				b, [][]byte{name}, b.Sequence(), copyEntry,
+			)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// walkFunc is called for every entry found while walking a bolt database.
+// The path is the list of bucket names leading to the bucket holding the
+// entry. Nested buckets are visited with a nil value, and their sequence.
+type walkFunc func(path [][]byte, k, v []byte, seq uint64) error
+
+// walkBoltBucket recursively visits all entries of the passed bucket, after
+// first visiting the bucket itself.
+func walkBoltBucket(b *bbolt.Bucket, path [][]byte, seq uint64,
+	fn walkFunc) error {
+
+	parent, name := path[:len(path)-1], path[len(path)-1]
+	if err := fn(parent, name, nil, seq); err != nil {
+		return err
+	}
+
+	return b.ForEach(func(k, v []byte) error {
+		nested := b.Bucket(k)
+		if nested == nil {
+			if v == nil {
+				v = []byte{}
+			}
+			return fn(path, k, v, 0)
+		}
+
+		nestedPath := append(append([][]byte{}, path...), k)
+
+		return walkBoltBucket(nested, nestedPath, nested.Sequence(), fn)
+	})
+}
+
+// copyBoltEntry writes a single entry found while walking the source database
+// into the destination transaction.
+func copyBoltEntry(tx *bbolt.Tx, path [][]byte, k, v []byte,
+	seq uint64) error {
+
+	// Entries without a path are top-level buckets.
+	if len(path) == 0 {
+		b, err := tx.CreateBucket(k)
+		if err != nil {
+			return err
+		}
+
+		return b.SetSequence(seq)
+	}
+
+	// Otherwise, we'll navigate to the parent bucket of the entry, which
+	// has already been created by a prior visit.
+	b := tx.Bucket(path[0])
+	for _, name := range path[1:] {
+		if b == nil {
+			break
+		}
+		b = b.Bucket(name)
+	}
+	if b == nil {
+		return fmt.Errorf("parent bucket of key %x not found", k)
+	}
+
+	// Nested buckets are visited with a nil value.
+	if v == nil {
+		nested, err := b.CreateBucket(k)
+		if err != nil {
+			return err
+		}
+
+		return nested.SetSequence(seq)
+	}
+
+	// As we only insert keys in order, we can instruct bbolt to fill its
+	// pages completely.
+	b.FillPercent = 1.0
+
+	return b.Put(k, v)
+}
@@ -0,0 +1,132 @@
+package kvdb
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCompactBoltDB asserts that compacting a bolt database shrinks its file
+// while preserving all buckets, key/value pairs and sequences.
+func TestCompactBoltDB(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "kvdb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	path := filepath.Join(tempDir, "test.db")
+	db, err := GetBoltBackend(path)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+
+	const numKeys = 10000
+	value := bytes.Repeat([]byte{1}, 100)
+
+	// We'll fill a nested bucket with a large number of keys, and then
+	// delete most of them again, which leaves the database file with a
+	// lot of free pages.
+	err = db.Update(func(tx Tx) error {
+		bucket, err := tx.CreateBucket(testBucket)
+		if err != nil {
+			return err
+		}
+		nested, err := bucket.CreateBucket(testNested)
+		if err != nil {
+			return err
+		}
+		if err := nested.SetSequence(42); err != nil {
+			return err
+		}
+
+		for i := 0; i < numKeys; i++ {
+			key := []byte(fmt.Sprintf("%08d", i))
+			if err := nested.Put(key, value); err != nil {
+				return err
+			}
+		}
+
+		return bucket.Put(testKeys[0], nil)
+	})
+	if err != nil {
+		t.Fatalf("unable to fill db: %v", err)
+	}
+
+	err = db.Update(func(tx Tx) error {
+		nested := tx.Bucket(testBucket).Bucket(testNested)
+		for i := 1; i < numKeys; i++ {
+			key := []byte(fmt.Sprintf("%08d", i))
+			if err := nested.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to delete keys: %v", err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatalf("unable to close db: %v", err)
+	}
+
+	oldSize, newSize, err := CompactBoltDB(path)
+	if err != nil {
+		t.Fatalf("unable to compact db: %v", err)
+	}
+	if newSize >= oldSize {
+		t.Fatalf("expected compacted size %v to be below %v", newSize,
+			oldSize)
+	}
+
+	db, err = GetBoltBackend(path)
+	if err != nil {
+		t.Fatalf("unable to open compacted db: %v", err)
+	}
+	defer db.Close()
+
+	err = db.View(func(tx Tx) error {
+		bucket := tx.Bucket(testBucket)
+		if bucket == nil {
+			t.Fatalf("bucket not found")
+		}
+		if v := bucket.Get(testKeys[0]); v == nil || len(v) != 0 {
+			t.Fatalf("expected empty value, got %x", v)
+		}
+
+		nested := bucket.Bucket(testNested)
+		if nested == nil {
+			t.Fatalf("nested bucket not found")
+		}
+		if seq := nested.Sequence(); seq != 42 {
+			t.Fatalf("expected sequence 42, got %v", seq)
+		}
+
+		var numFound int
+		err := nested.ForEach(func(k, v []byte) error {
+			if !bytes.Equal(v, value) {
+				t.Fatalf("value mismatch for key %s", k)
+			}
+			numFound++
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if numFound != 1 {
+			t.Fatalf("expected 1 key, found %v", numFound)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read compacted db: %v", err)
+	}
+}
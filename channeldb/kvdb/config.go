@@ -0,0 +1,39 @@
+package kvdb
+
+const (
+	// EtcdBackendName is the name of the etcd backend. The backend is only
+	// available if lnd is built with the kvdb_etcd build tag.
+	EtcdBackendName = "etcd"
+)
+
+// EtcdConfig holds the parameters needed to connect to an etcd cluster.
+type EtcdConfig struct {
+	// Host is the address of the etcd cluster, in host:port format.
+	Host string `long:"host" description:"Etcd database host."`
+
+	// User is the name of the user to authenticate as, if any.
+	User string `long:"user" description:"Etcd database user."`
+
+	// Pass is the password of the user to authenticate as, if any.
+	Pass string `long:"pass" description:"Password for the database user."`
+
+	// Prefix is prepended to every key written by this instance, allowing
+	// several databases to share a single etcd cluster.
+	Prefix string `long:"prefix" description:"Prefix prepended to all keys, allowing several nodes to share a single etcd cluster."`
+
+	// CertFile is the path to the TLS certificate used to authenticate
+	// with the cluster.
+	CertFile string `long:"certfile" description:"Path to the TLS certificate for etcd RPC."`
+
+	// KeyFile is the path to the TLS key used to authenticate with the
+	// cluster.
+	KeyFile string `long:"keyfile" description:"Path to the TLS private key for etcd RPC."`
+
+	// InsecureSkipVerify disables the verification of the cluster's TLS
+	// certificate.
+	InsecureSkipVerify bool `long:"insecureskipverify" description:"Whether we intend to skip TLS verification."`
+
+	// DisableTLS connects to the cluster without TLS. This should only be
+	// used for local testing.
+	DisableTLS bool `long:"disabletls" description:"Whether to connect to etcd without TLS. Only intended for testing."`
+}
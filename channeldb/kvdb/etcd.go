@@ -0,0 +1,955 @@
+// +build kvdb_etcd
+
+package kvdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/pkg/transport"
+)
+
+const (
+	// etcdTimeout is the maximum time we'll wait for a single request to
+	// the etcd cluster to complete.
+	etcdTimeout = 30 * time.Second
+
+	// valueMarker prefixes all values that hold regular data.
+	valueMarker byte = 0
+
+	// bucketMarker is the value of all keys that refer to a nested
+	// bucket.
+	bucketMarker byte = 1
+
+	// maxTxRetries is the number of times a managed read-write
+	// transaction is attempted before a conflict is returned to the
+	// caller.
+	maxTxRetries = 10
+)
+
+var (
+	// ErrTxConflict is returned when a read-write transaction couldn't be
+	// committed, as the keys it read were modified concurrently.
+	ErrTxConflict = errors.New("etcd transaction conflict")
+)
+
+// bucketID uniquely identifies a bucket within the key space of the backend.
+// It is derived from the ID of the parent bucket and the bucket's name, which
+// keeps the keys of nested buckets short regardless of their depth.
+type bucketID [sha256.Size]byte
+
+// rootBucketID is the ID of the implicit bucket that holds all top-level
+// buckets.
+var rootBucketID bucketID
+
+// rootVersionKey is the key, relative to the backend's prefix, that versions
+// the root bucket. Being shorter than a bucket ID, it can't clash with the key
+// of any bucket's child or sequence.
+const rootVersionKey = "version"
+
+// makeBucketID derives the ID of the bucket with the given name nested within
+// the given parent.
+func makeBucketID(parent bucketID, name []byte) bucketID {
+	h := sha256.New()
+	h.Write(parent[:])
+	h.Write(name)
+
+	var id bucketID
+	copy(id[:], h.Sum(nil))
+	return id
+}
+
+// The key space of the etcd backend is laid out as follows, where prefix is
+// the configured key prefix:
+//
+//   prefix || parentID || name -> bucketMarker
+//   prefix || parentID || key  -> valueMarker || value
+//   prefix || bucketID         -> sequence
+//
+// As neither keys nor bucket names may be empty, a sequence key can never
+// clash with a key of one of the bucket's children.
+//
+// Conflicts between transactions are detected per bucket rather than per key,
+// which keeps the number of comparisons of a transaction independent of the
+// number of keys it reads. Each bucket is versioned by the key referring to it
+// within its parent, which is rewritten whenever a transaction modifies the
+// bucket's children or sequence, while the root bucket is versioned by a
+// dedicated key. A read-write transaction only commits if none of the buckets
+// it accessed were modified in the meantime. Note that the cluster's
+// --max-txn-ops limit must still be large enough to hold all writes of a
+// single transaction.
+
+// etcdBackend is a Backend that stores all data within a remote etcd cluster,
+// enabling several lnd instances to share a replicated database.
+type etcdBackend struct {
+	cli    *clientv3.Client
+	prefix string
+
+	// writeMtx serializes all read-write transactions of this process.
+	// Transactions of other processes are detected as conflicts on
+	// commit, and retried by Update and Batch.
+	writeMtx sync.Mutex
+}
+
+// A compile time check to ensure etcdBackend implements the Backend
+// interface.
+var _ Backend = (*etcdBackend)(nil)
+
+// GetEtcdBackend connects to the etcd cluster described by the passed config.
+func GetEtcdBackend(cfg *EtcdConfig) (Backend, error) {
+	clientCfg := clientv3.Config{
+		Endpoints:   []string{cfg.Host},
+		DialTimeout: etcdTimeout,
+		Username:    cfg.User,
+		Password:    cfg.Pass,
+	}
+
+	if !cfg.DisableTLS {
+		tlsInfo := transport.TLSInfo{
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+		}
+		tlsConfig, err := tlsInfo.ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		clientCfg.TLS = tlsConfig
+	}
+
+	cli, err := clientv3.New(clientCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &etcdBackend{
+		cli:    cli,
+		prefix: cfg.Prefix,
+	}, nil
+}
+
+// newTx creates a new transaction on the backend. Read-write transactions
+// hold the write mutex until they are committed or rolled back.
+func (b *etcdBackend) newTx(writable bool) *etcdTx {
+	if writable {
+		b.writeMtx.Lock()
+	}
+
+	return &etcdTx{
+		backend:  b,
+		writable: writable,
+		reads:    make(map[string]int64),
+		writes:   make(map[string]*etcdWrite),
+		dirty:    make(map[string]struct{}),
+	}
+}
+
+// Begin starts a new transaction.
+//
+// NOTE: Part of the Backend interface.
+func (b *etcdBackend) Begin(writable bool) (Tx, error) {
+	return b.newTx(writable), nil
+}
+
+// View executes the passed function within a managed read-only transaction.
+//
+// NOTE: Part of the Backend interface.
+func (b *etcdBackend) View(f func(tx Tx) error) error {
+	tx := b.newTx(false)
+	defer tx.Rollback()
+
+	if err := f(tx); err != nil {
+		return err
+	}
+
+	return tx.err
+}
+
+// Update executes the passed function within a managed read-write
+// transaction. If the transaction conflicts with one committed concurrently
+// by another process, the function is executed again within a new
+// transaction, up to maxTxRetries times.
+//
+// NOTE: Part of the Backend interface.
+func (b *etcdBackend) Update(f func(tx Tx) error) error {
+	var err error
+	for i := 0; i < maxTxRetries; i++ {
+		tx := b.newTx(true)
+
+		err = f(tx)
+		if err == nil {
+			err = tx.err
+		}
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		err = tx.Commit()
+		if err != ErrTxConflict {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Batch executes the passed function within a read-write transaction. As
+// requests to the cluster are remote, no attempt is made to combine
+// concurrent calls.
+//
+// NOTE: Part of the Backend interface.
+func (b *etcdBackend) Batch(f func(tx Tx) error) error {
+	return b.Update(f)
+}
+
+// Close closes the connection to the etcd cluster.
+//
+// NOTE: Part of the Backend interface.
+func (b *etcdBackend) Close() error {
+	return b.cli.Close()
+}
+
+// etcdWrite is a pending write of a read-write transaction. A nil value
+// denotes a deletion.
+type etcdWrite struct {
+	value []byte
+}
+
+// etcdKV is a single key/value pair as seen by a transaction.
+type etcdKV struct {
+	key   string
+	value []byte
+}
+
+// etcdTx is a transaction on the etcd backend. All reads are served from a
+// consistent snapshot of the cluster, overlaid with the transaction's own
+// pending writes. On commit, the writes are only applied if none of the
+// buckets accessed by the transaction were modified in the meantime.
+type etcdTx struct {
+	backend  *etcdBackend
+	writable bool
+	closed   bool
+
+	// rev is the revision of the snapshot the transaction reads from. It
+	// is fixed by the first read.
+	rev int64
+
+	// reads maps the version key of every bucket accessed by the
+	// transaction to its modification revision, or zero if the key didn't
+	// exist.
+	reads map[string]int64
+
+	// writes holds all pending writes of the transaction.
+	writes map[string]*etcdWrite
+
+	// dirty is the set of version keys of the buckets modified by the
+	// transaction, which are rewritten on commit.
+	dirty map[string]struct{}
+
+	// err is the first error encountered while communicating with the
+	// cluster. As most Bucket methods don't return errors, it is instead
+	// returned when the transaction completes.
+	err error
+}
+
+// A compile time check to ensure etcdTx implements the Tx interface.
+var _ Tx = (*etcdTx)(nil)
+
+// root returns the implicit bucket holding all top-level buckets.
+func (t *etcdTx) root() *etcdBucket {
+	return &etcdBucket{
+		tx:         t,
+		id:         rootBucketID,
+		versionKey: t.backend.prefix + rootVersionKey,
+	}
+}
+
+// fetch returns the value stored under the given key within the transaction's
+// snapshot, along with its modification revision. Pending writes of the
+// transaction aren't taken into account.
+func (t *etcdTx) fetch(key string) ([]byte, int64, bool) {
+	if t.err != nil {
+		return nil, 0, false
+	}
+
+	opts := []clientv3.OpOption{}
+	if t.rev != 0 {
+		opts = append(opts, clientv3.WithRev(t.rev))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+
+	resp, err := t.backend.cli.Get(ctx, key, opts...)
+	if err != nil {
+		t.err = err
+		return nil, 0, false
+	}
+	if t.rev == 0 {
+		t.rev = resp.Header.Revision
+	}
+
+	if len(resp.Kvs) == 0 {
+		return nil, 0, false
+	}
+
+	return resp.Kvs[0].Value, resp.Kvs[0].ModRevision, true
+}
+
+// get returns the value stored under the given key, and whether the key
+// exists.
+func (t *etcdTx) get(key string) ([]byte, bool) {
+	if w, ok := t.writes[key]; ok {
+		return w.value, w.value != nil
+	}
+
+	value, _, ok := t.fetch(key)
+	return value, ok
+}
+
+// track records the modification revision of the given bucket version key,
+// such that the transaction only commits if the bucket isn't modified in the
+// meantime.
+func (t *etcdTx) track(versionKey string) {
+	if _, ok := t.reads[versionKey]; ok {
+		return
+	}
+
+	_, rev, _ := t.fetch(versionKey)
+	if t.err != nil {
+		return
+	}
+
+	t.reads[versionKey] = rev
+}
+
+// rangePrefix returns all key/value pairs whose key starts with the given
+// prefix, sorted by key.
+func (t *etcdTx) rangePrefix(prefix string) []etcdKV {
+	if t.err != nil {
+		return nil
+	}
+
+	opts := []clientv3.OpOption{clientv3.WithPrefix()}
+	if t.rev != 0 {
+		opts = append(opts, clientv3.WithRev(t.rev))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+
+	resp, err := t.backend.cli.Get(ctx, prefix, opts...)
+	if err != nil {
+		t.err = err
+		return nil
+	}
+	if t.rev == 0 {
+		t.rev = resp.Header.Revision
+	}
+
+	kvs := make(map[string][]byte, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		kvs[string(kv.Key)] = kv.Value
+	}
+
+	// Overlay the pending writes of the transaction.
+	for key, w := range t.writes {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		if w.value == nil {
+			delete(kvs, key)
+		} else {
+			kvs[key] = w.value
+		}
+	}
+
+	result := make([]etcdKV, 0, len(kvs))
+	for key, value := range kvs {
+		result = append(result, etcdKV{key: key, value: value})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].key < result[j].key
+	})
+
+	return result
+}
+
+// put adds a pending write of the given key.
+func (t *etcdTx) put(key string, value []byte) {
+	v := make([]byte, len(value))
+	copy(v, value)
+	t.writes[key] = &etcdWrite{value: v}
+}
+
+// del adds a pending deletion of the given key.
+func (t *etcdTx) del(key string) {
+	t.writes[key] = &etcdWrite{}
+}
+
+// Bucket retrieves the top-level bucket with the given name.
+//
+// NOTE: Part of the Tx interface.
+func (t *etcdTx) Bucket(name []byte) Bucket {
+	return t.root().Bucket(name)
+}
+
+// CreateBucket creates a new top-level bucket with the given name.
+//
+// NOTE: Part of the Tx interface.
+func (t *etcdTx) CreateBucket(name []byte) (Bucket, error) {
+	return t.root().CreateBucket(name)
+}
+
+// CreateBucketIfNotExists creates a new top-level bucket with the given name
+// if it doesn't exist yet.
+//
+// NOTE: Part of the Tx interface.
+func (t *etcdTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	return t.root().CreateBucketIfNotExists(name)
+}
+
+// DeleteBucket deletes the top-level bucket with the given name.
+//
+// NOTE: Part of the Tx interface.
+func (t *etcdTx) DeleteBucket(name []byte) error {
+	return t.root().DeleteBucket(name)
+}
+
+// Writable returns whether the transaction can be used to modify the
+// database.
+//
+// NOTE: Part of the Tx interface.
+func (t *etcdTx) Writable() bool {
+	return t.writable
+}
+
+// Commit atomically applies all pending writes of the transaction, given
+// that none of the buckets it accessed were modified since. ErrTxConflict is
+// returned otherwise.
+//
+// NOTE: Part of the Tx interface.
+func (t *etcdTx) Commit() error {
+	if t.closed {
+		return ErrTxClosed
+	}
+	if !t.writable {
+		return ErrTxNotWritable
+	}
+	defer t.close()
+
+	if t.err != nil {
+		return t.err
+	}
+	if len(t.writes) == 0 {
+		return nil
+	}
+
+	cmps := make([]clientv3.Cmp, 0, len(t.reads))
+	for key, rev := range t.reads {
+		cmps = append(cmps, clientv3.Compare(
+			clientv3.ModRevision(key), "=", rev,
+		))
+	}
+
+	ops := make([]clientv3.Op, 0, len(t.writes)+len(t.dirty))
+	for key, w := range t.writes {
+		if w.value == nil {
+			ops = append(ops, clientv3.OpDelete(key))
+		} else {
+			ops = append(ops, clientv3.OpPut(key, string(w.value)))
+		}
+	}
+
+	// Bump the version of every modified bucket, unless the key referring
+	// to it is already written by the transaction, which happens when the
+	// bucket is created or deleted.
+	for key := range t.dirty {
+		if _, ok := t.writes[key]; ok {
+			continue
+		}
+		ops = append(ops, clientv3.OpPut(
+			key, string([]byte{bucketMarker}),
+		))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+
+	resp, err := t.backend.cli.Txn(ctx).If(cmps...).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return ErrTxConflict
+	}
+
+	return nil
+}
+
+// Rollback discards all pending writes of the transaction.
+//
+// NOTE: Part of the Tx interface.
+func (t *etcdTx) Rollback() error {
+	if t.closed {
+		return ErrTxClosed
+	}
+
+	t.close()
+	return nil
+}
+
+// close marks the transaction as closed, releasing the write mutex if held.
+func (t *etcdTx) close() {
+	t.closed = true
+	t.writes = nil
+	t.dirty = nil
+
+	if t.writable {
+		t.backend.writeMtx.Unlock()
+	}
+}
+
+// etcdBucket is a bucket within an etcd transaction.
+type etcdBucket struct {
+	tx *etcdTx
+	id bucketID
+
+	// versionKey is the key whose modification revision versions the
+	// bucket's children and sequence.
+	versionKey string
+}
+
+// A compile time check to ensure etcdBucket implements the Bucket interface.
+var _ Bucket = (*etcdBucket)(nil)
+
+// prefix returns the common prefix of the keys of all children of the
+// bucket.
+func (b *etcdBucket) prefix() string {
+	return b.tx.backend.prefix + string(b.id[:])
+}
+
+// childKey returns the full key of the child with the given name.
+func (b *etcdBucket) childKey(name []byte) string {
+	return b.prefix() + string(name)
+}
+
+// sequenceKey returns the key of the bucket's sequence.
+func (b *etcdBucket) sequenceKey() string {
+	return b.prefix()
+}
+
+// read marks the bucket as accessed by the transaction.
+func (b *etcdBucket) read() {
+	b.tx.track(b.versionKey)
+}
+
+// write marks the bucket as modified by the transaction.
+func (b *etcdBucket) write() {
+	b.tx.dirty[b.versionKey] = struct{}{}
+}
+
+// children returns all children of the bucket, excluding the sequence key.
+func (b *etcdBucket) children() []etcdKV {
+	b.read()
+
+	prefix := b.prefix()
+	kvs := b.tx.rangePrefix(prefix)
+
+	children := kvs[:0]
+	for _, kv := range kvs {
+		if len(kv.key) == len(prefix) {
+			continue
+		}
+		children = append(children, kv)
+	}
+
+	return children
+}
+
+// nested returns the nested bucket with the given name.
+func (b *etcdBucket) nested(name []byte) *etcdBucket {
+	return &etcdBucket{
+		tx:         b.tx,
+		id:         makeBucketID(b.id, name),
+		versionKey: b.childKey(name),
+	}
+}
+
+// Bucket retrieves the nested bucket with the given name.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) Bucket(name []byte) Bucket {
+	b.read()
+
+	v, ok := b.tx.get(b.childKey(name))
+	if !ok || !isBucket(v) {
+		return nil
+	}
+
+	return b.nested(name)
+}
+
+// CreateBucket creates a new nested bucket with the given name.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) CreateBucket(name []byte) (Bucket, error) {
+	switch {
+	case !b.tx.writable:
+		return nil, ErrTxNotWritable
+	case len(name) == 0:
+		return nil, ErrBucketNameRequired
+	}
+
+	b.read()
+
+	key := b.childKey(name)
+	if v, ok := b.tx.get(key); ok {
+		if isBucket(v) {
+			return nil, ErrBucketExists
+		}
+		return nil, ErrIncompatibleValue
+	}
+
+	b.tx.put(key, []byte{bucketMarker})
+	b.write()
+
+	return b.nested(name), nil
+}
+
+// CreateBucketIfNotExists creates a new nested bucket with the given name if
+// it doesn't exist yet.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	bucket, err := b.CreateBucket(name)
+	if err == ErrBucketExists {
+		return b.nested(name), nil
+	}
+
+	return bucket, err
+}
+
+// DeleteBucket deletes the nested bucket with the given name.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) DeleteBucket(name []byte) error {
+	if !b.tx.writable {
+		return ErrTxNotWritable
+	}
+
+	b.read()
+
+	key := b.childKey(name)
+	v, ok := b.tx.get(key)
+	switch {
+	case !ok:
+		return ErrBucketNotFound
+	case !isBucket(v):
+		return ErrIncompatibleValue
+	}
+
+	b.nested(name).deleteContents()
+	b.tx.del(key)
+	b.write()
+
+	return nil
+}
+
+// deleteContents recursively deletes all children of the bucket, along with
+// its sequence.
+func (b *etcdBucket) deleteContents() {
+	b.read()
+
+	prefix := b.prefix()
+	for _, kv := range b.tx.rangePrefix(prefix) {
+		name := []byte(kv.key[len(prefix):])
+		if len(name) != 0 && isBucket(kv.value) {
+			b.nested(name).deleteContents()
+		}
+
+		b.tx.del(kv.key)
+	}
+}
+
+// Get returns the value stored under the given key.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) Get(key []byte) []byte {
+	b.read()
+
+	v, ok := b.tx.get(b.childKey(key))
+	if !ok || isBucket(v) {
+		return nil
+	}
+
+	return v[1:]
+}
+
+// Put stores the given value under the given key.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) Put(key, value []byte) error {
+	switch {
+	case !b.tx.writable:
+		return ErrTxNotWritable
+	case len(key) == 0:
+		return ErrKeyRequired
+	}
+
+	b.read()
+
+	fullKey := b.childKey(key)
+	if v, ok := b.tx.get(fullKey); ok && isBucket(v) {
+		return ErrIncompatibleValue
+	}
+
+	b.tx.put(fullKey, append([]byte{valueMarker}, value...))
+	b.write()
+
+	return nil
+}
+
+// Delete removes the given key from the bucket.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) Delete(key []byte) error {
+	if !b.tx.writable {
+		return ErrTxNotWritable
+	}
+
+	b.read()
+
+	fullKey := b.childKey(key)
+	v, ok := b.tx.get(fullKey)
+	switch {
+	case !ok:
+		return nil
+	case isBucket(v):
+		return ErrIncompatibleValue
+	}
+
+	b.tx.del(fullKey)
+	b.write()
+
+	return nil
+}
+
+// ForEach executes the passed function for every key/value pair within the
+// bucket.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) ForEach(fn func(k, v []byte) error) error {
+	prefix := b.prefix()
+	for _, kv := range b.children() {
+		k, v := decodeChild(prefix, kv)
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+
+	return b.tx.err
+}
+
+// Cursor returns a new cursor over the bucket. The cursor iterates over the
+// children of the bucket as they were at the time of its creation.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) Cursor() Cursor {
+	return &etcdCursor{
+		bucket:   b,
+		children: b.children(),
+		pos:      -1,
+	}
+}
+
+// Sequence returns the current value of the bucket's sequence.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) Sequence() uint64 {
+	b.read()
+
+	v, ok := b.tx.get(b.sequenceKey())
+	if !ok || len(v) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(v)
+}
+
+// NextSequence increments the bucket's sequence and returns the new value.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) NextSequence() (uint64, error) {
+	seq := b.Sequence() + 1
+	if err := b.SetSequence(seq); err != nil {
+		return 0, err
+	}
+
+	return seq, nil
+}
+
+// SetSequence overwrites the bucket's sequence with the given value.
+//
+// NOTE: Part of the Bucket interface.
+func (b *etcdBucket) SetSequence(v uint64) error {
+	if !b.tx.writable {
+		return ErrTxNotWritable
+	}
+
+	b.read()
+
+	var seq [8]byte
+	binary.BigEndian.PutUint64(seq[:], v)
+	b.tx.put(b.sequenceKey(), seq[:])
+	b.write()
+
+	return nil
+}
+
+// isBucket returns whether the passed raw value denotes a nested bucket.
+func isBucket(v []byte) bool {
+	return len(v) == 1 && v[0] == bucketMarker
+}
+
+// decodeChild splits a raw child of a bucket into its name and value. Nested
+// buckets are returned with a nil value.
+func decodeChild(prefix string, kv etcdKV) ([]byte, []byte) {
+	k := []byte(kv.key[len(prefix):])
+	if isBucket(kv.value) {
+		return k, nil
+	}
+
+	return k, kv.value[1:]
+}
+
+// etcdCursor iterates over the children of a bucket.
+type etcdCursor struct {
+	bucket   *etcdBucket
+	children []etcdKV
+	pos      int
+}
+
+// A compile time check to ensure etcdCursor implements the Cursor interface.
+var _ Cursor = (*etcdCursor)(nil)
+
+// current returns the key/value pair at the current position of the cursor.
+func (c *etcdCursor) current() ([]byte, []byte) {
+	if c.pos < 0 || c.pos >= len(c.children) {
+		return nil, nil
+	}
+
+	return decodeChild(c.bucket.prefix(), c.children[c.pos])
+}
+
+// First moves the cursor to the first key in the bucket.
+//
+// NOTE: Part of the Cursor interface.
+func (c *etcdCursor) First() ([]byte, []byte) {
+	c.pos = 0
+	return c.current()
+}
+
+// Last moves the cursor to the last key in the bucket.
+//
+// NOTE: Part of the Cursor interface.
+func (c *etcdCursor) Last() ([]byte, []byte) {
+	c.pos = len(c.children) - 1
+	return c.current()
+}
+
+// Next moves the cursor to the next key in the bucket.
+//
+// NOTE: Part of the Cursor interface.
+func (c *etcdCursor) Next() ([]byte, []byte) {
+	if c.pos < len(c.children) {
+		c.pos++
+	}
+	return c.current()
+}
+
+// Prev moves the cursor to the previous key in the bucket.
+//
+// NOTE: Part of the Cursor interface.
+func (c *etcdCursor) Prev() ([]byte, []byte) {
+	if c.pos >= 0 {
+		c.pos--
+	}
+	return c.current()
+}
+
+// Seek moves the cursor to the given key, or the next key after it.
+//
+// NOTE: Part of the Cursor interface.
+func (c *etcdCursor) Seek(seek []byte) ([]byte, []byte) {
+	prefix := c.bucket.prefix()
+	c.pos = sort.Search(len(c.children), func(i int) bool {
+		k := []byte(c.children[i].key[len(prefix):])
+		return bytes.Compare(k, seek) >= 0
+	})
+
+	return c.current()
+}
+
+// Delete removes the key/value pair the cursor currently points to. The
+// cursor is moved back by one position, such that a subsequent call to Next
+// returns the pair following the deleted one.
+//
+// NOTE: Part of the Cursor interface.
+func (c *etcdCursor) Delete() error {
+	k, v := c.current()
+	switch {
+	case k == nil:
+		return nil
+	case v == nil:
+		return ErrIncompatibleValue
+	}
+
+	if err := c.bucket.Delete(k); err != nil {
+		return err
+	}
+
+	c.children = append(c.children[:c.pos], c.children[c.pos+1:]...)
+	c.pos--
+
+	return nil
+}
+
+// openEtcdDriver parses the arguments of the etcd driver, which expects an
+// *EtcdConfig as its sole argument.
+func openEtcdDriver(args ...interface{}) (Backend, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid number of arguments to etcd "+
+			"backend: expected 1, got %v", len(args))
+	}
+
+	cfg, ok := args[0].(*EtcdConfig)
+	if !ok {
+		return nil, fmt.Errorf("first argument to etcd backend is " +
+			"not an *EtcdConfig")
+	}
+
+	return GetEtcdBackend(cfg)
+}
+
+func init() {
+	err := RegisterDriver(Driver{
+		Name: EtcdBackendName,
+		Open: openEtcdDriver,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("unable to register etcd driver: %v", err))
+	}
+}
@@ -0,0 +1,81 @@
+// +build kvdb_etcd
+
+package kvdb
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"go.etcd.io/etcd/embed"
+)
+
+const (
+	// readyTimeout is the maximum time we'll wait for an embedded etcd
+	// instance to become ready.
+	readyTimeout = 10 * time.Second
+)
+
+// getFreePort returns a free local TCP port.
+func getFreePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+// NewEmbeddedEtcdInstance starts a single node etcd cluster that stores its
+// data within the given directory. It returns a config that can be used to
+// connect to the instance, along with a function that shuts it down. The
+// instance is meant to be used as a stand-in for a remote cluster in tests.
+func NewEmbeddedEtcdInstance(path string) (*EtcdConfig, func(), error) {
+	clientPort, err := getFreePort()
+	if err != nil {
+		return nil, nil, err
+	}
+	peerPort, err := getFreePort()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientURL := url.URL{
+		Scheme: "http",
+		Host:   fmt.Sprintf("127.0.0.1:%d", clientPort),
+	}
+	peerURL := url.URL{
+		Scheme: "http",
+		Host:   fmt.Sprintf("127.0.0.1:%d", peerPort),
+	}
+
+	cfg := embed.NewConfig()
+	cfg.Dir = path
+	cfg.LCUrls = []url.URL{clientURL}
+	cfg.ACUrls = []url.URL{clientURL}
+	cfg.LPUrls = []url.URL{peerURL}
+	cfg.APUrls = []url.URL{peerURL}
+	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+
+	etcd, err := embed.StartEtcd(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(readyTimeout):
+		etcd.Close()
+		return nil, nil, fmt.Errorf("etcd instance failed to start "+
+			"within %v", readyTimeout)
+	}
+
+	etcdCfg := &EtcdConfig{
+		Host:       clientURL.Host,
+		DisableTLS: true,
+	}
+
+	return etcdCfg, etcd.Close, nil
+}
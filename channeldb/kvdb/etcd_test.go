@@ -0,0 +1,203 @@
+// +build kvdb_etcd
+
+package kvdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// TestEtcdBackend runs the backend test cases against an embedded etcd
+// instance.
+func TestEtcdBackend(t *testing.T) {
+	runBackendTests(t, func() (Backend, func(), error) {
+		tempDir, err := ioutil.TempDir("", "etcd")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cfg, stopEtcd, err := NewEmbeddedEtcdInstance(tempDir)
+		if err != nil {
+			os.RemoveAll(tempDir)
+			return nil, nil, err
+		}
+
+		db, err := Open(EtcdBackendName, cfg)
+		if err != nil {
+			stopEtcd()
+			os.RemoveAll(tempDir)
+			return nil, nil, err
+		}
+
+		cleanUp := func() {
+			db.Close()
+			stopEtcd()
+			os.RemoveAll(tempDir)
+		}
+
+		return db, cleanUp, nil
+	})
+}
+
+// newEtcdTestBackends starts an embedded etcd instance and opens the given
+// number of backends on it.
+func newEtcdTestBackends(t *testing.T, n int) ([]Backend, func()) {
+	tempDir, err := ioutil.TempDir("", "etcd")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	cfg, stopEtcd, err := NewEmbeddedEtcdInstance(tempDir)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to start etcd: %v", err)
+	}
+
+	var dbs []Backend
+	cleanUp := func() {
+		for _, db := range dbs {
+			db.Close()
+		}
+		stopEtcd()
+		os.RemoveAll(tempDir)
+	}
+
+	for i := 0; i < n; i++ {
+		db, err := Open(EtcdBackendName, cfg)
+		if err != nil {
+			cleanUp()
+			t.Fatalf("unable to open backend: %v", err)
+		}
+		dbs = append(dbs, db)
+	}
+
+	return dbs, cleanUp
+}
+
+// TestEtcdConflictRetry asserts that a transaction conflicting with one
+// committed by another process in the meantime is retried, and observes the
+// other process' write when it is.
+func TestEtcdConflictRetry(t *testing.T) {
+	dbs, cleanUp := newEtcdTestBackends(t, 2)
+	defer cleanUp()
+
+	err := dbs[0].Update(func(tx Tx) error {
+		bucket, err := tx.CreateBucket(testBucket)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte("key"), []byte{0})
+	})
+	if err != nil {
+		t.Fatalf("unable to populate bucket: %v", err)
+	}
+
+	var attempts int
+	err = dbs[0].Update(func(tx Tx) error {
+		attempts++
+
+		bucket := tx.Bucket(testBucket)
+		value := bucket.Get([]byte("key"))
+
+		// Modify the key from the second backend during the first
+		// attempt only, which must cause it to conflict.
+		if attempts == 1 {
+			err := dbs[1].Update(func(tx Tx) error {
+				return tx.Bucket(testBucket).Put(
+					[]byte("key"), []byte{1},
+				)
+			})
+			if err != nil {
+				t.Fatalf("unable to modify key: %v", err)
+			}
+		}
+
+		return bucket.Put([]byte("key"), []byte{value[0] + 10})
+	})
+	if err != nil {
+		t.Fatalf("unable to update key: %v", err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+
+	err = dbs[0].View(func(tx Tx) error {
+		value := tx.Bucket(testBucket).Get([]byte("key"))
+		if !bytes.Equal(value, []byte{11}) {
+			t.Fatalf("expected value 11, got %v", value)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read key: %v", err)
+	}
+}
+
+// TestEtcdLargeRead asserts that a transaction reading more keys than the
+// cluster allows operations within a single etcd transaction can still be
+// committed.
+func TestEtcdLargeRead(t *testing.T) {
+	dbs, cleanUp := newEtcdTestBackends(t, 1)
+	defer cleanUp()
+	db := dbs[0]
+
+	// Populate the bucket in several transactions, each well within the
+	// default operation limit.
+	const numKeys = 500
+	for i := 0; i < numKeys; i += 50 {
+		err := db.Update(func(tx Tx) error {
+			bucket, err := tx.CreateBucketIfNotExists(testBucket)
+			if err != nil {
+				return err
+			}
+
+			for j := i; j < i+50; j++ {
+				var key [4]byte
+				binary.BigEndian.PutUint32(key[:], uint32(j))
+				if err := bucket.Put(key[:], key[:]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unable to populate bucket: %v", err)
+		}
+	}
+
+	// Read all keys and write their count within a single transaction.
+	err := db.Update(func(tx Tx) error {
+		bucket := tx.Bucket(testBucket)
+
+		var count uint32
+		err := bucket.ForEach(func(k, v []byte) error {
+			count++
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		var value [4]byte
+		binary.BigEndian.PutUint32(value[:], count)
+		return bucket.Put([]byte("count"), value[:])
+	})
+	if err != nil {
+		t.Fatalf("unable to commit large read: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		value := tx.Bucket(testBucket).Get([]byte("count"))
+		if binary.BigEndian.Uint32(value) != numKeys {
+			t.Fatalf("expected count %d, got %v", numKeys, value)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read count: %v", err)
+	}
+}
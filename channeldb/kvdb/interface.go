@@ -0,0 +1,197 @@
+package kvdb
+
+import (
+	"io"
+
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// ErrBucketNotFound is returned when trying to access a bucket that
+	// has not been created yet.
+	ErrBucketNotFound = bbolt.ErrBucketNotFound
+
+	// ErrBucketExists is returned when creating a bucket that already
+	// exists.
+	ErrBucketExists = bbolt.ErrBucketExists
+
+	// ErrBucketNameRequired is returned when creating a bucket with a
+	// blank name.
+	ErrBucketNameRequired = bbolt.ErrBucketNameRequired
+
+	// ErrKeyRequired is returned when inserting a zero-length key.
+	ErrKeyRequired = bbolt.ErrKeyRequired
+
+	// ErrIncompatibleValue is returned when trying to create or delete a
+	// bucket on an existing non-bucket key, or when trying to create or
+	// delete a non-bucket key on an existing bucket key.
+	ErrIncompatibleValue = bbolt.ErrIncompatibleValue
+
+	// ErrTxNotWritable is returned when performing a write operation on a
+	// read-only transaction.
+	ErrTxNotWritable = bbolt.ErrTxNotWritable
+
+	// ErrTxClosed is returned when committing or rolling back a
+	// transaction that has already been committed or rolled back.
+	ErrTxClosed = bbolt.ErrTxClosed
+
+	// ErrDatabaseNotOpen is returned when a backend instance is accessed
+	// before it is opened or after it is closed.
+	ErrDatabaseNotOpen = bbolt.ErrDatabaseNotOpen
+
+	// ErrTimeout is returned when a backend instance couldn't be opened
+	// within the given timeout, as it's held open by another process.
+	ErrTimeout = bbolt.ErrTimeout
+)
+
+// Backend is the interface that all key value stores used by lnd must
+// implement. The interface mirrors the transactional model of bbolt: all
+// access happens within either a read-only or a read-write transaction, and
+// data is organized within a hierarchy of named buckets.
+type Backend interface {
+	// Begin starts a new transaction. Read-write transactions must be
+	// committed or rolled back by the caller, read-only transactions must
+	// be rolled back.
+	Begin(writable bool) (Tx, error)
+
+	// View executes the passed function within the context of a managed
+	// read-only transaction. Any error returned from the function is
+	// returned from View.
+	View(f func(tx Tx) error) error
+
+	// Update executes the passed function within the context of a managed
+	// read-write transaction. If the function returns nil, the
+	// transaction is committed, otherwise it is rolled back and the error
+	// is returned from Update. Backends that detect conflicts with
+	// concurrent transactions on commit may retry the transaction, so
+	// the function shouldn't have side effects beyond the transaction.
+	Update(f func(tx Tx) error) error
+
+	// Batch behaves like Update, however backends may choose to combine
+	// the passed function with those of concurrent Batch calls into a
+	// single transaction. As a result, the function may be executed more
+	// than once, and must be idempotent.
+	Batch(f func(tx Tx) error) error
+
+	// Close cleanly shuts down the backend, releasing all held resources.
+	Close() error
+}
+
+// Tx is a transaction on a Backend. Data is only accessible through the
+// top-level buckets of the transaction.
+type Tx interface {
+	// Bucket retrieves the top-level bucket with the given name, or nil
+	// if it doesn't exist.
+	Bucket(name []byte) Bucket
+
+	// CreateBucket creates a new top-level bucket with the given name. An
+	// error is returned if the bucket already exists.
+	CreateBucket(name []byte) (Bucket, error)
+
+	// CreateBucketIfNotExists creates a new top-level bucket with the
+	// given name if it doesn't exist yet, and returns it.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+
+	// DeleteBucket deletes the top-level bucket with the given name,
+	// along with all of its nested buckets and keys. ErrBucketNotFound is
+	// returned if the bucket doesn't exist.
+	DeleteBucket(name []byte) error
+
+	// Writable returns whether the transaction can be used to modify the
+	// database.
+	Writable() bool
+
+	// Commit writes all changes made within the transaction to the
+	// backend.
+	Commit() error
+
+	// Rollback discards all changes made within the transaction and
+	// closes it.
+	Rollback() error
+}
+
+// Bucket is a collection of key/value pairs and nested buckets. Buckets and
+// values share the same key space: a key may either hold a value or a
+// nested bucket.
+type Bucket interface {
+	// Bucket retrieves the nested bucket with the given name, or nil if
+	// it doesn't exist.
+	Bucket(name []byte) Bucket
+
+	// CreateBucket creates a new nested bucket with the given name. An
+	// error is returned if the bucket already exists.
+	CreateBucket(name []byte) (Bucket, error)
+
+	// CreateBucketIfNotExists creates a new nested bucket with the given
+	// name if it doesn't exist yet, and returns it.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+
+	// DeleteBucket deletes the nested bucket with the given name, along
+	// with all of its nested buckets and keys.
+	DeleteBucket(name []byte) error
+
+	// Get returns the value stored under the given key, or nil if the key
+	// doesn't exist or refers to a nested bucket. The returned slice is
+	// only valid for the life of the transaction.
+	Get(key []byte) []byte
+
+	// Put stores the given value under the given key, overwriting any
+	// existing value.
+	Put(key, value []byte) error
+
+	// Delete removes the given key from the bucket. Deleting a key that
+	// doesn't exist is not an error.
+	Delete(key []byte) error
+
+	// ForEach executes the passed function for every key/value pair
+	// within the bucket, in byte-sorted order of the keys. Nested buckets
+	// are passed with a nil value.
+	ForEach(fn func(k, v []byte) error) error
+
+	// Cursor returns a new cursor that can be used to iterate over the
+	// bucket in byte-sorted order of its keys.
+	Cursor() Cursor
+
+	// Sequence returns the current value of the bucket's sequence.
+	Sequence() uint64
+
+	// NextSequence increments the bucket's sequence and returns the new
+	// value.
+	NextSequence() (uint64, error)
+
+	// SetSequence overwrites the bucket's sequence with the given value.
+	SetSequence(v uint64) error
+}
+
+// Cursor iterates over the key/value pairs of a bucket in byte-sorted order.
+// Nested buckets are returned with a nil value. All methods return a nil key
+// once the cursor moves past either end of the bucket.
+type Cursor interface {
+	// First moves the cursor to the first key in the bucket.
+	First() (key, value []byte)
+
+	// Last moves the cursor to the last key in the bucket.
+	Last() (key, value []byte)
+
+	// Next moves the cursor to the next key in the bucket.
+	Next() (key, value []byte)
+
+	// Prev moves the cursor to the previous key in the bucket.
+	Prev() (key, value []byte)
+
+	// Seek moves the cursor to the given key, or the next key after it
+	// if the key doesn't exist.
+	Seek(seek []byte) (key, value []byte)
+
+	// Delete removes the key/value pair the cursor currently points to.
+	Delete() error
+}
+
+// SnapshotTx is implemented by transactions of backends that are able to
+// write a consistent, point-in-time copy of the entire database as seen by
+// the transaction. The copy can be opened as a database of the same backend.
+type SnapshotTx interface {
+	// WriteTo writes a copy of the entire database to w, returning the
+	// number of bytes written.
+	WriteTo(w io.Writer) (int64, error)
+}
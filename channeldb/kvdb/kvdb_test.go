@@ -0,0 +1,426 @@
+package kvdb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var (
+	testBucket  = []byte("test-bucket")
+	testNested  = []byte("nested")
+	testKeys    = [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+	testValue   = []byte("value")
+	missingName = []byte("missing")
+)
+
+// backendTestCase is a test that is run against every backend.
+type backendTestCase struct {
+	name string
+	test func(t *testing.T, db Backend)
+}
+
+var backendTestCases = []backendTestCase{
+	{
+		name: "bucket creation",
+		test: testBucketCreation,
+	},
+	{
+		name: "put get delete",
+		test: testPutGetDelete,
+	},
+	{
+		name: "iteration",
+		test: testIteration,
+	},
+	{
+		name: "sequence",
+		test: testSequence,
+	},
+	{
+		name: "rollback",
+		test: testRollback,
+	},
+	{
+		name: "delete bucket",
+		test: testDeleteBucket,
+	},
+}
+
+// runBackendTests runs all backend test cases, using the passed closure to
+// create a fresh backend for each of them.
+func runBackendTests(t *testing.T, makeBackend func() (Backend, func(),
+	error)) {
+
+	for _, testCase := range backendTestCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			db, cleanUp, err := makeBackend()
+			if err != nil {
+				t.Fatalf("unable to create backend: %v", err)
+			}
+			defer cleanUp()
+
+			testCase.test(t, db)
+		})
+	}
+}
+
+func testBucketCreation(t *testing.T, db Backend) {
+	err := db.Update(func(tx Tx) error {
+		if tx.Bucket(testBucket) != nil {
+			t.Fatalf("bucket exists before creation")
+		}
+
+		bucket, err := tx.CreateBucket(testBucket)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucket(testBucket); err != ErrBucketExists {
+			t.Fatalf("expected ErrBucketExists, got %v", err)
+		}
+		if _, err := tx.CreateBucketIfNotExists(testBucket); err != nil {
+			return err
+		}
+
+		_, err = bucket.CreateBucket(testNested)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to create buckets: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		bucket := tx.Bucket(testBucket)
+		if bucket == nil {
+			t.Fatalf("bucket not found")
+		}
+		if bucket.Bucket(testNested) == nil {
+			t.Fatalf("nested bucket not found")
+		}
+		if bucket.Bucket(missingName) != nil {
+			t.Fatalf("unknown nested bucket found")
+		}
+		if _, err := tx.CreateBucket(missingName); err != ErrTxNotWritable {
+			t.Fatalf("expected ErrTxNotWritable, got %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read buckets: %v", err)
+	}
+}
+
+func testPutGetDelete(t *testing.T, db Backend) {
+	err := db.Update(func(tx Tx) error {
+		bucket, err := tx.CreateBucket(testBucket)
+		if err != nil {
+			return err
+		}
+		if _, err := bucket.CreateBucket(testNested); err != nil {
+			return err
+		}
+
+		for _, key := range testKeys {
+			if err := bucket.Put(key, testValue); err != nil {
+				return err
+			}
+		}
+
+		// Values written within the transaction must be visible to
+		// it right away.
+		if !bytes.Equal(bucket.Get(testKeys[0]), testValue) {
+			t.Fatalf("pending write not visible")
+		}
+
+		if err := bucket.Put(testNested, testValue); err != ErrIncompatibleValue {
+			t.Fatalf("expected ErrIncompatibleValue, got %v", err)
+		}
+		if bucket.Get(testNested) != nil {
+			t.Fatalf("expected nil value for nested bucket")
+		}
+
+		return bucket.Delete(testKeys[1])
+	})
+	if err != nil {
+		t.Fatalf("unable to write values: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		bucket := tx.Bucket(testBucket)
+		if !bytes.Equal(bucket.Get(testKeys[0]), testValue) {
+			t.Fatalf("value mismatch")
+		}
+		if bucket.Get(testKeys[1]) != nil {
+			t.Fatalf("deleted value still present")
+		}
+		if bucket.Get(missingName) != nil {
+			t.Fatalf("unknown key found")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read values: %v", err)
+	}
+}
+
+func testIteration(t *testing.T, db Backend) {
+	err := db.Update(func(tx Tx) error {
+		bucket, err := tx.CreateBucket(testBucket)
+		if err != nil {
+			return err
+		}
+
+		// Insert the keys in reverse order to ensure iteration sorts
+		// them.
+		for i := len(testKeys) - 1; i >= 0; i-- {
+			if err := bucket.Put(testKeys[i], testValue); err != nil {
+				return err
+			}
+		}
+
+		_, err = bucket.CreateBucket(testNested)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to write values: %v", err)
+	}
+
+	expectedKeys := append(append([][]byte{}, testKeys...), testNested)
+
+	err = db.View(func(tx Tx) error {
+		bucket := tx.Bucket(testBucket)
+
+		var keys [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, testNested) && v != nil {
+				t.Fatalf("expected nil value for nested bucket")
+			}
+
+			keys = append(keys, k)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(keys) != len(expectedKeys) {
+			t.Fatalf("expected %d keys, got %d", len(expectedKeys),
+				len(keys))
+		}
+		for i := range keys {
+			if !bytes.Equal(keys[i], expectedKeys[i]) {
+				t.Fatalf("key %d mismatch: expected %s, got %s",
+					i, expectedKeys[i], keys[i])
+			}
+		}
+
+		c := bucket.Cursor()
+		if k, _ := c.Last(); !bytes.Equal(k, testNested) {
+			t.Fatalf("unexpected last key %s", k)
+		}
+		if k, _ := c.Prev(); !bytes.Equal(k, testKeys[2]) {
+			t.Fatalf("unexpected previous key %s", k)
+		}
+		if k, _ := c.Seek([]byte("bb")); !bytes.Equal(k, testKeys[2]) {
+			t.Fatalf("unexpected seek key %s", k)
+		}
+		if k, _ := c.First(); !bytes.Equal(k, testKeys[0]) {
+			t.Fatalf("unexpected first key %s", k)
+		}
+		if k, _ := c.Prev(); k != nil {
+			t.Fatalf("expected nil key before first, got %s", k)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate: %v", err)
+	}
+
+	// Delete all values through a cursor, which should leave only the
+	// nested bucket.
+	err = db.Update(func(tx Tx) error {
+		c := tx.Bucket(testBucket).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if v == nil {
+				continue
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to delete values: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		k, _ := tx.Bucket(testBucket).Cursor().First()
+		if !bytes.Equal(k, testNested) {
+			t.Fatalf("expected only nested bucket, found %s", k)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate: %v", err)
+	}
+}
+
+func testSequence(t *testing.T, db Backend) {
+	err := db.Update(func(tx Tx) error {
+		bucket, err := tx.CreateBucket(testBucket)
+		if err != nil {
+			return err
+		}
+
+		if bucket.Sequence() != 0 {
+			t.Fatalf("expected zero sequence")
+		}
+		for i := uint64(1); i <= 3; i++ {
+			seq, err := bucket.NextSequence()
+			if err != nil {
+				return err
+			}
+			if seq != i {
+				t.Fatalf("expected sequence %d, got %d", i, seq)
+			}
+		}
+
+		return bucket.SetSequence(10)
+	})
+	if err != nil {
+		t.Fatalf("unable to update sequence: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		bucket := tx.Bucket(testBucket)
+		if seq := bucket.Sequence(); seq != 10 {
+			t.Fatalf("expected sequence 10, got %d", seq)
+		}
+
+		// The sequence must not show up as a key of the bucket.
+		return bucket.ForEach(func(k, v []byte) error {
+			t.Fatalf("unexpected key %x", k)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unable to read sequence: %v", err)
+	}
+}
+
+func testRollback(t *testing.T, db Backend) {
+	tx, err := db.Begin(true)
+	if err != nil {
+		t.Fatalf("unable to begin tx: %v", err)
+	}
+	if _, err := tx.CreateBucket(testBucket); err != nil {
+		t.Fatalf("unable to create bucket: %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("unable to roll back: %v", err)
+	}
+
+	err = db.View(func(tx Tx) error {
+		if tx.Bucket(testBucket) != nil {
+			t.Fatalf("bucket of rolled back tx exists")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read buckets: %v", err)
+	}
+}
+
+func testDeleteBucket(t *testing.T, db Backend) {
+	err := db.Update(func(tx Tx) error {
+		bucket, err := tx.CreateBucket(testBucket)
+		if err != nil {
+			return err
+		}
+		nested, err := bucket.CreateBucket(testNested)
+		if err != nil {
+			return err
+		}
+
+		return nested.Put(testKeys[0], testValue)
+	})
+	if err != nil {
+		t.Fatalf("unable to create buckets: %v", err)
+	}
+
+	err = db.Update(func(tx Tx) error {
+		if err := tx.DeleteBucket(missingName); err != ErrBucketNotFound {
+			t.Fatalf("expected ErrBucketNotFound, got %v", err)
+		}
+
+		return tx.DeleteBucket(testBucket)
+	})
+	if err != nil {
+		t.Fatalf("unable to delete bucket: %v", err)
+	}
+
+	// Recreating the bucket must not bring back any of its prior
+	// contents.
+	err = db.Update(func(tx Tx) error {
+		bucket, err := tx.CreateBucket(testBucket)
+		if err != nil {
+			return err
+		}
+		nested, err := bucket.CreateBucket(testNested)
+		if err != nil {
+			return err
+		}
+		if nested.Get(testKeys[0]) != nil {
+			t.Fatalf("value of deleted bucket still present")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to recreate bucket: %v", err)
+	}
+}
+
+// TestBoltBackend runs the backend test cases against the bolt backend.
+func TestBoltBackend(t *testing.T) {
+	t.Parallel()
+
+	runBackendTests(t, func() (Backend, func(), error) {
+		tempDir, err := ioutil.TempDir("", "kvdb")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		db, err := Open(BoltBackendName, filepath.Join(tempDir, "test.db"))
+		if err != nil {
+			os.RemoveAll(tempDir)
+			return nil, nil, err
+		}
+
+		cleanUp := func() {
+			db.Close()
+			os.RemoveAll(tempDir)
+		}
+
+		return db, cleanUp, nil
+	})
+}
+
+// TestUnknownBackend asserts that opening an unregistered backend fails.
+func TestUnknownBackend(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Open("unknown"); err == nil {
+		t.Fatalf("expected error opening unknown backend")
+	}
+}
@@ -0,0 +1,352 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+)
+
+var (
+	// peerHistoryBucket is the name of the bucket that stores the
+	// connection history of each peer we've been connected to.
+	//
+	// maps: pubKey => PeerHistory
+	peerHistoryBucket = []byte("peer-history")
+)
+
+// PeerHistory records the connection history of a peer, which can be used to
+// judge its reliability. The history is updated whenever the peer goes online
+// or offline.
+type PeerHistory struct {
+	// FlapCount is the number of times the peer went online or offline.
+	FlapCount uint64
+
+	// LastFlap is the time the peer last went online or offline.
+	LastFlap time.Time
+
+	// FirstSeen is the time we first connected to the peer, from which
+	// on its uptime has been tracked.
+	FirstSeen time.Time
+
+	// Uptime is the total time the peer has been online, excluding its
+	// current session.
+	Uptime time.Duration
+
+	// OnlineSince is the time the current session with the peer started.
+	// It's zero if the peer is offline.
+	OnlineSince time.Time
+}
+
+// Online returns whether the peer is currently online.
+func (h *PeerHistory) Online() bool {
+	return !h.OnlineSince.IsZero()
+}
+
+// TotalUptime returns the total time the peer has been online up until the
+// given time, including its current session.
+func (h *PeerHistory) TotalUptime(now time.Time) time.Duration {
+	uptime := h.Uptime
+	if h.Online() && now.After(h.OnlineSince) {
+		uptime += now.Sub(h.OnlineSince)
+	}
+
+	return uptime
+}
+
+// Lifetime returns the time that has passed between the first time we
+// connected to the peer and the given time. The peer's uptime is tracked over
+// this period.
+func (h *PeerHistory) Lifetime(now time.Time) time.Duration {
+	if h.FirstSeen.IsZero() || !now.After(h.FirstSeen) {
+		return 0
+	}
+
+	return now.Sub(h.FirstSeen)
+}
+
+// RecordPeerOnline records that the peer with the given public key came online
+// at the given time, returning its updated history. If the peer is already
+// recorded as online, its current session is ended and a new one started,
+// without counting as a flap.
+func (d *DB) RecordPeerOnline(pubKey [33]byte,
+	timestamp time.Time) (*PeerHistory, error) {
+
+	return d.updatePeerHistory(pubKey, func(history *PeerHistory) {
+		if history.FirstSeen.IsZero() {
+			history.FirstSeen = timestamp
+		}
+
+		if history.Online() {
+			history.Uptime = history.TotalUptime(timestamp)
+		} else {
+			history.FlapCount++
+			history.LastFlap = timestamp
+		}
+
+		history.OnlineSince = timestamp
+	})
+}
+
+// RecordPeerOffline records that the peer with the given public key went
+// offline at the given time, returning its updated history. The duration of
+// the peer's current session is added to its uptime.
+func (d *DB) RecordPeerOffline(pubKey [33]byte,
+	timestamp time.Time) (*PeerHistory, error) {
+
+	return d.updatePeerHistory(pubKey, func(history *PeerHistory) {
+		if !history.Online() {
+			return
+		}
+
+		history.Uptime = history.TotalUptime(timestamp)
+		history.OnlineSince = time.Time{}
+		history.FlapCount++
+		history.LastFlap = timestamp
+	})
+}
+
+// ResetPeerSessions ends the sessions of all peers that are still recorded as
+// online. This is meant to be called on startup, as sessions are left open if
+// we weren't shut down cleanly. As it's unknown when such a session ended,
+// it's not added to the peer's uptime.
+func (d *DB) ResetPeerSessions() error {
+	return d.Update(func(tx kvdb.Tx) error {
+		histories := tx.Bucket(peerHistoryBucket)
+		if histories == nil {
+			return nil
+		}
+
+		// We'll first collect all open sessions, as we can't modify
+		// the bucket while iterating over it.
+		openSessions := make(map[[33]byte]*PeerHistory)
+		err := histories.ForEach(func(k, v []byte) error {
+			history, err := deserializePeerHistory(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			if !history.Online() {
+				return nil
+			}
+
+			var pubKey [33]byte
+			copy(pubKey[:], k)
+			openSessions[pubKey] = history
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for pubKey, history := range openSessions {
+			history.OnlineSince = time.Time{}
+
+			err := putPeerHistory(histories, pubKey, history)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// PrunePeerHistories deletes the connection history of all peers that aren't
+// within the given set of public keys. This is meant to be called on startup
+// with the peers we have channels with, as the history is only of use for
+// those.
+func (d *DB) PrunePeerHistories(keep map[[33]byte]struct{}) error {
+	return d.Update(func(tx kvdb.Tx) error {
+		histories := tx.Bucket(peerHistoryBucket)
+		if histories == nil {
+			return nil
+		}
+
+		// We'll first collect the peers to prune, as we can't modify
+		// the bucket while iterating over it.
+		var prune [][]byte
+		err := histories.ForEach(func(k, v []byte) error {
+			var pubKey [33]byte
+			copy(pubKey[:], k)
+			if _, ok := keep[pubKey]; !ok {
+				prune = append(prune, pubKey[:])
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, pubKey := range prune {
+			if err := histories.Delete(pubKey); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchPeerHistory returns the connection history of the peer with the given
+// public key. If we've never been connected to the peer,
+// ErrPeerHistoryNotFound is returned.
+func (d *DB) FetchPeerHistory(pubKey [33]byte) (*PeerHistory, error) {
+	var history *PeerHistory
+	err := d.View(func(tx kvdb.Tx) error {
+		histories := tx.Bucket(peerHistoryBucket)
+		if histories == nil {
+			return ErrPeerHistoryNotFound
+		}
+
+		historyBytes := histories.Get(pubKey[:])
+		if historyBytes == nil {
+			return ErrPeerHistoryNotFound
+		}
+
+		var err error
+		history, err = deserializePeerHistory(
+			bytes.NewReader(historyBytes),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// FetchPeerHistories returns the connection history of all peers we've ever
+// been connected to, keyed by their public keys.
+func (d *DB) FetchPeerHistories() (map[[33]byte]*PeerHistory, error) {
+	histories := make(map[[33]byte]*PeerHistory)
+	err := d.View(func(tx kvdb.Tx) error {
+		historyBucket := tx.Bucket(peerHistoryBucket)
+		if historyBucket == nil {
+			return nil
+		}
+
+		return historyBucket.ForEach(func(k, v []byte) error {
+			history, err := deserializePeerHistory(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			var pubKey [33]byte
+			copy(pubKey[:], k)
+			histories[pubKey] = history
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return histories, nil
+}
+
+// updatePeerHistory applies the given update to the connection history of
+// the peer with the given public key, creating it if it doesn't exist yet.
+func (d *DB) updatePeerHistory(pubKey [33]byte,
+	update func(*PeerHistory)) (*PeerHistory, error) {
+
+	var history *PeerHistory
+	err := d.Update(func(tx kvdb.Tx) error {
+		histories, err := tx.CreateBucketIfNotExists(peerHistoryBucket)
+		if err != nil {
+			return err
+		}
+
+		history = &PeerHistory{}
+		historyBytes := histories.Get(pubKey[:])
+		if historyBytes != nil {
+			history, err = deserializePeerHistory(
+				bytes.NewReader(historyBytes),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		update(history)
+
+		return putPeerHistory(histories, pubKey, history)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// putPeerHistory stores the connection history of the peer with the given
+// public key.
+func putPeerHistory(histories kvdb.Bucket, pubKey [33]byte,
+	history *PeerHistory) error {
+
+	var b bytes.Buffer
+	if err := serializePeerHistory(&b, history); err != nil {
+		return err
+	}
+
+	return histories.Put(pubKey[:], b.Bytes())
+}
+
+// serializeTime encodes the given time as nanoseconds since the unix epoch,
+// encoding the zero time as zero.
+func serializeTime(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return uint64(t.UnixNano())
+}
+
+// deserializeTime decodes a time encoded by serializeTime.
+func deserializeTime(t uint64) time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, int64(t))
+}
+
+// serializePeerHistory writes the peer history to the given writer.
+func serializePeerHistory(w io.Writer, history *PeerHistory) error {
+	return WriteElements(w,
+		history.FlapCount, serializeTime(history.LastFlap),
+		serializeTime(history.FirstSeen), uint64(history.Uptime),
+		serializeTime(history.OnlineSince),
+	)
+}
+
+// deserializePeerHistory reads a peer history from the given reader.
+func deserializePeerHistory(r io.Reader) (*PeerHistory, error) {
+	var (
+		history                          PeerHistory
+		lastFlap, firstSeen, onlineSince uint64
+		uptime                           uint64
+	)
+	err := ReadElements(r,
+		&history.FlapCount, &lastFlap, &firstSeen, &uptime,
+		&onlineSince,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	history.LastFlap = deserializeTime(lastFlap)
+	history.FirstSeen = deserializeTime(firstSeen)
+	history.Uptime = time.Duration(uptime)
+	history.OnlineSince = deserializeTime(onlineSince)
+
+	return &history, nil
+}
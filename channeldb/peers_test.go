@@ -0,0 +1,116 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPeerHistory tests that the flap count and uptime of a peer are tracked
+// correctly as it goes online and offline.
+func TestPeerHistory(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	var pubKey [33]byte
+	pubKey[0] = 2
+
+	if _, err := db.FetchPeerHistory(pubKey); err != ErrPeerHistoryNotFound {
+		t.Fatalf("expected ErrPeerHistoryNotFound, got %v", err)
+	}
+
+	start := time.Unix(1000, 0)
+	at := func(d time.Duration) time.Time {
+		return start.Add(d)
+	}
+
+	// The peer is online for an hour, offline for an hour, and then comes
+	// online again.
+	if _, err := db.RecordPeerOnline(pubKey, at(0)); err != nil {
+		t.Fatalf("unable to record peer online: %v", err)
+	}
+	if _, err := db.RecordPeerOffline(pubKey, at(time.Hour)); err != nil {
+		t.Fatalf("unable to record peer offline: %v", err)
+	}
+
+	// Recording the peer offline a second time shouldn't change anything.
+	_, err = db.RecordPeerOffline(pubKey, at(90*time.Minute))
+	if err != nil {
+		t.Fatalf("unable to record peer offline: %v", err)
+	}
+
+	_, err = db.RecordPeerOnline(pubKey, at(2*time.Hour))
+	if err != nil {
+		t.Fatalf("unable to record peer online: %v", err)
+	}
+
+	history, err := db.FetchPeerHistory(pubKey)
+	if err != nil {
+		t.Fatalf("unable to fetch peer history: %v", err)
+	}
+	if history.FlapCount != 3 {
+		t.Fatalf("expected 3 flaps, got %v", history.FlapCount)
+	}
+	if !history.LastFlap.Equal(at(2 * time.Hour)) {
+		t.Fatalf("expected last flap at %v, got %v", at(2*time.Hour),
+			history.LastFlap)
+	}
+	if !history.Online() {
+		t.Fatalf("expected peer to be online")
+	}
+
+	// After three hours, the peer has been online for two of them.
+	now := at(3 * time.Hour)
+	if history.TotalUptime(now) != 2*time.Hour {
+		t.Fatalf("expected uptime of 2h, got %v",
+			history.TotalUptime(now))
+	}
+	if history.Lifetime(now) != 3*time.Hour {
+		t.Fatalf("expected lifetime of 3h, got %v",
+			history.Lifetime(now))
+	}
+
+	// Resetting the sessions should mark the peer offline without adding
+	// to its uptime or flap count.
+	if err := db.ResetPeerSessions(); err != nil {
+		t.Fatalf("unable to reset peer sessions: %v", err)
+	}
+	histories, err := db.FetchPeerHistories()
+	if err != nil {
+		t.Fatalf("unable to fetch peer histories: %v", err)
+	}
+	history, ok := histories[pubKey]
+	if !ok {
+		t.Fatalf("peer history not found")
+	}
+	if history.Online() {
+		t.Fatalf("expected peer to be offline")
+	}
+	if history.TotalUptime(now) != time.Hour {
+		t.Fatalf("expected uptime of 1h, got %v",
+			history.TotalUptime(now))
+	}
+	if history.FlapCount != 3 {
+		t.Fatalf("expected 3 flaps, got %v", history.FlapCount)
+	}
+
+	// Pruning the histories should only retain those of the given peers.
+	otherPubKey := [33]byte{3}
+	if _, err := db.RecordPeerOnline(otherPubKey, now); err != nil {
+		t.Fatalf("unable to record peer online: %v", err)
+	}
+	keep := map[[33]byte]struct{}{otherPubKey: {}}
+	if err := db.PrunePeerHistories(keep); err != nil {
+		t.Fatalf("unable to prune peer histories: %v", err)
+	}
+	if _, err := db.FetchPeerHistory(pubKey); err != ErrPeerHistoryNotFound {
+		t.Fatalf("expected ErrPeerHistoryNotFound, got %v", err)
+	}
+	if _, err := db.FetchPeerHistory(otherPubKey); err != nil {
+		t.Fatalf("unable to fetch peer history: %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+package channeldb
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// archivedChannelBucket stores a compact archive record for every
+	// closed channel whose leftover state has been reaped from the
+	// database. The bucket is keyed by the serialized channel point of the
+	// channel, just like the closed channel bucket.
+	archivedChannelBucket = []byte("archived-chan-bucket")
+)
+
+// ChannelArchive is a compact record of a closed channel whose leftover state
+// has been reaped from the database. It's written once all forwarding packages
+// and cached witnesses of the channel have been purged, and also serves to
+// ensure a channel is only ever reaped once.
+type ChannelArchive struct {
+	// ChanPoint is the outpoint of the channel that was reaped.
+	ChanPoint wire.OutPoint
+
+	// ShortChanID is the short channel ID of the channel that was reaped.
+	ShortChanID lnwire.ShortChannelID
+
+	// CloseHeight is the height at which the closing transaction of the
+	// channel was confirmed.
+	CloseHeight uint32
+
+	// ReapHeight is the height at which the leftover state of the channel
+	// was reaped.
+	ReapHeight uint32
+
+	// NumFwdPkgs is the number of forwarding packages that were purged.
+	NumFwdPkgs uint32
+
+	// NumHtlcs is the number of HTLC adds, settles and fails that were
+	// stored within the purged forwarding packages.
+	NumHtlcs uint32
+
+	// NumWitnesses is the number of witnesses that were purged from the
+	// witness cache.
+	NumWitnesses uint32
+}
+
+// ReapClosedChannels purges the leftover state of all closed channels that
+// have been fully resolved, and whose closing transaction has been buried by
+// at least safetyDepth blocks at the given height. For each such channel, all
+// remaining forwarding packages are deleted, along with any preimage found in
+// the witness cache for the HTLCs they contain. Preimages of HTLCs that are
+// still active within any channel that hasn't been closed yet, as well as
+// those of the given retainHashes, are always retained. The latter must
+// include the HTLCs of closed channels that are still being resolved on-chain,
+// as their state is no longer found within the database. Finally, a compact
+// archive record is written for each reaped channel, which are returned.
+//
+// NOTE: The revocation log of a channel is already deleted once the channel is
+// closed, so it doesn't need to be reaped here.
+func (d *DB) ReapClosedChannels(height, safetyDepth uint32,
+	retainHashes map[[32]byte]struct{}) ([]*ChannelArchive, error) {
+
+	// Before reaping, we'll gather the payment hashes of all HTLCs that
+	// are still active, as we must never remove the preimages they may
+	// require to be resolved.
+	channels, err := d.FetchAllChannels()
+	if err != nil && err != ErrNoActiveChannels {
+		return nil, err
+	}
+	activeHashes := make(map[[32]byte]struct{}, len(retainHashes))
+	for hash := range retainHashes {
+		activeHashes[hash] = struct{}{}
+	}
+	for _, channel := range channels {
+		for _, htlc := range channel.LocalCommitment.Htlcs {
+			activeHashes[htlc.RHash] = struct{}{}
+		}
+		for _, htlc := range channel.RemoteCommitment.Htlcs {
+			activeHashes[htlc.RHash] = struct{}{}
+		}
+	}
+
+	var archives []*ChannelArchive
+	err = d.Update(func(tx kvdb.Tx) error {
+		closedChanBucket := tx.Bucket(closedChannelBucket)
+		if closedChanBucket == nil {
+			return nil
+		}
+
+		archiveBucket, err := tx.CreateBucketIfNotExists(
+			archivedChannelBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		// We'll first collect all channels that are ready to be
+		// reaped, as we can't modify the database while iterating.
+		var reapable []*ChannelCloseSummary
+		err = closedChanBucket.ForEach(func(k, v []byte) error {
+			if archiveBucket.Get(k) != nil {
+				return nil
+			}
+
+			summary, err := deserializeCloseChannelSummary(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			// Channels that haven't been fully resolved yet may
+			// still require their state.
+			if summary.IsPending {
+				return nil
+			}
+			if summary.CloseHeight+safetyDepth > height {
+				return nil
+			}
+
+			reapable = append(reapable, summary)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, summary := range reapable {
+			archive, err := reapChannel(tx, summary, activeHashes)
+			if err != nil {
+				return err
+			}
+			archive.ReapHeight = height
+
+			var chanPoint bytes.Buffer
+			err = writeOutpoint(&chanPoint, &summary.ChanPoint)
+			if err != nil {
+				return err
+			}
+
+			var b bytes.Buffer
+			if err := serializeChannelArchive(&b, archive); err != nil {
+				return err
+			}
+
+			err = archiveBucket.Put(chanPoint.Bytes(), b.Bytes())
+			if err != nil {
+				return err
+			}
+
+			archives = append(archives, archive)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return archives, nil
+}
+
+// reapChannel deletes all forwarding packages of the given closed channel,
+// along with the cached preimages of the HTLCs they contain, and returns an
+// archive record describing the purged state.
+func reapChannel(tx kvdb.Tx, summary *ChannelCloseSummary,
+	activeHashes map[[32]byte]struct{}) (*ChannelArchive, error) {
+
+	archive := &ChannelArchive{
+		ChanPoint:   summary.ChanPoint,
+		ShortChanID: summary.ShortChanID,
+		CloseHeight: summary.CloseHeight,
+	}
+
+	fwdPkgs, err := loadChannelFwdPkgs(tx, summary.ShortChanID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the payment hashes of all HTLCs found within the forwarding
+	// packages, as these map directly to the keys of their preimages
+	// within the witness cache.
+	var hashes [][32]byte
+	for _, fwdPkg := range fwdPkgs {
+		archive.NumFwdPkgs++
+		archive.NumHtlcs += uint32(len(fwdPkg.Adds))
+		archive.NumHtlcs += uint32(len(fwdPkg.SettleFails))
+
+		for _, add := range fwdPkg.Adds {
+			msg, ok := add.UpdateMsg.(*lnwire.UpdateAddHTLC)
+			if !ok {
+				continue
+			}
+			hashes = append(hashes, msg.PaymentHash)
+		}
+		for _, settleFail := range fwdPkg.SettleFails {
+			msg, ok := settleFail.UpdateMsg.(*lnwire.UpdateFulfillHTLC)
+			if !ok {
+				continue
+			}
+			hashes = append(hashes, sha256.Sum256(msg.PaymentPreimage[:]))
+		}
+	}
+
+	if fwdPkgBkt := tx.Bucket(fwdPackagesKey); fwdPkgBkt != nil {
+		sourceKey := makeLogKey(summary.ShortChanID.ToUint64())
+		if fwdPkgBkt.Bucket(sourceKey[:]) != nil {
+			err := fwdPkgBkt.DeleteBucket(sourceKey[:])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	witnessBucket := tx.Bucket(witnessBucketKey)
+	if witnessBucket == nil {
+		return archive, nil
+	}
+	witnessTypeBucketKey, err := Sha256HashWitness.toDBKey()
+	if err != nil {
+		return nil, err
+	}
+	witnessTypeBucket := witnessBucket.Bucket(witnessTypeBucketKey)
+	if witnessTypeBucket == nil {
+		return archive, nil
+	}
+
+	for _, hash := range hashes {
+		if _, ok := activeHashes[hash]; ok {
+			continue
+		}
+		if witnessTypeBucket.Get(hash[:]) == nil {
+			continue
+		}
+
+		if err := witnessTypeBucket.Delete(hash[:]); err != nil {
+			return nil, err
+		}
+		archive.NumWitnesses++
+	}
+
+	return archive, nil
+}
+
+// FetchChannelArchives returns the archive records of all closed channels
+// whose leftover state has been reaped.
+func (d *DB) FetchChannelArchives() ([]*ChannelArchive, error) {
+	var archives []*ChannelArchive
+	err := d.View(func(tx kvdb.Tx) error {
+		archiveBucket := tx.Bucket(archivedChannelBucket)
+		if archiveBucket == nil {
+			return nil
+		}
+
+		return archiveBucket.ForEach(func(_, v []byte) error {
+			archive, err := deserializeChannelArchive(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			archives = append(archives, archive)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return archives, nil
+}
+
+func serializeChannelArchive(w io.Writer, a *ChannelArchive) error {
+	return WriteElements(w,
+		a.ChanPoint, a.ShortChanID, a.CloseHeight, a.ReapHeight,
+		a.NumFwdPkgs, a.NumHtlcs, a.NumWitnesses,
+	)
+}
+
+func deserializeChannelArchive(r io.Reader) (*ChannelArchive, error) {
+	a := &ChannelArchive{}
+	err := ReadElements(r,
+		&a.ChanPoint, &a.ShortChanID, &a.CloseHeight, &a.ReapHeight,
+		&a.NumFwdPkgs, &a.NumHtlcs, &a.NumWitnesses,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
@@ -0,0 +1,235 @@
+package channeldb
+
+import (
+	"crypto/sha256"
+	"net"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestReapClosedChannels asserts that the forwarding packages and cached
+// preimages of a closed channel are only reaped once the channel has been
+// fully resolved and buried by the safety depth, and that preimages of active
+// and unresolved HTLCs are retained.
+func TestReapClosedChannels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	const (
+		closeHeight = 100
+		safetyDepth = 10
+	)
+
+	// First, we'll create the channel that will be closed.
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+	scid := lnwire.NewShortChanIDFromInt(0xdeadbeef)
+	if err := state.MarkAsOpen(scid); err != nil {
+		t.Fatalf("unable to mark channel as open: %v", err)
+	}
+
+	// Along with a second channel, which has an active HTLC whose
+	// preimage must never be reaped.
+	var settled, inactive, active, unresolved [32]byte
+	settled[0], inactive[0], active[0], unresolved[0] = 1, 2, 3, 4
+	activeHash := sha256.Sum256(active[:])
+
+	// The HTLCs of closed channels that are still being resolved on-chain
+	// are only known to the caller, and their preimages must be retained
+	// as well.
+	unresolvedHash := sha256.Sum256(unresolved[:])
+	retainHashes := map[[32]byte]struct{}{
+		unresolvedHash: {},
+	}
+
+	activeState, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	activeState.FundingOutpoint.Index++
+	activeState.LocalCommitment.Htlcs = []HTLC{
+		{
+			Signature:     testSig.Serialize(),
+			Incoming:      true,
+			Amt:           10,
+			RHash:         activeHash,
+			RefundTimeout: 1,
+			OnionBlob:     []byte("onionblob"),
+		},
+	}
+	if err := activeState.FullSync(); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// The closed channel is left with a forwarding package containing all
+	// four HTLCs, whose preimages are all known to the witness cache.
+	adds := []LogUpdate{
+		{
+			LogIndex: 0,
+			UpdateMsg: &lnwire.UpdateAddHTLC{
+				PaymentHash: sha256.Sum256(inactive[:]),
+			},
+		},
+		{
+			LogIndex: 1,
+			UpdateMsg: &lnwire.UpdateAddHTLC{
+				PaymentHash: activeHash,
+			},
+		},
+		{
+			LogIndex: 3,
+			UpdateMsg: &lnwire.UpdateAddHTLC{
+				PaymentHash: unresolvedHash,
+			},
+		},
+	}
+	settleFails := []LogUpdate{
+		{
+			LogIndex: 2,
+			UpdateMsg: &lnwire.UpdateFulfillHTLC{
+				PaymentPreimage: settled,
+			},
+		},
+	}
+	packager := NewChannelPackager(scid)
+	fwdPkg := NewFwdPkg(scid, 0, adds, settleFails)
+	err = cdb.Update(func(tx kvdb.Tx) error {
+		return packager.AddFwdPkg(tx, fwdPkg)
+	})
+	if err != nil {
+		t.Fatalf("unable to add fwd pkg: %v", err)
+	}
+
+	wCache := cdb.NewWitnessCache()
+	for _, preimage := range [][32]byte{
+		settled, inactive, active, unresolved,
+	} {
+		err := wCache.AddWitness(Sha256HashWitness, preimage[:])
+		if err != nil {
+			t.Fatalf("unable to add witness: %v", err)
+		}
+	}
+
+	summary := &ChannelCloseSummary{
+		ChanPoint:   state.FundingOutpoint,
+		ShortChanID: scid,
+		ClosingTXID: rev,
+		RemotePub:   state.IdentityPub,
+		Capacity:    state.Capacity,
+		CloseHeight: closeHeight,
+		CloseType:   RemoteForceClose,
+		IsPending:   true,
+	}
+	if err := state.CloseChannel(summary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	assertNumReaped := func(height uint32, expected int) []*ChannelArchive {
+		t.Helper()
+
+		archives, err := cdb.ReapClosedChannels(
+			height, safetyDepth, retainHashes,
+		)
+		if err != nil {
+			t.Fatalf("unable to reap closed channels: %v", err)
+		}
+		if len(archives) != expected {
+			t.Fatalf("expected %d reaped channels at height %d, "+
+				"got %d", expected, height, len(archives))
+		}
+
+		return archives
+	}
+
+	// As the channel hasn't been fully resolved yet, it must not be
+	// reaped, even though it's buried deep enough.
+	assertNumReaped(closeHeight+safetyDepth, 0)
+
+	if err := cdb.MarkChanFullyClosed(&state.FundingOutpoint); err != nil {
+		t.Fatalf("unable to fully close channel: %v", err)
+	}
+
+	// Once fully resolved, the channel should only be reaped once its
+	// closing transaction is buried by the safety depth.
+	assertNumReaped(closeHeight+safetyDepth-1, 0)
+	archives := assertNumReaped(closeHeight+safetyDepth, 1)
+
+	archive := archives[0]
+	if archive.ChanPoint != state.FundingOutpoint {
+		t.Fatalf("expected chan point %v, got %v",
+			state.FundingOutpoint, archive.ChanPoint)
+	}
+	if archive.NumFwdPkgs != 1 || archive.NumHtlcs != 4 ||
+		archive.NumWitnesses != 2 {
+
+		t.Fatalf("unexpected archive: %v", spew.Sdump(archive))
+	}
+
+	// The forwarding packages of the channel should be gone.
+	var fwdPkgs []*FwdPkg
+	err = cdb.View(func(tx kvdb.Tx) error {
+		var err error
+		fwdPkgs, err = packager.LoadFwdPkgs(tx)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to load fwd pkgs: %v", err)
+	}
+	if len(fwdPkgs) != 0 {
+		t.Fatalf("expected no fwd pkgs, found %d", len(fwdPkgs))
+	}
+
+	// Only the preimages of the active and unresolved HTLCs should remain.
+	for _, preimage := range [][32]byte{settled, inactive} {
+		hash := sha256.Sum256(preimage[:])
+		_, err := wCache.LookupWitness(Sha256HashWitness, hash[:])
+		if err != ErrNoWitnesses {
+			t.Fatalf("expected witness %x to be reaped, got: %v",
+				preimage, err)
+		}
+	}
+	for _, hash := range [][32]byte{activeHash, unresolvedHash} {
+		_, err := wCache.LookupWitness(Sha256HashWitness, hash[:])
+		if err != nil {
+			t.Fatalf("expected witness %x to be retained: %v",
+				hash, err)
+		}
+	}
+
+	// A channel must only be reaped once, and its archive record should
+	// be retrievable.
+	assertNumReaped(closeHeight+safetyDepth+1, 0)
+
+	stored, err := cdb.FetchChannelArchives()
+	if err != nil {
+		t.Fatalf("unable to fetch channel archives: %v", err)
+	}
+	if len(stored) != 1 {
+		t.Fatalf("expected 1 archive, got %d", len(stored))
+	}
+	if *stored[0] != *archive {
+		t.Fatalf("archives don't match: expected %v, got %v",
+			spew.Sdump(archive), spew.Sdump(stored[0]))
+	}
+	if stored[0].ReapHeight != closeHeight+safetyDepth {
+		t.Fatalf("expected reap height %d, got %d",
+			closeHeight+safetyDepth, stored[0].ReapHeight)
+	}
+}
@@ -0,0 +1,158 @@
+package channelnotifier
+
+import (
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// ChannelNotifier is a subsystem which all active, pending, and closed
+// channel events pipe through. It takes subscriptions for its events, and
+// whenever it receives a new event it notifies its subscribers over the
+// proper channel.
+type ChannelNotifier struct {
+	stopped uint32 // To be used atomically.
+
+	ntfnServer *subscribe.Server
+
+	chanDB *channeldb.DB
+}
+
+// PendingOpenChannelEvent represents a new event where a new channel has
+// entered a pending open state.
+type PendingOpenChannelEvent struct {
+	// ChannelPoint is the channel outpoint for the new channel.
+	ChannelPoint *wire.OutPoint
+
+	// PendingChannel is the channel configuration for the newly created
+	// channel, which is now waiting for its funding transaction to
+	// confirm.
+	PendingChannel *channeldb.OpenChannel
+}
+
+// OpenChannelEvent represents a new event where a channel goes from pending
+// open to open.
+type OpenChannelEvent struct {
+	// Channel is the channel that has become open.
+	Channel *channeldb.OpenChannel
+}
+
+// ActiveChannelEvent represents a new event where a channel becomes active,
+// meaning its link has been started and it can be used to forward payments.
+type ActiveChannelEvent struct {
+	// ChannelPoint is the channel outpoint for the channel that became
+	// active.
+	ChannelPoint *wire.OutPoint
+}
+
+// InactiveChannelEvent represents a new event where a channel becomes
+// inactive, meaning its link has been stopped.
+type InactiveChannelEvent struct {
+	// ChannelPoint is the channel outpoint for the channel that became
+	// inactive.
+	ChannelPoint *wire.OutPoint
+}
+
+// ClosedChannelEvent represents a new event where a channel has been closed
+// on-chain. The channel may still have outputs that need to be resolved.
+type ClosedChannelEvent struct {
+	// CloseSummary is the summary of the channel close that has occurred.
+	CloseSummary *channeldb.ChannelCloseSummary
+}
+
+// FullyResolvedChannelEvent represents a new event where all of a closed
+// channel's on-chain outputs have been resolved.
+type FullyResolvedChannelEvent struct {
+	// ChannelPoint is the channel outpoint for the channel that has been
+	// fully resolved.
+	ChannelPoint *wire.OutPoint
+}
+
+// New creates a new channel notifier. The ChannelNotifier gets channel
+// events from the funding manager, the switch and the chain arbitrator, and
+// dispatches them to its clients.
+func New(chanDB *channeldb.DB) *ChannelNotifier {
+	return &ChannelNotifier{
+		ntfnServer: subscribe.NewServer(),
+		chanDB:     chanDB,
+	}
+}
+
+// Stop signals the notifier for a graceful shutdown, cancelling all
+// subscriptions.
+func (c *ChannelNotifier) Stop() {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return
+	}
+
+	c.ntfnServer.Stop()
+}
+
+// SubscribeChannelEvents returns a subscribe.Client that will receive updates
+// any time the Server is made aware of a new event.
+func (c *ChannelNotifier) SubscribeChannelEvents() (*subscribe.Client, error) {
+	return c.ntfnServer.Subscribe()
+}
+
+// NotifyPendingOpenChannelEvent notifies all subscribed clients that a new
+// channel is pending.
+func (c *ChannelNotifier) NotifyPendingOpenChannelEvent(
+	pendingChan *channeldb.OpenChannel) {
+
+	c.sendUpdate(&PendingOpenChannelEvent{
+		ChannelPoint:   &pendingChan.FundingOutpoint,
+		PendingChannel: pendingChan,
+	})
+}
+
+// NotifyOpenChannelEvent notifies all subscribed clients that a channel has
+// gone from pending open to open.
+func (c *ChannelNotifier) NotifyOpenChannelEvent(
+	channel *channeldb.OpenChannel) {
+
+	c.sendUpdate(&OpenChannelEvent{Channel: channel})
+}
+
+// NotifyActiveChannelEvent notifies all subscribed clients that a channel is
+// active.
+func (c *ChannelNotifier) NotifyActiveChannelEvent(chanPoint wire.OutPoint) {
+	c.sendUpdate(&ActiveChannelEvent{ChannelPoint: &chanPoint})
+}
+
+// NotifyInactiveChannelEvent notifies all subscribed clients that a channel
+// is inactive.
+func (c *ChannelNotifier) NotifyInactiveChannelEvent(chanPoint wire.OutPoint) {
+	c.sendUpdate(&InactiveChannelEvent{ChannelPoint: &chanPoint})
+}
+
+// NotifyClosedChannelEvent notifies all subscribed clients that a channel has
+// closed. The close summary of the channel is fetched from the database, so
+// this must only be called once the channel has been marked as closed.
+func (c *ChannelNotifier) NotifyClosedChannelEvent(chanPoint wire.OutPoint) {
+	closeSummary, err := c.chanDB.FetchClosedChannel(&chanPoint)
+	if err != nil {
+		log.Warnf("Unable to fetch closed channel summary from the "+
+			"db: %v", err)
+		return
+	}
+
+	c.sendUpdate(&ClosedChannelEvent{CloseSummary: closeSummary})
+}
+
+// NotifyFullyResolvedChannelEvent notifies all subscribed clients that all
+// outputs of a closed channel have been resolved.
+func (c *ChannelNotifier) NotifyFullyResolvedChannelEvent(
+	chanPoint wire.OutPoint) {
+
+	c.sendUpdate(&FullyResolvedChannelEvent{ChannelPoint: &chanPoint})
+}
+
+// sendUpdate dispatches the given event to all subscribed clients.
+func (c *ChannelNotifier) sendUpdate(event interface{}) {
+	err := c.ntfnServer.SendUpdate(event)
+	if err != nil && err != subscribe.ErrServerShuttingDown {
+		log.Warnf("Unable to send channel event update: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package channelnotifier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// TestChannelEvents tests that channel events are delivered to subscribed
+// clients in the order they were notified.
+func TestChannelEvents(t *testing.T) {
+	t.Parallel()
+
+	notifier := New(nil)
+	defer notifier.Stop()
+
+	client, err := notifier.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer client.Cancel()
+
+	chanPoint := wire.OutPoint{Index: 1}
+	channel := &channeldb.OpenChannel{FundingOutpoint: chanPoint}
+
+	notifier.NotifyPendingOpenChannelEvent(channel)
+	notifier.NotifyOpenChannelEvent(channel)
+	notifier.NotifyActiveChannelEvent(chanPoint)
+	notifier.NotifyInactiveChannelEvent(chanPoint)
+	notifier.NotifyFullyResolvedChannelEvent(chanPoint)
+
+	nextEvent := func() interface{} {
+		select {
+		case event := <-client.Updates():
+			return event
+		case <-time.After(time.Second):
+			t.Fatalf("event not received")
+			return nil
+		}
+	}
+
+	pending, ok := nextEvent().(*PendingOpenChannelEvent)
+	if !ok || *pending.ChannelPoint != chanPoint {
+		t.Fatalf("expected pending open event for %v", chanPoint)
+	}
+	open, ok := nextEvent().(*OpenChannelEvent)
+	if !ok || open.Channel != channel {
+		t.Fatalf("expected open event for %v", chanPoint)
+	}
+	active, ok := nextEvent().(*ActiveChannelEvent)
+	if !ok || *active.ChannelPoint != chanPoint {
+		t.Fatalf("expected active event for %v", chanPoint)
+	}
+	inactive, ok := nextEvent().(*InactiveChannelEvent)
+	if !ok || *inactive.ChannelPoint != chanPoint {
+		t.Fatalf("expected inactive event for %v", chanPoint)
+	}
+	resolved, ok := nextEvent().(*FullyResolvedChannelEvent)
+	if !ok || *resolved.ChannelPoint != chanPoint {
+		t.Fatalf("expected fully resolved event for %v", chanPoint)
+	}
+}
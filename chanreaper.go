@@ -0,0 +1,141 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// ChanReaperConfig houses the resources required by the chanReaper.
+type ChanReaperConfig struct {
+	// DB is the channel database whose closed channels should be reaped.
+	DB *channeldb.DB
+
+	// Notifier is used to receive notifications of new blocks, as each
+	// new block may bury the closing transactions of more channels.
+	Notifier chainntnfs.ChainNotifier
+
+	// SafetyDepth is the number of blocks by which the closing
+	// transaction of a fully resolved channel must be buried before its
+	// leftover state is reaped.
+	SafetyDepth uint32
+
+	// UnresolvedHtlcHashes returns the payment hashes of all HTLCs of
+	// closed channels that are yet to be resolved on-chain, whose
+	// preimages must be retained.
+	UnresolvedHtlcHashes func() (map[[32]byte]struct{}, error)
+}
+
+// chanReaper is a background subsystem that garbage collects the leftover
+// state of closed channels. Once a channel has been fully resolved by the
+// contract court and its closing transaction has been buried by the
+// configured safety depth, its remaining forwarding packages and cached
+// preimages are purged from the database, leaving only a compact archive
+// record behind.
+type chanReaper struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *ChanReaperConfig
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newChanReaper creates a new instance of the chanReaper from the passed
+// config.
+func newChanReaper(cfg *ChanReaperConfig) *chanReaper {
+	return &chanReaper{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start launches the goroutine which reaps closed channels as new blocks
+// arrive.
+func (c *chanReaper) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	// A safety depth of zero signals that reaping has been disabled.
+	if c.cfg.SafetyDepth == 0 {
+		return nil
+	}
+
+	chdbLog.Tracef("Starting closed channel reaper")
+
+	// Registering without a best block will deliver the current tip
+	// first, allowing us to catch up with any channels that became
+	// reapable while we were down.
+	blockEpochs, err := c.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+
+	c.wg.Add(1)
+	go c.reaper(blockEpochs)
+
+	return nil
+}
+
+// Stop signals the chanReaper to exit, and waits for it to do so.
+func (c *chanReaper) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	chdbLog.Infof("Closed channel reaper shutting down")
+
+	close(c.quit)
+	c.wg.Wait()
+
+	return nil
+}
+
+// reaper reaps all closed channels which have become eligible at each new
+// block height.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *chanReaper) reaper(blockEpochs *chainntnfs.BlockEpochEvent) {
+	defer c.wg.Done()
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			height := uint32(epoch.Height)
+			retainHashes, err := c.cfg.UnresolvedHtlcHashes()
+			if err != nil {
+				chdbLog.Debugf("Deferring reaping of closed "+
+					"channels at height=%d: %v", height, err)
+				continue
+			}
+
+			archives, err := c.cfg.DB.ReapClosedChannels(
+				height, c.cfg.SafetyDepth, retainHashes,
+			)
+			if err != nil {
+				chdbLog.Errorf("Unable to reap closed channels "+
+					"at height=%d: %v", height, err)
+				continue
+			}
+
+			for _, archive := range archives {
+				chdbLog.Infof("Reaped closed channel %v: purged "+
+					"%d forwarding packages and %d "+
+					"witnesses", archive.ChanPoint,
+					archive.NumFwdPkgs, archive.NumWitnesses)
+			}
+
+		case <-c.quit:
+			return
+		}
+	}
+}
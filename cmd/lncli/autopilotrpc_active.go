@@ -4,6 +4,9 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/lightningnetwork/lnd/lnrpc/autopilotrpc"
 	"github.com/urfave/cli"
@@ -94,6 +97,192 @@ func disable(ctx *cli.Context) error {
 	return nil
 }
 
+var setWeightsCommand = cli.Command{
+	Name:      "setweights",
+	Usage:     "Set the weights of the autopilot heuristics.",
+	ArgsUsage: "name:weight [name:weight...]",
+	Description: `
+	Set the weights of the heuristics combined by the autopilot agent when
+	scoring nodes. The weights must sum to 1.0, and heuristics that aren't
+	specified are deactivated. The current weights are reported by the
+	status command.
+
+	Example:
+	lncli autopilot setweights preferential:0.6 betweenness:0.4`,
+	Action: actionDecorator(setWeights),
+}
+
+func setWeights(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "setweights")
+	}
+
+	weights := make(map[string]float64, ctx.NArg())
+	for _, arg := range ctx.Args() {
+		parts := strings.Split(arg, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("expected name:weight, got %v", arg)
+		}
+
+		weight, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode weight of "+
+				"heuristic %v: %v", parts[0], err)
+		}
+		weights[parts[0]] = weight
+	}
+
+	client, cleanUp := getAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &autopilotrpc.SetHeuristicWeightsRequest{
+		HeuristicWeights: weights,
+	}
+
+	resp, err := client.SetHeuristicWeights(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var queryScoresCommand = cli.Command{
+	Name:      "query",
+	Usage:     "Query the autopilot heuristics for nodes' scores.",
+	ArgsUsage: "[flags] <pubkey> <pubkey> <pubkey> ...",
+	Description: `
+	Query the scores the autopilot heuristics would give to the given set
+	of nodes when deciding where to open channels.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "heuristic",
+			Usage: "the name of the heuristic to query, all " +
+				"heuristics are queried if not set",
+		},
+		cli.BoolFlag{
+			Name: "ignorelocalstate, i",
+			Usage: "Ignore local channel state when calculating " +
+				"scores.",
+		},
+	},
+	Action: actionDecorator(queryScores),
+}
+
+func queryScores(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "query")
+	}
+
+	client, cleanUp := getAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &autopilotrpc.QueryScoresRequest{
+		Pubkeys:          ctx.Args(),
+		Heuristic:        ctx.String("heuristic"),
+		IgnoreLocalState: ctx.Bool("ignorelocalstate"),
+	}
+
+	resp, err := client.QueryScores(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var setScoresCommand = cli.Command{
+	Name:      "setscores",
+	Usage:     "Set the scores of an autopilot heuristic.",
+	ArgsUsage: "pubkey:score [pubkey:score...]",
+	Description: `
+	Set the scores of the nodes used by an external scoring heuristic of
+	the autopilot agent. Scores must be in the range [0.0, 1.0], and nodes
+	that aren't specified are given a score of zero.
+
+	Example:
+	lncli autopilot setscores <pubkey1>:0.8 <pubkey2>:0.3`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "heuristic",
+			Usage: "the name of the heuristic to set the scores of",
+			Value: "externalscore",
+		},
+	},
+	Action: actionDecorator(setScores),
+}
+
+func setScores(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "setscores")
+	}
+
+	scores := make(map[string]float64, ctx.NArg())
+	for _, arg := range ctx.Args() {
+		parts := strings.Split(arg, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("expected pubkey:score, got %v", arg)
+		}
+
+		score, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode score of node "+
+				"%v: %v", parts[0], err)
+		}
+		scores[parts[0]] = score
+	}
+
+	client, cleanUp := getAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &autopilotrpc.SetScoresRequest{
+		Heuristic: ctx.String("heuristic"),
+		Scores:    scores,
+	}
+
+	resp, err := client.SetScores(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var pruneReportCommand = cli.Command{
+	Name:  "prunereport",
+	Usage: "Show the channels found to be inactive or unprofitable.",
+	Description: `
+	Show the outcome of the latest evaluation of the autopilot channel
+	pruner, listing the channels found to be inactive or unprofitable, and
+	whether they were closed.`,
+	Action: actionDecorator(pruneReport),
+}
+
+func pruneReport(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &autopilotrpc.PruneReportRequest{}
+
+	resp, err := client.PruneReport(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // autopilotCommands will return the set of commands to enable for autopilotrpc
 // builds.
 func autopilotCommands() []cli.Command {
@@ -107,6 +296,10 @@ func autopilotCommands() []cli.Command {
 				getStatusCommand,
 				enableCommand,
 				disableCommand,
+				setWeightsCommand,
+				queryScoresCommand,
+				setScoresCommand,
+				pruneReportCommand,
 			},
 		},
 	}
@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"encoding/csv"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -11,20 +12,25 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/urfave/cli"
 	"golang.org/x/crypto/ssh/terminal"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	macaroon "gopkg.in/macaroon.v2"
 )
 
 // TODO(roasbeef): cli logic for supporting both positional and unix style
@@ -2391,6 +2397,25 @@ var addInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		cli.StringFlag{
+			Name: "hint_chan_ids",
+			Usage: "a comma separated list of the channel IDs of " +
+				"the private channels to consider for routing " +
+				"hints. If not set, all private channels are " +
+				"considered.",
+		},
+		cli.Uint64Flag{
+			Name: "cltv_expiry",
+			Usage: "the minimum CLTV delta of the HTLC paying to " +
+				"the invoice. If not set, the configured time " +
+				"lock delta is used.",
+		},
+		cli.StringFlag{
+			Name: "new_fallback_addr",
+			Usage: "include a new on-chain address from the " +
+				"wallet as the fallback address of the " +
+				"invoice: p2wkh or np2wkh",
+		},
 	},
 	Action: actionDecorator(addInvoice),
 }
@@ -2441,15 +2466,46 @@ func addInvoice(ctx *cli.Context) error {
 		return fmt.Errorf("unable to parse receipt: %v", err)
 	}
 
+	var hintChanIDs []uint64
+	if ctx.IsSet("hint_chan_ids") {
+		for _, s := range strings.Split(ctx.String("hint_chan_ids"), ",") {
+			chanID, err := strconv.ParseUint(
+				strings.TrimSpace(s), 10, 64,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to parse hint_chan_ids: "+
+					"%v", err)
+			}
+			hintChanIDs = append(hintChanIDs, chanID)
+		}
+	}
+
+	var fallbackAddrPolicy lnrpc.Invoice_FallbackAddrPolicy
+	switch ctx.String("new_fallback_addr") {
+	case "":
+		fallbackAddrPolicy = lnrpc.Invoice_FALLBACK_ADDR_PROVIDED
+	case "p2wkh":
+		fallbackAddrPolicy = lnrpc.Invoice_FALLBACK_ADDR_NEW_WITNESS_PUBKEY_HASH
+	case "np2wkh":
+		fallbackAddrPolicy = lnrpc.Invoice_FALLBACK_ADDR_NEW_NESTED_PUBKEY_HASH
+	default:
+		return fmt.Errorf("invalid new_fallback_addr type %v, supported "+
+			"address types are: p2wkh and np2wkh",
+			ctx.String("new_fallback_addr"))
+	}
+
 	invoice := &lnrpc.Invoice{
-		Memo:            ctx.String("memo"),
-		Receipt:         receipt,
-		RPreimage:       preimage,
-		Value:           amt,
-		DescriptionHash: descHash,
-		FallbackAddr:    ctx.String("fallback_addr"),
-		Expiry:          ctx.Int64("expiry"),
-		Private:         ctx.Bool("private"),
+		Memo:               ctx.String("memo"),
+		Receipt:            receipt,
+		RPreimage:          preimage,
+		Value:              amt,
+		DescriptionHash:    descHash,
+		FallbackAddr:       ctx.String("fallback_addr"),
+		Expiry:             ctx.Int64("expiry"),
+		Private:            ctx.Bool("private"),
+		HintChanIds:        hintChanIDs,
+		CltvExpiry:         ctx.Uint64("cltv_expiry"),
+		FallbackAddrPolicy: fallbackAddrPolicy,
 	}
 
 	resp, err := client.AddInvoice(context.Background(), invoice)
@@ -2775,7 +2831,7 @@ var queryRoutesCommand = cli.Command{
 	Category:    "Payments",
 	Usage:       "Query a route to a destination.",
 	Description: "Queries the channel router for a potential path to the destination that has sufficient flow for the amount including fees",
-	ArgsUsage:   "dest amt",
+	ArgsUsage:   "dest amt | --pay_req=[payment request]",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: "dest",
@@ -2806,6 +2862,18 @@ var queryRoutesCommand = cli.Command{
 			Usage: "(optional) number of blocks the last hop has to reveal " +
 				"the preimage",
 		},
+		cli.StringFlag{
+			Name: "pay_req",
+			Usage: "(optional) a zpay32 encoded payment request to " +
+				"query routes for, the destination, amount and " +
+				"route hints will be taken from the payment request",
+		},
+		cli.StringFlag{
+			Name: "route_hints",
+			Usage: "(optional) a json array string in the format of " +
+				"the route_hints returned by decodepayreq, used to " +
+				"reach destinations behind private channels",
+		},
 	},
 	Action: actionDecorator(queryRoutes),
 }
@@ -2823,25 +2891,29 @@ func queryRoutes(ctx *cli.Context) error {
 
 	args := ctx.Args()
 
+	// If a payment request was provided, then the destination and amount
+	// are optional as they'll be taken from the payment request.
+	payReq := ctx.String("pay_req")
+
 	switch {
 	case ctx.IsSet("dest"):
 		dest = ctx.String("dest")
-	case args.Present():
+	case args.Present() && payReq == "":
 		dest = args.First()
 		args = args.Tail()
-	default:
+	case payReq == "":
 		return fmt.Errorf("dest argument missing")
 	}
 
 	switch {
 	case ctx.IsSet("amt"):
 		amt = ctx.Int64("amt")
-	case args.Present():
+	case args.Present() && payReq == "":
 		amt, err = strconv.ParseInt(args.First(), 10, 64)
 		if err != nil {
 			return fmt.Errorf("unable to decode amt argument: %v", err)
 		}
-	default:
+	case payReq == "":
 		return fmt.Errorf("amt argument missing")
 	}
 
@@ -2856,6 +2928,22 @@ func queryRoutes(ctx *cli.Context) error {
 		FeeLimit:       feeLimit,
 		NumRoutes:      int32(ctx.Int("num_max_routes")),
 		FinalCltvDelta: int32(ctx.Int("final_cltv_delta")),
+		PaymentRequest: payReq,
+	}
+
+	// The route hints are expected in the same format as those returned
+	// by decodepayreq, so we'll unmarshal them as part of a request.
+	if ctx.IsSet("route_hints") {
+		jsonHints := fmt.Sprintf(
+			"{\"route_hints\": %s}", ctx.String("route_hints"),
+		)
+		hintsReq := &lnrpc.QueryRoutesRequest{}
+		err := jsonpb.UnmarshalString(jsonHints, hintsReq)
+		if err != nil {
+			return fmt.Errorf("unable to unmarshal json string "+
+				"from route hints: %v", err)
+		}
+		req.RouteHints = hintsReq.RouteHints
 	}
 
 	route, err := client.QueryRoutes(ctxb, req)
@@ -2931,6 +3019,192 @@ func debugLevel(ctx *cli.Context) error {
 	return nil
 }
 
+var getDBStatsCommand = cli.Command{
+	Name:  "getdbstats",
+	Usage: "Display the size of the channel database by subsystem.",
+	Description: `
+	Returns the size of the channel database, along with the number of keys,
+	buckets and bytes used by each of the subsystems storing data within it.`,
+	Action: actionDecorator(getDBStats),
+}
+
+func getDBStats(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetDBStatsRequest{}
+	resp, err := client.GetDBStats(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var backupDBCommand = cli.Command{
+	Name:      "backupdb",
+	Usage:     "Write a consistent backup of the channel database to a file.",
+	ArgsUsage: "output_file",
+	Description: `
+	Streams a consistent, point-in-time backup of the entire channel
+	database from the running lnd instance into output_file. The backup
+	records the database version and the best block known to lnd, which is
+	used by restoredb to refuse restoring outdated backups. As the backup
+	contains all channel secrets, this requires the admin macaroon. Admin
+	macaroons created by earlier versions of lnd lack the required backup
+	permission. To regenerate them, delete admin.macaroon, readonly.macaroon
+	and invoice.macaroon, then restart lnd.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "output_file",
+			Usage: "the file the backup should be written to",
+		},
+	},
+	Action: actionDecorator(backupDB),
+}
+
+func backupDB(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var outputFile string
+	switch {
+	case ctx.IsSet("output_file"):
+		outputFile = ctx.String("output_file")
+	case ctx.Args().Present():
+		outputFile = ctx.Args().First()
+	default:
+		return fmt.Errorf("output_file argument missing")
+	}
+
+	stream, err := client.BackupDatabase(
+		ctxb, &lnrpc.BackupDatabaseRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(
+		outputFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600,
+	)
+	if err != nil {
+		return err
+	}
+
+	// If we fail to receive the complete backup, we'll remove the partial
+	// file, so it can't be mistaken for a valid backup.
+	var complete bool
+	defer func() {
+		f.Close()
+		if !complete {
+			os.Remove(outputFile)
+		}
+	}()
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return fmt.Errorf("backup stream ended before the " +
+				"backup was complete")
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := f.Write(chunk.Data); err != nil {
+			return err
+		}
+
+		// The metadata is only sent once the backup is complete.
+		if chunk.Metadata != nil {
+			if err := f.Sync(); err != nil {
+				return err
+			}
+
+			complete = true
+			printRespJSON(chunk.Metadata)
+			return nil
+		}
+	}
+}
+
+var restoreDBCommand = cli.Command{
+	Name:      "restoredb",
+	Usage:     "Restore the channel database from a backup.",
+	ArgsUsage: "backup_file",
+	Description: `
+	Restores the channel database from a backup created with backupdb,
+	replacing the existing database. This command doesn't connect to lnd,
+	which MUST NOT be running while the database is restored. The restore
+	is refused if the existing database is held open by lnd.
+
+	Restoring an outdated channel state can lead to a loss of all funds
+	within the affected channels, as the remote party may punish us for
+	broadcasting a revoked commitment. Therefore, backups that are older
+	than the existing database are refused, unless --force is set.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "backup_file",
+			Usage: "the file holding the backup to restore",
+		},
+		cli.StringFlag{
+			Name: "db_dir",
+			Usage: "the directory holding the channel database, " +
+				"defaults to lnddir/data/graph/<network>",
+		},
+		cli.BoolFlag{
+			Name:  "force",
+			Usage: "restore the backup even if it's outdated",
+		},
+	},
+	Action: actionDecorator(restoreDB),
+}
+
+func restoreDB(ctx *cli.Context) error {
+	var backupFile string
+	switch {
+	case ctx.IsSet("backup_file"):
+		backupFile = ctx.String("backup_file")
+	case ctx.Args().Present():
+		backupFile = ctx.Args().First()
+	default:
+		return fmt.Errorf("backup_file argument missing")
+	}
+
+	dbDir := cleanAndExpandPath(ctx.String("db_dir"))
+	if dbDir == "" {
+		network := strings.ToLower(ctx.GlobalString("network"))
+		dbDir = filepath.Join(
+			cleanAndExpandPath(ctx.GlobalString("lnddir")),
+			defaultDataDir, defaultGraphSubDir, network,
+		)
+	}
+
+	f, err := os.Open(backupFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	header, err := channeldb.RestoreBackup(
+		dbDir, bufio.NewReader(f), ctx.Bool("force"),
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(&lnrpc.DatabaseBackupMetadata{
+		DbVersion:       header.DBVersion,
+		BestBlockHeight: header.BestHeight,
+		BestBlockHash:   header.BestHash.String(),
+		Timestamp:       header.Timestamp.Unix(),
+	})
+	return nil
+}
+
 var decodePayReqCommand = cli.Command{
 	Name:        "decodepayreq",
 	Category:    "Payments",
@@ -3155,13 +3429,16 @@ func feeReport(ctx *cli.Context) error {
 var updateChannelPolicyCommand = cli.Command{
 	Name:     "updatechanpolicy",
 	Category: "Channels",
-	Usage: "Update the channel policy for all channels, or a single " +
-		"channel.",
+	Usage: "Update the channel policy for all channels, the channels " +
+		"of a single peer, or a single channel.",
 	ArgsUsage: "base_fee_msat fee_rate time_lock_delta [channel_point]",
 	Description: `
-	Updates the channel policy for all channels, or just a particular channel
-	identified by its channel point. The update will be committed, and
-	broadcast to the rest of the network within the next batch.
+	Updates the channel policy for all channels, all channels with a
+	particular peer, or just a particular channel identified by its channel
+	point. The update will be committed, and broadcast to the rest of the
+	network within the next batch. The optional inbound forwarding limits
+	aren't broadcast, and are only enforced locally. They're persisted, and
+	limits set for all channels also apply to channels opened later on.
 	Channel points are encoded as: funding_txid:output_index`,
 	Flags: []cli.Flag{
 		cli.Int64Flag{
@@ -3187,6 +3464,34 @@ var updateChannelPolicyCommand = cli.Command{
 				"updated, if nil the policies for all channels " +
 				"will be updated. Takes the form of: txid:output_index",
 		},
+		cli.StringFlag{
+			Name: "peer",
+			Usage: "the hex-encoded public key of a peer whose " +
+				"channels should all be updated, can't be " +
+				"combined with chan_point",
+		},
+		cli.Uint64Flag{
+			Name: "max_forward_amt_msat",
+			Usage: "(optional) the largest amount in " +
+				"milli-satoshis that will be forwarded for an " +
+				"incoming HTLC",
+		},
+		cli.Uint64Flag{
+			Name: "max_pending_forwards",
+			Usage: "(optional) the maximum number of HTLCs a " +
+				"peer may have pending forwarding at once",
+		},
+		cli.Uint64Flag{
+			Name: "max_cltv_delta",
+			Usage: "(optional) the maximum number of blocks in " +
+				"the future an incoming HTLC's timelock may be " +
+				"set to",
+		},
+		cli.BoolFlag{
+			Name: "clear_inbound_limits",
+			Usage: "(optional) remove all inbound limits before " +
+				"applying any of the limits above",
+		},
 	},
 	Action: actionDecorator(updateChannelPolicy),
 }
@@ -3278,17 +3583,30 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		}
 	}
 
+	if chanPoint != nil && ctx.IsSet("peer") {
+		return fmt.Errorf("chan_point and peer cannot both be set")
+	}
+
 	req := &lnrpc.PolicyUpdateRequest{
-		BaseFeeMsat:   baseFee,
-		FeeRate:       feeRate,
-		TimeLockDelta: uint32(timeLockDelta),
+		BaseFeeMsat:        baseFee,
+		FeeRate:            feeRate,
+		TimeLockDelta:      uint32(timeLockDelta),
+		MaxForwardAmtMsat:  ctx.Uint64("max_forward_amt_msat"),
+		MaxPendingForwards: uint32(ctx.Uint64("max_pending_forwards")),
+		MaxCltvDelta:       uint32(ctx.Uint64("max_cltv_delta")),
+		ClearInboundLimits: ctx.Bool("clear_inbound_limits"),
 	}
 
-	if chanPoint != nil {
+	switch {
+	case chanPoint != nil:
 		req.Scope = &lnrpc.PolicyUpdateRequest_ChanPoint{
 			ChanPoint: chanPoint,
 		}
-	} else {
+	case ctx.IsSet("peer"):
+		req.Scope = &lnrpc.PolicyUpdateRequest_PeerPubKey{
+			PeerPubKey: ctx.String("peer"),
+		}
+	default:
 		req.Scope = &lnrpc.PolicyUpdateRequest_Global{
 			Global: true,
 		}
@@ -3303,6 +3621,52 @@ func updateChannelPolicy(ctx *cli.Context) error {
 	return nil
 }
 
+var feeDecisionsCommand = cli.Command{
+	Name:      "feedecisions",
+	Category:  "Channels",
+	Usage:     "Display the recent decisions made by the fee manager.",
+	ArgsUsage: "[channel_point]",
+	Description: `
+	Returns the recent decisions made by the fee manager when automatically
+	adjusting the fees of our channels. If a channel point is specified, then
+	only the decisions for that channel are returned. This command requires
+	the fee manager to be active.
+	Channel points are encoded as: funding_txid:output_index`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "(optional) the channel to return the fee " +
+				"decisions for",
+		},
+	},
+	Action: actionDecorator(feeDecisions),
+}
+
+func feeDecisions(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var chanPoint string
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPoint = ctx.String("chan_point")
+	case ctx.Args().Present():
+		chanPoint = ctx.Args().First()
+	}
+
+	req := &lnrpc.FeeDecisionsRequest{
+		ChanPoint: chanPoint,
+	}
+	resp, err := client.FeeDecisions(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var forwardingHistoryCommand = cli.Command{
 	Name:      "fwdinghistory",
 	Category:  "Payments",
@@ -3417,3 +3781,686 @@ func forwardingHistory(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var accountingReportCommand = cli.Command{
+	Name:     "accountingreport",
+	Category: "Payments",
+	Usage: "Create a ledger of all on-chain and off-chain activity " +
+		"of the node.",
+	ArgsUsage: "start_time [end_time]",
+	Description: `
+	Create a ledger of all on-chain and off-chain activity of the node over
+	a particular time range (--start_time and --end_time). This includes
+	on-chain receipts and sends, channel opens and closes along with their
+	fees, sweeps, routing fee income, and payments sent and received. The
+	start and end times are meant to be expressed in seconds since the Unix
+	epoch. If a start and end time aren't provided, then the ledger of the
+	past 24 hours is created.
+
+	Each entry references the wallet transaction or channeldb record it was
+	created from. With --csv, the ledger is printed in CSV format, suitable
+	for import into accounting software.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "the starting time for the ledger, expressed in " +
+				"seconds since the unix epoch",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "the end time for the ledger, expressed in " +
+				"seconds since the unix epoch",
+		},
+		cli.BoolFlag{
+			Name:  "csv",
+			Usage: "print the ledger in CSV format",
+		},
+	},
+	Action: actionDecorator(accountingReport),
+}
+
+func accountingReport(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		startTime, endTime uint64
+		err                error
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("start_time"):
+		startTime = ctx.Uint64("start_time")
+	case args.Present():
+		startTime, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode start_time %v", err)
+		}
+		args = args.Tail()
+	}
+
+	switch {
+	case ctx.IsSet("end_time"):
+		endTime = ctx.Uint64("end_time")
+	case args.Present():
+		endTime, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode end_time: %v", err)
+		}
+		args = args.Tail()
+	}
+
+	req := &lnrpc.AccountingReportRequest{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+	resp, err := client.AccountingReport(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Bool("csv") {
+		printRespJSON(resp)
+		return nil
+	}
+
+	w := csv.NewWriter(os.Stdout)
+	err = w.Write([]string{
+		"timestamp", "type", "amount_msat", "fee_msat", "reference",
+		"channel_point",
+	})
+	if err != nil {
+		return err
+	}
+	for _, entry := range resp.Entries {
+		err := w.Write([]string{
+			strconv.FormatUint(entry.Timestamp, 10),
+			strings.ToLower(entry.Type.String()),
+			strconv.FormatInt(entry.AmountMsat, 10),
+			strconv.FormatUint(entry.FeeMsat, 10),
+			entry.Reference,
+			entry.ChannelPoint,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+
+	return w.Error()
+}
+
+var bakeMacaroonCommand = cli.Command{
+	Name:      "bakemacaroon",
+	Category:  "Macaroons",
+	Usage:     "Bakes a new macaroon with the provided list of permissions.",
+	ArgsUsage: "[--save_to=] [--root_key_id=] permissions...",
+	Description: `
+	Bake a new macaroon that grants the provided permissions and optionally
+	saves it to a file. If no output file is specified, the macaroon is
+	printed as a hex string.
+
+	The permissions must be specified as a space separated list of
+	entity:action pairs, for example: info:read invoices:write
+
+	The macaroon is derived from the root key with the given ID, which
+	defaults to 0. All macaroons sharing a root key ID can be revoked at
+	once by deleting the ID using the deletemacaroonid command.
+
+	For example:
+		lncli bakemacaroon --root_key_id=1 info:read invoices:write`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "save_to",
+			Usage: "save the created macaroon to this file",
+		},
+		cli.Uint64Flag{
+			Name:  "root_key_id",
+			Usage: "the numerical root key ID used to create the macaroon",
+		},
+	},
+	Action: actionDecorator(bakeMacaroon),
+}
+
+func bakeMacaroon(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// At least one permission is required.
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "bakemacaroon")
+	}
+
+	var parsedPermissions []*lnrpc.MacaroonPermission
+	for _, permission := range ctx.Args() {
+		tuple := strings.Split(permission, ":")
+		if len(tuple) != 2 || tuple[0] == "" || tuple[1] == "" {
+			return fmt.Errorf("unable to parse permission tuple: %s",
+				permission)
+		}
+
+		parsedPermissions = append(
+			parsedPermissions, &lnrpc.MacaroonPermission{
+				Entity: tuple[0],
+				Action: tuple[1],
+			},
+		)
+	}
+
+	req := &lnrpc.BakeMacaroonRequest{
+		Permissions: parsedPermissions,
+		RootKeyId:   ctx.Uint64("root_key_id"),
+	}
+	resp, err := client.BakeMacaroon(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	// If the user didn't specify a file to save the macaroon to, we'll
+	// just print it as a hex string.
+	if !ctx.IsSet("save_to") {
+		fmt.Printf("%s\n", resp.Macaroon)
+		return nil
+	}
+
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		return err
+	}
+
+	savePath := cleanAndExpandPath(ctx.String("save_to"))
+	err = ioutil.WriteFile(savePath, macBytes, 0644)
+	if err != nil {
+		_ = os.Remove(savePath)
+		return err
+	}
+	fmt.Printf("Macaroon saved to %s\n", savePath)
+
+	return nil
+}
+
+var listMacaroonIDsCommand = cli.Command{
+	Name:     "listmacaroonids",
+	Category: "Macaroons",
+	Usage:    "List all macaroons root key IDs in use.",
+	Action:   actionDecorator(listMacaroonIDs),
+}
+
+func listMacaroonIDs(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListMacaroonIDsRequest{}
+	resp, err := client.ListMacaroonIDs(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deleteMacaroonIDCommand = cli.Command{
+	Name:      "deletemacaroonid",
+	Category:  "Macaroons",
+	Usage:     "Delete a specific macaroon ID.",
+	ArgsUsage: "root_key_id",
+	Description: `
+	Remove a macaroon ID using the specified root key ID. For example:
+
+		lncli deletemacaroonid 1
+
+	WARNING
+	When the ID is deleted, all macaroons created from that root key will
+	be invalidated.
+
+	Note that the default root key ID 0 cannot be deleted.`,
+	Action: actionDecorator(deleteMacaroonID),
+}
+
+func deleteMacaroonID(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// A root key ID is required.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "deletemacaroonid")
+	}
+
+	rootKeyID, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("root key ID must be a positive integer: %v",
+			err)
+	}
+
+	req := &lnrpc.DeleteMacaroonIDRequest{
+		RootKeyId: rootKeyID,
+	}
+	resp, err := client.DeleteMacaroonID(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var constrainMacaroonCommand = cli.Command{
+	Name:      "constrainmacaroon",
+	Category:  "Macaroons",
+	Usage:     "Adds one or more restrictions to an existing macaroon.",
+	ArgsUsage: "source_macaroon_file destination_macaroon_file",
+	Description: `
+	Add one or more first-party caveats to an existing macaroon, further
+	restricting what it can be used for. The constrained macaroon is written
+	to the destination file. This command doesn't require a connection to
+	lnd.
+
+	The available restrictions are:
+	--method: only allow calling the given RPC methods, identified by their
+	  full gRPC method names. Can be specified multiple times.
+	--max_payment_msat: the maximum amount of a single payment, excluding
+	  fees.
+	--budget_msat: the maximum cumulative amount spent by payments,
+	  including fees. The amount spent is tracked by lnd.
+	--budget_expiry: the duration after which no more payments can be made,
+	  if a budget is set.
+	--account: the ID of the virtual account the macaroon is bound to.
+	  Payments made using the macaroon are debited from the account, and
+	  invoices created using it credit the account once settled. The
+	  macaroon can then only be used for sendpayment, sendtoroute,
+	  addinvoice, listaccounts and decodepayreq.
+
+	The payment restrictions are enforced for sendpayment and sendtoroute
+	(SendPayment, SendPaymentSync, SendToRoute and SendToRouteSync). A
+	macaroon with --max_payment_msat or --budget_msat can only be used for
+	these calls, so funds can't be spent in other ways.
+
+	For example, to give a bot a budget of 100000 satoshis for a week:
+		lncli constrainmacaroon --method=/lnrpc.Lightning/SendPaymentSync \
+			--budget_msat=100000000 --budget_expiry=168h \
+			admin.macaroon bot.macaroon
+
+	To give an app its own account, created using createaccount:
+		lncli constrainmacaroon --account=1 admin.macaroon app.macaroon`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  "timeout",
+			Usage: "the number of seconds the macaroon is valid for",
+		},
+		cli.StringFlag{
+			Name:  "ip_address",
+			Usage: "the IP address the macaroon is bound to",
+		},
+		cli.StringSliceFlag{
+			Name:  "method",
+			Usage: "an RPC method the macaroon is restricted to",
+		},
+		cli.Uint64Flag{
+			Name:  "max_payment_msat",
+			Usage: "the maximum amount of a single payment",
+		},
+		cli.Uint64Flag{
+			Name: "budget_msat",
+			Usage: "the maximum cumulative amount spent by " +
+				"sendpayment and sendtoroute payments",
+		},
+		cli.DurationFlag{
+			Name:  "budget_expiry",
+			Usage: "the duration after which the budget expires",
+		},
+		cli.Uint64Flag{
+			Name:  "account",
+			Usage: "the ID of the account the macaroon is bound to",
+		},
+	},
+	Action: actionDecorator(constrainMacaroon),
+}
+
+func constrainMacaroon(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "constrainmacaroon")
+	}
+
+	srcPath := cleanAndExpandPath(ctx.Args().Get(0))
+	dstPath := cleanAndExpandPath(ctx.Args().Get(1))
+
+	macBytes, err := ioutil.ReadFile(srcPath)
+	if err != nil {
+		return fmt.Errorf("unable to read macaroon file: %v", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return fmt.Errorf("unable to decode macaroon: %v", err)
+	}
+
+	var constraints []macaroons.Constraint
+	if ctx.IsSet("timeout") {
+		constraints = append(constraints, macaroons.TimeoutConstraint(
+			ctx.Int64("timeout"),
+		))
+	}
+	if ctx.IsSet("ip_address") {
+		constraints = append(constraints, macaroons.IPLockConstraint(
+			ctx.String("ip_address"),
+		))
+	}
+	if ctx.IsSet("method") {
+		constraints = append(constraints, macaroons.MethodConstraint(
+			ctx.StringSlice("method")...,
+		))
+	}
+	if ctx.IsSet("max_payment_msat") {
+		constraints = append(constraints, macaroons.MaxPaymentConstraint(
+			ctx.Uint64("max_payment_msat"),
+		))
+	}
+	switch {
+	case ctx.IsSet("budget_msat"):
+		var expiry time.Time
+		if ctx.IsSet("budget_expiry") {
+			expiry = time.Now().Add(ctx.Duration("budget_expiry"))
+		}
+
+		constraints = append(constraints, macaroons.BudgetConstraint(
+			ctx.Uint64("budget_msat"), expiry,
+		))
+
+	case ctx.IsSet("budget_expiry"):
+		return fmt.Errorf("budget_expiry requires budget_msat to be set")
+	}
+	if ctx.IsSet("account") {
+		constraints = append(constraints, macaroons.AccountConstraint(
+			ctx.Uint64("account"),
+		))
+	}
+
+	if len(constraints) == 0 {
+		return fmt.Errorf("no restrictions specified")
+	}
+
+	constrainedMac, err := macaroons.AddConstraints(mac, constraints...)
+	if err != nil {
+		return err
+	}
+	constrainedBytes, err := constrainedMac.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(dstPath, constrainedBytes, 0600)
+	if err != nil {
+		_ = os.Remove(dstPath)
+		return err
+	}
+	fmt.Printf("Constrained macaroon saved to %s\n", dstPath)
+
+	return nil
+}
+
+var createAccountCommand = cli.Command{
+	Name:      "createaccount",
+	Category:  "Accounts",
+	Usage:     "Create a new virtual account.",
+	ArgsUsage: "[label]",
+	Description: `
+	Create a new virtual account with its own balance. A macaroon can be
+	bound to the account using constrainmacaroon --account, after which
+	payments made using it are debited from the account, including fees,
+	while invoices created using it credit the account once settled.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "an optional label describing the account",
+		},
+		cli.Uint64Flag{
+			Name:  "balance_msat",
+			Usage: "the initial balance of the account",
+		},
+	},
+	Action: actionDecorator(createAccount),
+}
+
+func createAccount(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	label := ctx.String("label")
+	if ctx.NArg() > 0 {
+		label = ctx.Args().First()
+	}
+
+	req := &lnrpc.CreateAccountRequest{
+		Label:       label,
+		BalanceMsat: ctx.Uint64("balance_msat"),
+	}
+	resp, err := client.CreateAccount(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listAccountsCommand = cli.Command{
+	Name:     "listaccounts",
+	Category: "Accounts",
+	Usage:    "List all virtual accounts and their balances.",
+	Action:   actionDecorator(listAccounts),
+}
+
+func listAccounts(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListAccounts(ctxb, &lnrpc.ListAccountsRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var fundAccountCommand = cli.Command{
+	Name:      "fundaccount",
+	Category:  "Accounts",
+	Usage:     "Add to the balance of a virtual account.",
+	ArgsUsage: "id amt_msat",
+	Description: `
+	Add the given amount in millisatoshi to the balance of the virtual
+	account with the given ID. For example:
+
+		lncli fundaccount 1 100000000`,
+	Action: actionDecorator(fundAccount),
+}
+
+func fundAccount(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "fundaccount")
+	}
+
+	id, err := strconv.ParseUint(ctx.Args().Get(0), 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to decode account ID: %v", err)
+	}
+	amt, err := strconv.ParseUint(ctx.Args().Get(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to decode amount: %v", err)
+	}
+
+	req := &lnrpc.FundAccountRequest{
+		Id:      id,
+		AmtMsat: amt,
+	}
+	resp, err := client.FundAccount(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var sendCustomCommand = cli.Command{
+	Name:      "sendcustom",
+	Category:  "Peers",
+	Usage:     "Send a custom message to a connected peer.",
+	ArgsUsage: "peer type data",
+	Description: `
+	Send a message of an odd type within the custom range, starting at
+	32768, to a connected peer. The data of the message is hex encoded.
+	For example:
+
+		lncli sendcustom <pubkey> 32769 68656c6c6f`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "peer",
+			Usage: "the hex-encoded public key of the peer",
+		},
+		cli.Uint64Flag{
+			Name:  "type",
+			Usage: "the custom message type",
+		},
+		cli.StringFlag{
+			Name:  "data",
+			Usage: "the hex-encoded data of the message",
+		},
+	},
+	Action: actionDecorator(sendCustom),
+}
+
+func sendCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	var peerStr string
+	switch {
+	case ctx.IsSet("peer"):
+		peerStr = ctx.String("peer")
+	case args.Present():
+		peerStr = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("peer argument missing")
+	}
+	peer, err := hex.DecodeString(peerStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode peer public key: %v", err)
+	}
+
+	var msgType uint64
+	switch {
+	case ctx.IsSet("type"):
+		msgType = ctx.Uint64("type")
+	case args.Present():
+		msgType, err = strconv.ParseUint(args.First(), 10, 16)
+		if err != nil {
+			return fmt.Errorf("unable to decode message type: %v",
+				err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("type argument missing")
+	}
+
+	var dataStr string
+	switch {
+	case ctx.IsSet("data"):
+		dataStr = ctx.String("data")
+	case args.Present():
+		dataStr = args.First()
+	}
+	data, err := hex.DecodeString(dataStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode message data: %v", err)
+	}
+
+	req := &lnrpc.SendCustomMessageRequest{
+		Peer: peer,
+		Type: uint32(msgType),
+		Data: data,
+	}
+	resp, err := client.SendCustomMessage(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var subscribeCustomCommand = cli.Command{
+	Name:     "subscribecustom",
+	Category: "Peers",
+	Usage:    "Subscribe to incoming custom messages.",
+	Description: `
+	Print all messages of a type within the custom range received from our
+	peers until interrupted.
+
+	Peers sending an even custom message type are disconnected, unless a
+	subscriber claimed the type. Even types can be claimed for as long as
+	the subscription is active using --even_type.`,
+	Flags: []cli.Flag{
+		cli.IntSliceFlag{
+			Name: "even_type",
+			Usage: "an even custom message type to accept from " +
+				"peers, can be specified multiple times",
+		},
+	},
+	Action: actionDecorator(subscribeCustom),
+}
+
+func subscribeCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SubscribeCustomMessagesRequest{}
+	for _, evenType := range ctx.IntSlice("even_type") {
+		if evenType < 0 || evenType > math.MaxUint16 {
+			return fmt.Errorf("invalid message type %v", evenType)
+		}
+		req.EvenTypes = append(req.EvenTypes, uint32(evenType))
+	}
+
+	stream, err := client.SubscribeCustomMessages(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printJSON(struct {
+			Peer string `json:"peer"`
+			Type uint32 `json:"type"`
+			Data string `json:"data"`
+		}{
+			Peer: hex.EncodeToString(msg.Peer),
+			Type: msg.Type,
+			Data: hex.EncodeToString(msg.Data),
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 const (
 	defaultDataDir          = "data"
 	defaultChainSubDir      = "chain"
+	defaultGraphSubDir      = "graph"
 	defaultTLSCertFilename  = "tls.cert"
 	defaultMacaroonFilename = "admin.macaroon"
 	defaultRPCPort          = "10009"
@@ -286,6 +287,9 @@ func main() {
 		queryRoutesCommand,
 		getNetworkInfoCommand,
 		debugLevelCommand,
+		getDBStatsCommand,
+		backupDBCommand,
+		restoreDBCommand,
 		decodePayReqCommand,
 		listChainTxnsCommand,
 		stopCommand,
@@ -293,7 +297,18 @@ func main() {
 		verifyMessageCommand,
 		feeReportCommand,
 		updateChannelPolicyCommand,
+		feeDecisionsCommand,
 		forwardingHistoryCommand,
+		accountingReportCommand,
+		bakeMacaroonCommand,
+		listMacaroonIDsCommand,
+		deleteMacaroonIDCommand,
+		constrainMacaroonCommand,
+		createAccountCommand,
+		listAccountsCommand,
+		fundAccountCommand,
+		sendCustomCommand,
+		subscribeCustomCommand,
 	}
 
 	// Add any extra autopilot commands determined by build flags.
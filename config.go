@@ -21,7 +21,10 @@ import (
 
 	"github.com/btcsuite/btcutil"
 	flags "github.com/jessevdk/go-flags"
+	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
@@ -54,6 +57,10 @@ const (
 	defaultMaxLogFiles         = 3
 	defaultMaxLogFileSize      = 10
 	defaultMaxBackoff          = time.Hour
+	defaultChanReapDepth       = 2016
+
+	defaultNumGraphSyncPeers    = discovery.DefaultNumActiveSyncers
+	defaultSyncRotationInterval = discovery.DefaultSyncerRotationInterval
 
 	defaultTorSOCKSPort            = 9050
 	defaultTorDNSHost              = "soa.nodes.lightning.directory"
@@ -64,6 +71,16 @@ const (
 
 	defaultBroadcastDelta = 10
 
+	defaultFeeManagerInterval          = time.Hour
+	defaultFeeManagerMinBaseFee        = lnwire.MilliSatoshi(0)
+	defaultFeeManagerMaxBaseFee        = lnwire.MilliSatoshi(2000)
+	defaultFeeManagerMinFeeRate        = 1
+	defaultFeeManagerMaxFeeRate        = 2500
+	defaultFeeManagerFwdWindow         = 24 * time.Hour
+	defaultFeeManagerMinUpdateInterval = 6 * time.Hour
+	defaultFeeManagerUpdateThreshold   = 0.1
+	defaultFeeManagerMaxUpdates        = 10
+
 	// minTimeLockDelta is the minimum timelock we require for incoming
 	// HTLCs on our channels.
 	minTimeLockDelta = 4
@@ -112,6 +129,9 @@ type chainConfig struct {
 	BaseFee             lnwire.MilliSatoshi `long:"basefee" description:"The base fee in millisatoshi we will charge for forwarding payments on our channels"`
 	FeeRate             lnwire.MilliSatoshi `long:"feerate" description:"The fee rate used when forwarding payments on our channels. The total fee charged is basefee + (amount * feerate / 1000000), where amount is the forwarded amount."`
 	TimeLockDelta       uint32              `long:"timelockdelta" description:"The CLTV delta we will subtract from a forwarded HTLC's timelock value"`
+	MaxForwardAmt       lnwire.MilliSatoshi `long:"maxforwardamt" description:"The largest HTLC, in millisatoshi, we are willing to forward for a peer. If this is not set, no limit is enforced."`
+	MaxPendingForwards  uint32              `long:"maxpendingforwards" description:"The maximum number of HTLCs a peer may have pending forwarding through us at once, across all of its channels. If this is not set, no limit is enforced."`
+	MaxCltvDelta        uint32              `long:"maxcltvdelta" description:"The maximum number of blocks in the future an incoming HTLC's timelock may be set to for us to forward it. If this is not set, only the protocol limit is enforced."`
 }
 
 type neutrinoConfig struct {
@@ -148,6 +168,29 @@ type autoPilotConfig struct {
 	MaxChannelSize int64   `long:"maxchansize" description:"The largest channel that the autopilot agent should create"`
 	Private        bool    `long:"private" description:"Whether the channels created by the autopilot agent should be private or not. Private channels won't be announced to the network."`
 	MinConfs       int32   `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
+
+	Heuristic map[string]float64 `long:"heuristic" description:"Heuristic to activate, and the weight to give it during scoring. Can be specified multiple times, in the form name:weight. The weights must sum to 1.0. (default: preferential:1.0)"`
+
+	Prune          bool                `long:"prune" description:"If the autopilot agent should cooperatively close channels it opened that have been inactive or unprofitable for the prune period."`
+	PruneDryRun    bool                `long:"prunedryrun" description:"Only report the channels that would be pruned, without closing them."`
+	PruneInterval  time.Duration       `long:"pruneinterval" description:"How often our channels should be evaluated for pruning."`
+	PrunePeriod    time.Duration       `long:"pruneperiod" description:"The period over which a channel must have been inactive or unprofitable before it's pruned."`
+	PruneMinUptime float64             `long:"pruneminuptime" description:"The minimum fraction of the prune period the peer of a channel must have been online for the channel to not be pruned."`
+	PruneMinFees   lnwire.MilliSatoshi `long:"pruneminfees" description:"The minimum amount of fees in millisatoshi a channel must have earned within the prune period to not be pruned. If 0, only channels without any forwards are pruned."`
+	PruneMaxCloses uint32              `long:"prunemaxcloses" description:"The maximum number of channels that will be closed within a single prune interval. 0 means no limit."`
+}
+
+type feeManagerConfig struct {
+	Active            bool                `long:"active" description:"If the fee manager should automatically adjust the forwarding fees of our channels or not."`
+	Interval          time.Duration       `long:"interval" description:"How often the fees of our channels should be re-evaluated."`
+	MinBaseFee        lnwire.MilliSatoshi `long:"minbasefee" description:"The lowest base fee in millisatoshi the fee manager will set."`
+	MaxBaseFee        lnwire.MilliSatoshi `long:"maxbasefee" description:"The highest base fee in millisatoshi the fee manager will set."`
+	MinFeeRate        uint32              `long:"minfeerate" description:"The lowest fee rate, expressed in millionths of the forwarded amount, the fee manager will set."`
+	MaxFeeRate        uint32              `long:"maxfeerate" description:"The highest fee rate, expressed in millionths of the forwarded amount, the fee manager will set."`
+	ForwardingWindow  time.Duration       `long:"fwdwindow" description:"How far back into the forwarding log the fee manager should look when determining a channel's recent forwarding volume."`
+	MinUpdateInterval time.Duration       `long:"minupdateinterval" description:"The minimum amount of time between two fee updates of the same channel."`
+	UpdateThreshold   float64             `long:"updatethreshold" description:"The minimum relative change of either the base fee or fee rate required for a new policy to be announced."`
+	MaxUpdates        uint32              `long:"maxupdates" description:"The maximum number of channels whose fees will be updated within a single interval. 0 means no limit."`
 }
 
 type torConfig struct {
@@ -161,6 +204,21 @@ type torConfig struct {
 	PrivateKeyPath  string `long:"privatekeypath" description:"The path to the private key of the onion service being created"`
 }
 
+type dbConfig struct {
+	Backend                      string           `long:"backend" description:"The selected database backend." choice:"bolt" choice:"etcd"`
+	AutoCompact                  bool             `long:"autocompact" description:"Compact the bolt database on startup, reclaiming the space of all deleted data. Compaction requires temporary disk space of up to the size of the database, and may take several minutes for large databases."`
+	ReapDepth                    uint32           `long:"reapdepth" description:"The number of blocks by which the closing transaction of a fully resolved channel must be buried before its leftover forwarding packages and cached preimages are purged. This must exceed the largest CLTV delta of any forwarded HTLC. Set to 0 to disable."`
+	CanceledInvoiceRetentionDays uint32           `long:"canceledinvoiceretentiondays" description:"The number of days, counted from their creation, for which canceled invoices are retained before being deleted. Settled invoices are never deleted. Set to 0 to retain canceled invoices forever."`
+	Etcd                         *kvdb.EtcdConfig `group:"etcd" namespace:"etcd" description:"Etcd database backend configuration, only used if backend=etcd. Requires lnd to be built with the kvdb_etcd build tag."`
+}
+
+type gossipConfig struct {
+	ChannelUpdateInterval time.Duration `long:"chanupdateinterval" description:"The interval in which we'll accept a new channel update for each direction of a channel, once its burst has been used up. Keep-alive updates that don't change the channel's policy are exempt. Set to 0 to disable rate limiting."`
+	MaxChannelUpdateBurst int           `long:"maxchanupdateburst" description:"The maximum number of channel updates we'll accept for each direction of a channel in quick succession."`
+	BanThreshold          uint32        `long:"banthreshold" description:"The ban score at which all gossip from a peer that doesn't concern our own channels will be ignored. Each rate limited channel update signed by the peer that sent it increments its ban score by one. Set to 0 to disable banning."`
+	BanDuration           time.Duration `long:"banduration" description:"The duration for which all gossip from a banned peer will be ignored."`
+}
+
 // config defines the configuration options for lnd.
 //
 // See loadConfig for further details regarding the configuration
@@ -221,8 +279,14 @@ type config struct {
 
 	Autopilot *autoPilotConfig `group:"Autopilot" namespace:"autopilot"`
 
+	FeeManager *feeManagerConfig `group:"feemanager" namespace:"feemanager"`
+
 	Tor *torConfig `group:"Tor" namespace:"tor"`
 
+	DB *dbConfig `group:"db" namespace:"db"`
+
+	Gossip *gossipConfig `group:"gossip" namespace:"gossip"`
+
 	SubRPCServers *subRPCServerConfigs `group:"subrpc"`
 
 	Hodl *hodl.Config `group:"hodl" namespace:"hodl"`
@@ -240,8 +304,13 @@ type config struct {
 
 	NoChanUpdates bool `long:"nochanupdates" description:"If specified, lnd will not request real-time channel updates from connected peers. This option should be used by routing nodes to save bandwidth."`
 
+	NumGraphSyncPeers    int           `long:"numgraphsyncpeers" description:"The number of peers that we should receive new graph updates from. This option can be tuned to save bandwidth for light clients or routing nodes."`
+	SyncRotationInterval time.Duration `long:"syncrotationinterval" description:"The interval in which we'll rotate one of the peers we receive new graph updates from for another, in order to not rely on the same set of peers."`
+
 	RejectPush bool `long:"rejectpush" description:"If true, lnd will not accept channel opening requests with non-zero push amounts. This should prevent accidental pushes to merchant nodes."`
 
+	HoldReplayedHtlcs bool `long:"holdreplayedhtlcs" description:"If true, HTLCs that were held for the HTLC interceptor when lnd shut down are held again after startup until an interceptor connects, for up to the intercept timeout, instead of being failed back."`
+
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -251,10 +320,10 @@ type config struct {
 // line options.
 //
 // The configuration proceeds as follows:
-// 	1) Start with a default config with sane settings
-// 	2) Pre-parse the command line to check for an alternative config file
-// 	3) Load configuration file overwriting defaults with any specified options
-// 	4) Parse CLI options and overwrite/add any specified options
+//  1. Start with a default config with sane settings
+//  2. Pre-parse the command line to check for an alternative config file
+//  3. Load configuration file overwriting defaults with any specified options
+//  4. Parse CLI options and overwrite/add any specified options
 func loadConfig() (*config, error) {
 	defaultCfg := config{
 		LndDir:         defaultLndDir,
@@ -309,17 +378,48 @@ func loadConfig() (*config, error) {
 			Allocation:     0.6,
 			MinChannelSize: int64(minChanFundingSize),
 			MaxChannelSize: int64(maxFundingAmount),
+			Heuristic: map[string]float64{
+				"preferential": 1.0,
+			},
+			PruneInterval:  autopilot.DefaultPruneInterval,
+			PrunePeriod:    autopilot.DefaultPrunePeriod,
+			PruneMinUptime: autopilot.DefaultPruneMinUptime,
+			PruneMaxCloses: autopilot.DefaultMaxClosesPerInterval,
 		},
-		TrickleDelay:        defaultTrickleDelay,
-		InactiveChanTimeout: defaultInactiveChanTimeout,
-		Alias:               defaultAlias,
-		Color:               defaultColor,
-		MinChanSize:         int64(minChanFundingSize),
+		FeeManager: &feeManagerConfig{
+			Interval:          defaultFeeManagerInterval,
+			MinBaseFee:        defaultFeeManagerMinBaseFee,
+			MaxBaseFee:        defaultFeeManagerMaxBaseFee,
+			MinFeeRate:        defaultFeeManagerMinFeeRate,
+			MaxFeeRate:        defaultFeeManagerMaxFeeRate,
+			ForwardingWindow:  defaultFeeManagerFwdWindow,
+			MinUpdateInterval: defaultFeeManagerMinUpdateInterval,
+			UpdateThreshold:   defaultFeeManagerUpdateThreshold,
+			MaxUpdates:        defaultFeeManagerMaxUpdates,
+		},
+		TrickleDelay:         defaultTrickleDelay,
+		InactiveChanTimeout:  defaultInactiveChanTimeout,
+		Alias:                defaultAlias,
+		Color:                defaultColor,
+		MinChanSize:          int64(minChanFundingSize),
+		NumGraphSyncPeers:    defaultNumGraphSyncPeers,
+		SyncRotationInterval: defaultSyncRotationInterval,
 		Tor: &torConfig{
 			SOCKS:   defaultTorSOCKS,
 			DNS:     defaultTorDNS,
 			Control: defaultTorControl,
 		},
+		DB: &dbConfig{
+			Backend:   kvdb.BoltBackendName,
+			ReapDepth: defaultChanReapDepth,
+			Etcd:      &kvdb.EtcdConfig{},
+		},
+		Gossip: &gossipConfig{
+			ChannelUpdateInterval: discovery.DefaultChannelUpdateInterval,
+			MaxChannelUpdateBurst: discovery.DefaultMaxChannelUpdateBurst,
+			BanThreshold:          discovery.DefaultBanThreshold,
+			BanDuration:           discovery.DefaultBanDuration,
+		},
 		net: &tor.ClearNet{},
 	}
 
@@ -451,6 +551,41 @@ func loadConfig() (*config, error) {
 		return nil, err
 	}
 
+	// Ensure that the autopilot heuristics are known, and that their
+	// weights sum to 1.0.
+	_, err := autopilot.NewCombinedHeuristic(
+		&autopilot.HeuristicConstraints{}, cfg.Autopilot.Heuristic,
+	)
+	if err != nil {
+		str := "%s: invalid autopilot.heuristic: %v (available " +
+			"heuristics: %v)"
+		err := fmt.Errorf(str, funcName, err,
+			autopilot.AvailableHeuristics())
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// Ensure that the channel pruning params are sane.
+	if cfg.Autopilot.PruneInterval <= 0 {
+		str := "%s: autopilot.pruneinterval must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.Autopilot.PrunePeriod < cfg.Autopilot.PruneInterval {
+		str := "%s: autopilot.pruneperiod must be at least " +
+			"autopilot.pruneinterval"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.Autopilot.PruneMinUptime < 0 || cfg.Autopilot.PruneMinUptime > 1 {
+		str := "%s: autopilot.pruneminuptime must be in the range [0, 1]"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Ensure that the specified values for the min and max channel size
 	// don't are within the bounds of the normal chan size constraints.
 	if cfg.Autopilot.MinChannelSize < int64(minChanFundingSize) {
@@ -460,6 +595,41 @@ func loadConfig() (*config, error) {
 		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
 	}
 
+	// Ensure the gossip sync parameters are sane. If we've been asked not
+	// to receive any channel updates, then we won't have any active
+	// syncers at all.
+	if cfg.NumGraphSyncPeers < 0 {
+		str := "%s: numgraphsyncpeers must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.SyncRotationInterval <= 0 {
+		str := "%s: syncrotationinterval must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.NoChanUpdates {
+		cfg.NumGraphSyncPeers = 0
+	}
+
+	// Ensure the channel update rate limiting parameters are sane.
+	if cfg.Gossip.ChannelUpdateInterval < 0 {
+		str := "%s: gossip.chanupdateinterval must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.Gossip.ChannelUpdateInterval > 0 &&
+		cfg.Gossip.MaxChannelUpdateBurst < 1 {
+
+		str := "%s: gossip.maxchanupdateburst must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Validate the Tor config parameters.
 	socks, err := lncfg.ParseAddressString(
 		cfg.Tor.SOCKS, strconv.Itoa(defaultTorSOCKSPort),
@@ -783,6 +953,21 @@ func loadConfig() (*config, error) {
 		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
 	}
 
+	// Ensure that the fee manager is configured with sane values. The
+	// fee bounds themselves are validated by the fee manager.
+	if cfg.FeeManager.Interval <= 0 {
+		str := "%s: feemanager.interval must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.FeeManager.UpdateThreshold < 0 {
+		str := "%s: feemanager.updatethreshold must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Validate profile port number.
 	if cfg.Profile != "" {
 		profilePort, err := strconv.Atoi(cfg.Profile)
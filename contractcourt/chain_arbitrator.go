@@ -135,6 +135,15 @@ type ChainArbitratorConfig struct {
 
 	// Sweeper allows resolvers to sweep their final outputs.
 	Sweeper *sweep.UtxoSweeper
+
+	// NotifyClosedChannel is a function closure that the ChainArbitrator
+	// will use to notify the ChannelNotifier about a newly closed channel.
+	NotifyClosedChannel func(wire.OutPoint)
+
+	// NotifyFullyResolvedChannel is a function closure that the
+	// ChainArbitrator will use to notify the ChannelNotifier once all of
+	// the outputs of a closed channel have been resolved.
+	NotifyFullyResolvedChannel func(wire.OutPoint)
 }
 
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
@@ -252,10 +261,16 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			return chanMachine.ForceClose()
 		},
 		MarkCommitmentBroadcasted: channel.MarkCommitmentBroadcasted,
-		MarkChannelClosed:         channel.CloseChannel,
-		IsPendingClose:            false,
-		ChainArbitratorConfig:     c.cfg,
-		ChainEvents:               chanEvents,
+		MarkChannelClosed: func(summary *channeldb.ChannelCloseSummary) error {
+			if err := channel.CloseChannel(summary); err != nil {
+				return err
+			}
+			c.cfg.NotifyClosedChannel(summary.ChanPoint)
+			return nil
+		},
+		IsPendingClose:        false,
+		ChainArbitratorConfig: c.cfg,
+		ChainEvents:           chanEvents,
 	}
 
 	// The final component needed is an arbitrator log that the arbitrator
@@ -265,7 +280,7 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 	// TODO(roasbeef); abstraction leak...
 	//  * rework: adaptor method to set log scope w/ factory func
 	chanLog, err := newBoltArbitratorLog(
-		c.chanSource.DB, arbCfg, c.cfg.ChainHash, chanPoint,
+		c.chanSource.Backend, arbCfg, c.cfg.ChainHash, chanPoint,
 	)
 	if err != nil {
 		blockEpoch.Cancel()
@@ -319,6 +334,8 @@ func (c *ChainArbitrator) resolveContract(chanPoint wire.OutPoint,
 	delete(c.activeWatchers, chanPoint)
 	c.Unlock()
 
+	c.cfg.NotifyFullyResolvedChannel(chanPoint)
+
 	return nil
 }
 
@@ -360,6 +377,7 @@ func (c *ChainArbitrator) Start() error {
 				contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 					return c.cfg.ContractBreach(chanPoint, retInfo)
 				},
+				notifyClosedChannel: c.cfg.NotifyClosedChannel,
 			},
 		)
 		if err != nil {
@@ -414,7 +432,8 @@ func (c *ChainArbitrator) Start() error {
 			CloseType:             closeChanInfo.CloseType,
 		}
 		chanLog, err := newBoltArbitratorLog(
-			c.chanSource.DB, arbCfg, c.cfg.ChainHash, chanPoint,
+			c.chanSource.Backend, arbCfg, c.cfg.ChainHash,
+			chanPoint,
 		)
 		if err != nil {
 			blockEpoch.Cancel()
@@ -680,6 +699,7 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 			contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 				return c.cfg.ContractBreach(chanPoint, retInfo)
 			},
+			notifyClosedChannel: c.cfg.NotifyClosedChannel,
 		},
 	)
 	if err != nil {
@@ -734,5 +754,62 @@ func (c *ChainArbitrator) SubscribeChannelEvents(
 	return watcher.SubscribeChannelEvents(), nil
 }
 
+// UnresolvedHtlcHashes returns the payment hashes of all incoming HTLCs that
+// are yet to be resolved on-chain by any of the active channel arbitrators, as
+// the preimages of these may still be required to claim them. An error is
+// returned if the commitment of a channel has confirmed, but its arbitrator
+// hasn't logged the resolvers of its HTLCs yet, as the set of hashes can't be
+// determined at that point.
+func (c *ChainArbitrator) UnresolvedHtlcHashes() (map[[32]byte]struct{}, error) {
+	c.Lock()
+	arbitrators := make(
+		map[wire.OutPoint]*ChannelArbitrator, len(c.activeChannels),
+	)
+	for chanPoint, arbitrator := range c.activeChannels {
+		arbitrators[chanPoint] = arbitrator
+	}
+	c.Unlock()
+
+	hashes := make(map[[32]byte]struct{})
+	for chanPoint, arbitrator := range arbitrators {
+		state, err := arbitrator.log.CurrentState()
+		if err != nil {
+			return nil, err
+		}
+
+		switch state {
+		// The HTLCs of channels whose commitment hasn't confirmed yet
+		// are still part of the channel state itself.
+		case StateDefault, StateBroadcastCommit,
+			StateCommitmentBroadcasted, StateFullyResolved:
+
+			continue
+
+		case StateContractClosed:
+			return nil, fmt.Errorf("resolvers of ChannelPoint(%v) "+
+				"not yet logged", chanPoint)
+		}
+
+		contracts, err := arbitrator.log.FetchUnresolvedContracts()
+		switch {
+		case err == errScopeBucketNoExist || err == errNoContracts:
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		for _, contract := range contracts {
+			switch r := contract.(type) {
+			case *htlcSuccessResolver:
+				hashes[r.payHash] = struct{}{}
+			case *htlcIncomingContestResolver:
+				hashes[r.payHash] = struct{}{}
+			}
+		}
+	}
+
+	return hashes, nil
+}
+
 // TODO(roasbeef): arbitration reports
 //  * types: contested, waiting for success conf, etc
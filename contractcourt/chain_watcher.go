@@ -104,6 +104,11 @@ type chainWatcherConfig struct {
 	// isOurAddr is a function that returns true if the passed address is
 	// known to us.
 	isOurAddr func(btcutil.Address) bool
+
+	// notifyClosedChannel is a function closure that's used to notify
+	// outside sub-systems once the channel has been marked as pending
+	// closed after a contract breach.
+	notifyClosedChannel func(wire.OutPoint)
 }
 
 // chainWatcher is a system that's assigned to every active channel. The duty
@@ -812,6 +817,7 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 	if err := c.cfg.chanState.CloseChannel(&closeSummary); err != nil {
 		return err
 	}
+	c.cfg.notifyClosedChannel(c.cfg.chanState.FundingOutpoint)
 
 	log.Infof("Breached channel=%v marked pending-closed",
 		c.cfg.chanState.FundingOutpoint)
@@ -57,6 +57,17 @@ type ChannelGraphTimeSeries interface {
 	// channel, then an empty slice will be returned.
 	FetchChanUpdates(chain chainhash.Hash,
 		shortChanID lnwire.ShortChannelID) ([]*lnwire.ChannelUpdate, error)
+
+	// ChanUpdateInfo returns the timestamps and checksums of the latest
+	// channel updates of each of the specified channels, in the same
+	// order. A zero timestamp and checksum is returned for each direction
+	// of a channel we don't know a channel update for. We'll use this to
+	// reply to channel range queries requesting them, and to determine
+	// which of the channels in a remote peer's reply have been updated.
+	ChanUpdateInfo(chain chainhash.Hash,
+		shortChanIDs []lnwire.ShortChannelID) (
+		[]lnwire.ChanUpdateTimestamps, []lnwire.ChanUpdateChecksums,
+		error)
 }
 
 // ChanSeries is an implementation of the ChannelGraphTimeSeries
@@ -364,6 +375,77 @@ func (c *ChanSeries) FetchChanUpdates(chain chainhash.Hash,
 	return chanUpdates, nil
 }
 
+// ChanUpdateInfo returns the timestamps and checksums of the latest channel
+// updates of each of the specified channels, in the same order. A zero
+// timestamp and checksum is returned for each direction of a channel we don't
+// know a channel update for.
+//
+// NOTE: This is part of the ChannelGraphTimeSeries interface.
+func (c *ChanSeries) ChanUpdateInfo(chain chainhash.Hash,
+	shortChanIDs []lnwire.ShortChannelID) ([]lnwire.ChanUpdateTimestamps,
+	[]lnwire.ChanUpdateChecksums, error) {
+
+	timestamps := make([]lnwire.ChanUpdateTimestamps, len(shortChanIDs))
+	checksums := make([]lnwire.ChanUpdateChecksums, len(shortChanIDs))
+	for i, shortChanID := range shortChanIDs {
+		_, e1, e2, err := c.graph.FetchChannelEdgesByID(
+			shortChanID.ToUint64(),
+		)
+		switch {
+		// If we don't know of the channel, or it has been marked as a
+		// zombie, then we don't have any channel updates for it.
+		case err == channeldb.ErrEdgeNotFound ||
+			err == channeldb.ErrZombieEdge ||
+			err == channeldb.ErrGraphNoEdgesFound:
+
+			continue
+
+		case err != nil:
+			return nil, nil, err
+		}
+
+		if e1 != nil {
+			timestamps[i].Timestamp1 = uint32(e1.LastUpdate.Unix())
+			checksums[i].Checksum1, err = policyChecksum(
+				chain, shortChanID, e1,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if e2 != nil {
+			timestamps[i].Timestamp2 = uint32(e2.LastUpdate.Unix())
+			checksums[i].Checksum2, err = policyChecksum(
+				chain, shortChanID, e2,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return timestamps, checksums, nil
+}
+
+// policyChecksum returns the checksum of the channel update corresponding to
+// the given channel edge policy.
+func policyChecksum(chain chainhash.Hash, shortChanID lnwire.ShortChannelID,
+	policy *channeldb.ChannelEdgePolicy) (uint32, error) {
+
+	chanUpdate := &lnwire.ChannelUpdate{
+		ChainHash:       chain,
+		ShortChannelID:  shortChanID,
+		Flags:           policy.Flags,
+		TimeLockDelta:   policy.TimeLockDelta,
+		HtlcMinimumMsat: policy.MinHTLC,
+		BaseFee:         uint32(policy.FeeBaseMSat),
+		FeeRate:         uint32(policy.FeeProportionalMillionths),
+		ExtraOpaqueData: policy.ExtraOpaqueData,
+	}
+
+	return chanUpdate.Checksum()
+}
+
 // A compile-time assertion to ensure that ChanSeries meets the
 // ChannelGraphTimeSeries interface.
 var _ ChannelGraphTimeSeries = (*ChanSeries)(nil)
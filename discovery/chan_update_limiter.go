@@ -0,0 +1,274 @@
+package discovery
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultChannelUpdateInterval is the default interval in which a new
+	// ChannelUpdate token is added to the bucket of each channel
+	// direction.
+	DefaultChannelUpdateInterval = time.Minute
+
+	// DefaultMaxChannelUpdateBurst is the default number of ChannelUpdates
+	// we'll accept for a single channel direction in quick succession
+	// before rate limiting kicks in.
+	DefaultMaxChannelUpdateBurst = 10
+
+	// DefaultBanThreshold is the default ban score at which a peer will
+	// be banned.
+	DefaultBanThreshold = 100
+
+	// DefaultBanDuration is the default duration for which we'll ignore
+	// any gossip from a banned peer.
+	DefaultBanDuration = 24 * time.Hour
+)
+
+// chanUpdateKey uniquely identifies a single direction of a channel.
+type chanUpdateKey struct {
+	shortChanID uint64
+	direction   lnwire.ChanUpdateFlag
+}
+
+// chanUpdateBucket is the token bucket of a single channel direction, along
+// with the time it was last used.
+type chanUpdateBucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// PeerGossipStats houses the rate limiting statistics we keep track of for
+// each peer that sends us gossip.
+type PeerGossipStats struct {
+	// NumRateLimitedUpdates is the total number of ChannelUpdates sent to
+	// us by the peer that were dropped due to rate limiting.
+	NumRateLimitedUpdates uint64
+
+	// BanScore is the current ban score of the peer. Each rate limited
+	// ChannelUpdate the peer signed itself increments the ban score, and
+	// the peer is banned once the ban threshold is reached.
+	BanScore uint32
+
+	// BannedUntil is the time until which any gossip from the peer will
+	// be ignored, apart from gossip concerning our own channels. A zero
+	// time denotes that the peer has never been banned.
+	BannedUntil time.Time
+
+	// lastRateLimited is the time at which an update sent by the peer was
+	// last rate limited.
+	lastRateLimited time.Time
+}
+
+// Banned returns whether the peer is banned at the given time.
+func (s *PeerGossipStats) Banned(now time.Time) bool {
+	return now.Before(s.BannedUntil)
+}
+
+// chanUpdateRateLimiter applies a token bucket to each direction of every
+// channel we receive ChannelUpdates for, and assigns a ban score to the peers
+// that sign the updates exceeding it. As each direction of a channel is owned
+// by a single node, the buckets effectively limit the rate at which each node
+// may update its policies.
+type chanUpdateRateLimiter struct {
+	// interval is the interval in which a new token is added to each
+	// bucket. A zero interval disables rate limiting.
+	interval time.Duration
+
+	// burst is the maximum number of tokens within each bucket.
+	burst int
+
+	// banThreshold is the ban score at which a peer is banned. A zero
+	// threshold disables banning.
+	banThreshold uint32
+
+	// banDuration is the duration for which a peer is banned.
+	banDuration time.Duration
+
+	// now returns the current time, and can be overridden within tests.
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[chanUpdateKey]*chanUpdateBucket
+	peerStats map[routing.Vertex]*PeerGossipStats
+}
+
+// newChanUpdateRateLimiter creates a new chanUpdateRateLimiter from the
+// rate limiting parameters of the given config.
+func newChanUpdateRateLimiter(cfg *Config) *chanUpdateRateLimiter {
+	return &chanUpdateRateLimiter{
+		interval:     cfg.ChannelUpdateInterval,
+		burst:        cfg.MaxChannelUpdateBurst,
+		banThreshold: cfg.BanThreshold,
+		banDuration:  cfg.BanDuration,
+		now:          time.Now,
+		buckets:      make(map[chanUpdateKey]*chanUpdateBucket),
+		peerStats:    make(map[routing.Vertex]*PeerGossipStats),
+	}
+}
+
+// allow determines whether the given ChannelUpdate relayed by the peer, and
+// signed by the owner of the channel direction it updates, should be
+// processed. prevPolicy is the policy we currently know of for the channel
+// direction, if any. Keep-alive updates, which only refresh the timestamp of a
+// policy that hasn't been refreshed within the last interval, aren't subject
+// to rate limiting. If the update is rate limited and the peer is the owner
+// itself, the peer's ban score is incremented, and the peer is banned once it
+// reaches the ban threshold. Peers merely relaying the updates of others are
+// never penalized, as they can't tell whether they're exceeding our limits.
+func (l *chanUpdateRateLimiter) allow(peer, owner routing.Vertex,
+	msg *lnwire.ChannelUpdate,
+	prevPolicy *channeldb.ChannelEdgePolicy) bool {
+
+	if l.interval == 0 {
+		return true
+	}
+
+	now := l.now()
+	if isKeepAlive(msg, prevPolicy) &&
+		now.Sub(prevPolicy.LastUpdate) >= l.interval {
+
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := chanUpdateKey{
+		shortChanID: msg.ShortChannelID.ToUint64(),
+		direction:   msg.Flags & lnwire.ChanUpdateDirection,
+	}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &chanUpdateBucket{
+			limiter: rate.NewLimiter(rate.Every(l.interval), l.burst),
+		}
+		l.buckets[key] = bucket
+	}
+	bucket.lastUsed = now
+
+	if bucket.limiter.AllowN(now, 1) {
+		return true
+	}
+
+	stats := l.statsFor(peer)
+	stats.NumRateLimitedUpdates++
+	stats.lastRateLimited = now
+
+	if peer != owner {
+		return false
+	}
+
+	stats.BanScore++
+
+	if l.banThreshold != 0 && stats.BanScore >= l.banThreshold {
+		log.Warnf("Banning peer=%x for %v after reaching ban score %v",
+			peer[:], l.banDuration, stats.BanScore)
+
+		stats.BannedUntil = now.Add(l.banDuration)
+		stats.BanScore = 0
+	}
+
+	return false
+}
+
+// prune removes the buckets of all channel directions that have fully refilled
+// since they were last used, as these are equivalent to new ones. It also
+// removes the statistics of all peers that are neither banned, nor had an
+// update rate limited within the last ban duration, resetting their ban
+// score.
+func (l *chanUpdateRateLimiter) prune() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	refillTime := l.interval * time.Duration(l.burst)
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.lastUsed) >= refillTime {
+			delete(l.buckets, key)
+		}
+	}
+
+	for peer, stats := range l.peerStats {
+		if stats.Banned(now) {
+			continue
+		}
+		if now.Sub(stats.lastRateLimited) < l.banDuration {
+			continue
+		}
+
+		delete(l.peerStats, peer)
+	}
+}
+
+// isBanned returns whether the peer is currently banned.
+func (l *chanUpdateRateLimiter) isBanned(peer routing.Vertex) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stats, ok := l.peerStats[peer]
+	if !ok {
+		return false
+	}
+
+	return stats.Banned(l.now())
+}
+
+// stats returns a copy of the rate limiting statistics of the peer. The
+// boolean returned signals whether any statistics exist for the peer.
+func (l *chanUpdateRateLimiter) stats(peer routing.Vertex) (PeerGossipStats,
+	bool) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stats, ok := l.peerStats[peer]
+	if !ok {
+		return PeerGossipStats{}, false
+	}
+
+	return *stats, true
+}
+
+// statsFor returns the rate limiting statistics of the peer, creating them if
+// they don't exist yet.
+//
+// NOTE: This method must be called with the mutex held.
+func (l *chanUpdateRateLimiter) statsFor(
+	peer routing.Vertex) *PeerGossipStats {
+
+	stats, ok := l.peerStats[peer]
+	if !ok {
+		stats = &PeerGossipStats{}
+		l.peerStats[peer] = stats
+	}
+
+	return stats
+}
+
+// isKeepAlive returns whether the ChannelUpdate only refreshes the timestamp
+// of the given policy, without changing any of its fields.
+func isKeepAlive(msg *lnwire.ChannelUpdate,
+	policy *channeldb.ChannelEdgePolicy) bool {
+
+	if policy == nil {
+		return false
+	}
+
+	baseFee := lnwire.MilliSatoshi(msg.BaseFee)
+	feeRate := lnwire.MilliSatoshi(msg.FeeRate)
+
+	return msg.Flags == policy.Flags &&
+		msg.TimeLockDelta == policy.TimeLockDelta &&
+		msg.HtlcMinimumMsat == policy.MinHTLC &&
+		baseFee == policy.FeeBaseMSat &&
+		feeRate == policy.FeeProportionalMillionths &&
+		bytes.Equal(msg.ExtraOpaqueData, policy.ExtraOpaqueData)
+}
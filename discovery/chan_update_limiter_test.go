@@ -0,0 +1,247 @@
+package discovery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+)
+
+// newTestChanUpdateLimiter creates a new chanUpdateRateLimiter whose current
+// time is controlled by the returned pointer.
+func newTestChanUpdateLimiter(burst int, banThreshold uint32) (
+	*chanUpdateRateLimiter, *time.Time) {
+
+	now := time.Unix(1000000, 0)
+	limiter := newChanUpdateRateLimiter(&Config{
+		ChannelUpdateInterval: time.Minute,
+		MaxChannelUpdateBurst: burst,
+		BanThreshold:          banThreshold,
+		BanDuration:           time.Hour,
+	})
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	return limiter, &now
+}
+
+// TestChanUpdateRateLimiterBurst ensures that we only accept the configured
+// burst of ChannelUpdates for a channel direction, and that a new update is
+// accepted once the interval has passed.
+func TestChanUpdateRateLimiterBurst(t *testing.T) {
+	t.Parallel()
+
+	const burst = 3
+
+	limiter, now := newTestChanUpdateLimiter(burst, 0)
+
+	var peer routing.Vertex
+	update := &lnwire.ChannelUpdate{
+		ShortChannelID: lnwire.NewShortChanIDFromInt(1),
+	}
+
+	for i := 0; i < burst; i++ {
+		if !limiter.allow(peer, peer, update, nil) {
+			t.Fatalf("expected update #%d to be allowed", i)
+		}
+	}
+	if limiter.allow(peer, peer, update, nil) {
+		t.Fatalf("expected update exceeding burst to be rate limited")
+	}
+
+	// The other direction of the channel has its own bucket, so its
+	// updates should still be allowed.
+	otherDirection := &lnwire.ChannelUpdate{
+		ShortChannelID: update.ShortChannelID,
+		Flags:          lnwire.ChanUpdateDirection,
+	}
+	if !limiter.allow(peer, peer, otherDirection, nil) {
+		t.Fatalf("expected update for other direction to be allowed")
+	}
+
+	// Once the interval has passed, a single new update should be
+	// allowed.
+	*now = now.Add(time.Minute)
+	if !limiter.allow(peer, peer, update, nil) {
+		t.Fatalf("expected update to be allowed after interval")
+	}
+	if limiter.allow(peer, peer, update, nil) {
+		t.Fatalf("expected update to be rate limited")
+	}
+
+	stats, ok := limiter.stats(peer)
+	if !ok {
+		t.Fatalf("expected stats for peer")
+	}
+	if stats.NumRateLimitedUpdates != 2 {
+		t.Fatalf("expected 2 rate limited updates, got %d",
+			stats.NumRateLimitedUpdates)
+	}
+}
+
+// TestChanUpdateRateLimiterKeepAlive ensures that keep-alive updates are
+// exempt from rate limiting, as long as the policy they refresh is older than
+// the interval.
+func TestChanUpdateRateLimiterKeepAlive(t *testing.T) {
+	t.Parallel()
+
+	limiter, now := newTestChanUpdateLimiter(1, 0)
+
+	var peer routing.Vertex
+	update := &lnwire.ChannelUpdate{
+		ShortChannelID: lnwire.NewShortChanIDFromInt(1),
+		TimeLockDelta:  144,
+		BaseFee:        1000,
+		FeeRate:        1,
+	}
+	prevPolicy := &channeldb.ChannelEdgePolicy{
+		LastUpdate:                now.Add(-time.Hour),
+		TimeLockDelta:             144,
+		FeeBaseMSat:               1000,
+		FeeProportionalMillionths: 1,
+	}
+
+	// Use up the single token of the bucket.
+	if !limiter.allow(peer, peer, update, nil) {
+		t.Fatalf("expected update to be allowed")
+	}
+
+	// A keep-alive update should still be allowed.
+	if !limiter.allow(peer, peer, update, prevPolicy) {
+		t.Fatalf("expected keep-alive update to be allowed")
+	}
+
+	// A keep-alive update for a policy that was refreshed recently isn't
+	// exempt.
+	prevPolicy.LastUpdate = *now
+	if limiter.allow(peer, peer, update, prevPolicy) {
+		t.Fatalf("expected recent keep-alive update to be rate limited")
+	}
+
+	// Neither is an update that changes the policy.
+	prevPolicy.LastUpdate = now.Add(-time.Hour)
+	prevPolicy.FeeBaseMSat = 2000
+	if limiter.allow(peer, peer, update, prevPolicy) {
+		t.Fatalf("expected policy change to be rate limited")
+	}
+}
+
+// TestChanUpdateRateLimiterBan ensures that a peer is banned once its ban
+// score reaches the ban threshold, and that the ban expires after the ban
+// duration.
+func TestChanUpdateRateLimiterBan(t *testing.T) {
+	t.Parallel()
+
+	const banThreshold = 5
+
+	limiter, now := newTestChanUpdateLimiter(1, banThreshold)
+
+	var spammer, honestPeer routing.Vertex
+	honestPeer[0] = 1
+
+	update := &lnwire.ChannelUpdate{
+		ShortChannelID: lnwire.NewShortChanIDFromInt(1),
+	}
+	if !limiter.allow(honestPeer, spammer, update, nil) {
+		t.Fatalf("expected update to be allowed")
+	}
+
+	// The honest peer relaying the spammer's updates should have them
+	// dropped, but must not be penalized for them.
+	for i := 0; i < banThreshold; i++ {
+		if limiter.allow(honestPeer, spammer, update, nil) {
+			t.Fatalf("expected relayed update to be rate limited")
+		}
+	}
+	if limiter.isBanned(honestPeer) {
+		t.Fatalf("expected relaying peer not to be banned")
+	}
+
+	for i := 0; i < banThreshold-1; i++ {
+		limiter.allow(spammer, spammer, update, nil)
+	}
+	if limiter.isBanned(spammer) {
+		t.Fatalf("expected peer not to be banned yet")
+	}
+
+	limiter.allow(spammer, spammer, update, nil)
+	if !limiter.isBanned(spammer) {
+		t.Fatalf("expected peer to be banned")
+	}
+	if limiter.isBanned(honestPeer) {
+		t.Fatalf("expected honest peer not to be banned")
+	}
+
+	stats, _ := limiter.stats(spammer)
+	if stats.NumRateLimitedUpdates != banThreshold {
+		t.Fatalf("expected %d rate limited updates, got %d",
+			banThreshold, stats.NumRateLimitedUpdates)
+	}
+	if stats.BanScore != 0 {
+		t.Fatalf("expected ban score to be reset, got %d",
+			stats.BanScore)
+	}
+
+	// Once the ban duration has passed, the peer should no longer be
+	// banned.
+	*now = now.Add(time.Hour)
+	if limiter.isBanned(spammer) {
+		t.Fatalf("expected ban to expire")
+	}
+}
+
+// TestChanUpdateRateLimiterPrune ensures that the buckets of channel
+// directions are only pruned once they've fully refilled, and that the
+// statistics of peers are only pruned once they're no longer banned and
+// haven't had an update rate limited within the ban duration.
+func TestChanUpdateRateLimiterPrune(t *testing.T) {
+	t.Parallel()
+
+	const burst = 2
+
+	limiter, now := newTestChanUpdateLimiter(burst, 1)
+
+	var spammer routing.Vertex
+	update := &lnwire.ChannelUpdate{
+		ShortChannelID: lnwire.NewShortChanIDFromInt(1),
+	}
+
+	// Use up the bucket, and get the spammer banned.
+	for i := 0; i < burst+1; i++ {
+		limiter.allow(spammer, spammer, update, nil)
+	}
+	if !limiter.isBanned(spammer) {
+		t.Fatalf("expected peer to be banned")
+	}
+
+	// The bucket hasn't refilled yet, and the peer is still banned, so
+	// neither should be pruned.
+	*now = now.Add(time.Minute)
+	limiter.prune()
+	if len(limiter.buckets) != 1 {
+		t.Fatalf("expected bucket to be retained")
+	}
+	if _, ok := limiter.stats(spammer); !ok {
+		t.Fatalf("expected stats of banned peer to be retained")
+	}
+
+	// Once the bucket has fully refilled, it should be pruned, while the
+	// peer's statistics are retained until its ban expires.
+	*now = now.Add(time.Minute)
+	limiter.prune()
+	if len(limiter.buckets) != 0 {
+		t.Fatalf("expected refilled bucket to be pruned")
+	}
+	if _, ok := limiter.stats(spammer); !ok {
+		t.Fatalf("expected stats of banned peer to be retained")
+	}
+
+	*now = now.Add(time.Hour)
+	limiter.prune()
+	if _, ok := limiter.stats(spammer); ok {
+		t.Fatalf("expected stats of peer to be pruned")
+	}
+}
@@ -13,15 +13,16 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/multimutex"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
 )
 
 var (
@@ -36,6 +37,10 @@ var (
 	// is in the process of being shut down.
 	ErrGossiperShuttingDown = errors.New("gossiper is shutting down")
 
+	// ErrPeerBanned is an error that is returned if we receive gossip
+	// from a peer that is currently banned.
+	ErrPeerBanned = errors.New("peer is banned")
+
 	// ErrGossipSyncerNotFound signals that we were unable to find an active
 	// gossip syncer corresponding to a gossip query message received from
 	// the remote peer.
@@ -145,6 +150,34 @@ type Config struct {
 	// TODO(roasbeef): extract ann crafting + sign from fundingMgr into
 	// here?
 	AnnSigner lnwallet.MessageSigner
+
+	// NumActiveSyncers is the number of peers for which we should have
+	// active syncers with. After reaching NumActiveSyncers, any future
+	// gossip syncers will be passive.
+	NumActiveSyncers int
+
+	// RotateTicker is a ticker responsible for notifying the SyncManager
+	// when it should rotate its active syncers. A single active syncer
+	// with a chansSynced state will be exchanged for a passive one.
+	RotateTicker ticker.Ticker
+
+	// ChannelUpdateInterval is the interval in which we'll allow a new
+	// ChannelUpdate for each direction of a channel, once its burst has
+	// been used up. A zero interval disables rate limiting.
+	ChannelUpdateInterval time.Duration
+
+	// MaxChannelUpdateBurst is the maximum number of ChannelUpdates we'll
+	// accept for each direction of a channel in quick succession.
+	MaxChannelUpdateBurst int
+
+	// BanThreshold is the ban score at which we'll ban a peer. Each
+	// ChannelUpdate a peer sends us that exceeds our rate limit increments
+	// its ban score. A zero threshold disables banning.
+	BanThreshold uint32
+
+	// BanDuration is the duration for which we'll ignore all gossip from
+	// a banned peer.
+	BanDuration time.Duration
 }
 
 // AuthenticatedGossiper is a subsystem which is responsible for receiving
@@ -221,13 +254,17 @@ type AuthenticatedGossiper struct {
 	rejectMtx     sync.RWMutex
 	recentRejects map[uint64]struct{}
 
-	// peerSyncers keeps track of all the gossip syncers we're maintain for
-	// peers that understand this mode of operation. When we go to send out
-	// new updates, for all peers in the map, we'll send the messages
-	// directly to their gossiper, rather than broadcasting them. With this
-	// change, we ensure we filter out all updates properly.
-	syncerMtx   sync.RWMutex
-	peerSyncers map[routing.Vertex]*gossipSyncer
+	// syncMgr is a subsystem responsible for managing the gossip syncers
+	// for peers currently connected. When we go to send out new updates,
+	// for all peers with a gossip syncer, we'll send the messages directly
+	// to their gossiper, rather than broadcasting them. With this change,
+	// we ensure we filter out all updates properly.
+	syncMgr *SyncManager
+
+	// chanUpdateLimiter rate limits the ChannelUpdates we receive for each
+	// channel, and keeps track of the ban scores of the peers relaying
+	// them.
+	chanUpdateLimiter *chanUpdateRateLimiter
 
 	sync.Mutex
 }
@@ -251,7 +288,13 @@ func New(cfg Config, selfKey *btcec.PublicKey) (*AuthenticatedGossiper, error) {
 		waitingProofs:           storage,
 		channelMtx:              multimutex.NewMutex(),
 		recentRejects:           make(map[uint64]struct{}),
-		peerSyncers:             make(map[routing.Vertex]*gossipSyncer),
+		syncMgr: newSyncManager(&SyncManagerCfg{
+			ChainHash:        cfg.ChainHash,
+			ChanSeries:       cfg.ChanSeries,
+			NumActiveSyncers: cfg.NumActiveSyncers,
+			RotateTicker:     cfg.RotateTicker,
+		}),
+		chanUpdateLimiter: newChanUpdateRateLimiter(&cfg),
 	}, nil
 }
 
@@ -419,6 +462,10 @@ func (d *AuthenticatedGossiper) Start() error {
 		return err
 	}
 
+	// Start the SyncManager, which will take care of rotating the set of
+	// active gossip syncers.
+	d.syncMgr.Start()
+
 	d.wg.Add(1)
 	go d.networkHandler()
 
@@ -435,11 +482,7 @@ func (d *AuthenticatedGossiper) Stop() {
 
 	d.blockEpochs.Cancel()
 
-	d.syncerMtx.RLock()
-	for _, syncer := range d.peerSyncers {
-		syncer.Stop()
-	}
-	d.syncerMtx.RUnlock()
+	d.syncMgr.Stop()
 
 	close(d.quit)
 	d.wg.Wait()
@@ -511,6 +554,23 @@ func (d *AuthenticatedGossiper) ProcessRemoteAnnouncement(msg lnwire.Message,
 		return errChan
 	}
 
+	// We'll ignore all other gossip from peers that are currently banned,
+	// apart from AnnounceSignatures, which are only exchanged for our own
+	// channels, and ChannelUpdates, which are only ignored once we know
+	// they aren't for one of our own channels.
+	switch msg.(type) {
+	case *lnwire.AnnounceSignatures, *lnwire.ChannelUpdate:
+
+	default:
+		if d.chanUpdateLimiter.isBanned(routing.Vertex(peer.PubKey())) {
+			log.Debugf("Ignoring %v from banned peer=%x",
+				msg.MsgType(), peer.PubKey())
+
+			errChan <- ErrPeerBanned
+			return errChan
+		}
+	}
+
 	nMsg := &networkMsg{
 		msg:      msg,
 		isRemote: true,
@@ -826,7 +886,7 @@ func (d *AuthenticatedGossiper) resendAnnounceSignatures() error {
 	// TODO(halseth): database access should be abstracted
 	// behind interface.
 	var msgsResend []msgTuple
-	if err := d.cfg.DB.View(func(tx *bbolt.Tx) error {
+	if err := d.cfg.DB.View(func(tx kvdb.Tx) error {
 		bucket := tx.Bucket(messageStoreKey)
 		if bucket == nil {
 			return nil
@@ -872,7 +932,7 @@ func (d *AuthenticatedGossiper) resendAnnounceSignatures() error {
 	deleteMsg := func(t msgTuple) error {
 		log.Debugf("Deleting message for chanID=%v from "+
 			"messageStore", t.msg.ChannelID)
-		if err := d.cfg.DB.Update(func(tx *bbolt.Tx) error {
+		if err := d.cfg.DB.Update(func(tx kvdb.Tx) error {
 			bucket := tx.Bucket(messageStoreKey)
 			if bucket == nil {
 				return fmt.Errorf("bucket " +
@@ -950,9 +1010,7 @@ func (d *AuthenticatedGossiper) findGossipSyncer(pub *btcec.PublicKey) (
 	target := routing.NewVertex(pub)
 
 	// First, we'll try to find an existing gossiper for this peer.
-	d.syncerMtx.RLock()
-	syncer, ok := d.peerSyncers[target]
-	d.syncerMtx.RUnlock()
+	syncer, ok := d.syncMgr.GossipSyncer(target)
 
 	// If one exists, then we'll return it directly.
 	if ok {
@@ -1156,12 +1214,7 @@ func (d *AuthenticatedGossiper) networkHandler() {
 			// For the set of peers that have an active gossip
 			// syncers, we'll collect their pubkeys so we can avoid
 			// sending them the full message blast below.
-			d.syncerMtx.RLock()
-			syncerPeers := make(map[routing.Vertex]*gossipSyncer)
-			for peerPub, syncer := range d.peerSyncers {
-				syncerPeers[peerPub] = syncer
-			}
-			d.syncerMtx.RUnlock()
+			syncerPeers := d.syncMgr.GossipSyncers()
 
 			log.Infof("Broadcasting batch of %v new announcements",
 				len(announcementBatch))
@@ -1203,6 +1256,10 @@ func (d *AuthenticatedGossiper) networkHandler() {
 					"channels: %v", err)
 			}
 
+			// We'll also use this opportunity to prune the rate
+			// limiting state that is no longer needed.
+			d.chanUpdateLimiter.prune()
+
 		// The gossiper has been signalled to exit, to we exit our
 		// main loop so the wait group can be decremented.
 		case <-d.quit:
@@ -1216,62 +1273,42 @@ func (d *AuthenticatedGossiper) networkHandler() {
 // InitSyncState is called by outside sub-systems when a connection is
 // established to a new peer that understands how to perform channel range
 // queries. We'll allocate a new gossip syncer for it, and start any goroutines
-// needed to handle new queries. The recvUpdates bool indicates if we should
-// continue to receive real-time updates from the remote peer once we've synced
-// channel state.
+// needed to handle new queries. Whether we'll receive real-time updates from
+// the remote peer is decided by the SyncManager. The extendedQueries flag
+// should be set if the remote peer understands the extended gossip queries.
 func (d *AuthenticatedGossiper) InitSyncState(syncPeer lnpeer.Peer,
-	recvUpdates bool) {
-
-	d.syncerMtx.Lock()
-	defer d.syncerMtx.Unlock()
-
-	// If we already have a syncer, then we'll exit early as we don't want
-	// to override it.
-	nodeID := routing.Vertex(syncPeer.PubKey())
-	if _, ok := d.peerSyncers[nodeID]; ok {
-		return
-	}
-
-	log.Infof("Creating new gossipSyncer for peer=%x", nodeID[:])
-
-	encoding := lnwire.EncodingSortedPlain
-	syncer := newGossiperSyncer(gossipSyncerCfg{
-		chainHash:       d.cfg.ChainHash,
-		syncChanUpdates: recvUpdates,
-		channelSeries:   d.cfg.ChanSeries,
-		encodingType:    encoding,
-		chunkSize:       encodingTypeToChunkSize[encoding],
-		sendToPeer: func(msgs ...lnwire.Message) error {
-			return syncPeer.SendMessage(false, msgs...)
-		},
-	})
-	copy(syncer.peerPub[:], nodeID[:])
-	d.peerSyncers[nodeID] = syncer
+	extendedQueries bool) {
 
-	syncer.Start()
+	d.syncMgr.InitSyncState(syncPeer, extendedQueries)
 }
 
 // PruneSyncState is called by outside sub-systems once a peer that we were
 // previously connected to has been disconnected. In this case we can stop the
 // existing gossipSyncer assigned to the peer and free up resources.
 func (d *AuthenticatedGossiper) PruneSyncState(peer *btcec.PublicKey) {
-	d.syncerMtx.Lock()
-	defer d.syncerMtx.Unlock()
+	d.syncMgr.PruneSyncState(routing.NewVertex(peer))
+}
 
-	log.Infof("Removing gossipSyncer for peer=%x",
-		peer.SerializeCompressed())
+// isOurChannel returns whether we are one of the nodes of the given channel.
+func (d *AuthenticatedGossiper) isOurChannel(
+	chanInfo *channeldb.ChannelEdgeInfo) bool {
 
-	vertex := routing.NewVertex(peer)
-	syncer, ok := d.peerSyncers[vertex]
-	if !ok {
-		return
-	}
+	selfKey := d.selfKey.SerializeCompressed()
+	return bytes.Equal(chanInfo.NodeKey1Bytes[:], selfKey) ||
+		bytes.Equal(chanInfo.NodeKey2Bytes[:], selfKey)
+}
 
-	syncer.Stop()
+// PeerGossipStats returns the rate limiting statistics of the given peer. The
+// boolean returned signals whether any statistics exist for the peer.
+func (d *AuthenticatedGossiper) PeerGossipStats(
+	peer routing.Vertex) (PeerGossipStats, bool) {
 
-	delete(d.peerSyncers, vertex)
+	return d.chanUpdateLimiter.stats(peer)
+}
 
-	return
+// SyncManager returns the gossiper's SyncManager instance.
+func (d *AuthenticatedGossiper) SyncManager() *SyncManager {
+	return d.syncMgr
 }
 
 // isRecentlyRejectedMsg returns true if we recently rejected a message, and
@@ -1928,9 +1965,52 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// point and when we call UpdateEdge() later.
 		d.channelMtx.Lock(msg.ShortChannelID.ToUint64())
 		defer d.channelMtx.Unlock(msg.ShortChannelID.ToUint64())
-		chanInfo, _, _, err := d.cfg.Router.GetChannelByID(msg.ShortChannelID)
+		chanInfo, e1, e2, err := d.cfg.Router.GetChannelByID(msg.ShortChannelID)
 		if err != nil {
 			switch err {
+			case channeldb.ErrZombieEdge:
+				// The channel was pruned from our graph as a
+				// zombie. As the update was deemed fresh
+				// above, it'll revive the channel as long as
+				// it was signed by one of the channel's nodes.
+				var pubKey *btcec.PublicKey
+				switch {
+				case msg.Flags&lnwire.ChanUpdateDirection == 0:
+					pubKey, _ = chanInfo.NodeKey1()
+				case msg.Flags&lnwire.ChanUpdateDirection == 1:
+					pubKey, _ = chanInfo.NodeKey2()
+				}
+
+				err := routing.ValidateChannelUpdateAnn(pubKey, msg)
+				if err != nil {
+					err := fmt.Errorf("unable to validate "+
+						"channel update for zombie "+
+						"short_chan_id=%v: %v",
+						shortChanID, err)
+					log.Error(err)
+					nMsg.err <- err
+					return nil
+				}
+
+				err = d.cfg.Router.MarkEdgeLive(msg.ShortChannelID)
+				if err != nil {
+					err := fmt.Errorf("unable to remove "+
+						"short_chan_id=%v from zombie "+
+						"index: %v", shortChanID, err)
+					log.Error(err)
+					nMsg.err <- err
+					return nil
+				}
+
+				log.Debugf("Removed edge with short_chan_id=%v "+
+					"from zombie index", shortChanID)
+
+				// We'll fallthrough to stash the update until
+				// we receive the channel's announcement once
+				// again, as the edge needs to exist within
+				// the graph before the update can be applied.
+				fallthrough
+
 			case channeldb.ErrGraphNotFound:
 				fallthrough
 			case channeldb.ErrGraphNoEdgesFound:
@@ -1984,12 +2064,17 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// The least-significant bit in the flag on the channel update
 		// announcement tells us "which" side of the channels directed
 		// edge is being updated.
-		var pubKey *btcec.PublicKey
+		var (
+			pubKey     *btcec.PublicKey
+			prevPolicy *channeldb.ChannelEdgePolicy
+		)
 		switch {
 		case msg.Flags&lnwire.ChanUpdateDirection == 0:
 			pubKey, _ = chanInfo.NodeKey1()
+			prevPolicy = e1
 		case msg.Flags&lnwire.ChanUpdateDirection == 1:
 			pubKey, _ = chanInfo.NodeKey2()
+			prevPolicy = e2
 		}
 
 		// Validate the channel announcement with the expected public
@@ -2005,6 +2090,35 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil
 		}
 
+		// Now that we know the update is valid, we'll make sure the
+		// channel isn't being updated too frequently, unless it's one
+		// of our own channels. If it is, we'll drop the update, and
+		// penalize the peer that relayed it if it also signed it.
+		if nMsg.isRemote && !d.isOurChannel(chanInfo) {
+			peer := routing.NewVertex(nMsg.source)
+			if d.chanUpdateLimiter.isBanned(peer) {
+				log.Debugf("Ignoring ChannelUpdate for "+
+					"short_chan_id=%v from banned peer=%x",
+					shortChanID, peer[:])
+
+				nMsg.err <- ErrPeerBanned
+				return nil
+			}
+
+			owner := routing.NewVertex(pubKey)
+			allowed := d.chanUpdateLimiter.allow(
+				peer, owner, msg, prevPolicy,
+			)
+			if !allowed {
+				log.Debugf("Rate limiting ChannelUpdate for "+
+					"short_chan_id=%v from peer=%x",
+					shortChanID, peer[:])
+
+				nMsg.err <- nil
+				return nil
+			}
+		}
+
 		update := &channeldb.ChannelEdgePolicy{
 			SigBytes:                  msg.Signature.ToSignatureBytes(),
 			ChannelID:                 shortChanID,
@@ -2427,7 +2541,7 @@ func (d *AuthenticatedGossiper) sendAnnSigReliably(
 	copy(key[:33], remotePeer.SerializeCompressed())
 	binary.BigEndian.PutUint64(key[33:], msg.ShortChannelID.ToUint64())
 
-	err := d.cfg.DB.Update(func(tx *bbolt.Tx) error {
+	err := d.cfg.DB.Update(func(tx kvdb.Tx) error {
 		bucket, err := tx.CreateBucketIfNotExists(messageStoreKey)
 		if err != nil {
 			return err
@@ -24,6 +24,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
 )
 
 var (
@@ -109,6 +110,9 @@ type mockGraphSource struct {
 	infos      map[uint64]*channeldb.ChannelEdgeInfo
 	edges      map[uint64][]*channeldb.ChannelEdgePolicy
 	bestHeight uint32
+
+	zombieMtx sync.Mutex
+	zombies   map[uint64][][33]byte
 }
 
 func newMockRouter(height uint32) *mockGraphSource {
@@ -116,6 +120,7 @@ func newMockRouter(height uint32) *mockGraphSource {
 		bestHeight: height,
 		infos:      make(map[uint64]*channeldb.ChannelEdgeInfo),
 		edges:      make(map[uint64][]*channeldb.ChannelEdgePolicy),
+		zombies:    make(map[uint64][][33]byte),
 	}
 }
 
@@ -181,7 +186,17 @@ func (r *mockGraphSource) GetChannelByID(chanID lnwire.ShortChannelID) (
 
 	chanInfo, ok := r.infos[chanID.ToUint64()]
 	if !ok {
-		return nil, nil, nil, channeldb.ErrEdgeNotFound
+		r.zombieMtx.Lock()
+		pubKeys, isZombie := r.zombies[chanID.ToUint64()]
+		r.zombieMtx.Unlock()
+		if !isZombie {
+			return nil, nil, nil, channeldb.ErrEdgeNotFound
+		}
+
+		return &channeldb.ChannelEdgeInfo{
+			NodeKey1Bytes: pubKeys[0],
+			NodeKey2Bytes: pubKeys[1],
+		}, nil, nil, channeldb.ErrZombieEdge
 	}
 
 	edges := r.edges[chanID.ToUint64()]
@@ -249,10 +264,10 @@ func (r *mockGraphSource) IsPublicNode(node routing.Vertex) (bool, error) {
 }
 
 // IsKnownEdge returns true if the graph source already knows of the passed
-// channel ID.
+// channel ID either as a live or zombie edge.
 func (r *mockGraphSource) IsKnownEdge(chanID lnwire.ShortChannelID) bool {
 	_, ok := r.infos[chanID.ToUint64()]
-	return ok
+	return ok || r.isZombieEdge(chanID)
 }
 
 // IsStaleEdgePolicy returns true if the graph source has a channel edge for
@@ -278,6 +293,34 @@ func (r *mockGraphSource) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 	}
 }
 
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live.
+func (r *mockGraphSource) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
+	r.zombieMtx.Lock()
+	defer r.zombieMtx.Unlock()
+
+	delete(r.zombies, chanID.ToUint64())
+	return nil
+}
+
+// markEdgeZombie marks an edge as a zombie within the mock's zombie index.
+func (r *mockGraphSource) markEdgeZombie(chanID lnwire.ShortChannelID,
+	pubKey1, pubKey2 [33]byte) {
+
+	r.zombieMtx.Lock()
+	defer r.zombieMtx.Unlock()
+
+	r.zombies[chanID.ToUint64()] = [][33]byte{pubKey1, pubKey2}
+}
+
+// isZombieEdge returns true if the edge is within the mock's zombie index.
+func (r *mockGraphSource) isZombieEdge(chanID lnwire.ShortChannelID) bool {
+	r.zombieMtx.Lock()
+	defer r.zombieMtx.Unlock()
+
+	_, ok := r.zombies[chanID.ToUint64()]
+	return ok
+}
+
 type mockNotifier struct {
 	clientCounter uint32
 	epochClients  map[uint32]chan *chainntnfs.BlockEpoch
@@ -596,6 +639,10 @@ func createTestCtx(startHeight uint32) (*testCtx, func(), error) {
 		RetransmitDelay:  retransmitDelay,
 		ProofMatureDelta: proofMatureDelta,
 		DB:               db,
+		RotateTicker: ticker.MockNew(
+			DefaultSyncerRotationInterval,
+		),
+		NumActiveSyncers: DefaultNumActiveSyncers,
 	}, nodeKeyPub1)
 	if err != nil {
 		cleanUpDb()
@@ -1632,6 +1679,10 @@ func TestSignatureAnnouncementRetryAtStartup(t *testing.T) {
 		RetransmitDelay:  retransmitDelay,
 		ProofMatureDelta: proofMatureDelta,
 		DB:               ctx.gossiper.cfg.DB,
+		RotateTicker: ticker.MockNew(
+			DefaultSyncerRotationInterval,
+		),
+		NumActiveSyncers: DefaultNumActiveSyncers,
 	}, ctx.gossiper.selfKey)
 	if err != nil {
 		t.Fatalf("unable to recreate gossiper: %v", err)
@@ -2790,3 +2841,101 @@ func (p *mockPeer) Address() net.Addr { return nil }
 func (p *mockPeer) QuitSignal() <-chan struct{} {
 	return p.quit
 }
+
+// TestProcessZombieEdge ensures that we don't re-learn channels that were
+// pruned as zombies from the announcements of our peers, and that they can
+// only be revived by an update signed by one of the channel's nodes.
+func TestProcessZombieEdge(t *testing.T) {
+	t.Parallel()
+
+	ctx, cleanup, err := createTestCtx(0)
+	if err != nil {
+		t.Fatalf("can't create context: %v", err)
+	}
+	defer cleanup()
+
+	remotePeer := &mockPeer{nodeKeyPriv1.PubKey(), nil, nil}
+
+	chanAnn, err := createRemoteChannelAnnouncement(0)
+	if err != nil {
+		t.Fatalf("unable to create chan ann: %v", err)
+	}
+	ctx.router.markEdgeZombie(
+		chanAnn.ShortChannelID, chanAnn.NodeID1, chanAnn.NodeID2,
+	)
+
+	processAnnouncement := func(msg lnwire.Message) error {
+		t.Helper()
+
+		select {
+		case err := <-ctx.gossiper.ProcessRemoteAnnouncement(
+			msg, remotePeer,
+		):
+			return err
+		case <-time.After(2 * time.Second):
+			t.Fatal("did not process remote announcement")
+		}
+
+		return nil
+	}
+
+	// The announcement of the zombie channel should be ignored.
+	if err := processAnnouncement(chanAnn); err != nil {
+		t.Fatalf("unable to process announcement: %v", err)
+	}
+	if _, ok := ctx.router.infos[chanAnn.ShortChannelID.ToUint64()]; ok {
+		t.Fatal("zombie channel was added to the graph")
+	}
+
+	// An update for the first node's direction that was signed by the
+	// second node shouldn't revive the channel.
+	timestamp := uint32(time.Now().Unix())
+	badUpdate, err := createUpdateAnnouncement(
+		0, 0, nodeKeyPriv2, timestamp,
+	)
+	if err != nil {
+		t.Fatalf("unable to create chan update: %v", err)
+	}
+	if err := processAnnouncement(badUpdate); err == nil {
+		t.Fatal("expected update with invalid signature to fail")
+	}
+	if !ctx.router.isZombieEdge(chanAnn.ShortChannelID) {
+		t.Fatal("channel was revived by invalid update")
+	}
+
+	// A valid update should revive the channel. The update will be held
+	// until we receive the channel's announcement once again.
+	update, err := createUpdateAnnouncement(0, 0, nodeKeyPriv1, timestamp)
+	if err != nil {
+		t.Fatalf("unable to create chan update: %v", err)
+	}
+	updateErr := ctx.gossiper.ProcessRemoteAnnouncement(update, remotePeer)
+
+	timeout := time.After(2 * time.Second)
+	for ctx.router.isZombieEdge(chanAnn.ShortChannelID) {
+		select {
+		case <-timeout:
+			t.Fatal("channel wasn't revived by valid update")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	if err := processAnnouncement(chanAnn); err != nil {
+		t.Fatalf("unable to process announcement: %v", err)
+	}
+	select {
+	case err := <-updateErr:
+		if err != nil {
+			t.Fatalf("unable to process update: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("update was not reprocessed")
+	}
+
+	if _, ok := ctx.router.infos[chanAnn.ShortChannelID.ToUint64()]; !ok {
+		t.Fatal("revived channel wasn't added to the graph")
+	}
+	if len(ctx.router.edges[chanAnn.ShortChannelID.ToUint64()]) != 1 {
+		t.Fatal("update of revived channel wasn't applied")
+	}
+}
@@ -0,0 +1,445 @@
+package discovery
+
+import (
+	"crypto/rand"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultSyncerRotationInterval is the default interval in which we'll
+	// rotate a single active syncer.
+	DefaultSyncerRotationInterval = 20 * time.Minute
+
+	// DefaultNumActiveSyncers is the default number of syncers from which
+	// we'll receive new graph updates.
+	DefaultNumActiveSyncers = 3
+)
+
+// SyncManagerCfg contains all of the dependencies required for the
+// SyncManager to carry out its duties.
+type SyncManagerCfg struct {
+	// ChainHash is a hash that indicates the specific network of the
+	// active chain.
+	ChainHash chainhash.Hash
+
+	// ChanSeries is an interface that provides access to a time series
+	// view of the current known channel graph. Each gossipSyncer will
+	// utilize this in order to create and respond to channel graph time
+	// series queries.
+	ChanSeries ChannelGraphTimeSeries
+
+	// NumActiveSyncers is the number of peers from which we'll receive
+	// new graph updates. Syncers beyond this number are passive, meaning
+	// they'll only reconcile the channel graph with their peer and reply
+	// to its queries.
+	NumActiveSyncers int
+
+	// RotateTicker is a ticker responsible for notifying the SyncManager
+	// when it should rotate its active syncers. A single active syncer
+	// with a chansSynced state will be exchanged for a passive one.
+	RotateTicker ticker.Ticker
+}
+
+// SyncManager is a subsystem of the gossiper that manages the gossip syncers
+// of all peers that understand gossip queries. Rather than receiving new graph
+// updates from every peer, which mostly results in redundant bandwidth, only a
+// limited number of syncers are active, while the remaining ones are passive.
+// The active syncers are rotated periodically, ensuring we don't rely on the
+// same set of peers for graph updates. Additionally, an initial historical
+// sync is performed against a single peer, in order to discover any channels
+// we may have missed while offline.
+type SyncManager struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg SyncManagerCfg
+
+	// syncersMtx guards the set of syncers and the state of the
+	// historical sync below.
+	syncersMtx sync.Mutex
+
+	// activeSyncers is the set of syncers we're receiving new graph
+	// updates from.
+	activeSyncers map[routing.Vertex]*gossipSyncer
+
+	// inactiveSyncers is the set of passive syncers, which we're not
+	// receiving new graph updates from.
+	inactiveSyncers map[routing.Vertex]*gossipSyncer
+
+	// historicalSyncer is the syncer performing the initial historical
+	// sync, if any.
+	historicalSyncer *gossipSyncer
+
+	// historicalSyncDone is closed by the historicalSyncer once it has
+	// completed its historical sync.
+	historicalSyncDone <-chan struct{}
+
+	// historicalSyncComplete indicates whether the initial historical
+	// sync has been completed.
+	historicalSyncComplete bool
+
+	// historicalSyncerChanged is used to signal the syncerHandler that a
+	// new historical syncer has been chosen.
+	historicalSyncerChanged chan struct{}
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newSyncManager constructs a new SyncManager backed by the given config.
+func newSyncManager(cfg *SyncManagerCfg) *SyncManager {
+	return &SyncManager{
+		cfg:                     *cfg,
+		activeSyncers:           make(map[routing.Vertex]*gossipSyncer),
+		inactiveSyncers:         make(map[routing.Vertex]*gossipSyncer),
+		historicalSyncerChanged: make(chan struct{}, 1),
+		quit:                    make(chan struct{}),
+	}
+}
+
+// Start starts the SyncManager in order to properly carry out its duties.
+func (m *SyncManager) Start() {
+	if !atomic.CompareAndSwapUint32(&m.started, 0, 1) {
+		return
+	}
+
+	m.wg.Add(1)
+	go m.syncerHandler()
+}
+
+// Stop stops the SyncManager from performing its duties, along with all of
+// the gossip syncers it manages.
+func (m *SyncManager) Stop() {
+	if !atomic.CompareAndSwapUint32(&m.stopped, 0, 1) {
+		return
+	}
+
+	close(m.quit)
+	m.wg.Wait()
+
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	for _, syncer := range m.activeSyncers {
+		syncer.Stop()
+	}
+	for _, syncer := range m.inactiveSyncers {
+		syncer.Stop()
+	}
+}
+
+// syncerHandler is the SyncManager's main event loop. It's responsible for
+// rotating the active syncers, and for tracking the completion of the initial
+// historical sync.
+//
+// NOTE: This must be run as a goroutine.
+func (m *SyncManager) syncerHandler() {
+	defer m.wg.Done()
+
+	m.cfg.RotateTicker.Resume()
+	defer m.cfg.RotateTicker.Stop()
+
+	for {
+		m.syncersMtx.Lock()
+		historicalSyncDone := m.historicalSyncDone
+		m.syncersMtx.Unlock()
+
+		select {
+		// The historical syncer has completed its sync, so we no
+		// longer need to perform an initial historical sync.
+		case <-historicalSyncDone:
+			m.syncersMtx.Lock()
+			if m.historicalSyncDone == historicalSyncDone {
+				log.Infof("Initial historical sync with "+
+					"peer=%x complete",
+					m.historicalSyncer.peerPub[:])
+
+				m.historicalSyncer = nil
+				m.historicalSyncDone = nil
+				m.historicalSyncComplete = true
+			}
+			m.syncersMtx.Unlock()
+
+		// A new historical syncer has been chosen, so we'll track its
+		// completion instead.
+		case <-m.historicalSyncerChanged:
+
+		// Our RotateTicker has ticked, so we'll attempt to rotate a
+		// single active syncer with a passive one.
+		case <-m.cfg.RotateTicker.Ticks():
+			m.rotateActiveSyncerCandidate()
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// rotateActiveSyncerCandidate rotates a single active syncer. In order to
+// achieve this, the active syncer must be in a chansSynced state in order to
+// process the sync transition.
+func (m *SyncManager) rotateActiveSyncerCandidate() {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	activeSyncer := chooseRandomSyncer(m.activeSyncers, chansSyncedFilter)
+	if activeSyncer == nil {
+		log.Debug("No eligible active syncer to rotate")
+		return
+	}
+
+	candidate := chooseRandomSyncer(m.inactiveSyncers, chansSyncedFilter)
+	if candidate == nil {
+		log.Debug("No eligible candidate to rotate active syncer")
+		return
+	}
+
+	log.Debugf("Rotating active GossipSyncer(%x) with GossipSyncer(%x)",
+		activeSyncer.peerPub[:], candidate.peerPub[:])
+
+	m.transitionSyncer(activeSyncer, PassiveSync)
+	m.transitionSyncer(candidate, ActiveSync)
+}
+
+// transitionSyncer moves the syncer to the set matching the given SyncerType,
+// and changes its SyncerType accordingly.
+//
+// NOTE: This method must be called with the syncersMtx lock held.
+func (m *SyncManager) transitionSyncer(s *gossipSyncer, syncType SyncerType) {
+	vertex := routing.Vertex(s.peerPub)
+	switch syncType {
+	case ActiveSync:
+		delete(m.inactiveSyncers, vertex)
+		m.activeSyncers[vertex] = s
+
+	case PassiveSync:
+		delete(m.activeSyncers, vertex)
+		m.inactiveSyncers[vertex] = s
+	}
+
+	s.setSyncType(syncType)
+}
+
+// InitSyncState is called by outside sub-systems when a connection is
+// established to a new peer that understands how to perform channel range
+// queries. We'll allocate a new gossip syncer for it, and start any goroutines
+// needed to handle new queries. The gossip syncer will be active if we don't
+// yet receive new graph updates from enough peers, and passive otherwise. If
+// the remote peer understands the extended gossip queries, we'll request the
+// timestamps and checksums of each channel within our channel range queries.
+func (m *SyncManager) InitSyncState(peer lnpeer.Peer, extendedQueries bool) {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	// If we already have a syncer, then we'll exit early as we don't want
+	// to override it.
+	nodeID := routing.Vertex(peer.PubKey())
+	if _, ok := m.gossipSyncer(nodeID); ok {
+		return
+	}
+
+	recvUpdates := len(m.activeSyncers) < m.cfg.NumActiveSyncers
+
+	log.Infof("Creating new gossipSyncer for peer=%x, recv_updates=%v",
+		nodeID[:], recvUpdates)
+
+	var queryOptions lnwire.QueryOption
+	if extendedQueries {
+		queryOptions = lnwire.QueryOptionTimestamps |
+			lnwire.QueryOptionChecksums
+	}
+
+	encoding := lnwire.EncodingSortedZlib
+	s := newGossiperSyncer(gossipSyncerCfg{
+		chainHash:       m.cfg.ChainHash,
+		syncChanUpdates: recvUpdates,
+		channelSeries:   m.cfg.ChanSeries,
+		encodingType:    encoding,
+		chunkSize:       encodingTypeToChunkSize[encoding],
+		queryOptions:    queryOptions,
+		sendToPeer: func(msgs ...lnwire.Message) error {
+			return peer.SendMessage(false, msgs...)
+		},
+	})
+	copy(s.peerPub[:], nodeID[:])
+
+	if recvUpdates {
+		m.activeSyncers[nodeID] = s
+	} else {
+		m.inactiveSyncers[nodeID] = s
+	}
+
+	// If we haven't performed our initial historical sync yet, we'll do
+	// so with this peer.
+	if !m.historicalSyncComplete && m.historicalSyncer == nil {
+		m.startHistoricalSync(s)
+	}
+
+	s.Start()
+}
+
+// PruneSyncState is called by outside sub-systems once a peer that we were
+// previously connected to has been disconnected. In this case we can stop the
+// existing gossipSyncer assigned to the peer and free up resources. If the
+// gossipSyncer was active, a passive one will take its place.
+func (m *SyncManager) PruneSyncState(peer routing.Vertex) {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	s, ok := m.gossipSyncer(peer)
+	if !ok {
+		return
+	}
+
+	log.Infof("Removing gossipSyncer for peer=%x", peer[:])
+
+	s.Stop()
+
+	_, wasActive := m.activeSyncers[peer]
+	delete(m.activeSyncers, peer)
+	delete(m.inactiveSyncers, peer)
+
+	// If the syncer was performing our initial historical sync, we'll
+	// need to find another peer to perform it with.
+	if m.historicalSyncer == s {
+		m.historicalSyncer = nil
+		m.historicalSyncDone = nil
+
+		if next := chooseRandomSyncer(m.allSyncers(), nil); next != nil {
+			m.startHistoricalSync(next)
+		}
+	}
+
+	// If the syncer was active, we'll replace it with a passive one to
+	// ensure we keep receiving new graph updates from enough peers.
+	if !wasActive {
+		return
+	}
+	candidate := chooseRandomSyncer(m.inactiveSyncers, nil)
+	if candidate == nil {
+		return
+	}
+
+	log.Debugf("Replacing active GossipSyncer(%x) with GossipSyncer(%x)",
+		peer[:], candidate.peerPub[:])
+
+	m.transitionSyncer(candidate, ActiveSync)
+}
+
+// startHistoricalSync requests the given syncer to perform our initial
+// historical sync.
+//
+// NOTE: This method must be called with the syncersMtx lock held.
+func (m *SyncManager) startHistoricalSync(s *gossipSyncer) {
+	log.Infof("Starting initial historical sync with peer=%x",
+		s.peerPub[:])
+
+	m.historicalSyncer = s
+	m.historicalSyncDone = s.historicalSync()
+
+	select {
+	case m.historicalSyncerChanged <- struct{}{}:
+	default:
+	}
+}
+
+// GossipSyncer returns the associated gossip syncer of a peer. The boolean
+// returned signals whether there exists a gossip syncer for the peer.
+func (m *SyncManager) GossipSyncer(peer routing.Vertex) (*gossipSyncer, bool) {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	return m.gossipSyncer(peer)
+}
+
+// gossipSyncer returns the associated gossip syncer of a peer. The boolean
+// returned signals whether there exists a gossip syncer for the peer.
+//
+// NOTE: This method must be called with the syncersMtx lock held.
+func (m *SyncManager) gossipSyncer(peer routing.Vertex) (*gossipSyncer, bool) {
+	if s, ok := m.activeSyncers[peer]; ok {
+		return s, true
+	}
+	if s, ok := m.inactiveSyncers[peer]; ok {
+		return s, true
+	}
+
+	return nil, false
+}
+
+// GossipSyncers returns all of the currently initialized gossip syncers.
+func (m *SyncManager) GossipSyncers() map[routing.Vertex]*gossipSyncer {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	return m.allSyncers()
+}
+
+// allSyncers returns a copy of the set of all gossip syncers.
+//
+// NOTE: This method must be called with the syncersMtx lock held.
+func (m *SyncManager) allSyncers() map[routing.Vertex]*gossipSyncer {
+	numSyncers := len(m.activeSyncers) + len(m.inactiveSyncers)
+	syncers := make(map[routing.Vertex]*gossipSyncer, numSyncers)
+
+	for peer, s := range m.activeSyncers {
+		syncers[peer] = s
+	}
+	for peer, s := range m.inactiveSyncers {
+		syncers[peer] = s
+	}
+
+	return syncers
+}
+
+// PeerSyncType returns the SyncerType of the gossip syncer of a peer. The
+// boolean returned signals whether there exists a gossip syncer for the peer.
+func (m *SyncManager) PeerSyncType(peer routing.Vertex) (SyncerType, bool) {
+	s, ok := m.GossipSyncer(peer)
+	if !ok {
+		return 0, false
+	}
+
+	return s.SyncType(), true
+}
+
+// chansSyncedFilter only allows syncers that have reached their terminal
+// state, as only those are able to change their update horizon immediately.
+func chansSyncedFilter(s *gossipSyncer) bool {
+	return s.SyncState() == chansSynced
+}
+
+// chooseRandomSyncer returns a random syncer out of the set of syncers that
+// pass the given filter, if any. A nil filter allows all syncers.
+func chooseRandomSyncer(syncers map[routing.Vertex]*gossipSyncer,
+	filter func(*gossipSyncer) bool) *gossipSyncer {
+
+	candidates := make([]*gossipSyncer, 0, len(syncers))
+	for _, s := range syncers {
+		if filter != nil && !filter(s) {
+			continue
+		}
+		candidates = append(candidates, s)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	idx, err := rand.Int(rand.Reader, big.NewInt(int64(len(candidates))))
+	if err != nil {
+		log.Errorf("Unable to choose random syncer: %v", err)
+		return nil
+	}
+
+	return candidates[idx.Int64()]
+}
@@ -0,0 +1,224 @@
+package discovery
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// latestKnownHeight is the height of the newest channel known to the mock
+// channel graph used by the SyncManager tests.
+const latestKnownHeight = 1000
+
+// newTestSyncManager creates a new test SyncManager using mock
+// implementations of its dependencies.
+func newTestSyncManager(numActiveSyncers int) (*SyncManager, *ticker.Mock) {
+	hID := lnwire.ShortChannelID{BlockHeight: latestKnownHeight}
+	rotateTicker := ticker.MockNew(DefaultSyncerRotationInterval)
+
+	return newSyncManager(&SyncManagerCfg{
+		ChanSeries:       newMockChannelGraphTimeSeries(hID),
+		NumActiveSyncers: numActiveSyncers,
+		RotateTicker:     rotateTicker,
+	}), rotateTicker
+}
+
+// newTestSyncPeer creates a new mock peer with a random identity key whose
+// sent messages can be inspected.
+func newTestSyncPeer(t *testing.T) *mockPeer {
+	t.Helper()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	return &mockPeer{
+		pk:       privKey.PubKey(),
+		sentMsgs: make(chan lnwire.Message, 10),
+		quit:     make(chan struct{}),
+	}
+}
+
+// assertSyncType ensures the gossip syncer of the given peer exists and has
+// the expected SyncerType.
+func assertSyncType(t *testing.T, m *SyncManager, peer *mockPeer,
+	syncType SyncerType) {
+
+	t.Helper()
+
+	peerSyncType, ok := m.PeerSyncType(routing.NewVertex(peer.pk))
+	if !ok {
+		t.Fatalf("expected gossip syncer for peer %x",
+			peer.pk.SerializeCompressed())
+	}
+	if peerSyncType != syncType {
+		t.Fatalf("expected sync type %v for peer %x, got %v", syncType,
+			peer.pk.SerializeCompressed(), peerSyncType)
+	}
+}
+
+// assertChanRangeQuery ensures the given peer is sent a QueryChannelRange
+// message starting at the expected height.
+func assertChanRangeQuery(t *testing.T, peer *mockPeer, startHeight uint32) {
+	t.Helper()
+
+	select {
+	case msg := <-peer.sentMsgs:
+		query, ok := msg.(*lnwire.QueryChannelRange)
+		if !ok {
+			t.Fatalf("expected QueryChannelRange, got %T", msg)
+		}
+		if query.FirstBlockHeight != startHeight {
+			t.Fatalf("expected query starting at height %v, "+
+				"got %v", startHeight, query.FirstBlockHeight)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("expected QueryChannelRange to be sent")
+	}
+}
+
+// TestSyncManagerNumActiveSyncers ensures that only the configured number of
+// gossip syncers are active, and that a passive syncer takes the place of an
+// active one once it's pruned.
+func TestSyncManagerNumActiveSyncers(t *testing.T) {
+	t.Parallel()
+
+	const numActiveSyncers = 2
+
+	syncMgr, _ := newTestSyncManager(numActiveSyncers)
+	syncMgr.Start()
+	defer syncMgr.Stop()
+
+	// We'll start by creating more peers than the number of active
+	// syncers we allow.
+	peers := make([]*mockPeer, numActiveSyncers+1)
+	for i := range peers {
+		peers[i] = newTestSyncPeer(t)
+		syncMgr.InitSyncState(peers[i], false)
+	}
+
+	// The first peers should be assigned active syncers, while the last
+	// one should be assigned a passive syncer.
+	for _, peer := range peers[:numActiveSyncers] {
+		assertSyncType(t, syncMgr, peer, ActiveSync)
+	}
+	assertSyncType(t, syncMgr, peers[numActiveSyncers], PassiveSync)
+
+	// Initializing the sync state of a peer that already has a syncer
+	// shouldn't change its type.
+	syncMgr.InitSyncState(peers[numActiveSyncers], false)
+	assertSyncType(t, syncMgr, peers[numActiveSyncers], PassiveSync)
+
+	// Once we prune one of the active syncers, the passive one should be
+	// promoted to take its place.
+	syncMgr.PruneSyncState(routing.NewVertex(peers[0].pk))
+	if _, ok := syncMgr.GossipSyncer(routing.NewVertex(peers[0].pk)); ok {
+		t.Fatalf("expected gossip syncer to be pruned")
+	}
+	for _, peer := range peers[1:] {
+		assertSyncType(t, syncMgr, peer, ActiveSync)
+	}
+}
+
+// TestSyncManagerHistoricalSync ensures that the SyncManager performs its
+// initial historical sync with a single peer, and that the historical sync is
+// reassigned if that peer disconnects before completing it.
+func TestSyncManagerHistoricalSync(t *testing.T) {
+	t.Parallel()
+
+	syncMgr, _ := newTestSyncManager(DefaultNumActiveSyncers)
+	syncMgr.Start()
+	defer syncMgr.Stop()
+
+	// The first peer should be asked for all of the channels it knows of.
+	peer1 := newTestSyncPeer(t)
+	syncMgr.InitSyncState(peer1, false)
+	assertChanRangeQuery(t, peer1, 0)
+
+	// The second peer, however, should only be asked for the channels
+	// since our newest one.
+	peer2 := newTestSyncPeer(t)
+	syncMgr.InitSyncState(peer2, false)
+	assertChanRangeQuery(t, peer2, latestKnownHeight-chanRangeQueryBuffer)
+
+	// If the first peer disconnects before completing the historical
+	// sync, then it should be reassigned to the second peer.
+	syncMgr.PruneSyncState(routing.NewVertex(peer1.pk))
+
+	s2, ok := syncMgr.GossipSyncer(routing.NewVertex(peer2.pk))
+	if !ok {
+		t.Fatalf("expected gossip syncer for second peer")
+	}
+
+	syncMgr.syncersMtx.Lock()
+	historicalSyncer := syncMgr.historicalSyncer
+	syncMgr.syncersMtx.Unlock()
+	if historicalSyncer != s2 {
+		t.Fatalf("expected historical sync to be reassigned")
+	}
+
+	s2.Lock()
+	historicalSyncPending := s2.historicalSyncPending
+	s2.Unlock()
+	if !historicalSyncPending {
+		t.Fatalf("expected historical sync to be pending")
+	}
+}
+
+// TestSyncManagerRotateActiveSyncer ensures that an active syncer is
+// exchanged for a passive one once the rotation ticker ticks.
+func TestSyncManagerRotateActiveSyncer(t *testing.T) {
+	t.Parallel()
+
+	syncMgr, rotateTicker := newTestSyncManager(1)
+	syncMgr.Start()
+	defer syncMgr.Stop()
+
+	activePeer := newTestSyncPeer(t)
+	syncMgr.InitSyncState(activePeer, false)
+	assertSyncType(t, syncMgr, activePeer, ActiveSync)
+
+	passivePeer := newTestSyncPeer(t)
+	syncMgr.InitSyncState(passivePeer, false)
+	assertSyncType(t, syncMgr, passivePeer, PassiveSync)
+
+	// Only syncers that have reached their terminal state are eligible
+	// for rotation, so we'll force both of them into it.
+	for _, peer := range []*mockPeer{activePeer, passivePeer} {
+		s, _ := syncMgr.GossipSyncer(routing.NewVertex(peer.pk))
+		atomic.StoreUint32(&s.state, uint32(chansSynced))
+	}
+
+	select {
+	case rotateTicker.Force <- time.Time{}:
+	case <-time.After(time.Second):
+		t.Fatalf("unable to force rotation tick")
+	}
+
+	// The syncers should eventually swap their types.
+	rotated := false
+	for i := 0; i < 10; i++ {
+		activeType, _ := syncMgr.PeerSyncType(
+			routing.NewVertex(activePeer.pk),
+		)
+		passiveType, _ := syncMgr.PeerSyncType(
+			routing.NewVertex(passivePeer.pk),
+		)
+		if activeType == PassiveSync && passiveType == ActiveSync {
+			rotated = true
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+	if !rotated {
+		t.Fatalf("expected active syncer to be rotated")
+	}
+}
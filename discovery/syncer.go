@@ -13,6 +13,34 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// SyncerType encapsulates the different types of syncing mechanisms for a
+// gossip syncer.
+type SyncerType uint8
+
+const (
+	// ActiveSync denotes that a gossip syncer should exercise its default
+	// behavior. This includes reconciling the set of missing graph updates
+	// with the remote peer _and_ receiving new updates from them.
+	ActiveSync SyncerType = iota
+
+	// PassiveSync denotes that a gossip syncer should not receive any new
+	// graph updates from the remote peer. It will still reconcile the set
+	// of missing graph updates with them, and reply to their queries.
+	PassiveSync
+)
+
+// String returns a human readable string describing the target SyncerType.
+func (t SyncerType) String() string {
+	switch t {
+	case ActiveSync:
+		return "ActiveSync"
+	case PassiveSync:
+		return "PassiveSync"
+	default:
+		return fmt.Sprintf("unknown sync type %d", t)
+	}
+}
+
 // syncerState is an enum that represents the current state of the
 // gossipSyncer.  As the syncer is a state machine, we'll gate our actions
 // based off of the current state and the next incoming message.
@@ -94,6 +122,7 @@ var (
 	// single message safely.
 	encodingTypeToChunkSize = map[lnwire.ShortChanIDEncoding]int32{
 		lnwire.EncodingSortedPlain: 8000,
+		lnwire.EncodingSortedZlib:  8000,
 	}
 
 	// ErrGossipSyncerExiting signals that the syncer has been killed.
@@ -105,6 +134,13 @@ const (
 	// asking the remote peer for their any channels they know of beyond
 	// our highest known channel ID.
 	chanRangeQueryBuffer = 144
+
+	// extendedQueryChunkSize is the max number of short chan IDs we'll
+	// send within a single reply to a channel range query that requests
+	// the timestamps and checksums of each channel. These take up an
+	// additional 16 bytes per channel, so we'll need to reduce our chunk
+	// size to still fit into a single message safely.
+	extendedQueryChunkSize = 2500
 )
 
 // gossipSyncerCfg is a struct that packages all the information a gossipSyncer
@@ -114,7 +150,8 @@ type gossipSyncerCfg struct {
 	chainHash chainhash.Hash
 
 	// syncChanUpdates is a bool that indicates if we should request a
-	// continual channel update stream or not.
+	// continual channel update stream or not. This only determines the
+	// initial SyncerType of the syncer, which may be changed later on.
 	syncChanUpdates bool
 
 	// channelSeries is the primary interface that we'll use to generate
@@ -129,6 +166,11 @@ type gossipSyncerCfg struct {
 	// encoding type that we can fit into a single message safely.
 	chunkSize int32
 
+	// queryOptions are the options we'll set within our channel range
+	// queries. These must only be set if the remote peer understands the
+	// extended gossip queries.
+	queryOptions lnwire.QueryOption
+
 	// sendToPeer is a function closure that should send the set of
 	// targeted messages to the peer we've been assigned to sync the graph
 	// state from.
@@ -170,6 +212,37 @@ type gossipSyncer struct {
 	// NOTE: This variable MUST be used atomically.
 	state uint32
 
+	// syncType denotes the SyncerType the gossipSyncer is currently
+	// configured as.
+	//
+	// NOTE: This variable MUST be used atomically.
+	syncType uint32
+
+	// syncSignal is used to wake up the gossipSyncer once it has reached
+	// its terminal state, after its SyncerType has changed or a
+	// historical sync has been requested.
+	syncSignal chan struct{}
+
+	// historicalSyncPending indicates whether a historical sync has been
+	// requested, but not started yet. A historical sync queries the
+	// remote peer for all channels it knows of, rather than only the
+	// channels beyond our newest channel.
+	//
+	// NOTE: This variable MUST be accessed with the mutex held.
+	historicalSyncPending bool
+
+	// historicalSyncActive indicates whether the current sync is a
+	// historical sync.
+	//
+	// NOTE: This variable MUST be accessed with the mutex held.
+	historicalSyncActive bool
+
+	// historicalSyncDone is closed once the requested historical sync
+	// has completed.
+	//
+	// NOTE: This variable MUST be accessed with the mutex held.
+	historicalSyncDone chan struct{}
+
 	// gossipMsgs is a channel that all messages from the target peer will
 	// be sent over.
 	gossipMsgs chan lnwire.Message
@@ -178,6 +251,11 @@ type gossipSyncer struct {
 	// buffer all the chunked response to our query.
 	bufferedChanRangeReplies []lnwire.ShortChannelID
 
+	// bufferedChanUpdateInfo holds the timestamps and checksums of the
+	// latest channel updates of the channels within
+	// bufferedChanRangeReplies, if the remote peer included them.
+	bufferedChanUpdateInfo map[lnwire.ShortChannelID]remoteChanUpdateInfo
+
 	// newChansToQuery is used to pass the set of channels we should query
 	// for from the waitingQueryChanReply state to the queryNewChannels
 	// state.
@@ -225,9 +303,16 @@ func newGossiperSyncer(cfg gossipSyncerCfg) *gossipSyncer {
 		interval, cfg.maxUndelayedQueryReplies,
 	)
 
+	syncType := ActiveSync
+	if !cfg.syncChanUpdates {
+		syncType = PassiveSync
+	}
+
 	return &gossipSyncer{
 		cfg:         cfg,
 		rateLimiter: rateLimiter,
+		syncType:    uint32(syncType),
+		syncSignal:  make(chan struct{}, 1),
 		gossipMsgs:  make(chan lnwire.Message, 100),
 		quit:        make(chan struct{}),
 	}
@@ -401,31 +486,33 @@ func (g *gossipSyncer) channelGraphSyncer() {
 		// This is our final terminal state where we'll only reply to
 		// any further queries by the remote peer.
 		case chansSynced:
-			// If we haven't yet sent out our update horizon, and
-			// we want to receive real-time channel updates, we'll
-			// do so now.
-			if g.localUpdateHorizon == nil && g.cfg.syncChanUpdates {
-				// TODO(roasbeef): query DB for most recent
-				// update?
-
-				// We'll give an hours room in our update
-				// horizon to ensure we don't miss any newer
-				// items.
-				updateHorizon := time.Now().Add(-time.Hour * 1)
-				log.Infof("gossipSyncer(%x): applying "+
-					"gossipFilter(start=%v)", g.peerPub[:],
-					updateHorizon)
-
-				g.localUpdateHorizon = &lnwire.GossipTimestampRange{
-					ChainHash:      g.cfg.chainHash,
-					FirstTimestamp: uint32(updateHorizon.Unix()),
-					TimestampRange: math.MaxUint32,
-				}
-				err := g.cfg.sendToPeer(g.localUpdateHorizon)
-				if err != nil {
-					log.Errorf("unable to send update "+
-						"horizon: %v", err)
-				}
+			// If we've just completed a historical sync, we'll
+			// signal this to the caller that requested it. If
+			// another one has been requested in the meantime,
+			// we'll start it now.
+			g.Lock()
+			if g.historicalSyncActive {
+				log.Infof("gossipSyncer(%x): historical sync "+
+					"complete", g.peerPub[:])
+
+				close(g.historicalSyncDone)
+				g.historicalSyncDone = nil
+				g.historicalSyncActive = false
+			}
+			historicalSyncPending := g.historicalSyncPending
+			g.Unlock()
+
+			if historicalSyncPending {
+				atomic.StoreUint32(&g.state, uint32(syncingChans))
+				continue
+			}
+
+			// Next, we'll make sure our update horizon reflects
+			// whether we want to receive real-time channel updates
+			// or not.
+			if err := g.applySyncType(); err != nil {
+				log.Errorf("unable to send update horizon: %v",
+					err)
 			}
 
 			// With our horizon set, we'll simply reply to any new
@@ -438,6 +525,8 @@ func (g *gossipSyncer) channelGraphSyncer() {
 						"query: %v", err)
 				}
 
+			case <-g.syncSignal:
+
 			case <-g.quit:
 				return
 			}
@@ -445,6 +534,54 @@ func (g *gossipSyncer) channelGraphSyncer() {
 	}
 }
 
+// applySyncType sends the remote peer a new update horizon if our current one
+// doesn't match the SyncerType of the gossipSyncer. Active syncers request all
+// updates from an hour ago onwards, while passive syncers request none.
+func (g *gossipSyncer) applySyncType() error {
+	// The remote peer won't send us any updates until we've sent our
+	// first update horizon, so a nil horizon is equivalent to one
+	// requesting no updates.
+	receivingUpdates := g.localUpdateHorizon != nil &&
+		g.localUpdateHorizon.TimestampRange != 0
+
+	var firstTimestamp, timestampRange uint32
+	switch g.SyncType() {
+	case ActiveSync:
+		if receivingUpdates {
+			return nil
+		}
+
+		// TODO(roasbeef): query DB for most recent update?
+
+		// We'll give an hours room in our update horizon to ensure we
+		// don't miss any newer items.
+		updateHorizon := time.Now().Add(-time.Hour * 1)
+		firstTimestamp = uint32(updateHorizon.Unix())
+		timestampRange = math.MaxUint32
+
+	case PassiveSync:
+		if !receivingUpdates {
+			return nil
+		}
+
+	default:
+		return fmt.Errorf("unknown sync type %v", g.SyncType())
+	}
+
+	log.Infof("gossipSyncer(%x): applying gossipFilter(start=%v, "+
+		"range=%v) for %v", g.peerPub[:],
+		time.Unix(int64(firstTimestamp), 0), timestampRange,
+		g.SyncType())
+
+	g.localUpdateHorizon = &lnwire.GossipTimestampRange{
+		ChainHash:      g.cfg.chainHash,
+		FirstTimestamp: firstTimestamp,
+		TimestampRange: timestampRange,
+	}
+
+	return g.cfg.sendToPeer(g.localUpdateHorizon)
+}
+
 // synchronizeChanIDs is called by the channelGraphSyncer when we need to query
 // the remote peer for its known set of channel IDs within a particular block
 // range. This method will be called continually until the entire range has
@@ -486,7 +623,7 @@ func (g *gossipSyncer) synchronizeChanIDs() (bool, error) {
 	// false indicating that we're net yet fully synced.
 	err := g.cfg.sendToPeer(&lnwire.QueryShortChanIDs{
 		ChainHash:    g.cfg.chainHash,
-		EncodingType: lnwire.EncodingSortedPlain,
+		EncodingType: g.cfg.encodingType,
 		ShortChanIDs: queryChunk,
 	})
 
@@ -501,6 +638,24 @@ func (g *gossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 		g.bufferedChanRangeReplies, msg.ShortChanIDs...,
 	)
 
+	// If the remote peer included the timestamps and checksums of the
+	// latest channel updates of each channel, we'll buffer those as well.
+	if len(msg.Timestamps) > 0 && g.bufferedChanUpdateInfo == nil {
+		g.bufferedChanUpdateInfo = make(
+			map[lnwire.ShortChannelID]remoteChanUpdateInfo,
+		)
+	}
+	for i, timestamps := range msg.Timestamps {
+		info := remoteChanUpdateInfo{
+			timestamps: timestamps,
+		}
+		if len(msg.Checksums) > 0 {
+			info.checksums = &msg.Checksums[i]
+		}
+
+		g.bufferedChanUpdateInfo[msg.ShortChanIDs[i]] = info
+	}
+
 	log.Infof("gossipSyncer(%x): buffering chan range reply of size=%v",
 		g.peerPub[:], len(msg.ShortChanIDs))
 
@@ -522,10 +677,27 @@ func (g *gossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 		return fmt.Errorf("unable to filter chan ids: %v", err)
 	}
 
+	// If the remote peer included the timestamps of its channel updates,
+	// we'll also query for the channels we already know of, but whose
+	// updates have changed since.
+	if len(g.bufferedChanUpdateInfo) > 0 {
+		updatedChans, err := g.filterUpdatedChans(newChans)
+		if err != nil {
+			return fmt.Errorf("unable to filter updated chans: %v",
+				err)
+		}
+
+		log.Infof("gossipSyncer(%x): remote peer has %v updated chans",
+			g.peerPub[:], len(updatedChans))
+
+		newChans = append(newChans, updatedChans...)
+	}
+
 	// As we've received the entirety of the reply, we no longer need to
 	// hold on to the set of buffered replies, so we'll let that be garbage
 	// collected now.
 	g.bufferedChanRangeReplies = nil
+	g.bufferedChanUpdateInfo = nil
 
 	// If there aren't any channels that we don't know of, then we can
 	// switch straight to our terminal state.
@@ -548,6 +720,93 @@ func (g *gossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 	return nil
 }
 
+// remoteChanUpdateInfo houses the timestamps and, if present, checksums of the
+// latest channel updates of a channel, as reported by the remote peer.
+type remoteChanUpdateInfo struct {
+	timestamps lnwire.ChanUpdateTimestamps
+	checksums  *lnwire.ChanUpdateChecksums
+}
+
+// filterUpdatedChans returns the set of buffered channels that we already know
+// of, but for which the remote peer has a newer channel update with a
+// different policy than ours. Channels within newChans are skipped, as we'll
+// already query for them.
+func (g *gossipSyncer) filterUpdatedChans(
+	newChans []lnwire.ShortChannelID) ([]lnwire.ShortChannelID, error) {
+
+	isNew := make(map[lnwire.ShortChannelID]struct{}, len(newChans))
+	for _, chanID := range newChans {
+		isNew[chanID] = struct{}{}
+	}
+
+	var knownChans []lnwire.ShortChannelID
+	for _, chanID := range g.bufferedChanRangeReplies {
+		if _, ok := isNew[chanID]; ok {
+			continue
+		}
+		if _, ok := g.bufferedChanUpdateInfo[chanID]; !ok {
+			continue
+		}
+		knownChans = append(knownChans, chanID)
+	}
+	if len(knownChans) == 0 {
+		return nil, nil
+	}
+
+	timestamps, checksums, err := g.cfg.channelSeries.ChanUpdateInfo(
+		g.cfg.chainHash, knownChans,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var updatedChans []lnwire.ShortChannelID
+	for i, chanID := range knownChans {
+		remote := g.bufferedChanUpdateInfo[chanID]
+
+		var remoteChecksum1, remoteChecksum2 *uint32
+		if remote.checksums != nil {
+			remoteChecksum1 = &remote.checksums.Checksum1
+			remoteChecksum2 = &remote.checksums.Checksum2
+		}
+
+		updated1 := isChanUpdateNewer(
+			remote.timestamps.Timestamp1, remoteChecksum1,
+			timestamps[i].Timestamp1, checksums[i].Checksum1,
+		)
+		updated2 := isChanUpdateNewer(
+			remote.timestamps.Timestamp2, remoteChecksum2,
+			timestamps[i].Timestamp2, checksums[i].Checksum2,
+		)
+		if updated1 || updated2 {
+			updatedChans = append(updatedChans, chanID)
+		}
+	}
+
+	return updatedChans, nil
+}
+
+// isChanUpdateNewer returns whether the remote peer's channel update for a
+// single direction of a channel is worth querying for. This is the case if
+// it's newer than ours, unless both carry the same checksum, in which case the
+// remote update is merely a keep-alive of the policy we already know of.
+func isChanUpdateNewer(remoteTimestamp uint32, remoteChecksum *uint32,
+	localTimestamp, localChecksum uint32) bool {
+
+	if remoteTimestamp <= localTimestamp {
+		return false
+	}
+
+	// If we don't have a channel update for this direction at all, or the
+	// remote peer didn't send us a checksum, then we'll have to query for
+	// it.
+	if localTimestamp == 0 || remoteChecksum == nil {
+		return true
+	}
+
+	return *remoteChecksum != localChecksum
+}
+
 // genChanRangeQuery generates the initial message we'll send to the remote
 // party when we're kicking off the channel graph synchronization upon
 // connection.
@@ -559,12 +818,25 @@ func (g *gossipSyncer) genChanRangeQuery() (*lnwire.QueryChannelRange, error) {
 		return nil, err
 	}
 
+	// If a historical sync has been requested, we'll start it now.
+	g.Lock()
+	historicalSync := g.historicalSyncPending
+	if historicalSync {
+		g.historicalSyncPending = false
+		g.historicalSyncActive = true
+	}
+	g.Unlock()
+
 	// Once we have the chan ID of the newest, we'll obtain the block
 	// height of the channel, then subtract our default horizon to ensure
 	// we don't miss any channels. By default, we go back 1 day from the
-	// newest channel.
+	// newest channel, unless we're performing a historical sync, in which
+	// case we'll query for all channels.
 	var startHeight uint32
 	switch {
+	case historicalSync:
+		startHeight = 0
+
 	case newestChan.BlockHeight <= chanRangeQueryBuffer:
 		fallthrough
 	case newestChan.BlockHeight == 0:
@@ -585,6 +857,7 @@ func (g *gossipSyncer) genChanRangeQuery() (*lnwire.QueryChannelRange, error) {
 		ChainHash:        g.cfg.chainHash,
 		FirstBlockHeight: startHeight,
 		NumBlocks:        math.MaxUint32 - startHeight,
+		QueryOptions:     g.cfg.queryOptions,
 	}, nil
 }
 
@@ -648,6 +921,17 @@ func (g *gossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 	// TODO(roasbeef): means can't send max uint above?
 	//  * or make internal 64
 
+	// If the remote peer requested the timestamps or checksums of each
+	// channel, we'll need to send smaller chunks to make room for them.
+	chunkSize := g.cfg.chunkSize
+	if query.QueryOptions != 0 && chunkSize > extendedQueryChunkSize {
+		chunkSize = extendedQueryChunkSize
+	}
+
+	// The query options aren't included within our replies.
+	replyQuery := *query
+	replyQuery.QueryOptions = 0
+
 	numChannels := int32(len(channelRange))
 	numChansSent := int32(0)
 	for {
@@ -659,7 +943,7 @@ func (g *gossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		// We know this is the final chunk, if the difference between
 		// the total number of channels, and the number of channels
 		// we've sent is less-than-or-equal to the chunk size.
-		isFinalChunk := (numChannels - numChansSent) <= g.cfg.chunkSize
+		isFinalChunk := (numChannels - numChansSent) <= chunkSize
 
 		// If this is indeed the last chunk, then we'll send the
 		// remainder of the channels.
@@ -672,7 +956,7 @@ func (g *gossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		} else {
 			// Otherwise, we'll only send off a fragment exactly
 			// sized to the proper chunk size.
-			channelChunk = channelRange[numChansSent : numChansSent+g.cfg.chunkSize]
+			channelChunk = channelRange[numChansSent : numChansSent+chunkSize]
 
 			log.Infof("gossipSyncer(%x): sending range chunk of "+
 				"size=%v", g.peerPub[:], len(channelChunk))
@@ -681,7 +965,7 @@ func (g *gossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		// With our chunk assembled, we'll now send to the remote peer
 		// the current chunk.
 		replyChunk := lnwire.ReplyChannelRange{
-			QueryChannelRange: *query,
+			QueryChannelRange: replyQuery,
 			Complete:          0,
 			EncodingType:      g.cfg.encodingType,
 			ShortChanIDs:      channelChunk,
@@ -689,6 +973,26 @@ func (g *gossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		if isFinalChunk {
 			replyChunk.Complete = 1
 		}
+
+		// If the remote peer requested them, we'll include the
+		// timestamps and checksums of the latest channel updates of
+		// each channel within the chunk.
+		if query.QueryOptions != 0 && len(channelChunk) > 0 {
+			timestamps, checksums, err := g.cfg.channelSeries.ChanUpdateInfo(
+				query.ChainHash, channelChunk,
+			)
+			if err != nil {
+				return err
+			}
+
+			opts := query.QueryOptions
+			if opts.Has(lnwire.QueryOptionTimestamps) {
+				replyChunk.Timestamps = timestamps
+			}
+			if opts.Has(lnwire.QueryOptionChecksums) {
+				replyChunk.Checksums = checksums
+			}
+		}
 		if err := g.cfg.sendToPeer(&replyChunk); err != nil {
 			return err
 		}
@@ -944,3 +1248,47 @@ func (g *gossipSyncer) ProcessQueryMsg(msg lnwire.Message, peerQuit <-chan struc
 func (g *gossipSyncer) SyncState() syncerState {
 	return syncerState(atomic.LoadUint32(&g.state))
 }
+
+// SyncType returns the current SyncerType of the target gossipSyncer.
+func (g *gossipSyncer) SyncType() SyncerType {
+	return SyncerType(atomic.LoadUint32(&g.syncType))
+}
+
+// setSyncType changes the SyncerType of the gossipSyncer. The new update
+// horizon matching the SyncerType will be sent to the remote peer once the
+// gossipSyncer has reached its terminal state.
+func (g *gossipSyncer) setSyncType(syncType SyncerType) {
+	atomic.StoreUint32(&g.syncType, uint32(syncType))
+	g.signal()
+}
+
+// historicalSync requests the gossipSyncer to query the remote peer for all
+// channels it knows of, rather than only the channels beyond our newest
+// channel. The historical sync will be started once any sync in progress has
+// completed. The returned channel is closed once the historical sync has
+// completed.
+func (g *gossipSyncer) historicalSync() <-chan struct{} {
+	g.Lock()
+	defer g.Unlock()
+
+	// If a historical sync is already pending or in progress, we'll
+	// simply return its channel.
+	if g.historicalSyncDone != nil {
+		return g.historicalSyncDone
+	}
+
+	g.historicalSyncDone = make(chan struct{})
+	g.historicalSyncPending = true
+
+	g.signal()
+
+	return g.historicalSyncDone
+}
+
+// signal wakes up the gossipSyncer if it's waiting within its terminal state.
+func (g *gossipSyncer) signal() {
+	select {
+	case g.syncSignal <- struct{}{}:
+	default:
+	}
+}
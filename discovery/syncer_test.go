@@ -28,6 +28,10 @@ type horizonQuery struct {
 type filterRangeReq struct {
 	startHeight, endHeight uint32
 }
+type chanUpdateInfoResp struct {
+	timestamps []lnwire.ChanUpdateTimestamps
+	checksums  []lnwire.ChanUpdateChecksums
+}
 
 type mockChannelGraphTimeSeries struct {
 	highestID lnwire.ShortChannelID
@@ -46,6 +50,9 @@ type mockChannelGraphTimeSeries struct {
 
 	updateReq  chan lnwire.ShortChannelID
 	updateResp chan []*lnwire.ChannelUpdate
+
+	updateInfoReq  chan []lnwire.ShortChannelID
+	updateInfoResp chan chanUpdateInfoResp
 }
 
 func newMockChannelGraphTimeSeries(
@@ -68,6 +75,9 @@ func newMockChannelGraphTimeSeries(
 
 		updateReq:  make(chan lnwire.ShortChannelID, 1),
 		updateResp: make(chan []*lnwire.ChannelUpdate, 1),
+
+		updateInfoReq:  make(chan []lnwire.ShortChannelID, 1),
+		updateInfoResp: make(chan chanUpdateInfoResp, 1),
 	}
 }
 
@@ -111,6 +121,15 @@ func (m *mockChannelGraphTimeSeries) FetchChanUpdates(chain chainhash.Hash,
 
 	return <-m.updateResp, nil
 }
+func (m *mockChannelGraphTimeSeries) ChanUpdateInfo(chain chainhash.Hash,
+	shortChanIDs []lnwire.ShortChannelID) ([]lnwire.ChanUpdateTimestamps,
+	[]lnwire.ChanUpdateChecksums, error) {
+
+	m.updateInfoReq <- shortChanIDs
+
+	resp := <-m.updateInfoResp
+	return resp.timestamps, resp.checksums, nil
+}
 
 var _ ChannelGraphTimeSeries = (*mockChannelGraphTimeSeries)(nil)
 
@@ -866,6 +885,174 @@ func TestGossipSyncerProcessChanRangeReply(t *testing.T) {
 	}
 }
 
+// TestGossipSyncerReplyChanRangeQueryExtended tests that if the remote peer
+// requests the timestamps and checksums of each channel within its channel
+// range query, then we'll include them within our replies.
+func TestGossipSyncerReplyChanRangeQueryExtended(t *testing.T) {
+	t.Parallel()
+
+	msgChan, syncer, chanSeries := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding,
+		defaultChunkSize,
+	)
+
+	query := &lnwire.QueryChannelRange{
+		FirstBlockHeight: 100,
+		NumBlocks:        50,
+		QueryOptions:     lnwire.QueryOptionTimestamps,
+	}
+
+	chanIDs := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+		lnwire.NewShortChanIDFromInt(2),
+	}
+	timestamps := []lnwire.ChanUpdateTimestamps{
+		{Timestamp1: 1, Timestamp2: 2},
+		{Timestamp1: 3},
+	}
+	checksums := []lnwire.ChanUpdateChecksums{
+		{Checksum1: 4, Checksum2: 5},
+		{Checksum1: 6},
+	}
+	go func() {
+		select {
+		case <-time.After(time.Second * 15):
+			t.Fatalf("no filter range query recvd")
+		case <-chanSeries.filterRangeReqs:
+			chanSeries.filterRangeResp <- chanIDs
+		}
+
+		select {
+		case <-time.After(time.Second * 15):
+			t.Fatalf("no update info query recvd")
+		case req := <-chanSeries.updateInfoReq:
+			if !reflect.DeepEqual(chanIDs, req) {
+				t.Fatalf("wrong request: expected %v, got %v",
+					chanIDs, req)
+			}
+			chanSeries.updateInfoResp <- chanUpdateInfoResp{
+				timestamps: timestamps,
+				checksums:  checksums,
+			}
+		}
+	}()
+
+	if err := syncer.replyChanRangeQuery(query); err != nil {
+		t.Fatalf("unable to issue query: %v", err)
+	}
+
+	select {
+	case <-time.After(time.Second * 15):
+		t.Fatalf("no msgs received")
+
+	case msg := <-msgChan:
+		rangeResp, ok := msg[0].(*lnwire.ReplyChannelRange)
+		if !ok {
+			t.Fatalf("expected ReplyChannelRange instead got %T",
+				msg[0])
+		}
+
+		// Only the timestamps were requested, so the checksums
+		// shouldn't be included.
+		if !reflect.DeepEqual(rangeResp.Timestamps, timestamps) {
+			t.Fatalf("expected timestamps %v, got %v",
+				spew.Sdump(timestamps),
+				spew.Sdump(rangeResp.Timestamps))
+		}
+		if len(rangeResp.Checksums) != 0 {
+			t.Fatalf("expected no checksums, got %v",
+				spew.Sdump(rangeResp.Checksums))
+		}
+		if rangeResp.QueryOptions != 0 {
+			t.Fatalf("query options shouldn't be included in reply")
+		}
+	}
+}
+
+// TestGossipSyncerProcessChanRangeReplyExtended tests that if the remote peer
+// includes the timestamps and checksums of each channel within its replies,
+// then we'll also query for the known channels whose policies have changed.
+func TestGossipSyncerProcessChanRangeReplyExtended(t *testing.T) {
+	t.Parallel()
+
+	_, syncer, chanSeries := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding,
+		defaultChunkSize,
+	)
+
+	// We'll know of all channels except for the first one. The second
+	// channel has a newer update with a different policy, the third one
+	// only has a newer keep-alive update, and the fourth one is entirely
+	// up to date.
+	chanIDs := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+		lnwire.NewShortChanIDFromInt(2),
+		lnwire.NewShortChanIDFromInt(3),
+		lnwire.NewShortChanIDFromInt(4),
+	}
+	reply := &lnwire.ReplyChannelRange{
+		Complete:     1,
+		ShortChanIDs: chanIDs,
+		Timestamps: []lnwire.ChanUpdateTimestamps{
+			{Timestamp1: 10},
+			{Timestamp1: 10, Timestamp2: 20},
+			{Timestamp1: 20},
+			{Timestamp1: 10},
+		},
+		Checksums: []lnwire.ChanUpdateChecksums{
+			{Checksum1: 1},
+			{Checksum1: 1, Checksum2: 2},
+			{Checksum1: 1},
+			{Checksum1: 1},
+		},
+	}
+
+	go func() {
+		select {
+		case <-time.After(time.Second * 15):
+			t.Fatalf("no filter query recvd")
+		case <-chanSeries.filterReq:
+			chanSeries.filterResp <- chanIDs[:1]
+		}
+
+		select {
+		case <-time.After(time.Second * 15):
+			t.Fatalf("no update info query recvd")
+		case req := <-chanSeries.updateInfoReq:
+			if !reflect.DeepEqual(chanIDs[1:], req) {
+				t.Fatalf("wrong request: expected %v, got %v",
+					chanIDs[1:], req)
+			}
+			chanSeries.updateInfoResp <- chanUpdateInfoResp{
+				timestamps: []lnwire.ChanUpdateTimestamps{
+					{Timestamp1: 10, Timestamp2: 10},
+					{Timestamp1: 10},
+					{Timestamp1: 10},
+				},
+				checksums: []lnwire.ChanUpdateChecksums{
+					{Checksum1: 1, Checksum2: 1},
+					{Checksum1: 1},
+					{Checksum1: 1},
+				},
+			}
+		}
+	}()
+
+	if err := syncer.processChanRangeReply(reply); err != nil {
+		t.Fatalf("unable to process reply: %v", err)
+	}
+
+	if syncer.SyncState() != queryNewChannels {
+		t.Fatalf("wrong state: expected %v instead got %v",
+			queryNewChannels, syncer.state)
+	}
+	expectedChans := chanIDs[:2]
+	if !reflect.DeepEqual(syncer.newChansToQuery, expectedChans) {
+		t.Fatalf("wrong set of chans to query: expected %v, got %v",
+			expectedChans, syncer.newChansToQuery)
+	}
+}
+
 // TestGossipSyncerSynchronizeChanIDs tests that we properly request chunks of
 // the short chan ID's which were unknown to us. We'll ensure that we request
 // chunk by chunk, and after the last chunk, we return true indicating that we
@@ -0,0 +1,435 @@
+package feemanager
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultMaxDecisions is the default number of fee decisions we'll
+	// retain for each channel.
+	DefaultMaxDecisions = 20
+)
+
+// ChannelSnapshot is the view of a channel that the fee manager uses to
+// decide on the channel's fees.
+type ChannelSnapshot struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Capacity is the total capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our current balance within the channel.
+	LocalBalance lnwire.MilliSatoshi
+
+	// BaseFee is the base fee currently advertised for the channel.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee, expressed in millionths, currently
+	// advertised for the channel.
+	FeeRate uint32
+
+	// TimeLockDelta is the time lock delta currently advertised for the
+	// channel. It's carried over unmodified when the fees are updated.
+	TimeLockDelta uint32
+}
+
+// Decision records the outcome of a single fee evaluation for a channel.
+type Decision struct {
+	// ChanPoint is the funding outpoint of the evaluated channel.
+	ChanPoint wire.OutPoint
+
+	// Timestamp is the time the decision was made.
+	Timestamp time.Time
+
+	// LocalRatio is the fraction of the channel's capacity that was on our
+	// side at the time of the decision.
+	LocalRatio float64
+
+	// ForwardVolume is the amount forwarded out over the channel within
+	// the forwarding window.
+	ForwardVolume lnwire.MilliSatoshi
+
+	// OldBaseFee is the base fee before the decision.
+	OldBaseFee lnwire.MilliSatoshi
+
+	// OldFeeRate is the fee rate before the decision.
+	OldFeeRate uint32
+
+	// NewBaseFee is the base fee computed for the channel.
+	NewBaseFee lnwire.MilliSatoshi
+
+	// NewFeeRate is the fee rate computed for the channel.
+	NewFeeRate uint32
+
+	// Applied is true if the new fees were applied to the channel.
+	Applied bool
+
+	// Reason is a human readable explanation of the decision.
+	Reason string
+}
+
+// String returns a human readable version of the decision.
+func (d *Decision) String() string {
+	return fmt.Sprintf("chan_point=%v, local_ratio=%.2f, fwd_volume=%v, "+
+		"base_fee=%v->%v, fee_rate=%v->%v, applied=%v: %v",
+		d.ChanPoint, d.LocalRatio, d.ForwardVolume, d.OldBaseFee,
+		d.NewBaseFee, d.OldFeeRate, d.NewFeeRate, d.Applied, d.Reason)
+}
+
+// Config houses all the items that the fee manager needs to carry out its
+// duties.
+type Config struct {
+	// Bounds are the limits within which the fee manager will set the
+	// fees of our channels.
+	Bounds FeeBounds
+
+	// Ticker fires each time the fees of our channels should be
+	// re-evaluated.
+	Ticker ticker.Ticker
+
+	// ForwardingWindow is how far back into the forwarding log we'll look
+	// when determining a channel's recent forwarding volume.
+	ForwardingWindow time.Duration
+
+	// MinUpdateInterval is the minimum amount of time that must pass
+	// before the fees of a channel are updated again. This prevents us
+	// from spamming the network with channel updates.
+	MinUpdateInterval time.Duration
+
+	// UpdateThreshold is the minimum relative change of either the base
+	// fee or fee rate required for an update to be applied.
+	UpdateThreshold float64
+
+	// MaxUpdatesPerInterval is the maximum number of channels whose fees
+	// will be updated within a single evaluation round. A value of zero
+	// means there is no limit.
+	MaxUpdatesPerInterval uint32
+
+	// MaxDecisions is the maximum number of decisions we'll retain for
+	// each channel.
+	MaxDecisions int
+
+	// FetchChannels returns a snapshot of all channels whose fees should
+	// be managed.
+	FetchChannels func() ([]*ChannelSnapshot, error)
+
+	// ForwardingEvents returns all forwarding events that occurred within
+	// the passed time range.
+	ForwardingEvents func(start, end time.Time) ([]channeldb.ForwardingEvent,
+		error)
+
+	// UpdatePolicy applies and propagates the new policy for the target
+	// channel.
+	UpdatePolicy func(chanPoint wire.OutPoint,
+		policy routing.ChannelPolicy) error
+
+	// Now returns the current time.
+	Now func() time.Time
+}
+
+// Manager is a subsystem that periodically re-evaluates the forwarding fees
+// of our channels based on their local balance and recent forwarding volume.
+// Any new fees are kept within the operator supplied bounds, and updates are
+// rate limited in order to not spam the network with channel updates.
+type Manager struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *Config
+
+	// lastUpdate tracks the last time we updated the fees of each channel.
+	// It's only accessed by the main evaluation goroutine.
+	lastUpdate map[wire.OutPoint]time.Time
+
+	// decisions is the set of recent decisions made for each channel.
+	decisions   map[wire.OutPoint][]*Decision
+	decisionMtx sync.RWMutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a new fee manager backed by the passed config.
+func New(cfg *Config) (*Manager, error) {
+	if err := cfg.Bounds.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.MaxDecisions <= 0 {
+		cfg.MaxDecisions = DefaultMaxDecisions
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	return &Manager{
+		cfg:        cfg,
+		lastUpdate: make(map[wire.OutPoint]time.Time),
+		decisions:  make(map[wire.OutPoint][]*Decision),
+		quit:       make(chan struct{}),
+	}, nil
+}
+
+// Start launches the goroutine that periodically evaluates the fees of our
+// channels.
+func (m *Manager) Start() error {
+	if !atomic.CompareAndSwapUint32(&m.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Fee manager starting, bounds: base_fee=[%v, %v], "+
+		"fee_rate=[%v, %v]", m.cfg.Bounds.MinBaseFee,
+		m.cfg.Bounds.MaxBaseFee, m.cfg.Bounds.MinFeeRate,
+		m.cfg.Bounds.MaxFeeRate)
+
+	m.cfg.Ticker.Resume()
+
+	m.wg.Add(1)
+	go m.evaluator()
+
+	return nil
+}
+
+// Stop signals the fee manager to exit, and waits for it to do so.
+func (m *Manager) Stop() error {
+	if !atomic.CompareAndSwapUint32(&m.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Fee manager shutting down")
+
+	m.cfg.Ticker.Stop()
+
+	close(m.quit)
+	m.wg.Wait()
+
+	return nil
+}
+
+// evaluator is the main goroutine of the fee manager. Each time the ticker
+// fires, all channels will have their fees re-evaluated.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) evaluator() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.cfg.Ticker.Ticks():
+			if err := m.evaluateChannels(); err != nil {
+				log.Errorf("Unable to evaluate channel fees: "+
+					"%v", err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// evaluateChannels performs a single evaluation round over all of our
+// channels, updating the fees of those that warrant it.
+func (m *Manager) evaluateChannels() error {
+	channels, err := m.cfg.FetchChannels()
+	if err != nil {
+		return err
+	}
+
+	// Before evaluating each channel, we'll compute the amount that was
+	// forwarded out over each of them within the forwarding window.
+	now := m.cfg.Now()
+	events, err := m.cfg.ForwardingEvents(
+		now.Add(-m.cfg.ForwardingWindow), now,
+	)
+	if err != nil {
+		return err
+	}
+	volumes := make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
+	for _, event := range events {
+		volumes[event.OutgoingChanID] += event.AmtOut
+	}
+
+	// Any channels that are no longer returned have been closed, so
+	// we'll drop the state we track for them.
+	m.pruneClosedChannels(channels)
+
+	var numUpdates uint32
+	for _, channel := range channels {
+		decision := m.evaluateChannel(
+			channel, volumes[channel.ChanID], now, numUpdates,
+		)
+		if decision.Applied {
+			numUpdates++
+		}
+
+		log.Debugf("Fee decision: %v", decision)
+
+		m.recordDecision(decision)
+	}
+
+	return nil
+}
+
+// evaluateChannel computes the new fees for the channel, and applies them if
+// none of the rate limits prevent it from doing so.
+func (m *Manager) evaluateChannel(channel *ChannelSnapshot,
+	fwdVolume lnwire.MilliSatoshi, now time.Time,
+	numUpdates uint32) *Decision {
+
+	var localRatio float64
+	capMSat := lnwire.NewMSatFromSatoshis(channel.Capacity)
+	if capMSat > 0 {
+		localRatio = float64(channel.LocalBalance) / float64(capMSat)
+	}
+
+	score := channelScore(localRatio, fwdVolume, channel.Capacity)
+	baseFee, feeRate := computeFees(score, m.cfg.Bounds)
+
+	decision := &Decision{
+		ChanPoint:     channel.ChanPoint,
+		Timestamp:     now,
+		LocalRatio:    localRatio,
+		ForwardVolume: fwdVolume,
+		OldBaseFee:    channel.BaseFee,
+		OldFeeRate:    channel.FeeRate,
+		NewBaseFee:    baseFee,
+		NewFeeRate:    feeRate,
+	}
+
+	// If the change in fees is too small to be worth announcing, we'll
+	// leave the channel as is.
+	baseFeeChanged := significantChange(
+		uint64(channel.BaseFee), uint64(baseFee),
+		m.cfg.UpdateThreshold,
+	)
+	feeRateChanged := significantChange(
+		uint64(channel.FeeRate), uint64(feeRate),
+		m.cfg.UpdateThreshold,
+	)
+	if !baseFeeChanged && !feeRateChanged {
+		decision.Reason = "change below update threshold"
+		return decision
+	}
+
+	// Otherwise, we'll make sure we haven't updated this channel too
+	// recently.
+	lastUpdate, ok := m.lastUpdate[channel.ChanPoint]
+	if ok && now.Sub(lastUpdate) < m.cfg.MinUpdateInterval {
+		decision.Reason = fmt.Sprintf("rate limited, last update "+
+			"was at %v", lastUpdate)
+		return decision
+	}
+
+	// We'll also ensure that we don't exceed the number of updates we're
+	// allowed to send out within a single round.
+	if m.cfg.MaxUpdatesPerInterval != 0 &&
+		numUpdates >= m.cfg.MaxUpdatesPerInterval {
+
+		decision.Reason = "max updates per interval reached"
+		return decision
+	}
+
+	policy := routing.ChannelPolicy{
+		FeeSchema: routing.FeeSchema{
+			BaseFee: baseFee,
+			FeeRate: feeRate,
+		},
+		TimeLockDelta: channel.TimeLockDelta,
+	}
+	if err := m.cfg.UpdatePolicy(channel.ChanPoint, policy); err != nil {
+		decision.Reason = fmt.Sprintf("unable to update policy: %v",
+			err)
+		return decision
+	}
+
+	m.lastUpdate[channel.ChanPoint] = now
+
+	log.Infof("Updated fees for ChannelPoint(%v): base_fee=%v->%v, "+
+		"fee_rate=%v->%v", channel.ChanPoint, channel.BaseFee, baseFee,
+		channel.FeeRate, feeRate)
+
+	decision.Applied = true
+	decision.Reason = "fees updated"
+	return decision
+}
+
+// pruneClosedChannels removes the last update time and decision history of
+// all channels that aren't part of the passed set of open channels.
+func (m *Manager) pruneClosedChannels(channels []*ChannelSnapshot) {
+	openChans := make(map[wire.OutPoint]struct{}, len(channels))
+	for _, channel := range channels {
+		openChans[channel.ChanPoint] = struct{}{}
+	}
+
+	for chanPoint := range m.lastUpdate {
+		if _, ok := openChans[chanPoint]; !ok {
+			delete(m.lastUpdate, chanPoint)
+		}
+	}
+
+	m.decisionMtx.Lock()
+	defer m.decisionMtx.Unlock()
+
+	for chanPoint := range m.decisions {
+		if _, ok := openChans[chanPoint]; !ok {
+			log.Debugf("Dropping fee decisions of closed "+
+				"channel %v", chanPoint)
+			delete(m.decisions, chanPoint)
+		}
+	}
+}
+
+// recordDecision adds the decision to the channel's history, evicting the
+// oldest decision if the history is full.
+func (m *Manager) recordDecision(decision *Decision) {
+	m.decisionMtx.Lock()
+	defer m.decisionMtx.Unlock()
+
+	history := append(m.decisions[decision.ChanPoint], decision)
+	if len(history) > m.cfg.MaxDecisions {
+		history = history[len(history)-m.cfg.MaxDecisions:]
+	}
+	m.decisions[decision.ChanPoint] = history
+}
+
+// Decisions returns the recent fee decisions made by the fee manager. If a
+// channel point is passed, then only the decisions for that channel will be
+// returned.
+func (m *Manager) Decisions(chanPoint *wire.OutPoint) []*Decision {
+	m.decisionMtx.RLock()
+	defer m.decisionMtx.RUnlock()
+
+	if chanPoint != nil {
+		history := m.decisions[*chanPoint]
+		decisions := make([]*Decision, len(history))
+		copy(decisions, history)
+		return decisions
+	}
+
+	var decisions []*Decision
+	for _, history := range m.decisions {
+		decisions = append(decisions, history...)
+	}
+
+	// As the decisions were collected from a map, we'll sort them by their
+	// timestamp to present them in a stable order.
+	sort.SliceStable(decisions, func(i, j int) bool {
+		return decisions[i].Timestamp.Before(decisions[j].Timestamp)
+	})
+
+	return decisions
+}
@@ -0,0 +1,355 @@
+package feemanager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+type policyUpdate struct {
+	chanPoint wire.OutPoint
+	policy    routing.ChannelPolicy
+}
+
+type managerHarness struct {
+	t *testing.T
+
+	manager *Manager
+	ticker  *ticker.Mock
+
+	channels []*ChannelSnapshot
+	events   []channeldb.ForwardingEvent
+	now      time.Time
+
+	updateErr error
+	updates   chan policyUpdate
+}
+
+func newManagerHarness(t *testing.T, cfg Config) *managerHarness {
+	h := &managerHarness{
+		t:       t,
+		ticker:  ticker.MockNew(time.Hour),
+		now:     time.Unix(1000000, 0),
+		updates: make(chan policyUpdate, 10),
+	}
+
+	cfg.Bounds = testBounds
+	cfg.Ticker = h.ticker
+	cfg.ForwardingWindow = time.Hour
+	cfg.FetchChannels = func() ([]*ChannelSnapshot, error) {
+		return h.channels, nil
+	}
+	cfg.ForwardingEvents = func(start,
+		end time.Time) ([]channeldb.ForwardingEvent, error) {
+
+		var events []channeldb.ForwardingEvent
+		for _, event := range h.events {
+			if event.Timestamp.Before(start) ||
+				event.Timestamp.After(end) {
+
+				continue
+			}
+			events = append(events, event)
+		}
+		return events, nil
+	}
+	cfg.UpdatePolicy = func(chanPoint wire.OutPoint,
+		policy routing.ChannelPolicy) error {
+
+		if h.updateErr != nil {
+			return h.updateErr
+		}
+		h.updates <- policyUpdate{chanPoint, policy}
+		return nil
+	}
+	cfg.Now = func() time.Time {
+		return h.now
+	}
+
+	manager, err := New(&cfg)
+	if err != nil {
+		t.Fatalf("unable to create fee manager: %v", err)
+	}
+	h.manager = manager
+
+	return h
+}
+
+func (h *managerHarness) assertUpdate(chanPoint wire.OutPoint,
+	baseFee lnwire.MilliSatoshi, feeRate uint32) {
+
+	h.t.Helper()
+
+	select {
+	case update := <-h.updates:
+		if update.chanPoint != chanPoint {
+			h.t.Fatalf("expected update for %v, got %v",
+				chanPoint, update.chanPoint)
+		}
+		if update.policy.BaseFee != baseFee {
+			h.t.Fatalf("expected base fee %v, got %v", baseFee,
+				update.policy.BaseFee)
+		}
+		if update.policy.FeeRate != feeRate {
+			h.t.Fatalf("expected fee rate %v, got %v", feeRate,
+				update.policy.FeeRate)
+		}
+	case <-time.After(5 * time.Second):
+		h.t.Fatalf("expected policy update for %v", chanPoint)
+	}
+}
+
+func (h *managerHarness) assertNoUpdate() {
+	h.t.Helper()
+
+	select {
+	case update := <-h.updates:
+		h.t.Fatalf("unexpected policy update: %v", update)
+	default:
+	}
+}
+
+func (h *managerHarness) evaluate() {
+	h.t.Helper()
+
+	if err := h.manager.evaluateChannels(); err != nil {
+		h.t.Fatalf("unable to evaluate channels: %v", err)
+	}
+}
+
+func testChannel(index uint32, localRatio float64) *ChannelSnapshot {
+	const capacity = 100000
+	capMSat := lnwire.NewMSatFromSatoshis(capacity)
+
+	return &ChannelSnapshot{
+		ChanPoint:     wire.OutPoint{Index: index},
+		ChanID:        lnwire.NewShortChanIDFromInt(uint64(index)),
+		Capacity:      capacity,
+		LocalBalance:  lnwire.MilliSatoshi(localRatio * float64(capMSat)),
+		BaseFee:       1000,
+		FeeRate:       1,
+		TimeLockDelta: 144,
+	}
+}
+
+// TestManagerTick ensures that the fee manager evaluates our channels once its
+// ticker fires, and that the time lock delta of each channel is preserved.
+func TestManagerTick(t *testing.T) {
+	t.Parallel()
+
+	h := newManagerHarness(t, Config{})
+	h.channels = []*ChannelSnapshot{testChannel(0, 0)}
+
+	if err := h.manager.Start(); err != nil {
+		t.Fatalf("unable to start fee manager: %v", err)
+	}
+	defer h.manager.Stop()
+
+	select {
+	case h.ticker.Force <- h.now:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("fee manager didn't consume tick")
+	}
+
+	// The channel has no local balance left, so it should have its fee
+	// rate raised half way to the maximum.
+	select {
+	case update := <-h.updates:
+		if update.policy.FeeRate != 501 {
+			t.Fatalf("expected fee rate 501, got %v",
+				update.policy.FeeRate)
+		}
+		if update.policy.TimeLockDelta != 144 {
+			t.Fatalf("expected time lock delta 144, got %v",
+				update.policy.TimeLockDelta)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected policy update")
+	}
+}
+
+// TestManagerForwardingVolume ensures that only forwarding events within the
+// forwarding window contribute to a channel's fees.
+func TestManagerForwardingVolume(t *testing.T) {
+	t.Parallel()
+
+	h := newManagerHarness(t, Config{})
+	channel := testChannel(0, 1)
+	h.channels = []*ChannelSnapshot{channel}
+
+	capMSat := lnwire.NewMSatFromSatoshis(channel.Capacity)
+	h.events = []channeldb.ForwardingEvent{
+		{
+			Timestamp:      h.now.Add(-time.Minute),
+			OutgoingChanID: channel.ChanID,
+			AmtOut:         capMSat / 2,
+		},
+		{
+			Timestamp:      h.now.Add(-2 * time.Hour),
+			OutgoingChanID: channel.ChanID,
+			AmtOut:         capMSat / 2,
+		},
+	}
+
+	// Only the first event falls within the forwarding window, so the
+	// channel's fees should be raised a quarter of the way.
+	h.evaluate()
+	h.assertUpdate(channel.ChanPoint, 500, 251)
+
+	decisions := h.manager.Decisions(&channel.ChanPoint)
+	if len(decisions) != 1 {
+		t.Fatalf("expected 1 decision, got %v", len(decisions))
+	}
+	if decisions[0].ForwardVolume != capMSat/2 {
+		t.Fatalf("expected forward volume %v, got %v", capMSat/2,
+			decisions[0].ForwardVolume)
+	}
+}
+
+// TestManagerRateLimit ensures that the fee manager doesn't update the fees
+// of a channel more often than permitted, and skips updates that are too
+// small to be worth announcing.
+func TestManagerRateLimit(t *testing.T) {
+	t.Parallel()
+
+	h := newManagerHarness(t, Config{
+		MinUpdateInterval: time.Hour,
+		UpdateThreshold:   0.1,
+	})
+	channel := testChannel(0, 0)
+	h.channels = []*ChannelSnapshot{channel}
+
+	// The initial evaluation should result in an update.
+	h.evaluate()
+	h.assertUpdate(channel.ChanPoint, 1000, 501)
+
+	// Once the balance shifts, another evaluation within the min update
+	// interval shouldn't result in an update.
+	h.channels = []*ChannelSnapshot{testChannel(0, 1)}
+	h.now = h.now.Add(time.Minute)
+	h.evaluate()
+	h.assertNoUpdate()
+
+	// After the min update interval has passed, the update should go
+	// through.
+	h.now = h.now.Add(time.Hour)
+	h.evaluate()
+	h.assertUpdate(channel.ChanPoint, 0, 1)
+
+	// Finally, a change below the update threshold should be skipped even
+	// though the channel is no longer rate limited.
+	h.now = h.now.Add(2 * time.Hour)
+	current := testChannel(0, 0.95)
+	current.BaseFee = 50
+	current.FeeRate = 25
+	h.channels = []*ChannelSnapshot{current}
+	h.evaluate()
+	h.assertNoUpdate()
+
+	decisions := h.manager.Decisions(&channel.ChanPoint)
+	if len(decisions) != 4 {
+		t.Fatalf("expected 4 decisions, got %v", len(decisions))
+	}
+	for i, applied := range []bool{true, false, true, false} {
+		if decisions[i].Applied != applied {
+			t.Fatalf("expected decision %d to have applied=%v: %v",
+				i, applied, decisions[i])
+		}
+	}
+}
+
+// TestManagerMaxUpdatesPerInterval ensures that no more than the configured
+// number of channels are updated within a single round.
+func TestManagerMaxUpdatesPerInterval(t *testing.T) {
+	t.Parallel()
+
+	h := newManagerHarness(t, Config{
+		MaxUpdatesPerInterval: 1,
+	})
+	h.channels = []*ChannelSnapshot{
+		testChannel(0, 0), testChannel(1, 0),
+	}
+
+	h.evaluate()
+	h.assertUpdate(h.channels[0].ChanPoint, 1000, 501)
+	h.assertNoUpdate()
+
+	// The skipped channel should be updated within the next round.
+	h.channels = h.channels[1:]
+	h.evaluate()
+	h.assertUpdate(h.channels[0].ChanPoint, 1000, 501)
+}
+
+// TestManagerDecisionHistory ensures that failed updates are recorded, and
+// that the decision history of each channel is bounded.
+func TestManagerDecisionHistory(t *testing.T) {
+	t.Parallel()
+
+	h := newManagerHarness(t, Config{
+		MaxDecisions: 2,
+	})
+	channel := testChannel(0, 0)
+	h.channels = []*ChannelSnapshot{channel}
+	h.updateErr = errors.New("update failed")
+
+	for i := 0; i < 3; i++ {
+		h.now = h.now.Add(time.Minute)
+		h.evaluate()
+	}
+
+	decisions := h.manager.Decisions(nil)
+	if len(decisions) != 2 {
+		t.Fatalf("expected 2 decisions, got %v", len(decisions))
+	}
+	for _, decision := range decisions {
+		if decision.Applied {
+			t.Fatalf("decision shouldn't have been applied: %v",
+				decision)
+		}
+	}
+	if !decisions[1].Timestamp.Equal(h.now) {
+		t.Fatalf("expected latest decision at %v, got %v", h.now,
+			decisions[1].Timestamp)
+	}
+}
+
+// TestManagerPruneClosedChannels ensures that the state tracked for channels
+// that have been closed is dropped on the next evaluation round.
+func TestManagerPruneClosedChannels(t *testing.T) {
+	t.Parallel()
+
+	h := newManagerHarness(t, Config{})
+	openChannel := testChannel(0, 0)
+	closedChannel := testChannel(1, 0)
+	h.channels = []*ChannelSnapshot{openChannel, closedChannel}
+
+	h.evaluate()
+	if len(h.manager.Decisions(&closedChannel.ChanPoint)) != 1 {
+		t.Fatalf("expected decision for %v", closedChannel.ChanPoint)
+	}
+
+	// Once the channel is no longer returned as one of our open channels,
+	// its decisions should be removed.
+	h.channels = []*ChannelSnapshot{openChannel}
+	h.now = h.now.Add(time.Minute)
+	h.evaluate()
+
+	if len(h.manager.Decisions(&closedChannel.ChanPoint)) != 0 {
+		t.Fatalf("expected decisions of %v to be dropped",
+			closedChannel.ChanPoint)
+	}
+	if _, ok := h.manager.lastUpdate[closedChannel.ChanPoint]; ok {
+		t.Fatalf("expected last update of %v to be dropped",
+			closedChannel.ChanPoint)
+	}
+	if len(h.manager.Decisions(&openChannel.ChanPoint)) != 2 {
+		t.Fatalf("expected 2 decisions for %v",
+			openChannel.ChanPoint)
+	}
+}
@@ -0,0 +1,108 @@
+package feemanager
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// FeeBounds is the set of operator supplied limits within which the fee
+// manager is allowed to set a channel's forwarding fees.
+type FeeBounds struct {
+	// MinBaseFee is the lowest base fee the fee manager will set.
+	MinBaseFee lnwire.MilliSatoshi
+
+	// MaxBaseFee is the highest base fee the fee manager will set.
+	MaxBaseFee lnwire.MilliSatoshi
+
+	// MinFeeRate is the lowest proportional fee, expressed in millionths
+	// of the forwarded amount, the fee manager will set.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the highest proportional fee, expressed in millionths
+	// of the forwarded amount, the fee manager will set.
+	MaxFeeRate uint32
+}
+
+// Validate ensures that the lower bounds of the set aren't greater than their
+// upper counterparts.
+func (b *FeeBounds) Validate() error {
+	if b.MinBaseFee > b.MaxBaseFee {
+		return fmt.Errorf("min base fee (%v) is greater than max base "+
+			"fee (%v)", b.MinBaseFee, b.MaxBaseFee)
+	}
+	if b.MinFeeRate > b.MaxFeeRate {
+		return fmt.Errorf("min fee rate (%v) is greater than max fee "+
+			"rate (%v)", b.MinFeeRate, b.MaxFeeRate)
+	}
+
+	return nil
+}
+
+// channelScore computes a score within the range [0, 1] for a channel, which
+// is used to interpolate the channel's fees between the configured bounds. A
+// channel that has little local balance left is scarce liquidity, so we'll
+// charge more to forward over it. The same applies to channels that have
+// recently forwarded a large volume relative to their capacity, as this
+// signals demand for the channel. Both factors are weighted equally.
+func channelScore(localRatio float64, fwdVolume lnwire.MilliSatoshi,
+	capacity btcutil.Amount) float64 {
+
+	balanceFactor := clamp(1-localRatio, 0, 1)
+
+	var volumeFactor float64
+	if capacity > 0 {
+		capMSat := lnwire.NewMSatFromSatoshis(capacity)
+		volumeFactor = clamp(float64(fwdVolume)/float64(capMSat), 0, 1)
+	}
+
+	return (balanceFactor + volumeFactor) / 2
+}
+
+// computeFees returns the base fee and fee rate for a channel with the given
+// score, interpolated linearly within the passed bounds.
+func computeFees(score float64, bounds FeeBounds) (lnwire.MilliSatoshi,
+	uint32) {
+
+	score = clamp(score, 0, 1)
+
+	baseFeeRange := float64(bounds.MaxBaseFee - bounds.MinBaseFee)
+	baseFee := bounds.MinBaseFee + lnwire.MilliSatoshi(score*baseFeeRange)
+
+	feeRateRange := float64(bounds.MaxFeeRate - bounds.MinFeeRate)
+	feeRate := bounds.MinFeeRate + uint32(score*feeRateRange)
+
+	return baseFee, feeRate
+}
+
+// significantChange returns true if the new value differs from the old one by
+// at least the passed relative threshold. A change from a zero value is
+// always considered significant.
+func significantChange(oldVal, newVal uint64, threshold float64) bool {
+	if oldVal == newVal {
+		return false
+	}
+	if oldVal == 0 {
+		return true
+	}
+
+	diff := float64(newVal) - float64(oldVal)
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff/float64(oldVal) >= threshold
+}
+
+// clamp restricts the passed value to the range [min, max].
+func clamp(v, min, max float64) float64 {
+	switch {
+	case v < min:
+		return min
+	case v > max:
+		return max
+	default:
+		return v
+	}
+}
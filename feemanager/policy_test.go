@@ -0,0 +1,129 @@
+package feemanager
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var testBounds = FeeBounds{
+	MinBaseFee: 0,
+	MaxBaseFee: 2000,
+	MinFeeRate: 1,
+	MaxFeeRate: 1001,
+}
+
+// TestFeeBoundsValidate ensures that inverted fee bounds are rejected.
+func TestFeeBoundsValidate(t *testing.T) {
+	t.Parallel()
+
+	if err := testBounds.Validate(); err != nil {
+		t.Fatalf("valid bounds rejected: %v", err)
+	}
+
+	invalidBase := testBounds
+	invalidBase.MinBaseFee = invalidBase.MaxBaseFee + 1
+	if err := invalidBase.Validate(); err == nil {
+		t.Fatalf("expected invalid base fee bounds to be rejected")
+	}
+
+	invalidRate := testBounds
+	invalidRate.MinFeeRate = invalidRate.MaxFeeRate + 1
+	if err := invalidRate.Validate(); err == nil {
+		t.Fatalf("expected invalid fee rate bounds to be rejected")
+	}
+}
+
+// TestComputeFees ensures that the fees of a channel are properly
+// interpolated within the bounds according to its local balance and
+// forwarding volume.
+func TestComputeFees(t *testing.T) {
+	t.Parallel()
+
+	const capacity = btcutil.Amount(100000)
+	capMSat := lnwire.NewMSatFromSatoshis(capacity)
+
+	tests := []struct {
+		name       string
+		localRatio float64
+		fwdVolume  lnwire.MilliSatoshi
+		baseFee    lnwire.MilliSatoshi
+		feeRate    uint32
+	}{
+		{
+			name:       "full local balance, no volume",
+			localRatio: 1,
+			baseFee:    testBounds.MinBaseFee,
+			feeRate:    testBounds.MinFeeRate,
+		},
+		{
+			name:       "empty local balance, full volume",
+			localRatio: 0,
+			fwdVolume:  capMSat,
+			baseFee:    testBounds.MaxBaseFee,
+			feeRate:    testBounds.MaxFeeRate,
+		},
+		{
+			name:       "empty local balance, no volume",
+			localRatio: 0,
+			baseFee:    1000,
+			feeRate:    501,
+		},
+		{
+			name:       "balanced, half volume",
+			localRatio: 0.5,
+			fwdVolume:  capMSat / 2,
+			baseFee:    1000,
+			feeRate:    501,
+		},
+		{
+			name:       "volume exceeding capacity is capped",
+			localRatio: 1,
+			fwdVolume:  capMSat * 10,
+			baseFee:    1000,
+			feeRate:    501,
+		},
+	}
+
+	for _, test := range tests {
+		score := channelScore(test.localRatio, test.fwdVolume, capacity)
+		baseFee, feeRate := computeFees(score, testBounds)
+		if baseFee != test.baseFee {
+			t.Fatalf("%v: expected base fee %v, got %v", test.name,
+				test.baseFee, baseFee)
+		}
+		if feeRate != test.feeRate {
+			t.Fatalf("%v: expected fee rate %v, got %v", test.name,
+				test.feeRate, feeRate)
+		}
+	}
+}
+
+// TestSignificantChange ensures that we only consider a fee change
+// significant once it exceeds the relative threshold.
+func TestSignificantChange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		oldVal, newVal uint64
+		threshold      float64
+		significant    bool
+	}{
+		{oldVal: 100, newVal: 100, threshold: 0, significant: false},
+		{oldVal: 0, newVal: 1, threshold: 0.5, significant: true},
+		{oldVal: 100, newVal: 104, threshold: 0.05, significant: false},
+		{oldVal: 100, newVal: 105, threshold: 0.05, significant: true},
+		{oldVal: 100, newVal: 90, threshold: 0.05, significant: true},
+	}
+
+	for i, test := range tests {
+		significant := significantChange(
+			test.oldVal, test.newVal, test.threshold,
+		)
+		if significant != test.significant {
+			t.Fatalf("test #%d: expected significant=%v, got %v",
+				i, test.significant, significant)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/feemanager"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// maxFwdEventsPerQuery is the number of forwarding events the fee
+	// manager will fetch from the forwarding log at once.
+	maxFwdEventsPerQuery = 50000
+)
+
+// initFeeManager creates a new fee manager that's backed by the passed server
+// and configured according to the passed fee manager config.
+func initFeeManager(s *server,
+	cfg *feeManagerConfig) (*feemanager.Manager, error) {
+
+	srvrLog.Infof("Instantiating fee manager with cfg: %v",
+		spew.Sdump(cfg))
+
+	return feemanager.New(&feemanager.Config{
+		Bounds: feemanager.FeeBounds{
+			MinBaseFee: cfg.MinBaseFee,
+			MaxBaseFee: cfg.MaxBaseFee,
+			MinFeeRate: cfg.MinFeeRate,
+			MaxFeeRate: cfg.MaxFeeRate,
+		},
+		Ticker:                ticker.New(cfg.Interval),
+		ForwardingWindow:      cfg.ForwardingWindow,
+		MinUpdateInterval:     cfg.MinUpdateInterval,
+		UpdateThreshold:       cfg.UpdateThreshold,
+		MaxUpdatesPerInterval: cfg.MaxUpdates,
+		FetchChannels: func() ([]*feemanager.ChannelSnapshot, error) {
+			return fetchFeeChannelSnapshots(s)
+		},
+		ForwardingEvents: func(start,
+			end time.Time) ([]channeldb.ForwardingEvent, error) {
+
+			return fetchForwardingEvents(s.chanDB, start, end)
+		},
+		UpdatePolicy: func(chanPoint wire.OutPoint,
+			policy routing.ChannelPolicy) error {
+
+			return s.updateChannelPolicy(policy, chanPoint)
+		},
+	})
+}
+
+// fetchFeeChannelSnapshots combines the set of open channels within the
+// database with our current routing policies within the graph to create a
+// snapshot of each channel for the fee manager.
+func fetchFeeChannelSnapshots(s *server) ([]*feemanager.ChannelSnapshot,
+	error) {
+
+	openChannels, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+	localBalances := make(map[wire.OutPoint]lnwire.MilliSatoshi)
+	for _, channel := range openChannels {
+		localCommit := channel.LocalCommitment
+		localBalances[channel.FundingOutpoint] = localCommit.LocalBalance
+	}
+
+	var snapshots []*feemanager.ChannelSnapshot
+	err = s.chanRouter.ForAllOutgoingChannels(func(
+		info *channeldb.ChannelEdgeInfo,
+		edge *channeldb.ChannelEdgePolicy) error {
+
+		// If we haven't yet advertised a policy for this channel, or
+		// it's no longer open, then we'll skip it.
+		localBalance, ok := localBalances[info.ChannelPoint]
+		if edge == nil || !ok {
+			return nil
+		}
+
+		snapshots = append(snapshots, &feemanager.ChannelSnapshot{
+			ChanPoint:     info.ChannelPoint,
+			ChanID:        lnwire.NewShortChanIDFromInt(info.ChannelID),
+			Capacity:      info.Capacity,
+			LocalBalance:  localBalance,
+			BaseFee:       edge.FeeBaseMSat,
+			FeeRate:       uint32(edge.FeeProportionalMillionths),
+			TimeLockDelta: uint32(edge.TimeLockDelta),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// fetchForwardingEvents returns all forwarding events within the forwarding
+// log that occurred within the passed time range.
+func fetchForwardingEvents(chanDB *channeldb.DB, start,
+	end time.Time) ([]channeldb.ForwardingEvent, error) {
+
+	var (
+		events      []channeldb.ForwardingEvent
+		indexOffset uint32
+	)
+	for {
+		timeSlice, err := chanDB.ForwardingLog().Query(
+			channeldb.ForwardingEventQuery{
+				StartTime:    start,
+				EndTime:      end,
+				IndexOffset:  indexOffset,
+				NumMaxEvents: maxFwdEventsPerQuery,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, timeSlice.ForwardingEvents...)
+
+		// If this query returned less than the max number of events,
+		// then we've reached the end of the time slice.
+		if len(timeSlice.ForwardingEvents) < maxFwdEventsPerQuery {
+			return events, nil
+		}
+		indexOffset = timeSlice.LastIndexOffset
+	}
+}
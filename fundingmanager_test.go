@@ -347,6 +347,8 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		ReportShortChanID: func(wire.OutPoint) error {
 			return nil
 		},
+		NotifyPendingOpenChannelEvent: func(*channeldb.OpenChannel) {},
+		NotifyOpenChannelEvent:        func(*channeldb.OpenChannel) {},
 		PublishTransaction: func(txn *wire.MsgTx) error {
 			publTxChan <- txn
 			return nil
@@ -436,8 +438,10 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 			publishChan <- txn
 			return nil
 		},
-		ZombieSweeperInterval: oldCfg.ZombieSweeperInterval,
-		ReservationTimeout:    oldCfg.ReservationTimeout,
+		ZombieSweeperInterval:         oldCfg.ZombieSweeperInterval,
+		ReservationTimeout:            oldCfg.ReservationTimeout,
+		NotifyPendingOpenChannelEvent: oldCfg.NotifyPendingOpenChannelEvent,
+		NotifyOpenChannelEvent:        oldCfg.NotifyOpenChannelEvent,
 	})
 	if err != nil {
 		t.Fatalf("failed recreating aliceFundingManager: %v", err)
@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -119,6 +119,11 @@ type CircuitMap interface {
 	// CommitCircuits.
 	NumPending() int
 
+	// NumPendingFrom returns the number of active circuits added by
+	// CommitCircuits whose incoming HTLC arrived over one of the passed
+	// channels.
+	NumPendingFrom(chanIDs ...lnwire.ShortChannelID) int
+
 	// NumOpen returns the number of circuits with HTLCs that have been
 	// forwarded via an outgoing link.
 	NumOpen() int
@@ -212,7 +217,7 @@ func NewCircuitMap(cfg *CircuitMapConfig) (CircuitMap, error) {
 // initBuckets ensures that the primary buckets used by the circuit are
 // initialized so that we can assume their existence after startup.
 func (cm *circuitMap) initBuckets() error {
-	return cm.cfg.DB.Update(func(tx *bbolt.Tx) error {
+	return cm.cfg.DB.Update(func(tx kvdb.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(circuitKeystoneKey)
 		if err != nil {
 			return err
@@ -237,7 +242,7 @@ func (cm *circuitMap) restoreMemState() error {
 		pending = make(map[CircuitKey]*PaymentCircuit)
 	)
 
-	if err := cm.cfg.DB.Update(func(tx *bbolt.Tx) error {
+	if err := cm.cfg.DB.Update(func(tx kvdb.Tx) error {
 		// Restore any of the circuits persisted in the circuit bucket
 		// back into memory.
 		circuitBkt := tx.Bucket(circuitAddKey)
@@ -462,7 +467,7 @@ func (cm *circuitMap) TrimOpenCircuits(chanID lnwire.ShortChannelID,
 		return nil
 	}
 
-	return cm.cfg.DB.Update(func(tx *bbolt.Tx) error {
+	return cm.cfg.DB.Update(func(tx kvdb.Tx) error {
 		keystoneBkt := tx.Bucket(circuitKeystoneKey)
 		if keystoneBkt == nil {
 			return ErrCorruptedCircuitMap
@@ -615,7 +620,7 @@ func (cm *circuitMap) CommitCircuits(circuits ...*PaymentCircuit) (
 	// Write the entire batch of circuits to the persistent circuit bucket
 	// using bolt's Batch write. This method must be called from multiple,
 	// distinct goroutines to have any impact on performance.
-	err := cm.cfg.DB.Batch(func(tx *bbolt.Tx) error {
+	err := cm.cfg.DB.Batch(func(tx kvdb.Tx) error {
 		circuitBkt := tx.Bucket(circuitAddKey)
 		if circuitBkt == nil {
 			return ErrCorruptedCircuitMap
@@ -705,7 +710,7 @@ func (cm *circuitMap) OpenCircuits(keystones ...Keystone) error {
 	}
 	cm.mtx.RUnlock()
 
-	err := cm.cfg.DB.Update(func(tx *bbolt.Tx) error {
+	err := cm.cfg.DB.Update(func(tx kvdb.Tx) error {
 		// Now, load the circuit bucket to which we will write the
 		// already serialized circuit.
 		keystoneBkt := tx.Bucket(circuitKeystoneKey)
@@ -846,7 +851,7 @@ func (cm *circuitMap) DeleteCircuits(inKeys ...CircuitKey) error {
 	}
 	cm.mtx.Unlock()
 
-	err := cm.cfg.DB.Batch(func(tx *bbolt.Tx) error {
+	err := cm.cfg.DB.Batch(func(tx kvdb.Tx) error {
 		for _, circuit := range removedCircuits {
 			// If this htlc made it to an outgoing link, load the
 			// keystone bucket from which we will remove the
@@ -934,6 +939,31 @@ func (cm *circuitMap) NumPending() int {
 	return len(cm.pending)
 }
 
+// NumPendingFrom returns the number of active circuits added by
+// CommitCircuits whose incoming HTLC arrived over one of the passed channels.
+func (cm *circuitMap) NumPendingFrom(chanIDs ...lnwire.ShortChannelID) int {
+	if len(chanIDs) == 0 {
+		return 0
+	}
+
+	incomingChans := make(map[lnwire.ShortChannelID]struct{}, len(chanIDs))
+	for _, chanID := range chanIDs {
+		incomingChans[chanID] = struct{}{}
+	}
+
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	var numPending int
+	for inKey := range cm.pending {
+		if _, ok := incomingChans[inKey.ChanID]; ok {
+			numPending++
+		}
+	}
+
+	return numPending
+}
+
 // NumOpen returns the number of circuits that have been opened by way of
 // setting their keystones. This is the number of HTLCs that are waiting for a
 // settle/fail response from a remote peer.
@@ -0,0 +1,246 @@
+package htlcswitch
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// HtlcEventType denotes the type of an HtlcEvent.
+type HtlcEventType uint8
+
+const (
+	// HtlcEventForward is emitted once an HTLC that arrived over one of our
+	// links has been added to the channel of its outgoing link.
+	HtlcEventForward HtlcEventType = iota
+
+	// HtlcEventSettle is emitted once a forwarded HTLC has been settled
+	// by the downstream peer.
+	HtlcEventSettle
+
+	// HtlcEventForwardFail is emitted once a forwarded HTLC has been
+	// failed by the downstream peer, or on-chain.
+	HtlcEventForwardFail
+
+	// HtlcEventLinkFail is emitted once an HTLC that arrived over one of
+	// our links has been failed by us, either by one of our links or by
+	// the switch itself.
+	HtlcEventLinkFail
+)
+
+// String returns a human readable string for the event type.
+func (t HtlcEventType) String() string {
+	switch t {
+	case HtlcEventForward:
+		return "forward"
+	case HtlcEventSettle:
+		return "settle"
+	case HtlcEventForwardFail:
+		return "forward fail"
+	case HtlcEventLinkFail:
+		return "link fail"
+	default:
+		return "unknown"
+	}
+}
+
+// HtlcEvent describes a change in the state of an HTLC that arrived over one
+// of our links.
+type HtlcEvent struct {
+	// Type is the type of the event.
+	Type HtlcEventType
+
+	// IncomingCircuit identifies the HTLC on its incoming channel.
+	IncomingCircuit CircuitKey
+
+	// OutgoingCircuit identifies the HTLC on its outgoing channel. This
+	// will be blank if the HTLC was never added to an outgoing channel.
+	OutgoingCircuit CircuitKey
+
+	// IncomingAmt is the value of the incoming HTLC.
+	IncomingAmt lnwire.MilliSatoshi
+
+	// OutgoingAmt is the value of the outgoing HTLC, if any.
+	OutgoingAmt lnwire.MilliSatoshi
+
+	// IncomingTimeout is the absolute timelock of the incoming HTLC. This
+	// is only known for forward and link fail events.
+	IncomingTimeout uint32
+
+	// OutgoingTimeout is the absolute timelock of the outgoing HTLC. This
+	// is only known for forward and link fail events.
+	OutgoingTimeout uint32
+
+	// Timestamp is the time at which the event occurred.
+	Timestamp time.Time
+
+	// FailureMessage is the failure sent back to the sender of the HTLC.
+	// This is only set for link fail events, as the failures of forward
+	// fail events are encrypted by the downstream peer.
+	FailureMessage lnwire.FailureMessage
+
+	// FailureDetail provides additional information on why the HTLC was
+	// failed. This is only set for link fail events.
+	FailureDetail FailureDetail
+}
+
+// HtlcEventClient represents an intent to receive notifications from the
+// switch regarding the HTLCs that arrive over our links.
+type HtlcEventClient struct {
+	// Events is a receive only channel over which new HTLC events will be
+	// sent.
+	Events <-chan *HtlcEvent
+
+	// Cancel is a function closure that should be executed when the client
+	// wishes to cancel their notification intent. Doing so allows the
+	// switch to free up resources.
+	Cancel func()
+}
+
+// htlcEventClient couples a client's notification channel with the queue used
+// to buffer its pending notifications.
+type htlcEventClient struct {
+	events    chan *HtlcEvent
+	ntfnQueue *queue.ConcurrentQueue
+
+	cancelled  uint32 // To be used atomically.
+	cancelChan chan struct{}
+}
+
+// htlcNotifier dispatches the HTLC events of the switch and its links to all
+// registered clients.
+type htlcNotifier struct {
+	// clientCounter is used to assign a unique ID to each client. It must
+	// be used atomically.
+	clientCounter uint64
+
+	clientMtx sync.RWMutex
+	clients   map[uint64]*htlcEventClient
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newHtlcNotifier creates a new htlc notifier that stops dispatching events
+// once the passed quit channel is closed.
+func newHtlcNotifier(quit chan struct{}) *htlcNotifier {
+	return &htlcNotifier{
+		clients: make(map[uint64]*htlcEventClient),
+		quit:    quit,
+	}
+}
+
+// subscribe registers a new client that will receive all subsequent events.
+func (n *htlcNotifier) subscribe() *HtlcEventClient {
+	clientID := atomic.AddUint64(&n.clientCounter, 1)
+
+	log.Debugf("New htlc event client subscription, client %v", clientID)
+
+	client := &htlcEventClient{
+		events:     make(chan *HtlcEvent),
+		ntfnQueue:  queue.NewConcurrentQueue(20),
+		cancelChan: make(chan struct{}),
+	}
+	client.ntfnQueue.Start()
+
+	// We'll launch a goroutine that proxies all events appended to the
+	// client's queue to its events channel.
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+
+		for {
+			select {
+			case ntfn := <-client.ntfnQueue.ChanOut():
+				select {
+				case client.events <- ntfn.(*HtlcEvent):
+				case <-client.cancelChan:
+					return
+				case <-n.quit:
+					return
+				}
+
+			case <-client.cancelChan:
+				return
+
+			case <-n.quit:
+				return
+			}
+		}
+	}()
+
+	n.clientMtx.Lock()
+	n.clients[clientID] = client
+	n.clientMtx.Unlock()
+
+	return &HtlcEventClient{
+		Events: client.events,
+		Cancel: func() {
+			if !atomic.CompareAndSwapUint32(&client.cancelled, 0, 1) {
+				return
+			}
+
+			n.clientMtx.Lock()
+			delete(n.clients, clientID)
+			n.clientMtx.Unlock()
+
+			close(client.cancelChan)
+			client.ntfnQueue.Stop()
+		},
+	}
+}
+
+// notify dispatches the passed event to all registered clients.
+func (n *htlcNotifier) notify(event *HtlcEvent) {
+	event.Timestamp = time.Now()
+
+	log.Tracef("Dispatching htlc event: %v", newLogClosure(func() string {
+		return spew.Sdump(event)
+	}))
+
+	n.clientMtx.RLock()
+	defer n.clientMtx.RUnlock()
+
+	for _, client := range n.clients {
+		select {
+		case client.ntfnQueue.ChanIn() <- event:
+		case <-client.cancelChan:
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// notifyLinkFail dispatches a link fail event for the HTLC identified by the
+// passed packet, which was failed with the given failure.
+func (n *htlcNotifier) notifyLinkFail(pkt *htlcPacket,
+	failure lnwire.FailureMessage, detail FailureDetail) {
+
+	n.notify(&HtlcEvent{
+		Type:            HtlcEventLinkFail,
+		IncomingCircuit: pkt.inKey(),
+		IncomingAmt:     pkt.incomingAmount,
+		OutgoingAmt:     pkt.amount,
+		IncomingTimeout: pkt.incomingTimeout,
+		OutgoingTimeout: pkt.outgoingTimeout,
+		FailureMessage:  failure,
+		FailureDetail:   detail,
+	})
+}
+
+// SubscribeHtlcEvents returns a new client which can be used by the caller to
+// receive notifications whenever an HTLC that arrived over one of our links is
+// forwarded, settled, or failed.
+func (s *Switch) SubscribeHtlcEvents() (*HtlcEventClient, error) {
+	select {
+	case <-s.quit:
+		return nil, ErrSwitchExiting
+	default:
+	}
+
+	return s.htlcNotifier.subscribe(), nil
+}
@@ -0,0 +1,425 @@
+package htlcswitch
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultInterceptTimeout is the default duration a forwarded HTLC
+	// will be held for the registered interceptor, after which it'll be
+	// resumed as if the interceptor had never seen it.
+	DefaultInterceptTimeout = 30 * time.Second
+)
+
+var (
+	// ErrInterceptorActive is returned when attempting to register a
+	// forward interceptor while another one is already registered.
+	ErrInterceptorActive = errors.New("forward interceptor already " +
+		"registered")
+
+	// ErrFwdNotHeld is returned when attempting to resolve a forward that
+	// is no longer held by the switch, either because it's already been
+	// resolved, or because its intercept timeout has expired.
+	ErrFwdNotHeld = errors.New("forward is not held by the switch")
+
+	// ErrPreimageMismatch is returned when attempting to settle an
+	// intercepted forward with a preimage that doesn't match its payment
+	// hash.
+	ErrPreimageMismatch = errors.New("preimage does not match payment " +
+		"hash")
+)
+
+// InterceptedPacket contains the details of an HTLC that was forwarded to the
+// switch and is being held for the registered interceptor.
+type InterceptedPacket struct {
+	// IncomingCircuit is the circuit key of the incoming HTLC.
+	IncomingCircuit CircuitKey
+
+	// OutgoingChanID is the channel that the sender requested the HTLC be
+	// forwarded over.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// Hash is the payment hash of the HTLC.
+	Hash [32]byte
+
+	// IncomingAmount is the value of the incoming HTLC.
+	IncomingAmount lnwire.MilliSatoshi
+
+	// OutgoingAmount is the value the sender requested be forwarded.
+	OutgoingAmount lnwire.MilliSatoshi
+
+	// IncomingExpiry is the absolute timelock of the incoming HTLC.
+	IncomingExpiry uint32
+
+	// OutgoingExpiry is the absolute timelock the sender requested the
+	// outgoing HTLC be set to.
+	OutgoingExpiry uint32
+}
+
+// InterceptedForward is a forwarded HTLC that's being held by the switch until
+// the registered interceptor decides how it should be resolved. Exactly one of
+// its resolution methods will succeed, any subsequent calls will return
+// ErrFwdNotHeld.
+type InterceptedForward interface {
+	// Packet returns the details of the intercepted HTLC.
+	Packet() InterceptedPacket
+
+	// Resume continues forwarding the HTLC as if it was never intercepted.
+	Resume() error
+
+	// Settle settles the incoming HTLC using the passed preimage, without
+	// forwarding it.
+	Settle(preimage [32]byte) error
+
+	// Fail fails the incoming HTLC back to the sender with the passed
+	// failure code, without forwarding it.
+	Fail(code lnwire.FailCode) error
+}
+
+// ForwardInterceptor is called by the switch for each HTLC that's to be
+// forwarded while the interceptor is registered. The interceptor MUST NOT
+// block, and is expected to resolve the passed forward asynchronously.
+type ForwardInterceptor func(InterceptedForward)
+
+// interceptedForward is the switch's implementation of the
+// InterceptedForward interface.
+type interceptedForward struct {
+	packet     *htlcPacket
+	htlcSwitch *Switch
+
+	// timer resumes the forward once the intercept timeout expires.
+	timer *time.Timer
+}
+
+// A compile time check to ensure interceptedForward implements the
+// InterceptedForward interface.
+var _ InterceptedForward = (*interceptedForward)(nil)
+
+// Packet returns the details of the intercepted HTLC.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Packet() InterceptedPacket {
+	htlc := f.packet.htlc.(*lnwire.UpdateAddHTLC)
+
+	return InterceptedPacket{
+		IncomingCircuit: f.packet.inKey(),
+		OutgoingChanID:  f.packet.outgoingChanID,
+		Hash:            htlc.PaymentHash,
+		IncomingAmount:  f.packet.incomingAmount,
+		OutgoingAmount:  htlc.Amount,
+		IncomingExpiry:  f.packet.incomingTimeout,
+		OutgoingExpiry:  htlc.Expiry,
+	}
+}
+
+// Resume continues forwarding the HTLC as if it was never intercepted.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Resume() error {
+	if !f.htlcSwitch.releaseForward(f, false) {
+		return ErrFwdNotHeld
+	}
+
+	// Any error encountered while forwarding the packet will result in it
+	// being failed back by the switch, so there's nothing left for us to
+	// do here.
+	if err := f.htlcSwitch.route(f.packet); err != nil {
+		log.Debugf("Unable to forward resumed htlc %v: %v",
+			f.packet.inKey(), err)
+	}
+
+	return nil
+}
+
+// Settle settles the incoming HTLC using the passed preimage, without
+// forwarding it.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Settle(preimage [32]byte) error {
+	htlc := f.packet.htlc.(*lnwire.UpdateAddHTLC)
+	if sha256.Sum256(preimage[:]) != htlc.PaymentHash {
+		return ErrPreimageMismatch
+	}
+
+	if !f.htlcSwitch.releaseForward(f, true) {
+		return ErrFwdNotHeld
+	}
+
+	return f.resolve(&lnwire.UpdateFulfillHTLC{
+		PaymentPreimage: preimage,
+	})
+}
+
+// Fail fails the incoming HTLC back to the sender with the passed failure
+// code, without forwarding it.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Fail(code lnwire.FailCode) error {
+	failure, err := f.failureMessage(code)
+	if err != nil {
+		return err
+	}
+
+	// Encrypt the failure so that the sender will be able to read it. As
+	// the failure originates from us, we use EncryptFirstHop.
+	reason, err := f.packet.obfuscator.EncryptFirstHop(failure)
+	if err != nil {
+		return fmt.Errorf("unable to obfuscate error: %v", err)
+	}
+
+	if !f.htlcSwitch.releaseForward(f, true) {
+		return ErrFwdNotHeld
+	}
+
+	err = f.resolve(&lnwire.UpdateFailHTLC{
+		Reason: reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	f.htlcSwitch.htlcNotifier.notifyLinkFail(
+		f.packet, failure, FailureDetailInterceptor,
+	)
+
+	return nil
+}
+
+// failureMessage creates the failure message for the passed failure code.
+// Only failure codes that don't carry any data specific to the HTLC are
+// supported.
+func (f *interceptedForward) failureMessage(
+	code lnwire.FailCode) (lnwire.FailureMessage, error) {
+
+	switch code {
+	case lnwire.CodeTemporaryChannelFailure:
+		update, err := f.htlcSwitch.cfg.FetchLastChannelUpdate(
+			f.packet.outgoingChanID,
+		)
+		if err != nil {
+			return &lnwire.FailTemporaryNodeFailure{}, nil
+		}
+		return lnwire.NewTemporaryChannelFailure(update), nil
+
+	case lnwire.CodeTemporaryNodeFailure:
+		return &lnwire.FailTemporaryNodeFailure{}, nil
+
+	case lnwire.CodePermanentNodeFailure:
+		return &lnwire.FailPermanentNodeFailure{}, nil
+
+	case lnwire.CodePermanentChannelFailure:
+		return &lnwire.FailPermanentChannelFailure{}, nil
+
+	case lnwire.CodeUnknownNextPeer:
+		return &lnwire.FailUnknownNextPeer{}, nil
+
+	case lnwire.CodeUnknownPaymentHash:
+		return &lnwire.FailUnknownPaymentHash{}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported failure code: %v", code)
+	}
+}
+
+// resolve delivers the passed settle or fail message back to the incoming link
+// of the intercepted HTLC.
+func (f *interceptedForward) resolve(htlc lnwire.Message) error {
+	pkt := &htlcPacket{
+		sourceRef:      f.packet.sourceRef,
+		incomingChanID: f.packet.incomingChanID,
+		incomingHTLCID: f.packet.incomingHTLCID,
+		circuit:        f.packet.circuit,
+		htlc:           htlc,
+	}
+
+	return f.htlcSwitch.mailOrchestrator.Deliver(pkt.incomingChanID, pkt)
+}
+
+// RegisterInterceptor registers the passed interceptor with the switch. Once
+// registered, all forwarded HTLCs will be held until the interceptor resolves
+// them, or the switch's intercept timeout expires. Only a single interceptor
+// can be registered at a time.
+func (s *Switch) RegisterInterceptor(interceptor ForwardInterceptor) error {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	if s.interceptor != nil {
+		return ErrInterceptorActive
+	}
+	s.interceptor = interceptor
+
+	// Any forwards that are already held were replayed after a restart
+	// before the interceptor was registered, so we'll offer them to it now.
+	held := make([]*interceptedForward, 0, len(s.heldForwards))
+	for _, fwd := range s.heldForwards {
+		held = append(held, fwd)
+	}
+
+	log.Infof("Forward interceptor registered, offering %d held forwards",
+		len(held))
+
+	// The interceptor is called without holding the mutex, as it may
+	// choose to resolve the forwards right away.
+	s.interceptorMtx.Unlock()
+	for _, fwd := range held {
+		interceptor(fwd)
+	}
+	s.interceptorMtx.Lock()
+
+	return nil
+}
+
+// HeldForward returns the forward with the given incoming circuit key if it's
+// currently being held by the switch. Forwards are no longer held once they've
+// been resolved, or their intercept timeout has expired.
+func (s *Switch) HeldForward(inKey CircuitKey) (InterceptedForward, bool) {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	fwd, ok := s.heldForwards[inKey]
+	if !ok {
+		return nil, false
+	}
+
+	return fwd, true
+}
+
+// UnregisterInterceptor removes the registered interceptor. Any forwards that
+// are still held for it will be resumed.
+func (s *Switch) UnregisterInterceptor() {
+	s.interceptorMtx.Lock()
+	s.interceptor = nil
+	held := make([]*interceptedForward, 0, len(s.heldForwards))
+	for _, fwd := range s.heldForwards {
+		held = append(held, fwd)
+	}
+	s.interceptorMtx.Unlock()
+
+	log.Infof("Forward interceptor unregistered, resuming %d held "+
+		"forwards", len(held))
+
+	for _, fwd := range held {
+		// The forward may have been resolved concurrently, in which
+		// case there's nothing left to do.
+		if err := fwd.Resume(); err != nil && err != ErrFwdNotHeld {
+			log.Errorf("Unable to resume forward %v: %v",
+				fwd.packet.inKey(), err)
+		}
+	}
+}
+
+// interceptForward hands the passed packet to the registered interceptor, if
+// any. True is returned if the packet is being held by the switch, in which
+// case the caller shouldn't forward it.
+//
+// Circuits of packets that are held while the switch shuts down will have
+// been persisted without a keystone. Once the incoming link replays the add
+// after a restart, the circuit map will instruct us to fail it back, in which
+// case replayed should be true. If an interceptor is registered by then, we'll
+// offer it the packet again rather than failing it back. As the interceptor is
+// usually registered only after the switch has started, replayed packets can
+// optionally be held for up to the intercept timeout after startup while no
+// interceptor is registered, and offered to it once it registers.
+func (s *Switch) interceptForward(packet *htlcPacket, replayed bool) bool {
+	// Payments originating from our node aren't forwards, so we'll never
+	// intercept them.
+	if packet.incomingChanID == sourceHop {
+		return false
+	}
+
+	s.interceptorMtx.Lock()
+
+	inKey := packet.inKey()
+	if _, ok := s.heldForwards[inKey]; ok {
+		s.interceptorMtx.Unlock()
+		return true
+	}
+
+	interceptor := s.interceptor
+	if interceptor == nil && (!replayed || !s.cfg.HoldReplayedForwards ||
+		time.Since(s.startTime) >= s.cfg.InterceptTimeout) {
+
+		s.interceptorMtx.Unlock()
+		return false
+	}
+
+	// If a replayed add has already been offered to the interceptor since
+	// startup, then it has either been resumed and is still on its way to
+	// the outgoing link, or it was resolved and the incoming link will
+	// process the resolution. In either case, we'll drop the duplicate.
+	if replayed {
+		if _, ok := s.replayedForwards[inKey]; ok {
+			s.interceptorMtx.Unlock()
+			return true
+		}
+		s.replayedForwards[inKey] = struct{}{}
+	}
+
+	fwd := &interceptedForward{
+		packet:     packet,
+		htlcSwitch: s,
+	}
+	s.heldForwards[inKey] = fwd
+
+	// To ensure a misbehaving interceptor can't cause HTLCs to be stuck
+	// until they expire, we'll resume the forward once the intercept
+	// timeout has passed.
+	fwd.timer = time.AfterFunc(s.cfg.InterceptTimeout, func() {
+		err := fwd.Resume()
+		if err == nil {
+			log.Warnf("Resumed forward %v after intercept "+
+				"timeout", inKey)
+		}
+	})
+
+	s.interceptorMtx.Unlock()
+
+	// If no interceptor is registered yet, the forward will be offered to
+	// it once it registers. Otherwise, the interceptor is called without
+	// holding the mutex, as it may choose to resolve the forward right
+	// away.
+	if interceptor != nil {
+		interceptor(fwd)
+	}
+
+	return true
+}
+
+// releaseForward removes the passed forward from the set of held forwards.
+// False is returned if the forward was no longer held. If closed is true, the
+// forward's circuit is about to be torn down by its incoming link.
+func (s *Switch) releaseForward(fwd *interceptedForward, closed bool) bool {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	inKey := fwd.packet.inKey()
+	if s.heldForwards[inKey] != fwd {
+		return false
+	}
+	delete(s.heldForwards, inKey)
+	fwd.timer.Stop()
+
+	if closed {
+		delete(s.replayedForwards, inKey)
+	}
+
+	return true
+}
+
+// pruneReplayedForwards removes the replayed forwards whose circuits have been
+// torn down, as their adds will no longer be replayed.
+func (s *Switch) pruneReplayedForwards() {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	for inKey := range s.replayedForwards {
+		if s.circuits.LookupCircuit(inKey) == nil {
+			delete(s.replayedForwards, inKey)
+		}
+	}
+}
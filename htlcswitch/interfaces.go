@@ -2,6 +2,7 @@ package htlcswitch
 
 import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -62,6 +63,9 @@ type ChannelLink interface {
 	// is a more compact representation of a channel's full outpoint.
 	ChanID() lnwire.ChannelID
 
+	// ChannelPoint returns the channel outpoint for the channel link.
+	ChannelPoint() *wire.OutPoint
+
 	// ShortChanID returns the short channel ID for the channel link. The
 	// short channel ID encodes the exact location in the main chain that
 	// the original funding output can be found.
@@ -78,6 +82,11 @@ type ChannelLink interface {
 	// policy to govern if it an incoming HTLC should be forwarded or not.
 	UpdateForwardingPolicy(ForwardingPolicy)
 
+	// UpdateInboundLimits replaces the inbound forwarding limits of the
+	// target ChannelLink. Unlike UpdateForwardingPolicy, a zero value for
+	// any of the limits will disable it.
+	UpdateInboundLimits(channeldb.InboundLimits)
+
 	// HtlcSatifiesPolicy should return a nil error if the passed HTLC
 	// details satisfy the current forwarding policy fo the target link.
 	// Otherwise, a valid protocol failure message should be returned in
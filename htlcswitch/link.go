@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -90,6 +91,23 @@ type ForwardingPolicy struct {
 	//    per-hop payload of the incoming HTLC's onion packet.
 	TimeLockDelta uint32
 
+	// MaxForwardAmt is the largest amount, expressed in milli-satoshi,
+	// that we'll forward for an HTLC that arrives over this link. A value
+	// of zero means that no limit is enforced.
+	MaxForwardAmt lnwire.MilliSatoshi
+
+	// MaxPendingForwards is the maximum number of HTLCs that the remote
+	// peer of this link may have pending forwarding through us at once,
+	// across all of the channels we have open with them. A value of zero
+	// means that no limit is enforced.
+	MaxPendingForwards uint32
+
+	// MaxCltvDelta is the maximum number of blocks, relative to the
+	// current height, that the timelock of an HTLC arriving over this link
+	// may be set to for us to forward it. A value of zero means that only
+	// the global maxCltvExpiry limit is enforced.
+	MaxCltvDelta uint32
+
 	// TODO(roasbeef): add fee module inside of switch
 }
 
@@ -1139,6 +1157,13 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 
 				go l.forwardBatch(failPkt)
 
+				if pkt.incomingChanID != sourceHop {
+					l.cfg.Switch.htlcNotifier.notifyLinkFail(
+						pkt, failure,
+						FailureDetailOutgoingAdd,
+					)
+				}
+
 				// Remove this packet from the link's mailbox,
 				// this prevents it from being reprocessed if
 				// the link restarts and resets it mailbox. If
@@ -1170,6 +1195,18 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 
 		l.cfg.Peer.SendMessage(false, htlc)
 
+		if pkt.incomingChanID != sourceHop {
+			l.cfg.Switch.htlcNotifier.notify(&HtlcEvent{
+				Type:            HtlcEventForward,
+				IncomingCircuit: pkt.inKey(),
+				OutgoingCircuit: pkt.outKey(),
+				IncomingAmt:     pkt.incomingAmount,
+				OutgoingAmt:     htlc.Amount,
+				IncomingTimeout: pkt.incomingTimeout,
+				OutgoingTimeout: htlc.Expiry,
+			})
+		}
+
 	case *lnwire.UpdateFulfillHTLC:
 		// If hodl.SettleOutgoing mode is active, we exit early to
 		// simulate arbitrary delays between the switch adding the
@@ -1798,6 +1835,13 @@ func (l *channelLink) ChanID() lnwire.ChannelID {
 	return lnwire.NewChanIDFromOutPoint(l.channel.ChannelPoint())
 }
 
+// ChannelPoint returns the channel outpoint for the channel link.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) ChannelPoint() *wire.OutPoint {
+	return l.channel.ChannelPoint()
+}
+
 // Bandwidth returns the total amount that can flow through the channel link at
 // this given instance. The value returned is expressed in millisatoshi and can
 // be used by callers when making forwarding decisions to determine if a link
@@ -1865,6 +1909,28 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 	if newPolicy.MinHTLC != 0 {
 		l.cfg.FwrdingPolicy.MinHTLC = newPolicy.MinHTLC
 	}
+	if newPolicy.MaxForwardAmt != 0 {
+		l.cfg.FwrdingPolicy.MaxForwardAmt = newPolicy.MaxForwardAmt
+	}
+	if newPolicy.MaxPendingForwards != 0 {
+		l.cfg.FwrdingPolicy.MaxPendingForwards = newPolicy.MaxPendingForwards
+	}
+	if newPolicy.MaxCltvDelta != 0 {
+		l.cfg.FwrdingPolicy.MaxCltvDelta = newPolicy.MaxCltvDelta
+	}
+}
+
+// UpdateInboundLimits replaces the inbound forwarding limits of the link. A
+// zero value for any of the limits will disable it.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) UpdateInboundLimits(limits channeldb.InboundLimits) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.cfg.FwrdingPolicy.MaxForwardAmt = limits.MaxForwardAmt
+	l.cfg.FwrdingPolicy.MaxPendingForwards = limits.MaxPendingForwards
+	l.cfg.FwrdingPolicy.MaxCltvDelta = limits.MaxCltvDelta
 }
 
 // HtlcSatifiesPolicy should return a nil error if the passed HTLC details
@@ -1994,6 +2060,68 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 	return nil
 }
 
+// htlcSatisfiesInboundPolicy returns a nil error if an HTLC that arrived over
+// this link and is to be forwarded satisfies the operator defined inbound
+// limits of the link. Otherwise, a failure message is returned that is to be
+// sent back to the source of the HTLC. numPending is used to retrieve the
+// number of HTLCs that the remote peer currently has pending forwarding
+// through us, and is only called if a limit is set for it.
+func (l *channelLink) htlcSatisfiesInboundPolicy(payHash [32]byte,
+	amtToForward lnwire.MilliSatoshi, incomingTimeout, heightNow uint32,
+	numPending func() int) lnwire.FailureMessage {
+
+	l.RLock()
+	policy := l.cfg.FwrdingPolicy
+	l.RUnlock()
+
+	// First, we'll ensure that the HTLC isn't attempting to forward more
+	// than we're willing to route for this peer. If so, we'll send our
+	// latest channel update along with the failure.
+	if policy.MaxForwardAmt != 0 && amtToForward > policy.MaxForwardAmt {
+		l.errorf("incoming htlc(%x) is too large to forward: "+
+			"max_forward_amt=%v, htlc_value=%v", payHash[:],
+			policy.MaxForwardAmt, amtToForward)
+
+		var failure lnwire.FailureMessage
+		update, err := l.cfg.FetchLastChannelUpdate(l.ShortChanID())
+		if err != nil {
+			failure = &lnwire.FailTemporaryNodeFailure{}
+		} else {
+			failure = lnwire.NewTemporaryChannelFailure(update)
+		}
+
+		return failure
+	}
+
+	// Next, we'll reject the HTLC if it would lock up our funds for longer
+	// than we're willing to. An HTLC that has already expired is left to
+	// be rejected by the regular forwarding policy.
+	if policy.MaxCltvDelta != 0 && incomingTimeout > heightNow &&
+		incomingTimeout-heightNow > policy.MaxCltvDelta {
+
+		l.errorf("incoming htlc(%x) has a time lock too far in the "+
+			"future: got %v, but maximum is %v", payHash[:],
+			incomingTimeout-heightNow, policy.MaxCltvDelta)
+
+		return &lnwire.FailExpiryTooFar{}
+	}
+
+	// Finally, we'll ensure that the peer doesn't already have too many
+	// HTLCs pending forwarding through us.
+	if policy.MaxPendingForwards == 0 {
+		return nil
+	}
+	if n := numPending(); n >= int(policy.MaxPendingForwards) {
+		l.errorf("incoming htlc(%x) exceeds the peer's pending forward "+
+			"limit: max_pending_forwards=%v, num_pending=%v",
+			payHash[:], policy.MaxPendingForwards, n)
+
+		return &lnwire.FailTemporaryNodeFailure{}
+	}
+
+	return nil
+}
+
 // Stats returns the statistics of channel link.
 //
 // NOTE: Part of the ChannelLink interface.
@@ -2206,8 +2334,28 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 	var (
 		needUpdate    bool
 		switchPackets []*htlcPacket
+
+		// numForwarded is the number of HTLCs within this batch that
+		// we've decided to forward so far.
+		numForwarded int
 	)
 
+	// numPeerPending returns the number of HTLCs the remote peer has
+	// pending forwarding through us. The number of circuits within the
+	// switch is only fetched once per batch, as the circuits of the
+	// packets within this batch won't be committed until they're
+	// forwarded, so we'll account for those separately.
+	numSwitchPending := -1
+	numPeerPending := func() int {
+		if numSwitchPending < 0 {
+			numSwitchPending = l.cfg.Switch.NumPendingForwards(
+				l.cfg.Peer.PubKey(),
+			)
+		}
+
+		return numSwitchPending + numForwarded
+	}
+
 	for i, pd := range lockedInHtlcs {
 		idx := uint16(i)
 
@@ -2241,8 +2389,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
-				onionBlob[:], pd.SourceRef)
+			l.sendMalformedHTLCError(
+				pd, failureCode, onionBlob[:],
+			)
 			needUpdate = true
 
 			log.Errorf("unable to decode onion hop "+
@@ -2259,8 +2408,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
-				onionBlob[:], pd.SourceRef)
+			l.sendMalformedHTLCError(
+				pd, failureCode, onionBlob[:],
+			)
 			needUpdate = true
 
 			log.Errorf("unable to decode onion "+
@@ -2292,7 +2442,8 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 
 				failure := lnwire.FailFinalExpiryTooSoon{}
 				l.sendHTLCError(
-					pd.HtlcIndex, &failure, obfuscator, pd.SourceRef,
+					pd, &failure, obfuscator,
+					FailureDetailExpiryTooSoon,
 				)
 				needUpdate = true
 				continue
@@ -2310,7 +2461,8 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					" %v", err)
 				failure := lnwire.FailUnknownPaymentHash{}
 				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+					pd, failure, obfuscator,
+					FailureDetailUnknownInvoice,
 				)
 
 				needUpdate = true
@@ -2334,7 +2486,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// TODO(conner): track ownership of settlements to
 			// properly recover from failures? or add batch invoice
 			// settlement
-			if invoice.Terms.Settled {
+			if invoice.Terms.State == channeldb.ContractSettled {
 				log.Warnf("Accepting duplicate payment for "+
 					"hash=%x", pd.RHash[:])
 			}
@@ -2359,7 +2511,8 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 
 				failure := lnwire.FailIncorrectPaymentAmount{}
 				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+					pd, failure, obfuscator,
+					FailureDetailIncorrectAmount,
 				)
 
 				needUpdate = true
@@ -2386,7 +2539,8 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 
 				failure := lnwire.FailIncorrectPaymentAmount{}
 				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+					pd, failure, obfuscator,
+					FailureDetailIncorrectAmount,
 				)
 
 				needUpdate = true
@@ -2405,8 +2559,8 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 
 				failure := lnwire.FailFinalExpiryTooSoon{}
 				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator,
-					pd.SourceRef,
+					pd, failure, obfuscator,
+					FailureDetailExpiryTooSoon,
 				)
 
 				needUpdate = true
@@ -2422,7 +2576,8 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					fwdInfo.OutgoingCTLV,
 				)
 				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+					pd, failure, obfuscator,
+					FailureDetailIncorrectCltvExpiry,
 				)
 
 				needUpdate = true
@@ -2533,8 +2688,20 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				continue
 			}
 
-			// TODO(roasbeef): ensure don't accept outrageous
-			// timeout for htlc
+			// Before forwarding the HTLC, we'll ensure that it
+			// adheres to the inbound limits of this link.
+			failure := l.htlcSatisfiesInboundPolicy(
+				pd.RHash, fwdInfo.AmountToForward, pd.Timeout,
+				heightNow, numPeerPending,
+			)
+			if failure != nil {
+				l.sendHTLCError(
+					pd, failure, obfuscator,
+					FailureDetailInboundLimit,
+				)
+				needUpdate = true
+				continue
+			}
 
 			// With all our forwarding constraints met, we'll
 			// create the outgoing HTLC using the parameters as
@@ -2567,7 +2734,8 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				}
 
 				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+					pd, failure, obfuscator,
+					FailureDetailOnionDecode,
 				)
 				needUpdate = true
 				continue
@@ -2598,6 +2766,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				fwdPkg.FwdFilter.Set(idx)
 				switchPackets = append(switchPackets,
 					updatePacket)
+				numForwarded++
 			}
 		}
 	}
@@ -2671,8 +2840,8 @@ func (l *channelLink) handleBatchFwdErrs(errChan chan error) {
 
 // sendHTLCError functions cancels HTLC and send cancel message back to the
 // peer from which HTLC was received.
-func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMessage,
-	e ErrorEncrypter, sourceRef *channeldb.AddRef) {
+func (l *channelLink) sendHTLCError(pd *lnwallet.PaymentDescriptor,
+	failure lnwire.FailureMessage, e ErrorEncrypter, detail FailureDetail) {
 
 	reason, err := e.EncryptFirstHop(failure)
 	if err != nil {
@@ -2680,7 +2849,7 @@ func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMess
 		return
 	}
 
-	err = l.channel.FailHTLC(htlcIndex, reason, sourceRef, nil, nil)
+	err = l.channel.FailHTLC(pd.HtlcIndex, reason, pd.SourceRef, nil, nil)
 	if err != nil {
 		log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -2688,18 +2857,22 @@ func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMess
 
 	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
 		ChanID: l.ChanID(),
-		ID:     htlcIndex,
+		ID:     pd.HtlcIndex,
 		Reason: reason,
 	})
+
+	l.notifyIncomingFail(pd, failure, detail)
 }
 
 // sendMalformedHTLCError helper function which sends the malformed HTLC update
 // to the payment sender.
-func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
-	code lnwire.FailCode, onionBlob []byte, sourceRef *channeldb.AddRef) {
+func (l *channelLink) sendMalformedHTLCError(pd *lnwallet.PaymentDescriptor,
+	code lnwire.FailCode, onionBlob []byte) {
 
 	shaOnionBlob := sha256.Sum256(onionBlob)
-	err := l.channel.MalformedFailHTLC(htlcIndex, code, shaOnionBlob, sourceRef)
+	err := l.channel.MalformedFailHTLC(
+		pd.HtlcIndex, code, shaOnionBlob, pd.SourceRef,
+	)
 	if err != nil {
 		log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -2707,10 +2880,39 @@ func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
 
 	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailMalformedHTLC{
 		ChanID:       l.ChanID(),
-		ID:           htlcIndex,
+		ID:           pd.HtlcIndex,
 		ShaOnionBlob: shaOnionBlob,
 		FailureCode:  code,
 	})
+
+	var failure lnwire.FailureMessage
+	switch code {
+	case lnwire.CodeInvalidOnionVersion:
+		failure = lnwire.NewInvalidOnionVersion(shaOnionBlob[:])
+	case lnwire.CodeInvalidOnionHmac:
+		failure = lnwire.NewInvalidOnionHmac(shaOnionBlob[:])
+	case lnwire.CodeInvalidOnionKey:
+		failure = lnwire.NewInvalidOnionKey(shaOnionBlob[:])
+	}
+	l.notifyIncomingFail(pd, failure, FailureDetailOnionDecode)
+}
+
+// notifyIncomingFail dispatches a link fail event for an incoming HTLC that
+// was failed back to the remote peer by this link.
+func (l *channelLink) notifyIncomingFail(pd *lnwallet.PaymentDescriptor,
+	failure lnwire.FailureMessage, detail FailureDetail) {
+
+	l.cfg.Switch.htlcNotifier.notify(&HtlcEvent{
+		Type: HtlcEventLinkFail,
+		IncomingCircuit: CircuitKey{
+			ChanID: l.ShortChanID(),
+			HtlcID: pd.HtlcIndex,
+		},
+		IncomingAmt:     pd.Amount,
+		IncomingTimeout: pd.Timeout,
+		FailureMessage:  failure,
+		FailureDetail:   detail,
+	})
 }
 
 // fail is a function which is used to encapsulate the action necessary for
@@ -93,3 +93,97 @@ func (e LinkFailureError) ShouldSendToPeer() bool {
 		return true
 	}
 }
+
+// FailureDetail provides additional information on why an HTLC was failed by
+// one of our links, or by the switch itself, on top of the failure message
+// that is sent back to the sender.
+type FailureDetail uint8
+
+const (
+	// FailureDetailNone indicates that no additional information is
+	// available on why the HTLC was failed.
+	FailureDetailNone FailureDetail = iota
+
+	// FailureDetailOnionDecode indicates that we were unable to process
+	// the onion blob of the HTLC, or to encode it for the next hop.
+	FailureDetailOnionDecode
+
+	// FailureDetailUnknownInvoice indicates that we were the final hop of
+	// the HTLC, but didn't know of an invoice for its payment hash.
+	FailureDetailUnknownInvoice
+
+	// FailureDetailIncorrectAmount indicates that we were the final hop of
+	// the HTLC, but it didn't pay the amount requested by the invoice.
+	FailureDetailIncorrectAmount
+
+	// FailureDetailExpiryTooSoon indicates that the HTLC expires too soon
+	// for us to accept it.
+	FailureDetailExpiryTooSoon
+
+	// FailureDetailIncorrectCltvExpiry indicates that we were the final
+	// hop of the HTLC, but its expiry didn't match the one within the
+	// onion.
+	FailureDetailIncorrectCltvExpiry
+
+	// FailureDetailInboundLimit indicates that the HTLC violated the
+	// inbound forwarding limits of its incoming link.
+	FailureDetailInboundLimit
+
+	// FailureDetailForwardingPolicy indicates that the HTLC didn't satisfy
+	// the forwarding policy of its outgoing link.
+	FailureDetailForwardingPolicy
+
+	// FailureDetailUnknownNextPeer indicates that we don't have an active
+	// link for the outgoing channel requested by the HTLC.
+	FailureDetailUnknownNextPeer
+
+	// FailureDetailInsufficientBalance indicates that none of our links
+	// with the next peer had sufficient bandwidth to forward the HTLC.
+	FailureDetailInsufficientBalance
+
+	// FailureDetailIncompleteForward indicates that the HTLC was failed as
+	// its forward couldn't be completed before a restart.
+	FailureDetailIncompleteForward
+
+	// FailureDetailOutgoingAdd indicates that the outgoing link was unable
+	// to add the HTLC to its channel.
+	FailureDetailOutgoingAdd
+
+	// FailureDetailInterceptor indicates that the HTLC was failed by the
+	// registered forward interceptor.
+	FailureDetailInterceptor
+)
+
+// String returns a human readable string for the failure detail.
+func (d FailureDetail) String() string {
+	switch d {
+	case FailureDetailNone:
+		return "none"
+	case FailureDetailOnionDecode:
+		return "onion decode"
+	case FailureDetailUnknownInvoice:
+		return "unknown invoice"
+	case FailureDetailIncorrectAmount:
+		return "incorrect amount"
+	case FailureDetailExpiryTooSoon:
+		return "expiry too soon"
+	case FailureDetailIncorrectCltvExpiry:
+		return "incorrect cltv expiry"
+	case FailureDetailInboundLimit:
+		return "inbound limit"
+	case FailureDetailForwardingPolicy:
+		return "forwarding policy"
+	case FailureDetailUnknownNextPeer:
+		return "unknown next peer"
+	case FailureDetailInsufficientBalance:
+		return "insufficient balance"
+	case FailureDetailIncompleteForward:
+		return "incomplete forward"
+	case FailureDetailOutgoingAdd:
+		return "outgoing add"
+	case FailureDetailInterceptor:
+		return "interceptor"
+	default:
+		return "unknown detail"
+	}
+}
@@ -12,10 +12,10 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
@@ -176,6 +176,31 @@ type Config struct {
 	// LogEventTicker is a signal instructing the htlcswitch to log
 	// aggregate stats about it's forwarding during the last interval.
 	LogEventTicker ticker.Ticker
+
+	// DefaultInboundLimits are the inbound forwarding limits links are
+	// created with. Updates of the inbound limits that don't target any
+	// channels with limits of their own are applied to these.
+	DefaultInboundLimits channeldb.InboundLimits
+
+	// InterceptTimeout is the duration a forwarded HTLC will be held for
+	// a registered forward interceptor before it's resumed. If zero,
+	// DefaultInterceptTimeout is used.
+	InterceptTimeout time.Duration
+
+	// HoldReplayedForwards, if true, causes forwards that were held for
+	// the interceptor when we shut down to be held again after startup
+	// until an interceptor registers, for up to the intercept timeout.
+	// Otherwise, they're failed back unless an interceptor is already
+	// registered by the time they're replayed.
+	HoldReplayedForwards bool
+
+	// NotifyActiveChannel allows the switch to notify other subsystems
+	// that a channel's link has been added to the switch and started.
+	NotifyActiveChannel func(wire.OutPoint)
+
+	// NotifyInactiveChannel allows the switch to notify other subsystems
+	// that a channel's link has been removed from the switch.
+	NotifyInactiveChannel func(wire.OutPoint)
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -248,6 +273,18 @@ type Switch struct {
 	// channels that the switch maintains with that peer.
 	interfaceIndex map[[33]byte]map[lnwire.ChannelID]ChannelLink
 
+	// globalInboundLimits are the inbound forwarding limits that were
+	// last set at runtime for all links. These take precedence over
+	// DefaultInboundLimits, and are nil if they've never been set.
+	globalInboundLimits *channeldb.InboundLimits
+
+	// inboundLimits maps the channel point of a link to the inbound
+	// forwarding limits that were last set for it at runtime. These take
+	// precedence over the global limits. Both are persisted, and applied
+	// to each link as it's added to the switch, such that they persist
+	// across reconnections of the peer and restarts.
+	inboundLimits map[wire.OutPoint]*channeldb.InboundLimits
+
 	// htlcPlex is the channel which all connected links use to coordinate
 	// the setup/teardown of Sphinx (onion routing) payment circuits.
 	// Active links forward any add/settle messages over this channel each
@@ -277,10 +314,39 @@ type Switch struct {
 	// active ChainNotifier instance. This will be used to retrieve the
 	// lastest height of the chain.
 	blockEpochStream *chainntnfs.BlockEpochEvent
+
+	// interceptorMtx guards the forward interceptor and the set of
+	// forwards held for it below.
+	interceptorMtx sync.Mutex
+
+	// interceptor is the currently registered forward interceptor, if
+	// any. All forwarded HTLCs will be handed to it before being routed.
+	interceptor ForwardInterceptor
+
+	// heldForwards is the set of forwards that are currently being held
+	// for the interceptor, indexed by their incoming circuit key.
+	heldForwards map[CircuitKey]*interceptedForward
+
+	// replayedForwards is the set of incoming circuit keys of forwards
+	// that were replayed after a restart and offered to the interceptor.
+	replayedForwards map[CircuitKey]struct{}
+
+	// startTime is the time the switch was started at. Replayed forwards
+	// are held for an interceptor that has yet to register for up to the
+	// intercept timeout after this time.
+	startTime time.Time
+
+	// htlcNotifier dispatches the HTLC events of the switch and its links
+	// to all subscribed clients.
+	htlcNotifier *htlcNotifier
 }
 
 // New creates the new instance of htlc switch.
 func New(cfg Config, currentHeight uint32) (*Switch, error) {
+	if cfg.InterceptTimeout == 0 {
+		cfg.InterceptTimeout = DefaultInterceptTimeout
+	}
+
 	circuitMap, err := NewCircuitMap(&CircuitMapConfig{
 		DB:                    cfg.DB,
 		ExtractErrorEncrypter: cfg.ExtractErrorEncrypter,
@@ -294,22 +360,34 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		return nil, err
 	}
 
+	globalLimits, chanLimits, err := cfg.DB.FetchInboundLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	quit := make(chan struct{})
+
 	return &Switch{
-		bestHeight:        currentHeight,
-		cfg:               &cfg,
-		circuits:          circuitMap,
-		paymentSequencer:  sequencer,
-		control:           NewPaymentControl(false, cfg.DB),
-		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
-		mailOrchestrator:  newMailOrchestrator(),
-		forwardingIndex:   make(map[lnwire.ShortChannelID]ChannelLink),
-		interfaceIndex:    make(map[[33]byte]map[lnwire.ChannelID]ChannelLink),
-		pendingLinkIndex:  make(map[lnwire.ChannelID]ChannelLink),
-		pendingPayments:   make(map[uint64]*pendingPayment),
-		htlcPlex:          make(chan *plexPacket),
-		chanCloseRequests: make(chan *ChanClose),
-		resolutionMsgs:    make(chan *resolutionMsg),
-		quit:              make(chan struct{}),
+		bestHeight:          currentHeight,
+		cfg:                 &cfg,
+		circuits:            circuitMap,
+		paymentSequencer:    sequencer,
+		control:             NewPaymentControl(false, cfg.DB),
+		linkIndex:           make(map[lnwire.ChannelID]ChannelLink),
+		mailOrchestrator:    newMailOrchestrator(),
+		forwardingIndex:     make(map[lnwire.ShortChannelID]ChannelLink),
+		interfaceIndex:      make(map[[33]byte]map[lnwire.ChannelID]ChannelLink),
+		globalInboundLimits: globalLimits,
+		inboundLimits:       chanLimits,
+		heldForwards:        make(map[CircuitKey]*interceptedForward),
+		replayedForwards:    make(map[CircuitKey]struct{}),
+		pendingLinkIndex:    make(map[lnwire.ChannelID]ChannelLink),
+		pendingPayments:     make(map[uint64]*pendingPayment),
+		htlcPlex:            make(chan *plexPacket),
+		chanCloseRequests:   make(chan *ChanClose),
+		resolutionMsgs:      make(chan *resolutionMsg),
+		htlcNotifier:        newHtlcNotifier(quit),
+		quit:                quit,
 	}, nil
 }
 
@@ -478,6 +556,135 @@ func (s *Switch) UpdateForwardingPolicies(newPolicy ForwardingPolicy,
 	return nil
 }
 
+// InboundLimitsUpdate describes a modification of the inbound forwarding
+// limits of a set of links.
+type InboundLimitsUpdate struct {
+	// Clear resets all of the limits, such that none of them are
+	// enforced, before any of the limits below are applied.
+	Clear bool
+
+	// MaxForwardAmt, if non-zero, is the new largest amount we'll forward
+	// for an HTLC.
+	MaxForwardAmt lnwire.MilliSatoshi
+
+	// MaxPendingForwards, if non-zero, is the new maximum number of HTLCs
+	// a peer may have pending forwarding through us at once.
+	MaxPendingForwards uint32
+
+	// MaxCltvDelta, if non-zero, is the new maximum number of blocks the
+	// timelock of an HTLC may be set to in the future.
+	MaxCltvDelta uint32
+}
+
+// apply returns the result of applying the update to the passed limits.
+func (u *InboundLimitsUpdate) apply(
+	limits channeldb.InboundLimits) *channeldb.InboundLimits {
+
+	if u.Clear {
+		limits = channeldb.InboundLimits{}
+	}
+	if u.MaxForwardAmt != 0 {
+		limits.MaxForwardAmt = u.MaxForwardAmt
+	}
+	if u.MaxPendingForwards != 0 {
+		limits.MaxPendingForwards = u.MaxPendingForwards
+	}
+	if u.MaxCltvDelta != 0 {
+		limits.MaxCltvDelta = u.MaxCltvDelta
+	}
+
+	return &limits
+}
+
+// UpdateInboundLimits applies the update to the inbound forwarding limits of
+// the set of target channels. If the set of targeted channels is nil, then
+// the limits of all channels will be updated, including the ones that are
+// added later on. Unlike forwarding policies, the limits are persisted, and
+// the target channels don't need to be active.
+func (s *Switch) UpdateInboundLimits(update InboundLimitsUpdate,
+	targetChans ...wire.OutPoint) error {
+
+	log.Debugf("Updating link inbound limits: %v",
+		newLogClosure(func() string {
+			return spew.Sdump(update)
+		}),
+	)
+
+	s.indexMtx.Lock()
+
+	// If no channels have been targeted, then we'll update the global
+	// limits, along with the limits of all channels that have limits of
+	// their own, as the update should apply to all of them.
+	if len(targetChans) == 0 {
+		base := s.cfg.DefaultInboundLimits
+		if s.globalInboundLimits != nil {
+			base = *s.globalInboundLimits
+		}
+		globalLimits := update.apply(base)
+		err := s.cfg.DB.PutInboundLimits(nil, globalLimits)
+		if err != nil {
+			s.indexMtx.Unlock()
+			return err
+		}
+		s.globalInboundLimits = globalLimits
+
+		for chanPoint := range s.inboundLimits {
+			targetChans = append(targetChans, chanPoint)
+		}
+	}
+
+	for _, chanPoint := range targetChans {
+		chanPoint := chanPoint
+
+		base := s.cfg.DefaultInboundLimits
+		if limits := s.currentInboundLimits(chanPoint); limits != nil {
+			base = *limits
+		}
+		limits := update.apply(base)
+		err := s.cfg.DB.PutInboundLimits(&chanPoint, limits)
+		if err != nil {
+			s.indexMtx.Unlock()
+			return err
+		}
+		s.inboundLimits[chanPoint] = limits
+	}
+
+	// With the new limits stored, we'll collect the links that are
+	// currently active, along with the limits that now apply to them.
+	linksToUpdate := make(map[ChannelLink]channeldb.InboundLimits)
+	for _, link := range s.linkIndex {
+		limits := s.currentInboundLimits(*link.ChannelPoint())
+		if limits != nil {
+			linksToUpdate[link] = *limits
+		}
+	}
+
+	s.indexMtx.Unlock()
+
+	// With all the links we need to update collected, we can release the
+	// mutex then update each link directly.
+	for link, limits := range linksToUpdate {
+		link.UpdateInboundLimits(limits)
+	}
+
+	return nil
+}
+
+// currentInboundLimits returns the inbound limits that were set at runtime
+// and apply to the target channel. If no limits were set, then nil is
+// returned, meaning the default limits apply.
+//
+// NOTE: This method requires the indexMtx to be held.
+func (s *Switch) currentInboundLimits(
+	chanPoint wire.OutPoint) *channeldb.InboundLimits {
+
+	if limits, ok := s.inboundLimits[chanPoint]; ok {
+		return limits
+	}
+
+	return s.globalInboundLimits
+}
+
 // forward is used in order to find next channel link and apply htlc update.
 // Also this function is used by channel links itself in order to forward the
 // update after it has been included in the channel.
@@ -512,7 +719,10 @@ func (s *Switch) forward(packet *htlcPacket) error {
 			}
 			addErr := ErrIncompleteForward
 
-			return s.failAddPacket(packet, failure, addErr)
+			return s.failAddPacket(
+				packet, failure, FailureDetailIncompleteForward,
+				addErr,
+			)
 		}
 
 		packet.circuit = circuit
@@ -639,8 +849,13 @@ func (s *Switch) ForwardPackets(linkQuit chan struct{},
 	}
 
 	// Now, forward any packets for circuits that were successfully added to
-	// the switch's circuit map.
+	// the switch's circuit map, unless they're to be held for the forward
+	// interceptor.
 	for _, packet := range addedPackets {
+		if s.interceptForward(packet, false) {
+			continue
+		}
+
 		err := s.routeAsync(packet, fwdChan, linkQuit)
 		if err != nil {
 			return errChan
@@ -650,7 +865,18 @@ func (s *Switch) ForwardPackets(linkQuit chan struct{},
 
 	// Lastly, for any packets that failed, this implies that they were
 	// left in a half added state, which can happen when recovering from
-	// failures.
+	// failures. This includes forwards that were held for the interceptor
+	// when we shut down, so we'll offer those to it again if one is
+	// registered.
+	var unheldPackets []*htlcPacket
+	for _, packet := range failedPackets {
+		if s.interceptForward(packet, true) {
+			continue
+		}
+		unheldPackets = append(unheldPackets, packet)
+	}
+	failedPackets = unheldPackets
+
 	if len(failedPackets) > 0 {
 		var failure lnwire.FailureMessage
 		update, err := s.cfg.FetchLastChannelUpdate(
@@ -668,7 +894,10 @@ func (s *Switch) ForwardPackets(linkQuit chan struct{},
 
 			// We don't handle the error here since this method
 			// always returns an error.
-			s.failAddPacket(packet, failure, addErr)
+			s.failAddPacket(
+				packet, failure, FailureDetailIncompleteForward,
+				addErr,
+			)
 		}
 	}
 
@@ -1032,7 +1261,10 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			addErr := fmt.Errorf("unable to find link with "+
 				"destination %v", packet.outgoingChanID)
 
-			return s.failAddPacket(packet, failure, addErr)
+			return s.failAddPacket(
+				packet, failure, FailureDetailUnknownNextPeer,
+				addErr,
+			)
 		}
 		interfaceLinks, _ := s.getLinks(targetLink.Peer().PubKey())
 		s.indexMtx.RUnlock()
@@ -1103,7 +1335,10 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 				"channel link insufficient capacity, need "+
 				"%v", htlc.Amount)
 
-			return s.failAddPacket(packet, failure, addErr)
+			return s.failAddPacket(
+				packet, failure,
+				FailureDetailInsufficientBalance, addErr,
+			)
 
 		// If we had a forwarding failure due to the HTLC not
 		// satisfying the current policy, then we'll send back an
@@ -1131,7 +1366,10 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 				htlc.PaymentHash[:], packet.outgoingChanID,
 				linkErr)
 
-			return s.failAddPacket(packet, linkErr, addErr)
+			return s.failAddPacket(
+				packet, linkErr, FailureDetailForwardingPolicy,
+				addErr,
+			)
 		}
 
 		// Send the packet to the destination channel link which
@@ -1148,6 +1386,27 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 		}
 
 		fail, isFail := htlc.(*lnwire.UpdateFailHTLC)
+
+		// If this is the response to a forwarded HTLC that was added to
+		// the outgoing channel, we'll notify our htlc event
+		// subscribers of its outcome. Failures of the outgoing link
+		// itself have already been notified by the link.
+		if circuit.Outgoing != nil && !packet.hasSource &&
+			packet.incomingChanID != sourceHop {
+
+			eventType := HtlcEventSettle
+			if isFail {
+				eventType = HtlcEventForwardFail
+			}
+			s.htlcNotifier.notify(&HtlcEvent{
+				Type:            eventType,
+				IncomingCircuit: circuit.Incoming,
+				OutgoingCircuit: *circuit.Outgoing,
+				IncomingAmt:     circuit.IncomingAmount,
+				OutgoingAmt:     circuit.OutgoingAmount,
+			})
+		}
+
 		if isFail && !packet.hasSource {
 			switch {
 			case circuit.ErrorEncrypter == nil:
@@ -1217,9 +1476,11 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 
 // failAddPacket encrypts a fail packet back to an add packet's source.
 // The ciphertext will be derived from the failure message proivded by context.
-// This method returns the failErr if all other steps complete successfully.
+// The failure detail is only used to notify htlc event subscribers. This
+// method returns the failErr if all other steps complete successfully.
 func (s *Switch) failAddPacket(packet *htlcPacket,
-	failure lnwire.FailureMessage, failErr error) error {
+	failure lnwire.FailureMessage, detail FailureDetail,
+	failErr error) error {
 
 	// Encrypt the failure so that the sender will be able to read the error
 	// message. Since we failed this packet, we use EncryptFirstHop to
@@ -1254,6 +1515,8 @@ func (s *Switch) failAddPacket(packet *htlcPacket,
 		return err
 	}
 
+	s.htlcNotifier.notifyLinkFail(packet, failure, detail)
+
 	return failErr
 }
 
@@ -1350,7 +1613,7 @@ func (s *Switch) closeCircuit(pkt *htlcPacket) (*PaymentCircuit, error) {
 // we're the originator of the payment, so the link stops attempting to
 // re-broadcast.
 func (s *Switch) ackSettleFail(settleFailRef channeldb.SettleFailRef) error {
-	return s.cfg.DB.Batch(func(tx *bbolt.Tx) error {
+	return s.cfg.DB.Batch(func(tx kvdb.Tx) error {
 		return s.cfg.SwitchPackager.AckSettleFails(tx, settleFailRef)
 	})
 }
@@ -1603,6 +1866,10 @@ out:
 				}
 			}()
 
+			// We'll also forget any replayed forwards whose
+			// circuits have since been torn down.
+			s.pruneReplayedForwards()
+
 		// The log ticker has fired, so we'll calculate some forwarding
 		// stats for the last 10 seconds to display within the logs to
 		// users.
@@ -1695,6 +1962,8 @@ func (s *Switch) Start() error {
 
 	log.Infof("Starting HTLC Switch")
 
+	s.startTime = time.Now()
+
 	blockEpochStream, err := s.cfg.Notifier.RegisterBlockEpochNtfn(nil)
 	if err != nil {
 		return err
@@ -1760,7 +2029,7 @@ func (s *Switch) reforwardResponses() error {
 func (s *Switch) loadChannelFwdPkgs(source lnwire.ShortChannelID) ([]*channeldb.FwdPkg, error) {
 
 	var fwdPkgs []*channeldb.FwdPkg
-	if err := s.cfg.DB.Update(func(tx *bbolt.Tx) error {
+	if err := s.cfg.DB.Update(func(tx kvdb.Tx) error {
 		var err error
 		fwdPkgs, err = s.cfg.SwitchPackager.LoadChannelFwdPkgs(
 			tx, source,
@@ -1880,6 +2149,14 @@ func (s *Switch) Stop() error {
 
 	close(s.quit)
 
+	// Stop the intercept timers of any held forwards. Their circuits have
+	// been persisted, so they'll be replayed once we start back up.
+	s.interceptorMtx.Lock()
+	for _, fwd := range s.heldForwards {
+		fwd.timer.Stop()
+	}
+	s.interceptorMtx.Unlock()
+
 	s.wg.Wait()
 
 	// Wait until all active goroutines have finished exiting before
@@ -1911,6 +2188,13 @@ func (s *Switch) AddLink(link ChannelLink) error {
 	mailbox := s.mailOrchestrator.GetOrCreateMailBox(chanID)
 	link.AttachMailBox(mailbox)
 
+	// If any inbound limits were set for this link at runtime, then we'll
+	// apply them as the link was created with the default ones.
+	limits := s.currentInboundLimits(*link.ChannelPoint())
+	if limits != nil {
+		link.UpdateInboundLimits(*limits)
+	}
+
 	if err := link.Start(); err != nil {
 		s.removeLink(chanID)
 		return err
@@ -1932,6 +2216,8 @@ func (s *Switch) AddLink(link ChannelLink) error {
 		)
 	}
 
+	s.cfg.NotifyActiveChannel(*link.ChannelPoint())
+
 	return nil
 }
 
@@ -2042,6 +2328,8 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 		}
 	}
 
+	s.cfg.NotifyInactiveChannel(*link.ChannelPoint())
+
 	return link
 }
 
@@ -2102,6 +2390,21 @@ func (s *Switch) GetLinksByInterface(hop [33]byte) ([]ChannelLink, error) {
 	return s.getLinks(hop)
 }
 
+// NumPendingForwards returns the number of HTLCs that arrived from the target
+// peer, over any of its channels, and are still pending forwarding through
+// the switch.
+func (s *Switch) NumPendingForwards(peer [33]byte) int {
+	s.indexMtx.RLock()
+	links := s.interfaceIndex[peer]
+	chanIDs := make([]lnwire.ShortChannelID, 0, len(links))
+	for _, link := range links {
+		chanIDs = append(chanIDs, link.ShortChanID())
+	}
+	s.indexMtx.RUnlock()
+
+	return s.circuits.NumPendingFrom(chanIDs...)
+}
+
 // getLinks is function which returns the channel links of the peer by hop
 // destination id.
 //
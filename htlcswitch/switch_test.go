@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/fastsha256"
 	"github.com/davecgh/go-spew/spew"
@@ -1912,3 +1913,608 @@ func TestMultiHopPaymentForwardingEvents(t *testing.T) {
 		}
 	}
 }
+
+// TestSwitchInboundLimits tests that inbound limits set at runtime are applied
+// to links that are added later on, are persisted across restarts, and can be
+// cleared.
+func TestSwitchInboundLimits(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	cdb, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, cdb)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+	link1 := newMockChannelLink(s, chanID1, aliceChanID, alicePeer, true)
+	link1.chanPoint = wire.OutPoint{Index: 1}
+	link2 := newMockChannelLink(s, chanID2, bobChanID, alicePeer, true)
+	link2.chanPoint = wire.OutPoint{Index: 2}
+
+	if err := s.AddLink(link1); err != nil {
+		t.Fatalf("unable to add link: %v", err)
+	}
+
+	// We'll set a global limit, followed by a limit of link2's channel
+	// while it's not active yet.
+	err = s.UpdateInboundLimits(InboundLimitsUpdate{MaxCltvDelta: 100})
+	if err != nil {
+		t.Fatalf("unable to update inbound limits: %v", err)
+	}
+	err = s.UpdateInboundLimits(
+		InboundLimitsUpdate{MaxForwardAmt: 1000}, link2.chanPoint,
+	)
+	if err != nil {
+		t.Fatalf("unable to update inbound limits: %v", err)
+	}
+
+	assertLimits := func(link *mockChannelLink,
+		expected channeldb.InboundLimits) {
+
+		t.Helper()
+
+		if link.inboundLimits != expected {
+			t.Fatalf("expected limits %v for %v, got %v",
+				expected, link.chanPoint, link.inboundLimits)
+		}
+	}
+	assertLimits(link1, channeldb.InboundLimits{MaxCltvDelta: 100})
+
+	// Once link2 is added, it should receive both the global limit and
+	// its own.
+	if err := s.AddLink(link2); err != nil {
+		t.Fatalf("unable to add link: %v", err)
+	}
+	assertLimits(link2, channeldb.InboundLimits{
+		MaxForwardAmt: 1000,
+		MaxCltvDelta:  100,
+	})
+
+	// The limits should be restored from disk by a new switch.
+	s.Stop()
+	s2, err := initSwitchWithDB(testStartingHeight, cdb)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s2.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s2.Stop()
+
+	link1 = newMockChannelLink(s2, chanID1, aliceChanID, alicePeer, true)
+	link1.chanPoint = wire.OutPoint{Index: 1}
+	link2 = newMockChannelLink(s2, chanID2, bobChanID, alicePeer, true)
+	link2.chanPoint = wire.OutPoint{Index: 2}
+	if err := s2.AddLink(link1); err != nil {
+		t.Fatalf("unable to add link: %v", err)
+	}
+	if err := s2.AddLink(link2); err != nil {
+		t.Fatalf("unable to add link: %v", err)
+	}
+	assertLimits(link1, channeldb.InboundLimits{MaxCltvDelta: 100})
+	assertLimits(link2, channeldb.InboundLimits{
+		MaxForwardAmt: 1000,
+		MaxCltvDelta:  100,
+	})
+
+	// Finally, clearing the limits globally should remove them from all
+	// links, while still allowing new limits to be set at the same time.
+	err = s2.UpdateInboundLimits(InboundLimitsUpdate{
+		Clear:              true,
+		MaxPendingForwards: 5,
+	})
+	if err != nil {
+		t.Fatalf("unable to update inbound limits: %v", err)
+	}
+	assertLimits(link1, channeldb.InboundLimits{MaxPendingForwards: 5})
+	assertLimits(link2, channeldb.InboundLimits{MaxPendingForwards: 5})
+}
+
+// TestSwitchForwardInterceptor tests that forwarded HTLCs are held for a
+// registered interceptor, and are properly resolved according to its
+// decisions.
+func TestSwitchForwardInterceptor(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	fwds := make(chan InterceptedForward, 1)
+	err = s.RegisterInterceptor(func(fwd InterceptedForward) {
+		fwds <- fwd
+	})
+	if err != nil {
+		t.Fatalf("unable to register interceptor: %v", err)
+	}
+
+	// Only a single interceptor can be registered at a time.
+	err = s.RegisterInterceptor(func(InterceptedForward) {})
+	if err != ErrInterceptorActive {
+		t.Fatalf("expected ErrInterceptorActive, got %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+
+	// forward sends an HTLC from Alice to Bob through the switch, and
+	// returns the forward handed to the interceptor.
+	forward := func(htlcID uint64) InterceptedForward {
+		t.Helper()
+
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1,
+			},
+		}
+		for err := range s.ForwardPackets(nil, packet) {
+			t.Fatalf("unable to forward packet: %v", err)
+		}
+
+		select {
+		case fwd := <-fwds:
+			pkt := fwd.Packet()
+			if pkt.IncomingCircuit != packet.inKey() {
+				t.Fatalf("expected circuit %v, got %v",
+					packet.inKey(), pkt.IncomingCircuit)
+			}
+			if pkt.Hash != rhash {
+				t.Fatalf("payment hash mismatch")
+			}
+			return fwd
+		case <-time.After(time.Second):
+			t.Fatalf("forward was not intercepted")
+		}
+
+		return nil
+	}
+
+	assertPacket := func(link *mockChannelLink, expected lnwire.Message) {
+		t.Helper()
+
+		select {
+		case pkt := <-link.packets:
+			if fmt.Sprintf("%T", pkt.htlc) !=
+				fmt.Sprintf("%T", expected) {
+
+				t.Fatalf("expected %T, got %T", expected,
+					pkt.htlc)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%T was not delivered", expected)
+		}
+	}
+
+	assertNoPacket := func(link *mockChannelLink) {
+		t.Helper()
+
+		select {
+		case pkt := <-link.packets:
+			t.Fatalf("unexpected packet: %v", spew.Sdump(pkt))
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	// The first HTLC should be held until the interceptor resumes it, at
+	// which point it should be forwarded to Bob.
+	fwd := forward(0)
+	assertNoPacket(bobChannelLink)
+	if err := fwd.Resume(); err != nil {
+		t.Fatalf("unable to resume forward: %v", err)
+	}
+	assertPacket(bobChannelLink, &lnwire.UpdateAddHTLC{})
+
+	// A forward can only be resolved once.
+	if err := fwd.Resume(); err != ErrFwdNotHeld {
+		t.Fatalf("expected ErrFwdNotHeld, got %v", err)
+	}
+
+	// The second HTLC should be failed back to Alice.
+	fwd = forward(1)
+	if err := fwd.Fail(lnwire.CodeTemporaryNodeFailure); err != nil {
+		t.Fatalf("unable to fail forward: %v", err)
+	}
+	assertPacket(aliceChannelLink, &lnwire.UpdateFailHTLC{})
+	assertNoPacket(bobChannelLink)
+
+	// The third HTLC should only be settled back to Alice once the
+	// interceptor provides the correct preimage.
+	fwd = forward(2)
+	if err := fwd.Settle([32]byte{}); err != ErrPreimageMismatch {
+		t.Fatalf("expected ErrPreimageMismatch, got %v", err)
+	}
+	if err := fwd.Settle(preimage); err != nil {
+		t.Fatalf("unable to settle forward: %v", err)
+	}
+	assertPacket(aliceChannelLink, &lnwire.UpdateFulfillHTLC{})
+	assertNoPacket(bobChannelLink)
+
+	// Finally, any forwards still held once the interceptor is
+	// unregistered should be resumed.
+	forward(3)
+	s.UnregisterInterceptor()
+	assertPacket(bobChannelLink, &lnwire.UpdateAddHTLC{})
+}
+
+// TestSwitchForwardInterceptorTimeout tests that a forward that isn't resolved
+// by the interceptor within the intercept timeout is resumed.
+func TestSwitchForwardInterceptorTimeout(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.InterceptTimeout = 100 * time.Millisecond
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	// We'll register an interceptor that never resolves the forwards
+	// handed to it.
+	if err := s.RegisterInterceptor(func(InterceptedForward) {}); err != nil {
+		t.Fatalf("unable to register interceptor: %v", err)
+	}
+
+	packet := &htlcPacket{
+		incomingChanID: aliceChannelLink.ShortChanID(),
+		incomingHTLCID: 0,
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			Amount: 1,
+		},
+	}
+	for err := range s.ForwardPackets(nil, packet) {
+		t.Fatalf("unable to forward packet: %v", err)
+	}
+
+	// Once the intercept timeout expires, the HTLC should be forwarded to
+	// Bob.
+	select {
+	case pkt := <-bobChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateAddHTLC); !ok {
+			t.Fatalf("expected add, got %T", pkt.htlc)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("forward was not resumed after timeout")
+	}
+}
+
+// TestSwitchForwardInterceptorReplay tests that, if enabled, forwards replayed
+// after a restart are held until an interceptor registers, and are then
+// offered to it.
+func TestSwitchForwardInterceptorReplay(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	newPacket := func(htlcID uint64) *htlcPacket {
+		return &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				Amount: 1,
+			},
+		}
+	}
+
+	// Without a registered interceptor, new forwards shouldn't be held.
+	if s.interceptForward(newPacket(0), false) {
+		t.Fatalf("forward held without interceptor")
+	}
+
+	// Replayed forwards should only be held if the switch is configured
+	// to do so, as the interceptor may not have registered yet after the
+	// restart.
+	replayed := newPacket(1)
+	if s.interceptForward(replayed, true) {
+		t.Fatalf("replayed forward held without being enabled")
+	}
+
+	s.cfg.HoldReplayedForwards = true
+	if !s.interceptForward(replayed, true) {
+		t.Fatalf("replayed forward not held")
+	}
+	if _, ok := s.HeldForward(replayed.inKey()); !ok {
+		t.Fatalf("replayed forward not found")
+	}
+
+	// Once the interceptor registers, it should be offered the replayed
+	// forward.
+	fwds := make(chan InterceptedForward, 1)
+	err = s.RegisterInterceptor(func(fwd InterceptedForward) {
+		fwds <- fwd
+	})
+	if err != nil {
+		t.Fatalf("unable to register interceptor: %v", err)
+	}
+	defer s.UnregisterInterceptor()
+
+	var fwd InterceptedForward
+	select {
+	case fwd = <-fwds:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("replayed forward not offered to interceptor")
+	}
+	if fwd.Packet().IncomingCircuit != replayed.inKey() {
+		t.Fatalf("expected forward %v, got %v", replayed.inKey(),
+			fwd.Packet().IncomingCircuit)
+	}
+
+	// Resuming the forward should deliver it to Bob, after which it's no
+	// longer held.
+	if err := fwd.Resume(); err != nil {
+		t.Fatalf("unable to resume forward: %v", err)
+	}
+	select {
+	case <-bobChannelLink.packets:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("resumed forward not delivered to bob")
+	}
+	if _, ok := s.HeldForward(replayed.inKey()); ok {
+		t.Fatalf("resumed forward still held")
+	}
+}
+
+// TestSwitchHtlcEvents checks that the switch notifies htlc event subscribers
+// of settled forwards, as well as of forwards it fails itself.
+func TestSwitchHtlcEvents(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	client, err := s.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe to htlc events: %v", err)
+	}
+	defer client.Cancel()
+
+	assertEvent := func(eventType HtlcEventType,
+		incoming CircuitKey) *HtlcEvent {
+
+		t.Helper()
+
+		select {
+		case event := <-client.Events:
+			if event.Type != eventType {
+				t.Fatalf("expected %v event, got %v", eventType,
+					event.Type)
+			}
+			if event.IncomingCircuit != incoming {
+				t.Fatalf("expected incoming circuit %v, got %v",
+					incoming, event.IncomingCircuit)
+			}
+			if event.Timestamp.IsZero() {
+				t.Fatalf("event timestamp not set")
+			}
+			return event
+		case <-time.After(time.Second):
+			t.Fatalf("%v event was not dispatched", eventType)
+		}
+
+		return nil
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+
+	// Forward an HTLC from Alice to Bob, and settle it once it reaches
+	// Bob's link.
+	packet := &htlcPacket{
+		incomingChanID: aliceChannelLink.ShortChanID(),
+		incomingHTLCID: 0,
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		incomingAmount: 2,
+		amount:         1,
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+	if err := s.forward(packet); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		if err := bobChannelLink.completeCircuit(packet); err != nil {
+			t.Fatalf("unable to complete payment circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+
+	settle := &htlcPacket{
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		outgoingHTLCID: 0,
+		amount:         1,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+	if err := s.forward(settle); err != nil {
+		t.Fatal(err)
+	}
+
+	event := assertEvent(HtlcEventSettle, packet.inKey())
+	expectedOut := CircuitKey{ChanID: bobChanID, HtlcID: 0}
+	if event.OutgoingCircuit != expectedOut {
+		t.Fatalf("expected outgoing circuit %v, got %v", expectedOut,
+			event.OutgoingCircuit)
+	}
+	if event.IncomingAmt != 2 || event.OutgoingAmt != 1 {
+		t.Fatalf("unexpected amounts: in=%v, out=%v",
+			event.IncomingAmt, event.OutgoingAmt)
+	}
+
+	// Next, forward an HTLC to a channel the switch doesn't know of. It
+	// should be failed by the switch, and reported with the reason.
+	packet = &htlcPacket{
+		incomingChanID: aliceChannelLink.ShortChanID(),
+		incomingHTLCID: 1,
+		outgoingChanID: lnwire.NewShortChanIDFromInt(999),
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+	if err := s.forward(packet); err == nil {
+		t.Fatalf("expected forward to unknown channel to fail")
+	}
+
+	event = assertEvent(HtlcEventLinkFail, packet.inKey())
+	if event.FailureDetail != FailureDetailUnknownNextPeer {
+		t.Fatalf("expected detail %v, got %v",
+			FailureDetailUnknownNextPeer, event.FailureDetail)
+	}
+	if _, ok := event.FailureMessage.(*lnwire.FailUnknownNextPeer); !ok {
+		t.Fatalf("expected FailUnknownNextPeer, got %T",
+			event.FailureMessage)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"container/heap"
 	"crypto/sha256"
 	"fmt"
 	"sync"
@@ -28,6 +29,12 @@ var (
 	debugHash = chainhash.Hash(sha256.Sum256(debugPre[:]))
 )
 
+const (
+	// invoiceGCInterval is the interval at which the registry deletes
+	// canceled invoices that have exceeded their retention period.
+	invoiceGCInterval = time.Hour
+)
+
 // invoiceRegistry is a central registry of all the outstanding invoices
 // created by the daemon. The registry is a thin wrapper around a map in order
 // to ensure that all updates/reads are thread safe.
@@ -49,6 +56,16 @@ type invoiceRegistry struct {
 	// that *all* nodes are able to fully settle.
 	debugInvoices map[chainhash.Hash]*channeldb.Invoice
 
+	// canceledRetention is the duration for which canceled invoices are
+	// retained, measured from their creation date. A zero value means
+	// canceled invoices are never deleted.
+	canceledRetention time.Duration
+
+	// newExpiries is used to hand newly added invoices to the expiry
+	// watcher, which will cancel them once they expire. As it's
+	// unbounded, adding invoices never blocks on the expiry watcher.
+	newExpiries *queue.ConcurrentQueue
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -56,10 +73,16 @@ type invoiceRegistry struct {
 // newInvoiceRegistry creates a new invoice registry. The invoice registry
 // wraps the persistent on-disk invoice storage with an additional in-memory
 // layer. The in-memory layer is in place such that debug invoices can be added
-// which are volatile yet available system wide within the daemon.
-func newInvoiceRegistry(cdb *channeldb.DB) *invoiceRegistry {
+// which are volatile yet available system wide within the daemon. Canceled
+// invoices created longer than canceledRetention ago are deleted, unless
+// canceledRetention is zero.
+func newInvoiceRegistry(cdb *channeldb.DB,
+	canceledRetention time.Duration) *invoiceRegistry {
+
 	return &invoiceRegistry{
 		cdb:                 cdb,
+		canceledRetention:   canceledRetention,
+		newExpiries:         queue.NewConcurrentQueue(20),
 		debugInvoices:       make(map[chainhash.Hash]*channeldb.Invoice),
 		notificationClients: make(map[uint32]*invoiceSubscription),
 		newSubscriptions:    make(chan *invoiceSubscription),
@@ -71,9 +94,32 @@ func newInvoiceRegistry(cdb *channeldb.DB) *invoiceRegistry {
 
 // Start starts the registry and all goroutines it needs to carry out its task.
 func (i *invoiceRegistry) Start() error {
-	i.wg.Add(1)
+	// Before launching the expiry watcher, we'll gather the expiry of all
+	// open invoices, such that any invoice that expired while we were
+	// down is canceled immediately.
+	invoices, err := i.cdb.FetchAllInvoices(true)
+	if err != nil && err != channeldb.ErrNoInvoicesCreated {
+		return err
+	}
 
+	var expiries invoiceExpiryQueue
+	for _, invoice := range invoices {
+		expiry, err := newInvoiceExpiry(&invoice)
+		if err != nil {
+			ltndLog.Warnf("Unable to determine expiry of invoice "+
+				"with add_index=%v: %v", invoice.AddIndex, err)
+			continue
+		}
+
+		expiries = append(expiries, expiry)
+	}
+	heap.Init(&expiries)
+
+	i.newExpiries.Start()
+
+	i.wg.Add(2)
 	go i.invoiceEventNotifier()
+	go i.invoiceExpiryWatcher(expiries)
 
 	return nil
 }
@@ -83,13 +129,222 @@ func (i *invoiceRegistry) Stop() {
 	close(i.quit)
 
 	i.wg.Wait()
+
+	i.newExpiries.Stop()
+}
+
+// invoiceExpiry couples the payment hash of an open invoice with the time at
+// which it expires.
+type invoiceExpiry struct {
+	paymentHash chainhash.Hash
+
+	expiry time.Time
+}
+
+// newInvoiceExpiry returns the invoiceExpiry of the passed invoice, which is
+// determined by the creation date of the invoice along with the expiry
+// encoded within its payment request.
+func newInvoiceExpiry(invoice *channeldb.Invoice) (*invoiceExpiry, error) {
+	expiry, _, err := decodeInvoiceParams(invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+
+	return &invoiceExpiry{
+		paymentHash: paymentHash,
+		expiry:      invoice.CreationDate.Add(expiry),
+	}, nil
+}
+
+// decodeInvoiceParams returns the expiry and minimum final CLTV delta of the
+// passed invoice. These are taken from the payment request parameters stored
+// along side the invoice, or decoded from its payment request if the invoice
+// predates them being stored.
+func decodeInvoiceParams(invoice *channeldb.Invoice) (time.Duration, uint32,
+	error) {
+
+	if invoice.Params != nil {
+		return invoice.Params.Expiry, invoice.Params.FinalCltvDelta, nil
+	}
+
+	payReq, err := zpay32.Decode(
+		string(invoice.PaymentRequest), activeNetParams.Params,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return payReq.Expiry(), uint32(payReq.MinFinalCLTVExpiry()), nil
+}
+
+// invoiceExpiryQueue is a min-heap of invoice expiries, ordered such that
+// the invoice expiring first is always at the top.
+type invoiceExpiryQueue []*invoiceExpiry
+
+// Len returns the number of expiries within the queue.
+//
+// NOTE: This is part of the heap.Interface interface.
+func (q invoiceExpiryQueue) Len() int { return len(q) }
+
+// Less returns whether the expiry at index i expires before the expiry at
+// index j.
+//
+// NOTE: This is part of the heap.Interface interface.
+func (q invoiceExpiryQueue) Less(i, j int) bool {
+	return q[i].expiry.Before(q[j].expiry)
+}
+
+// Swap swaps the expiries at the passed indices.
+//
+// NOTE: This is part of the heap.Interface interface.
+func (q invoiceExpiryQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+// Push adds an expiry to the end of the queue.
+//
+// NOTE: This is part of the heap.Interface interface.
+func (q *invoiceExpiryQueue) Push(x interface{}) {
+	*q = append(*q, x.(*invoiceExpiry))
 }
 
+// Pop removes the expiry at the end of the queue.
+//
+// NOTE: This is part of the heap.Interface interface.
+func (q *invoiceExpiryQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return x
+}
+
+// invoiceExpiryWatcher is the dedicated goroutine responsible for canceling
+// open invoices once they expire, and for periodically deleting canceled
+// invoices that have exceeded their retention period, if enabled.
+//
+// NOTE: This MUST be run as a goroutine.
+func (i *invoiceRegistry) invoiceExpiryWatcher(expiries invoiceExpiryQueue) {
+	defer i.wg.Done()
+
+	var gcTicks <-chan time.Time
+	if i.canceledRetention != 0 {
+		gcTicker := time.NewTicker(invoiceGCInterval)
+		defer gcTicker.Stop()
+
+		gcTicks = gcTicker.C
+		i.deleteCanceledInvoices()
+	}
+
+	for {
+		// We'll cancel all invoices that have already expired, and
+		// then wait until the next invoice expires.
+		now := time.Now()
+		for expiries.Len() > 0 && !expiries[0].expiry.After(now) {
+			expiry := heap.Pop(&expiries).(*invoiceExpiry)
+			i.cancelExpiredInvoice(expiry.paymentHash)
+		}
+
+		var (
+			expiryTimer *time.Timer
+			nextExpiry  <-chan time.Time
+		)
+		if expiries.Len() > 0 {
+			expiryTimer = time.NewTimer(expiries[0].expiry.Sub(now))
+			nextExpiry = expiryTimer.C
+		}
+
+		select {
+		case expiry := <-i.newExpiries.ChanOut():
+			heap.Push(&expiries, expiry.(*invoiceExpiry))
+
+		case <-nextExpiry:
+
+		case <-gcTicks:
+			i.deleteCanceledInvoices()
+
+		case <-i.quit:
+			if expiryTimer != nil {
+				expiryTimer.Stop()
+			}
+			return
+		}
+
+		if expiryTimer != nil {
+			expiryTimer.Stop()
+		}
+	}
+}
+
+// cancelExpiredInvoice cancels the invoice with the passed payment hash, as
+// it has expired, and notifies all clients of the cancellation. Invoices that
+// have been settled or canceled in the meantime are left untouched.
+func (i *invoiceRegistry) cancelExpiredInvoice(rHash chainhash.Hash) {
+	// We'll hold the lock while canceling the invoice, such that it's only
+	// canceled and notified once, even if the expiry watcher and a lookup
+	// race to cancel it.
+	i.Lock()
+	defer i.Unlock()
+
+	invoice, err := i.cdb.LookupInvoice(rHash)
+	if err != nil {
+		ltndLog.Errorf("Unable to look up expired invoice %x: %v",
+			rHash[:], err)
+		return
+	}
+	if invoice.Terms.State != channeldb.ContractOpen {
+		return
+	}
+
+	canceledInvoice, err := i.cdb.CancelInvoice(rHash)
+	if err != nil {
+		ltndLog.Errorf("Unable to cancel expired invoice %x: %v",
+			rHash[:], err)
+		return
+	}
+
+	ltndLog.Infof("Canceled expired invoice %x", rHash[:])
+
+	i.notifyClients(canceledInvoice, invoiceCanceled)
+}
+
+// deleteCanceledInvoices deletes all canceled invoices that have exceeded
+// their retention period.
+func (i *invoiceRegistry) deleteCanceledInvoices() {
+	createdBefore := time.Now().Add(-i.canceledRetention)
+	numDeleted, err := i.cdb.DeleteCanceledInvoices(createdBefore)
+	if err != nil {
+		ltndLog.Errorf("Unable to delete canceled invoices: %v", err)
+		return
+	}
+
+	if numDeleted > 0 {
+		ltndLog.Infof("Deleted %d canceled invoices created before %v",
+			numDeleted, createdBefore)
+	}
+}
+
+// invoiceEventType denotes the way in which an invoice event modified an
+// invoice.
+type invoiceEventType uint8
+
+const (
+	// invoiceAdded denotes a newly created invoice.
+	invoiceAdded invoiceEventType = iota
+
+	// invoiceSettled denotes an invoice that was settled.
+	invoiceSettled
+
+	// invoiceCanceled denotes an invoice that was canceled as it expired.
+	invoiceCanceled
+)
+
 // invoiceEvent represents a new event that has modified on invoice on disk.
-// Only two event types are currently supported: newly created invoices, and
-// instance where invoices are settled.
+// Three event types are currently supported: newly created invoices, and
+// instances where invoices are settled or canceled.
 type invoiceEvent struct {
-	isSettle bool
+	eventType invoiceEventType
 
 	invoice *channeldb.Invoice
 }
@@ -140,30 +395,32 @@ func (i *invoiceRegistry) invoiceEventNotifier() {
 				// received this notification in order to
 				// ensure we don't duplicate any events.
 				invoice := event.invoice
+				isSettle := event.eventType == invoiceSettled
+				isAdd := event.eventType == invoiceAdded
 				switch {
 				// If we've already sent this settle event to
 				// the client, then we can skip this.
-				case event.isSettle &&
+				case isSettle &&
 					client.settleIndex >= invoice.SettleIndex:
 					continue
 
 				// Similarly, if we've already sent this add to
 				// the client then we can skip this one.
-				case !event.isSettle &&
+				case isAdd &&
 					client.addIndex >= invoice.AddIndex:
 					continue
 
 				// These two states should never happen, but we
 				// log them just in case so we can detect this
 				// instance.
-				case !event.isSettle &&
+				case isAdd &&
 					client.addIndex+1 != invoice.AddIndex:
 					ltndLog.Warnf("client=%v for invoice "+
 						"notifications missed an update, "+
 						"add_index=%v, new add event index=%v",
 						clientID, client.addIndex,
 						invoice.AddIndex)
-				case event.isSettle &&
+				case isSettle &&
 					client.settleIndex+1 != invoice.SettleIndex:
 					ltndLog.Warnf("client=%v for invoice "+
 						"notifications missed an update, "+
@@ -174,8 +431,8 @@ func (i *invoiceRegistry) invoiceEventNotifier() {
 
 				select {
 				case client.ntfnQueue.ChanIn() <- &invoiceEvent{
-					isSettle: event.isSettle,
-					invoice:  invoice,
+					eventType: event.eventType,
+					invoice:   invoice,
 				}:
 				case <-i.quit:
 					return
@@ -186,10 +443,13 @@ func (i *invoiceRegistry) invoiceEventNotifier() {
 				// index it has. We'll use this to ensure we
 				// don't send a notification twice, which can
 				// happen if a new event is added while we're
-				// catching up a new client.
-				if event.isSettle {
+				// catching up a new client. Cancellations
+				// aren't indexed, so they're never part of
+				// the backlog.
+				switch event.eventType {
+				case invoiceSettled:
 					client.settleIndex = invoice.SettleIndex
-				} else {
+				case invoiceAdded:
 					client.addIndex = invoice.AddIndex
 				}
 			}
@@ -225,8 +485,8 @@ func (i *invoiceRegistry) deliverBacklogEvents(client *invoiceSubscription) erro
 
 		select {
 		case client.ntfnQueue.ChanIn() <- &invoiceEvent{
-			isSettle: false,
-			invoice:  &addEvent,
+			eventType: invoiceAdded,
+			invoice:   &addEvent,
 		}:
 		case <-i.quit:
 			return fmt.Errorf("registry shutting down")
@@ -239,8 +499,8 @@ func (i *invoiceRegistry) deliverBacklogEvents(client *invoiceSubscription) erro
 
 		select {
 		case client.ntfnQueue.ChanIn() <- &invoiceEvent{
-			isSettle: true,
-			invoice:  &settleEvent,
+			eventType: invoiceSettled,
+			invoice:   &settleEvent,
 		}:
 		case <-i.quit:
 			return fmt.Errorf("registry shutting down")
@@ -296,7 +556,21 @@ func (i *invoiceRegistry) AddInvoice(invoice *channeldb.Invoice) (uint64, error)
 
 	// Now that we've added the invoice, we'll send dispatch a message to
 	// notify the clients of this new invoice.
-	i.notifyClients(invoice, false)
+	i.notifyClients(invoice, invoiceAdded)
+
+	// Finally, we'll hand the invoice to the expiry watcher, such that it
+	// can be canceled once it expires.
+	expiry, err := newInvoiceExpiry(invoice)
+	if err != nil {
+		ltndLog.Warnf("Unable to determine expiry of invoice with "+
+			"add_index=%v: %v", addIndex, err)
+		return addIndex, nil
+	}
+
+	select {
+	case i.newExpiries.ChanIn() <- expiry:
+	case <-i.quit:
+	}
 
 	return addIndex, nil
 }
@@ -305,9 +579,9 @@ func (i *invoiceRegistry) AddInvoice(invoice *channeldb.Invoice) (uint64, error)
 // then we're able to pull the funds pending within an HTLC. We'll also return
 // what the expected min final CLTV delta is, pre-parsed from the payment
 // request. This may be used by callers to determine if an HTLC is well formed
-// according to the cltv delta.
-//
-// TODO(roasbeef): ignore if settled?
+// according to the cltv delta. Canceled invoices are never returned, and
+// open invoices that have expired are canceled before being rejected, such
+// that HTLCs paying to them are failed.
 func (i *invoiceRegistry) LookupInvoice(rHash chainhash.Hash) (channeldb.Invoice, uint32, error) {
 	// First check the in-memory debug invoice index to see if this is an
 	// existing invoice added for debugging.
@@ -327,14 +601,25 @@ func (i *invoiceRegistry) LookupInvoice(rHash chainhash.Hash) (channeldb.Invoice
 		return channeldb.Invoice{}, 0, err
 	}
 
-	payReq, err := zpay32.Decode(
-		string(invoice.PaymentRequest), activeNetParams.Params,
-	)
+	if invoice.Terms.State == channeldb.ContractCanceled {
+		return channeldb.Invoice{}, 0, channeldb.ErrInvoiceAlreadyCanceled
+	}
+
+	expiry, finalCltvDelta, err := decodeInvoiceParams(&invoice)
 	if err != nil {
 		return channeldb.Invoice{}, 0, err
 	}
 
-	return invoice, uint32(payReq.MinFinalCLTVExpiry()), nil
+	// The expiry watcher may not have caught up with this invoice yet, so
+	// we'll make sure not to accept payments to an expired invoice.
+	if invoice.Terms.State == channeldb.ContractOpen &&
+		!time.Now().Before(invoice.CreationDate.Add(expiry)) {
+
+		i.cancelExpiredInvoice(rHash)
+		return channeldb.Invoice{}, 0, channeldb.ErrInvoiceAlreadyCanceled
+	}
+
+	return invoice, finalCltvDelta, nil
 }
 
 // SettleInvoice attempts to mark an invoice as settled. If the invoice is a
@@ -358,6 +643,9 @@ func (i *invoiceRegistry) SettleInvoice(rHash chainhash.Hash,
 
 	// If this isn't a debug invoice, then we'll attempt to settle an
 	// invoice matching this rHash on disk (if one exists).
+	// The invoice may have expired after the HTLC paying to it was
+	// accepted, in which case it's settled nonetheless, as the HTLC has
+	// already been settled.
 	invoice, err := i.cdb.SettleInvoice(rHash, amtPaid)
 	if err != nil {
 		return err
@@ -365,17 +653,19 @@ func (i *invoiceRegistry) SettleInvoice(rHash chainhash.Hash,
 
 	ltndLog.Infof("Payment received: %v", spew.Sdump(invoice))
 
-	i.notifyClients(invoice, true)
+	i.notifyClients(invoice, invoiceSettled)
 
 	return nil
 }
 
 // notifyClients notifies all currently registered invoice notification clients
-// of a newly added/settled invoice.
-func (i *invoiceRegistry) notifyClients(invoice *channeldb.Invoice, settle bool) {
+// of a newly added/settled/canceled invoice.
+func (i *invoiceRegistry) notifyClients(invoice *channeldb.Invoice,
+	eventType invoiceEventType) {
+
 	event := &invoiceEvent{
-		isSettle: settle,
-		invoice:  invoice,
+		eventType: eventType,
+		invoice:   invoice,
 	}
 
 	select {
@@ -384,11 +674,12 @@ func (i *invoiceRegistry) notifyClients(invoice *channeldb.Invoice, settle bool)
 	}
 }
 
-// invoiceSubscription represents an intent to receive updates for newly added
-// or settled invoices. For each newly added invoice, a copy of the invoice
-// will be sent over the NewInvoices channel. Similarly, for each newly settled
-// invoice, a copy of the invoice will be sent over the SettledInvoices
-// channel.
+// invoiceSubscription represents an intent to receive updates for newly added,
+// settled or canceled invoices. For each newly added invoice, a copy of the
+// invoice will be sent over the NewInvoices channel. Similarly, for each newly
+// settled invoice, a copy of the invoice will be sent over the SettledInvoices
+// channel, and for each invoice canceled as it expired, over the
+// CanceledInvoices channel.
 type invoiceSubscription struct {
 	cancelled uint32 // To be used atomically.
 
@@ -402,6 +693,11 @@ type invoiceSubscription struct {
 	// StartingInvoiceIndex field.
 	SettledInvoices chan *channeldb.Invoice
 
+	// CanceledInvoices is a channel that we'll use to send all invoices
+	// that are canceled while the subscription is active. As canceled
+	// invoices aren't indexed, no backlog of these is sent.
+	CanceledInvoices chan *channeldb.Invoice
+
 	// addIndex is the highest add index the caller knows of. We'll use
 	// this information to send out an event backlog to the notifications
 	// subscriber. Any new add events with an index greater than this will
@@ -451,13 +747,14 @@ func (i *invoiceSubscription) Cancel() {
 // this value. Afterwards, we'll send out real-time notifications.
 func (i *invoiceRegistry) SubscribeNotifications(addIndex, settleIndex uint64) *invoiceSubscription {
 	client := &invoiceSubscription{
-		NewInvoices:     make(chan *channeldb.Invoice),
-		SettledInvoices: make(chan *channeldb.Invoice),
-		addIndex:        addIndex,
-		settleIndex:     settleIndex,
-		inv:             i,
-		ntfnQueue:       queue.NewConcurrentQueue(20),
-		cancelChan:      make(chan struct{}),
+		NewInvoices:      make(chan *channeldb.Invoice),
+		SettledInvoices:  make(chan *channeldb.Invoice),
+		CanceledInvoices: make(chan *channeldb.Invoice),
+		addIndex:         addIndex,
+		settleIndex:      settleIndex,
+		inv:              i,
+		ntfnQueue:        queue.NewConcurrentQueue(20),
+		cancelChan:       make(chan struct{}),
 	}
 	client.ntfnQueue.Start()
 
@@ -477,15 +774,20 @@ func (i *invoiceRegistry) SubscribeNotifications(addIndex, settleIndex uint64) *
 		for {
 			select {
 			// A new invoice event has been sent by the
-			// invoiceRegistry! We'll figure out if this is an add
-			// event or a settle event, then dispatch the event to
+			// invoiceRegistry! We'll figure out if this is an add,
+			// settle or cancel event, then dispatch the event to
 			// the client.
 			case ntfn := <-client.ntfnQueue.ChanOut():
 				invoiceEvent := ntfn.(*invoiceEvent)
 
-				targetChan := client.NewInvoices
-				if invoiceEvent.isSettle {
+				var targetChan chan *channeldb.Invoice
+				switch invoiceEvent.eventType {
+				case invoiceAdded:
+					targetChan = client.NewInvoices
+				case invoiceSettled:
 					targetChan = client.SettledInvoices
+				case invoiceCanceled:
+					targetChan = client.CanceledInvoices
 				}
 
 				select {
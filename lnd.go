@@ -41,6 +41,7 @@ import (
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -160,7 +161,7 @@ func lndMain() error {
 
 	// Open the channeldb, which is dedicated to storing channel, and
 	// network related metadata.
-	chanDB, err := channeldb.Open(graphDir)
+	chanDB, err := openChannelDB(graphDir)
 	if err != nil {
 		ltndLog.Errorf("unable to open channeldb: %v", err)
 		return err
@@ -234,6 +235,8 @@ func lndMain() error {
 		// Create the macaroon authentication/authorization service.
 		macaroonService, err = macaroons.NewService(
 			networkDir, macaroons.IPLockChecker,
+			macaroons.MethodChecker, macaroons.MaxPaymentChecker,
+			macaroons.BudgetChecker, macaroons.AccountChecker,
 		)
 		if err != nil {
 			srvrLog.Errorf("unable to create macaroon service: %v", err)
@@ -316,7 +319,11 @@ func lndMain() error {
 	// Set up an auotpilot manager from the current config. This will be
 	// used to manage the underlying autopilot agent, starting and stopping
 	// it at will.
-	atplCfg := initAutoPilot(server, cfg.Autopilot)
+	atplCfg, err := initAutoPilot(server, cfg.Autopilot)
+	if err != nil {
+		ltndLog.Errorf("unable to init autopilot: %v", err)
+		return err
+	}
 	atplManager, err := autopilot.NewManager(atplCfg)
 	if err != nil {
 		ltndLog.Errorf("unable to create autopilot manager: %v", err)
@@ -419,6 +426,45 @@ func main() {
 	}
 }
 
+// openChannelDB opens the channeldb using the database backend selected
+// within the config. The default bolt backend stores the database within the
+// passed graph directory.
+func openChannelDB(graphDir string) (*channeldb.DB, error) {
+	if cfg.DB.Backend == kvdb.BoltBackendName {
+		// As bbolt never shrinks its database file, we'll compact the
+		// database before opening it if requested.
+		if cfg.DB.AutoCompact {
+			ltndLog.Infof("Compacting channel database, this may " +
+				"take a while")
+
+			oldSize, newSize, err := channeldb.Compact(graphDir)
+			if err != nil {
+				return nil, err
+			}
+
+			ltndLog.Infof("Compacted channel database from %v to "+
+				"%v bytes", oldSize, newSize)
+		}
+
+		return channeldb.Open(graphDir)
+	}
+
+	ltndLog.Infof("Opening %v database backend", cfg.DB.Backend)
+
+	backend, err := kvdb.Open(cfg.DB.Backend, cfg.DB.Etcd)
+	if err != nil {
+		return nil, err
+	}
+
+	chanDB, err := channeldb.CreateWithBackend(backend)
+	if err != nil {
+		backend.Close()
+		return nil, err
+	}
+
+	return chanDB, nil
+}
+
 // fileExists reports whether the named file or directory exists.
 // This function is taken from https://github.com/btcsuite/btcd
 func fileExists(name string) bool {
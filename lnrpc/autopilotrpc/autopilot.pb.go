@@ -33,7 +33,7 @@ func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
 func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()    {}
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_45e6f1df6dc1d1df, []int{0}
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{0}
 }
 func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusRequest.Unmarshal(m, b)
@@ -55,17 +55,19 @@ var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
 
 type StatusResponse struct {
 	// / Indicates whether the autopilot is active or not.
-	Active               bool     `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// / The weights of the heuristics combined by the autopilot agent.
+	HeuristicWeights     map[string]float64 `protobuf:"bytes,2,rep,name=heuristic_weights,proto3" json:"heuristic_weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
 func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()    {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_45e6f1df6dc1d1df, []int{1}
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{1}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusResponse.Unmarshal(m, b)
@@ -92,6 +94,13 @@ func (m *StatusResponse) GetActive() bool {
 	return false
 }
 
+func (m *StatusResponse) GetHeuristicWeights() map[string]float64 {
+	if m != nil {
+		return m.HeuristicWeights
+	}
+	return nil
+}
+
 type ModifyStatusRequest struct {
 	// / Whether the autopilot agent should be enabled or not.
 	Enable               bool     `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
@@ -104,7 +113,7 @@ func (m *ModifyStatusRequest) Reset()         { *m = ModifyStatusRequest{} }
 func (m *ModifyStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyStatusRequest) ProtoMessage()    {}
 func (*ModifyStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_45e6f1df6dc1d1df, []int{2}
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{2}
 }
 func (m *ModifyStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyStatusRequest.Unmarshal(m, b)
@@ -141,7 +150,7 @@ func (m *ModifyStatusResponse) Reset()         { *m = ModifyStatusResponse{} }
 func (m *ModifyStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyStatusResponse) ProtoMessage()    {}
 func (*ModifyStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_45e6f1df6dc1d1df, []int{3}
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{3}
 }
 func (m *ModifyStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyStatusResponse.Unmarshal(m, b)
@@ -161,11 +170,531 @@ func (m *ModifyStatusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModifyStatusResponse proto.InternalMessageInfo
 
+type SetHeuristicWeightsRequest struct {
+	// / The new weight of each heuristic, keyed by the name of the heuristic.
+	HeuristicWeights     map[string]float64 `protobuf:"bytes,1,rep,name=heuristic_weights,proto3" json:"heuristic_weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetHeuristicWeightsRequest) Reset()         { *m = SetHeuristicWeightsRequest{} }
+func (m *SetHeuristicWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetHeuristicWeightsRequest) ProtoMessage()    {}
+func (*SetHeuristicWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{4}
+}
+func (m *SetHeuristicWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetHeuristicWeightsRequest.Unmarshal(m, b)
+}
+func (m *SetHeuristicWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetHeuristicWeightsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetHeuristicWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetHeuristicWeightsRequest.Merge(dst, src)
+}
+func (m *SetHeuristicWeightsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetHeuristicWeightsRequest.Size(m)
+}
+func (m *SetHeuristicWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetHeuristicWeightsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetHeuristicWeightsRequest proto.InternalMessageInfo
+
+func (m *SetHeuristicWeightsRequest) GetHeuristicWeights() map[string]float64 {
+	if m != nil {
+		return m.HeuristicWeights
+	}
+	return nil
+}
+
+type SetHeuristicWeightsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetHeuristicWeightsResponse) Reset()         { *m = SetHeuristicWeightsResponse{} }
+func (m *SetHeuristicWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetHeuristicWeightsResponse) ProtoMessage()    {}
+func (*SetHeuristicWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{5}
+}
+func (m *SetHeuristicWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetHeuristicWeightsResponse.Unmarshal(m, b)
+}
+func (m *SetHeuristicWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetHeuristicWeightsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetHeuristicWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetHeuristicWeightsResponse.Merge(dst, src)
+}
+func (m *SetHeuristicWeightsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetHeuristicWeightsResponse.Size(m)
+}
+func (m *SetHeuristicWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetHeuristicWeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetHeuristicWeightsResponse proto.InternalMessageInfo
+
+type QueryScoresRequest struct {
+	// / The hex-encoded public keys of the nodes to query the scores of.
+	Pubkeys []string `protobuf:"bytes,1,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	// *
+	// The name of the heuristic to query. If empty, all heuristics used by the
+	// autopilot agent are queried.
+	Heuristic string `protobuf:"bytes,2,opt,name=heuristic,proto3" json:"heuristic,omitempty"`
+	// *
+	// If set, we will ignore the local channel state when calculating scores,
+	// instead of giving nodes we already have channels with a score of zero.
+	IgnoreLocalState     bool     `protobuf:"varint,3,opt,name=ignore_local_state,proto3" json:"ignore_local_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryScoresRequest) Reset()         { *m = QueryScoresRequest{} }
+func (m *QueryScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScoresRequest) ProtoMessage()    {}
+func (*QueryScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{6}
+}
+func (m *QueryScoresRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryScoresRequest.Unmarshal(m, b)
+}
+func (m *QueryScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryScoresRequest.Marshal(b, m, deterministic)
+}
+func (dst *QueryScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScoresRequest.Merge(dst, src)
+}
+func (m *QueryScoresRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryScoresRequest.Size(m)
+}
+func (m *QueryScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScoresRequest proto.InternalMessageInfo
+
+func (m *QueryScoresRequest) GetPubkeys() []string {
+	if m != nil {
+		return m.Pubkeys
+	}
+	return nil
+}
+
+func (m *QueryScoresRequest) GetHeuristic() string {
+	if m != nil {
+		return m.Heuristic
+	}
+	return ""
+}
+
+func (m *QueryScoresRequest) GetIgnoreLocalState() bool {
+	if m != nil {
+		return m.IgnoreLocalState
+	}
+	return false
+}
+
+type QueryScoresResponse struct {
+	// / The scores given by each of the queried heuristics.
+	Results              []*QueryScoresResponse_HeuristicResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *QueryScoresResponse) Reset()         { *m = QueryScoresResponse{} }
+func (m *QueryScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScoresResponse) ProtoMessage()    {}
+func (*QueryScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{7}
+}
+func (m *QueryScoresResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryScoresResponse.Unmarshal(m, b)
+}
+func (m *QueryScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryScoresResponse.Marshal(b, m, deterministic)
+}
+func (dst *QueryScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScoresResponse.Merge(dst, src)
+}
+func (m *QueryScoresResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryScoresResponse.Size(m)
+}
+func (m *QueryScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScoresResponse proto.InternalMessageInfo
+
+func (m *QueryScoresResponse) GetResults() []*QueryScoresResponse_HeuristicResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type QueryScoresResponse_HeuristicResult struct {
+	// / The name of the heuristic.
+	Heuristic string `protobuf:"bytes,1,opt,name=heuristic,proto3" json:"heuristic,omitempty"`
+	// / The score given to each node, keyed by its hex-encoded public key.
+	Scores               map[string]float64 `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryScoresResponse_HeuristicResult) Reset()         { *m = QueryScoresResponse_HeuristicResult{} }
+func (m *QueryScoresResponse_HeuristicResult) String() string { return proto.CompactTextString(m) }
+func (*QueryScoresResponse_HeuristicResult) ProtoMessage()    {}
+func (*QueryScoresResponse_HeuristicResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{7, 0}
+}
+func (m *QueryScoresResponse_HeuristicResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryScoresResponse_HeuristicResult.Unmarshal(m, b)
+}
+func (m *QueryScoresResponse_HeuristicResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryScoresResponse_HeuristicResult.Marshal(b, m, deterministic)
+}
+func (dst *QueryScoresResponse_HeuristicResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScoresResponse_HeuristicResult.Merge(dst, src)
+}
+func (m *QueryScoresResponse_HeuristicResult) XXX_Size() int {
+	return xxx_messageInfo_QueryScoresResponse_HeuristicResult.Size(m)
+}
+func (m *QueryScoresResponse_HeuristicResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScoresResponse_HeuristicResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScoresResponse_HeuristicResult proto.InternalMessageInfo
+
+func (m *QueryScoresResponse_HeuristicResult) GetHeuristic() string {
+	if m != nil {
+		return m.Heuristic
+	}
+	return ""
+}
+
+func (m *QueryScoresResponse_HeuristicResult) GetScores() map[string]float64 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+type SetScoresRequest struct {
+	// / The name of the heuristic to provide scores to.
+	Heuristic string `protobuf:"bytes,1,opt,name=heuristic,proto3" json:"heuristic,omitempty"`
+	// *
+	// A map from hex-encoded public keys to scores. Scores must be in the range
+	// [0.0, 1.0]. Nodes not found among the scores are given a score of zero.
+	Scores               map[string]float64 `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetScoresRequest) Reset()         { *m = SetScoresRequest{} }
+func (m *SetScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*SetScoresRequest) ProtoMessage()    {}
+func (*SetScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{8}
+}
+func (m *SetScoresRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetScoresRequest.Unmarshal(m, b)
+}
+func (m *SetScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetScoresRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetScoresRequest.Merge(dst, src)
+}
+func (m *SetScoresRequest) XXX_Size() int {
+	return xxx_messageInfo_SetScoresRequest.Size(m)
+}
+func (m *SetScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetScoresRequest proto.InternalMessageInfo
+
+func (m *SetScoresRequest) GetHeuristic() string {
+	if m != nil {
+		return m.Heuristic
+	}
+	return ""
+}
+
+func (m *SetScoresRequest) GetScores() map[string]float64 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+type SetScoresResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetScoresResponse) Reset()         { *m = SetScoresResponse{} }
+func (m *SetScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*SetScoresResponse) ProtoMessage()    {}
+func (*SetScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{9}
+}
+func (m *SetScoresResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetScoresResponse.Unmarshal(m, b)
+}
+func (m *SetScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetScoresResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetScoresResponse.Merge(dst, src)
+}
+func (m *SetScoresResponse) XXX_Size() int {
+	return xxx_messageInfo_SetScoresResponse.Size(m)
+}
+func (m *SetScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetScoresResponse proto.InternalMessageInfo
+
+type PruneReportRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PruneReportRequest) Reset()         { *m = PruneReportRequest{} }
+func (m *PruneReportRequest) String() string { return proto.CompactTextString(m) }
+func (*PruneReportRequest) ProtoMessage()    {}
+func (*PruneReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{10}
+}
+func (m *PruneReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PruneReportRequest.Unmarshal(m, b)
+}
+func (m *PruneReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PruneReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *PruneReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneReportRequest.Merge(dst, src)
+}
+func (m *PruneReportRequest) XXX_Size() int {
+	return xxx_messageInfo_PruneReportRequest.Size(m)
+}
+func (m *PruneReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneReportRequest proto.InternalMessageInfo
+
+type PruneCandidate struct {
+	// / The funding outpoint of the channel.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The short channel ID of the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The hex-encoded public key of the channel's peer.
+	PubKey string `protobuf:"bytes,3,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / The capacity of the channel in satoshis.
+	Capacity int64 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// / The fraction of the prune period the peer was online.
+	Uptime float64 `protobuf:"fixed64,5,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// / The number of payments forwarded through the channel within the period.
+	NumForwards uint64 `protobuf:"varint,6,opt,name=num_forwards,proto3" json:"num_forwards,omitempty"`
+	// / The fees in millisatoshi earned by the channel within the period.
+	FeesEarnedMsat uint64 `protobuf:"varint,7,opt,name=fees_earned_msat,proto3" json:"fees_earned_msat,omitempty"`
+	// / Why the channel was found to be inactive or unprofitable.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// / Whether the closure of the channel was initiated.
+	Closed bool `protobuf:"varint,9,opt,name=closed,proto3" json:"closed,omitempty"`
+	// / Why the channel wasn't closed, if it should have been.
+	CloseError           string   `protobuf:"bytes,10,opt,name=close_error,proto3" json:"close_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PruneCandidate) Reset()         { *m = PruneCandidate{} }
+func (m *PruneCandidate) String() string { return proto.CompactTextString(m) }
+func (*PruneCandidate) ProtoMessage()    {}
+func (*PruneCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{11}
+}
+func (m *PruneCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PruneCandidate.Unmarshal(m, b)
+}
+func (m *PruneCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PruneCandidate.Marshal(b, m, deterministic)
+}
+func (dst *PruneCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneCandidate.Merge(dst, src)
+}
+func (m *PruneCandidate) XXX_Size() int {
+	return xxx_messageInfo_PruneCandidate.Size(m)
+}
+func (m *PruneCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneCandidate proto.InternalMessageInfo
+
+func (m *PruneCandidate) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *PruneCandidate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *PruneCandidate) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *PruneCandidate) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *PruneCandidate) GetUptime() float64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
+func (m *PruneCandidate) GetNumForwards() uint64 {
+	if m != nil {
+		return m.NumForwards
+	}
+	return 0
+}
+
+func (m *PruneCandidate) GetFeesEarnedMsat() uint64 {
+	if m != nil {
+		return m.FeesEarnedMsat
+	}
+	return 0
+}
+
+func (m *PruneCandidate) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PruneCandidate) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
+func (m *PruneCandidate) GetCloseError() string {
+	if m != nil {
+		return m.CloseError
+	}
+	return ""
+}
+
+type PruneReportResponse struct {
+	// *
+	// The unix timestamp of the latest evaluation. Zero if no evaluation has
+	// taken place yet.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / Whether the channel pruner only reports channels without closing them.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	// / The channels found to be inactive or unprofitable.
+	Candidates           []*PruneCandidate `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PruneReportResponse) Reset()         { *m = PruneReportResponse{} }
+func (m *PruneReportResponse) String() string { return proto.CompactTextString(m) }
+func (*PruneReportResponse) ProtoMessage()    {}
+func (*PruneReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_abd9ea9bf34fe997, []int{12}
+}
+func (m *PruneReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PruneReportResponse.Unmarshal(m, b)
+}
+func (m *PruneReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PruneReportResponse.Marshal(b, m, deterministic)
+}
+func (dst *PruneReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneReportResponse.Merge(dst, src)
+}
+func (m *PruneReportResponse) XXX_Size() int {
+	return xxx_messageInfo_PruneReportResponse.Size(m)
+}
+func (m *PruneReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneReportResponse proto.InternalMessageInfo
+
+func (m *PruneReportResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PruneReportResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PruneReportResponse) GetCandidates() []*PruneCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StatusRequest)(nil), "autopilotrpc.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "autopilotrpc.StatusResponse")
+	proto.RegisterMapType((map[string]float64)(nil), "autopilotrpc.StatusResponse.HeuristicWeightsEntry")
 	proto.RegisterType((*ModifyStatusRequest)(nil), "autopilotrpc.ModifyStatusRequest")
 	proto.RegisterType((*ModifyStatusResponse)(nil), "autopilotrpc.ModifyStatusResponse")
+	proto.RegisterType((*SetHeuristicWeightsRequest)(nil), "autopilotrpc.SetHeuristicWeightsRequest")
+	proto.RegisterMapType((map[string]float64)(nil), "autopilotrpc.SetHeuristicWeightsRequest.HeuristicWeightsEntry")
+	proto.RegisterType((*SetHeuristicWeightsResponse)(nil), "autopilotrpc.SetHeuristicWeightsResponse")
+	proto.RegisterType((*QueryScoresRequest)(nil), "autopilotrpc.QueryScoresRequest")
+	proto.RegisterType((*QueryScoresResponse)(nil), "autopilotrpc.QueryScoresResponse")
+	proto.RegisterType((*QueryScoresResponse_HeuristicResult)(nil), "autopilotrpc.QueryScoresResponse.HeuristicResult")
+	proto.RegisterMapType((map[string]float64)(nil), "autopilotrpc.QueryScoresResponse.HeuristicResult.ScoresEntry")
+	proto.RegisterType((*SetScoresRequest)(nil), "autopilotrpc.SetScoresRequest")
+	proto.RegisterMapType((map[string]float64)(nil), "autopilotrpc.SetScoresRequest.ScoresEntry")
+	proto.RegisterType((*SetScoresResponse)(nil), "autopilotrpc.SetScoresResponse")
+	proto.RegisterType((*PruneReportRequest)(nil), "autopilotrpc.PruneReportRequest")
+	proto.RegisterType((*PruneCandidate)(nil), "autopilotrpc.PruneCandidate")
+	proto.RegisterType((*PruneReportResponse)(nil), "autopilotrpc.PruneReportResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -187,6 +716,24 @@ type AutopilotClient interface {
 	// ModifyStatus is used to modify the status of the autopilot agent, like
 	// enabling or disabling it.
 	ModifyStatus(ctx context.Context, in *ModifyStatusRequest, opts ...grpc.CallOption) (*ModifyStatusResponse, error)
+	// *
+	// SetHeuristicWeights is used to modify the weights of the heuristics that
+	// are combined by the autopilot agent when scoring nodes. The weights must sum
+	// to 1.0, and heuristics not specified are deactivated.
+	SetHeuristicWeights(ctx context.Context, in *SetHeuristicWeightsRequest, opts ...grpc.CallOption) (*SetHeuristicWeightsResponse, error)
+	// *
+	// QueryScores queries the autopilot heuristics for the scores they would give
+	// to the given set of nodes when deciding where to open channels.
+	QueryScores(ctx context.Context, in *QueryScoresRequest, opts ...grpc.CallOption) (*QueryScoresResponse, error)
+	// *
+	// SetScores attempts to set the scores used by the running autopilot agent,
+	// if the external scoring heuristic is enabled.
+	SetScores(ctx context.Context, in *SetScoresRequest, opts ...grpc.CallOption) (*SetScoresResponse, error)
+	// *
+	// PruneReport returns the outcome of the latest evaluation of the channel
+	// pruner, listing the channels found to be inactive or unprofitable, and
+	// whether they were closed.
+	PruneReport(ctx context.Context, in *PruneReportRequest, opts ...grpc.CallOption) (*PruneReportResponse, error)
 }
 
 type autopilotClient struct {
@@ -215,6 +762,42 @@ func (c *autopilotClient) ModifyStatus(ctx context.Context, in *ModifyStatusRequ
 	return out, nil
 }
 
+func (c *autopilotClient) SetHeuristicWeights(ctx context.Context, in *SetHeuristicWeightsRequest, opts ...grpc.CallOption) (*SetHeuristicWeightsResponse, error) {
+	out := new(SetHeuristicWeightsResponse)
+	err := c.cc.Invoke(ctx, "/autopilotrpc.Autopilot/SetHeuristicWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autopilotClient) QueryScores(ctx context.Context, in *QueryScoresRequest, opts ...grpc.CallOption) (*QueryScoresResponse, error) {
+	out := new(QueryScoresResponse)
+	err := c.cc.Invoke(ctx, "/autopilotrpc.Autopilot/QueryScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autopilotClient) SetScores(ctx context.Context, in *SetScoresRequest, opts ...grpc.CallOption) (*SetScoresResponse, error) {
+	out := new(SetScoresResponse)
+	err := c.cc.Invoke(ctx, "/autopilotrpc.Autopilot/SetScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autopilotClient) PruneReport(ctx context.Context, in *PruneReportRequest, opts ...grpc.CallOption) (*PruneReportResponse, error) {
+	out := new(PruneReportResponse)
+	err := c.cc.Invoke(ctx, "/autopilotrpc.Autopilot/PruneReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AutopilotServer is the server API for Autopilot service.
 type AutopilotServer interface {
 	// *
@@ -224,6 +807,24 @@ type AutopilotServer interface {
 	// ModifyStatus is used to modify the status of the autopilot agent, like
 	// enabling or disabling it.
 	ModifyStatus(context.Context, *ModifyStatusRequest) (*ModifyStatusResponse, error)
+	// *
+	// SetHeuristicWeights is used to modify the weights of the heuristics that
+	// are combined by the autopilot agent when scoring nodes. The weights must sum
+	// to 1.0, and heuristics not specified are deactivated.
+	SetHeuristicWeights(context.Context, *SetHeuristicWeightsRequest) (*SetHeuristicWeightsResponse, error)
+	// *
+	// QueryScores queries the autopilot heuristics for the scores they would give
+	// to the given set of nodes when deciding where to open channels.
+	QueryScores(context.Context, *QueryScoresRequest) (*QueryScoresResponse, error)
+	// *
+	// SetScores attempts to set the scores used by the running autopilot agent,
+	// if the external scoring heuristic is enabled.
+	SetScores(context.Context, *SetScoresRequest) (*SetScoresResponse, error)
+	// *
+	// PruneReport returns the outcome of the latest evaluation of the channel
+	// pruner, listing the channels found to be inactive or unprofitable, and
+	// whether they were closed.
+	PruneReport(context.Context, *PruneReportRequest) (*PruneReportResponse, error)
 }
 
 func RegisterAutopilotServer(s *grpc.Server, srv AutopilotServer) {
@@ -266,6 +867,78 @@ func _Autopilot_ModifyStatus_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Autopilot_SetHeuristicWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHeuristicWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutopilotServer).SetHeuristicWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autopilotrpc.Autopilot/SetHeuristicWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutopilotServer).SetHeuristicWeights(ctx, req.(*SetHeuristicWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Autopilot_QueryScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutopilotServer).QueryScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autopilotrpc.Autopilot/QueryScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutopilotServer).QueryScores(ctx, req.(*QueryScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Autopilot_SetScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutopilotServer).SetScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autopilotrpc.Autopilot/SetScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutopilotServer).SetScores(ctx, req.(*SetScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Autopilot_PruneReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutopilotServer).PruneReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/autopilotrpc.Autopilot/PruneReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutopilotServer).PruneReport(ctx, req.(*PruneReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Autopilot_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "autopilotrpc.Autopilot",
 	HandlerType: (*AutopilotServer)(nil),
@@ -278,30 +951,81 @@ var _Autopilot_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyStatus",
 			Handler:    _Autopilot_ModifyStatus_Handler,
 		},
+		{
+			MethodName: "SetHeuristicWeights",
+			Handler:    _Autopilot_SetHeuristicWeights_Handler,
+		},
+		{
+			MethodName: "QueryScores",
+			Handler:    _Autopilot_QueryScores_Handler,
+		},
+		{
+			MethodName: "SetScores",
+			Handler:    _Autopilot_SetScores_Handler,
+		},
+		{
+			MethodName: "PruneReport",
+			Handler:    _Autopilot_PruneReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "autopilotrpc/autopilot.proto",
 }
 
 func init() {
-	proto.RegisterFile("autopilotrpc/autopilot.proto", fileDescriptor_autopilot_45e6f1df6dc1d1df)
-}
-
-var fileDescriptor_autopilot_45e6f1df6dc1d1df = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2c, 0x2d, 0xc9,
-	0x2f, 0xc8, 0xcc, 0xc9, 0x2f, 0x29, 0x2a, 0x48, 0xd6, 0x87, 0x73, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
-	0xf2, 0x85, 0x78, 0x90, 0x65, 0x95, 0xf8, 0xb9, 0x78, 0x83, 0x4b, 0x12, 0x4b, 0x4a, 0x8b, 0x83,
-	0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x94, 0x34, 0xb8, 0xf8, 0x60, 0x02, 0xc5, 0x05, 0xf9, 0x79,
-	0xc5, 0xa9, 0x42, 0x62, 0x5c, 0x6c, 0x89, 0xc9, 0x25, 0x99, 0x65, 0xa9, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0x1c, 0x41, 0x50, 0x9e, 0x92, 0x2e, 0x97, 0xb0, 0x6f, 0x7e, 0x4a, 0x66, 0x5a, 0x25, 0x8a,
-	0x01, 0x20, 0xe5, 0xa9, 0x79, 0x89, 0x49, 0x39, 0x70, 0xe5, 0x10, 0x9e, 0x92, 0x18, 0x97, 0x08,
-	0xaa, 0x72, 0x88, 0xf1, 0x46, 0xcb, 0x19, 0xb9, 0x38, 0x1d, 0x61, 0x4e, 0x12, 0x72, 0xe6, 0x62,
-	0x83, 0xc8, 0x0b, 0x49, 0xeb, 0x21, 0x3b, 0x54, 0x0f, 0xc5, 0x12, 0x29, 0x19, 0xec, 0x92, 0x50,
-	0x17, 0x87, 0x72, 0xf1, 0x20, 0x5b, 0x25, 0xa4, 0x88, 0xaa, 0x1a, 0x8b, 0xab, 0xa5, 0x94, 0xf0,
-	0x29, 0x81, 0x18, 0xeb, 0x64, 0x12, 0x65, 0x94, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0x9f, 0x93, 0x99, 0x9e, 0x51, 0x92, 0x97, 0x99, 0x97, 0x9e, 0x97, 0x5a, 0x52, 0x9e,
-	0x5f, 0x94, 0xad, 0x9f, 0x93, 0x97, 0xa2, 0x9f, 0x93, 0x87, 0x12, 0xe4, 0x45, 0x05, 0xc9, 0x49,
-	0x6c, 0xe0, 0x60, 0x37, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x71, 0xb3, 0xba, 0x96, 0x01,
-	0x00, 0x00,
+	proto.RegisterFile("autopilotrpc/autopilot.proto", fileDescriptor_autopilot_abd9ea9bf34fe997)
+}
+
+var fileDescriptor_autopilot_abd9ea9bf34fe997 = []byte{
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdd, 0x6a, 0xe3, 0x46,
+	0x14, 0x46, 0x76, 0xe2, 0x58, 0xc7, 0xf9, 0x1d, 0xa7, 0x41, 0x28, 0x6e, 0xeb, 0x88, 0x5e, 0xb8,
+	0x81, 0xda, 0xd4, 0xed, 0x45, 0x5b, 0xda, 0x42, 0x13, 0x0a, 0x85, 0xb6, 0xd0, 0x8e, 0x09, 0x85,
+	0xde, 0x68, 0xc7, 0xd2, 0xc4, 0xd6, 0x46, 0x9e, 0xd1, 0xce, 0x8c, 0x12, 0x0c, 0xfb, 0x02, 0xcb,
+	0xbe, 0xc7, 0xde, 0xee, 0x2b, 0xec, 0x3e, 0xc1, 0xbe, 0xd2, 0xa2, 0x91, 0x64, 0x4b, 0xb6, 0xe2,
+	0x4d, 0x60, 0xd9, 0x3b, 0x7f, 0x67, 0xce, 0xdf, 0xf7, 0xcd, 0x99, 0x63, 0x41, 0x87, 0xc4, 0x8a,
+	0x47, 0x41, 0xc8, 0x95, 0x88, 0xbc, 0xc1, 0x02, 0xf4, 0x23, 0xc1, 0x15, 0x47, 0xbb, 0xc5, 0x53,
+	0xe7, 0x00, 0xf6, 0x46, 0x8a, 0xa8, 0x58, 0x62, 0xfa, 0x2c, 0xa6, 0x52, 0x39, 0xef, 0x0c, 0xd8,
+	0xcf, 0x2d, 0x32, 0xe2, 0x4c, 0x52, 0x74, 0x02, 0x0d, 0xe2, 0xa9, 0xe0, 0x96, 0x5a, 0x46, 0xd7,
+	0xe8, 0x35, 0x71, 0x86, 0xd0, 0x13, 0x38, 0x9a, 0xd2, 0x58, 0x04, 0x52, 0x05, 0x9e, 0x7b, 0x47,
+	0x83, 0xc9, 0x54, 0x49, 0xab, 0xd6, 0xad, 0xf7, 0x5a, 0xc3, 0x61, 0xbf, 0x58, 0xa5, 0x5f, 0x4e,
+	0xd8, 0xff, 0x23, 0x8f, 0xfa, 0x2f, 0x0d, 0xfa, 0x9d, 0x29, 0x31, 0xc7, 0xeb, 0xc9, 0xec, 0x4b,
+	0xf8, 0xac, 0xd2, 0x17, 0x1d, 0x42, 0xfd, 0x86, 0xce, 0x75, 0x3f, 0x26, 0x4e, 0x7e, 0xa2, 0x63,
+	0xd8, 0xbe, 0x25, 0x61, 0x4c, 0xad, 0x5a, 0xd7, 0xe8, 0x19, 0x38, 0x05, 0x3f, 0xd5, 0x7e, 0x30,
+	0x9c, 0x6f, 0xa0, 0xfd, 0x37, 0xf7, 0x83, 0xeb, 0x79, 0x89, 0x68, 0xc2, 0x8a, 0x32, 0x32, 0x0e,
+	0x17, 0xac, 0x52, 0xe4, 0x9c, 0xc0, 0x71, 0xd9, 0x3d, 0x6d, 0x3a, 0x11, 0xc6, 0x1e, 0x51, 0xb5,
+	0xda, 0x4f, 0x9e, 0x2e, 0xac, 0x12, 0xc3, 0xd0, 0x62, 0xfc, 0xba, 0x22, 0xc6, 0xbd, 0x49, 0x3e,
+	0xb1, 0x30, 0x9f, 0xc3, 0x69, 0x65, 0x2f, 0x19, 0xe1, 0xe7, 0x80, 0xfe, 0x8d, 0xa9, 0x98, 0x8f,
+	0x3c, 0x2e, 0xe8, 0x82, 0xa7, 0x05, 0x3b, 0x51, 0x3c, 0xbe, 0xa1, 0xf3, 0x94, 0x9d, 0x89, 0x73,
+	0x88, 0x3a, 0x60, 0x2e, 0x1a, 0xd5, 0xc5, 0x4c, 0xbc, 0x34, 0xa0, 0x3e, 0xa0, 0x60, 0xc2, 0xb8,
+	0xa0, 0x6e, 0xc8, 0x3d, 0x12, 0xba, 0x52, 0x11, 0x45, 0xad, 0xba, 0x96, 0xbe, 0xe2, 0xc4, 0x79,
+	0x55, 0x83, 0x76, 0xa9, 0x7c, 0x36, 0x8c, 0x7f, 0xc2, 0x8e, 0xa0, 0x32, 0x0e, 0x17, 0xea, 0x7e,
+	0x5b, 0x56, 0xb7, 0x22, 0x66, 0x29, 0x2b, 0xd6, 0x91, 0x38, 0xcf, 0x60, 0xbf, 0x35, 0xe0, 0x60,
+	0xe5, 0xb0, 0x4c, 0xc3, 0x58, 0xa5, 0x71, 0x05, 0x0d, 0xa9, 0x93, 0x67, 0x83, 0xfe, 0xcb, 0xa3,
+	0xab, 0xf7, 0xd3, 0xe3, 0xf4, 0x6a, 0xb3, 0x64, 0xf6, 0x8f, 0xd0, 0x2a, 0x98, 0x1f, 0x75, 0x8b,
+	0xaf, 0x0d, 0x38, 0x1c, 0x51, 0x55, 0xbe, 0xa5, 0xcd, 0x24, 0x2e, 0x56, 0x48, 0x9c, 0xaf, 0x0d,
+	0x68, 0x29, 0xdb, 0xc7, 0xee, 0xb8, 0x0d, 0x47, 0x85, 0x12, 0xd9, 0xb4, 0x1d, 0x03, 0xfa, 0x47,
+	0xc4, 0x8c, 0x62, 0x1a, 0x71, 0xa1, 0xf2, 0x6d, 0xf4, 0xa6, 0x06, 0xfb, 0xda, 0x7c, 0x49, 0x98,
+	0x1f, 0xf8, 0x44, 0x51, 0xf4, 0x15, 0xec, 0x79, 0x53, 0xc2, 0x18, 0x0d, 0xdd, 0x88, 0x07, 0x4c,
+	0x65, 0x35, 0xcb, 0xc6, 0x64, 0x4c, 0x13, 0x83, 0x1b, 0xf8, 0xba, 0xfe, 0x16, 0xce, 0x61, 0x36,
+	0xc0, 0x6e, 0xd2, 0x6d, 0x5d, 0x47, 0xe6, 0x10, 0xd9, 0xd0, 0xf4, 0x48, 0x44, 0xbc, 0x40, 0xcd,
+	0xad, 0xad, 0xae, 0xd1, 0xab, 0xe3, 0x05, 0x4e, 0xb6, 0x45, 0x1c, 0xa9, 0x60, 0x46, 0xad, 0x6d,
+	0x4d, 0x27, 0x43, 0xc8, 0x81, 0x5d, 0x16, 0xcf, 0xdc, 0x6b, 0x2e, 0xee, 0x88, 0xf0, 0xa5, 0xd5,
+	0xd0, 0xc5, 0x4a, 0x36, 0x74, 0x0e, 0x87, 0xd7, 0x94, 0x4a, 0x97, 0x12, 0xc1, 0xa8, 0xef, 0xce,
+	0x24, 0x51, 0xd6, 0x8e, 0xf6, 0x5b, 0xb3, 0x27, 0x75, 0x04, 0x25, 0x92, 0x33, 0xab, 0xa9, 0x9b,
+	0xcb, 0x50, 0x62, 0xf7, 0x42, 0x2e, 0xa9, 0x6f, 0x99, 0xe9, 0xb6, 0x4a, 0x11, 0xea, 0x42, 0x4b,
+	0xff, 0x72, 0xa9, 0x10, 0x5c, 0x58, 0xa0, 0x83, 0x8a, 0x26, 0xe7, 0xa5, 0x01, 0xed, 0x92, 0xb2,
+	0xd9, 0x43, 0xea, 0x80, 0x99, 0x30, 0x90, 0x8a, 0xcc, 0x22, 0xad, 0x61, 0x1d, 0x2f, 0x0d, 0x89,
+	0x4a, 0xbe, 0x98, 0xbb, 0x22, 0x66, 0x5a, 0xbf, 0x26, 0xce, 0x21, 0xfa, 0x19, 0xc0, 0xcb, 0x2f,
+	0x43, 0x5a, 0x75, 0x3d, 0x40, 0x9d, 0xf2, 0x00, 0x95, 0x6f, 0x0c, 0x17, 0xfc, 0x87, 0x2f, 0xb6,
+	0xc0, 0xfc, 0x2d, 0xf7, 0x45, 0x97, 0xd0, 0x48, 0xb7, 0x2c, 0x3a, 0xad, 0xfe, 0xc3, 0xd0, 0x53,
+	0x60, 0x77, 0x36, 0xfd, 0x9b, 0xa0, 0x2b, 0xd8, 0x2d, 0x2e, 0x6c, 0x74, 0x56, 0xf6, 0xae, 0xd8,
+	0xfd, 0xb6, 0xb3, 0xc9, 0x25, 0x4b, 0xfb, 0x14, 0xda, 0x15, 0xdb, 0x11, 0xf5, 0x1e, 0xba, 0xcc,
+	0xed, 0xaf, 0x1f, 0xe0, 0x99, 0xd5, 0xc2, 0xd0, 0x2a, 0x6c, 0x0e, 0xd4, 0xdd, 0xb0, 0x54, 0xd2,
+	0xdc, 0x67, 0x1f, 0x5c, 0x3b, 0xe8, 0x2f, 0x30, 0x17, 0xaf, 0x0c, 0x7d, 0xb1, 0xf9, 0x85, 0xdb,
+	0x5f, 0xde, 0x7b, 0xbe, 0xec, 0xb0, 0x30, 0x44, 0xab, 0x1d, 0xae, 0xbf, 0x5c, 0xfb, 0x6c, 0x83,
+	0x47, 0x9a, 0xf3, 0xe2, 0xfb, 0xff, 0x87, 0x93, 0x40, 0x4d, 0xe3, 0x71, 0xdf, 0xe3, 0xb3, 0x41,
+	0x98, 0x48, 0xc2, 0x02, 0x36, 0x61, 0x54, 0xdd, 0x71, 0x71, 0x33, 0x08, 0x99, 0x3f, 0x08, 0x59,
+	0xe9, 0x13, 0x46, 0x44, 0xde, 0xb8, 0xa1, 0x3f, 0x63, 0xbe, 0x7b, 0x3f, 0x00, 0x8a, 0xa0, 0xf5,
+	0xea, 0xe6, 0x08, 0x00, 0x00,
 }
@@ -4,9 +4,12 @@ package autopilotrpc
 
 import (
 	"context"
+	"encoding/hex"
 	"os"
+	"sort"
 	"sync/atomic"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"google.golang.org/grpc"
@@ -35,6 +38,28 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/autopilotrpc.Autopilot/SetHeuristicWeights": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/autopilotrpc.Autopilot/QueryScores": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/autopilotrpc.Autopilot/SetScores": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/autopilotrpc.Autopilot/PruneReport": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 )
 
@@ -133,8 +158,14 @@ func (s *Server) RegisterWithRootServer(grpcServer *grpc.Server) error {
 func (s *Server) Status(ctx context.Context,
 	in *StatusRequest) (*StatusResponse, error) {
 
+	weights, err := s.manager.HeuristicWeights()
+	if err != nil {
+		return nil, err
+	}
+
 	return &StatusResponse{
-		Active: s.manager.IsActive(),
+		Active:           s.manager.IsActive(),
+		HeuristicWeights: weights,
 	}, nil
 }
 
@@ -154,3 +185,143 @@ func (s *Server) ModifyStatus(ctx context.Context,
 	}
 	return &ModifyStatusResponse{}, err
 }
+
+// SetHeuristicWeights modifies the weights of the heuristics combined by the
+// autopilot agent.
+//
+// NOTE: Part of the AutopilotServer interface.
+func (s *Server) SetHeuristicWeights(ctx context.Context,
+	in *SetHeuristicWeightsRequest) (*SetHeuristicWeightsResponse, error) {
+
+	log.Debugf("Setting heuristic weights to %v", in.HeuristicWeights)
+
+	err := s.manager.SetHeuristicWeights(in.HeuristicWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetHeuristicWeightsResponse{}, nil
+}
+
+// parseNodeID parses the given hex-encoded public key into an
+// autopilot.NodeID.
+func parseNodeID(pubStr string) (autopilot.NodeID, error) {
+	pubHex, err := hex.DecodeString(pubStr)
+	if err != nil {
+		return autopilot.NodeID{}, err
+	}
+	pubKey, err := btcec.ParsePubKey(pubHex, btcec.S256())
+	if err != nil {
+		return autopilot.NodeID{}, err
+	}
+
+	return autopilot.NewNodeID(pubKey), nil
+}
+
+// QueryScores queries the autopilot heuristics for the scores they would give
+// to the given set of nodes.
+//
+// NOTE: Part of the AutopilotServer interface.
+func (s *Server) QueryScores(ctx context.Context, in *QueryScoresRequest) (
+	*QueryScoresResponse, error) {
+
+	var nodes []autopilot.NodeID
+	for _, pubStr := range in.Pubkeys {
+		nID, err := parseNodeID(pubStr)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, nID)
+	}
+
+	// Query the heuristics.
+	heuristicScores, err := s.manager.QueryHeuristics(
+		nodes, !in.IgnoreLocalState, in.Heuristic,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &QueryScoresResponse{}
+	for heuristic, scores := range heuristicScores {
+		result := &QueryScoresResponse_HeuristicResult{
+			Heuristic: heuristic,
+			Scores:    make(map[string]float64, len(scores)),
+		}
+
+		for nID, score := range scores {
+			result.Scores[hex.EncodeToString(nID[:])] = score
+		}
+
+		resp.Results = append(resp.Results, result)
+	}
+
+	// Sort the results by heuristic name, such that the response is
+	// deterministic.
+	sort.Slice(resp.Results, func(i, j int) bool {
+		return resp.Results[i].Heuristic < resp.Results[j].Heuristic
+	})
+
+	return resp, nil
+}
+
+// SetScores sets the scores of the external score heuristic, if it is used by
+// the autopilot agent.
+//
+// NOTE: Part of the AutopilotServer interface.
+func (s *Server) SetScores(ctx context.Context,
+	in *SetScoresRequest) (*SetScoresResponse, error) {
+
+	scores := make(map[autopilot.NodeID]float64, len(in.Scores))
+	for pubStr, score := range in.Scores {
+		nID, err := parseNodeID(pubStr)
+		if err != nil {
+			return nil, err
+		}
+		scores[nID] = score
+	}
+
+	log.Debugf("Setting %v scores for heuristic %v", len(scores),
+		in.Heuristic)
+
+	if err := s.manager.SetNodeScores(in.Heuristic, scores); err != nil {
+		return nil, err
+	}
+
+	return &SetScoresResponse{}, nil
+}
+
+// PruneReport returns the outcome of the latest evaluation of the channel
+// pruner.
+//
+// NOTE: Part of the AutopilotServer interface.
+func (s *Server) PruneReport(ctx context.Context,
+	in *PruneReportRequest) (*PruneReportResponse, error) {
+
+	report := s.manager.PruneReport()
+	if report == nil {
+		return &PruneReportResponse{}, nil
+	}
+
+	resp := &PruneReportResponse{
+		Timestamp:  report.Timestamp.Unix(),
+		DryRun:     report.DryRun,
+		Candidates: make([]*PruneCandidate, 0, len(report.Candidates)),
+	}
+	for _, c := range report.Candidates {
+		resp.Candidates = append(resp.Candidates, &PruneCandidate{
+			ChannelPoint:   c.ChanPoint.String(),
+			ChanId:         c.ChanID.ToUint64(),
+			PubKey:         hex.EncodeToString(c.Node[:]),
+			Capacity:       int64(c.Capacity),
+			Uptime:         c.Uptime,
+			NumForwards:    c.NumForwards,
+			FeesEarnedMsat: uint64(c.FeesEarned),
+			Reason:         c.Reason,
+			Closed:         c.Closed,
+			CloseError:     c.CloseError,
+		})
+	}
+
+	return resp, nil
+}
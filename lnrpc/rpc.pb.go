@@ -49,7 +49,7 @@ func (x AddressType) String() string {
 	return proto.EnumName(AddressType_name, int32(x))
 }
 func (AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{0}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{0}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -84,7 +84,290 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{38, 0}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{38, 0}
+}
+
+type ChannelEventUpdate_UpdateType int32
+
+const (
+	ChannelEventUpdate_OPEN_CHANNEL           ChannelEventUpdate_UpdateType = 0
+	ChannelEventUpdate_CLOSED_CHANNEL         ChannelEventUpdate_UpdateType = 1
+	ChannelEventUpdate_ACTIVE_CHANNEL         ChannelEventUpdate_UpdateType = 2
+	ChannelEventUpdate_INACTIVE_CHANNEL       ChannelEventUpdate_UpdateType = 3
+	ChannelEventUpdate_PENDING_OPEN_CHANNEL   ChannelEventUpdate_UpdateType = 4
+	ChannelEventUpdate_FULLY_RESOLVED_CHANNEL ChannelEventUpdate_UpdateType = 5
+)
+
+var ChannelEventUpdate_UpdateType_name = map[int32]string{
+	0: "OPEN_CHANNEL",
+	1: "CLOSED_CHANNEL",
+	2: "ACTIVE_CHANNEL",
+	3: "INACTIVE_CHANNEL",
+	4: "PENDING_OPEN_CHANNEL",
+	5: "FULLY_RESOLVED_CHANNEL",
+}
+var ChannelEventUpdate_UpdateType_value = map[string]int32{
+	"OPEN_CHANNEL":           0,
+	"CLOSED_CHANNEL":         1,
+	"ACTIVE_CHANNEL":         2,
+	"INACTIVE_CHANNEL":       3,
+	"PENDING_OPEN_CHANNEL":   4,
+	"FULLY_RESOLVED_CHANNEL": 5,
+}
+
+func (x ChannelEventUpdate_UpdateType) String() string {
+	return proto.EnumName(ChannelEventUpdate_UpdateType_name, int32(x))
+}
+func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{42, 0}
+}
+
+type Peer_SyncType int32
+
+const (
+	// *
+	// Denotes that we cannot determine the peer's current sync type.
+	Peer_UNKNOWN_SYNC Peer_SyncType = 0
+	// *
+	// Denotes that we are actively receiving new graph updates from the peer.
+	Peer_ACTIVE_SYNC Peer_SyncType = 1
+	// *
+	// Denotes that we are not receiving new graph updates from the peer.
+	Peer_PASSIVE_SYNC Peer_SyncType = 2
+)
+
+var Peer_SyncType_name = map[int32]string{
+	0: "UNKNOWN_SYNC",
+	1: "ACTIVE_SYNC",
+	2: "PASSIVE_SYNC",
+}
+var Peer_SyncType_value = map[string]int32{
+	"UNKNOWN_SYNC": 0,
+	"ACTIVE_SYNC":  1,
+	"PASSIVE_SYNC": 2,
+}
+
+func (x Peer_SyncType) String() string {
+	return proto.EnumName(Peer_SyncType_name, int32(x))
+}
+func (Peer_SyncType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{43, 0}
+}
+
+type PeerEvent_EventType int32
+
+const (
+	PeerEvent_PEER_ONLINE  PeerEvent_EventType = 0
+	PeerEvent_PEER_OFFLINE PeerEvent_EventType = 1
+)
+
+var PeerEvent_EventType_name = map[int32]string{
+	0: "PEER_ONLINE",
+	1: "PEER_OFFLINE",
+}
+var PeerEvent_EventType_value = map[string]int32{
+	"PEER_ONLINE":  0,
+	"PEER_OFFLINE": 1,
+}
+
+func (x PeerEvent_EventType) String() string {
+	return proto.EnumName(PeerEvent_EventType_name, int32(x))
+}
+func (PeerEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{47, 0}
+}
+
+type Invoice_InvoiceState int32
+
+const (
+	Invoice_OPEN     Invoice_InvoiceState = 0
+	Invoice_SETTLED  Invoice_InvoiceState = 1
+	Invoice_CANCELED Invoice_InvoiceState = 2
+)
+
+var Invoice_InvoiceState_name = map[int32]string{
+	0: "OPEN",
+	1: "SETTLED",
+	2: "CANCELED",
+}
+var Invoice_InvoiceState_value = map[string]int32{
+	"OPEN":     0,
+	"SETTLED":  1,
+	"CANCELED": 2,
+}
+
+func (x Invoice_InvoiceState) String() string {
+	return proto.EnumName(Invoice_InvoiceState_name, int32(x))
+}
+func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{89, 0}
+}
+
+type Invoice_FallbackAddrPolicy int32
+
+const (
+	// / Only include the fallback address provided in fallback_addr, if any.
+	Invoice_FALLBACK_ADDR_PROVIDED Invoice_FallbackAddrPolicy = 0
+	// / Include a new pay-to-witness-key-hash address from the wallet.
+	Invoice_FALLBACK_ADDR_NEW_WITNESS_PUBKEY_HASH Invoice_FallbackAddrPolicy = 1
+	// / Include a new nested pay-to-witness-key-hash address from the wallet.
+	Invoice_FALLBACK_ADDR_NEW_NESTED_PUBKEY_HASH Invoice_FallbackAddrPolicy = 2
+)
+
+var Invoice_FallbackAddrPolicy_name = map[int32]string{
+	0: "FALLBACK_ADDR_PROVIDED",
+	1: "FALLBACK_ADDR_NEW_WITNESS_PUBKEY_HASH",
+	2: "FALLBACK_ADDR_NEW_NESTED_PUBKEY_HASH",
+}
+var Invoice_FallbackAddrPolicy_value = map[string]int32{
+	"FALLBACK_ADDR_PROVIDED":                0,
+	"FALLBACK_ADDR_NEW_WITNESS_PUBKEY_HASH": 1,
+	"FALLBACK_ADDR_NEW_NESTED_PUBKEY_HASH":  2,
+}
+
+func (x Invoice_FallbackAddrPolicy) String() string {
+	return proto.EnumName(Invoice_FallbackAddrPolicy_name, int32(x))
+}
+func (Invoice_FallbackAddrPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{89, 1}
+}
+
+type LedgerEntry_EntryType int32
+
+const (
+	LedgerEntry_ONCHAIN_RECEIVE  LedgerEntry_EntryType = 0
+	LedgerEntry_ONCHAIN_SEND     LedgerEntry_EntryType = 1
+	LedgerEntry_CHANNEL_OPEN     LedgerEntry_EntryType = 2
+	LedgerEntry_CHANNEL_CLOSE    LedgerEntry_EntryType = 3
+	LedgerEntry_SWEEP            LedgerEntry_EntryType = 4
+	LedgerEntry_FORWARD_FEE      LedgerEntry_EntryType = 5
+	LedgerEntry_PAYMENT_SENT     LedgerEntry_EntryType = 6
+	LedgerEntry_PAYMENT_RECEIVED LedgerEntry_EntryType = 7
+)
+
+var LedgerEntry_EntryType_name = map[int32]string{
+	0: "ONCHAIN_RECEIVE",
+	1: "ONCHAIN_SEND",
+	2: "CHANNEL_OPEN",
+	3: "CHANNEL_CLOSE",
+	4: "SWEEP",
+	5: "FORWARD_FEE",
+	6: "PAYMENT_SENT",
+	7: "PAYMENT_RECEIVED",
+}
+var LedgerEntry_EntryType_value = map[string]int32{
+	"ONCHAIN_RECEIVE":  0,
+	"ONCHAIN_SEND":     1,
+	"CHANNEL_OPEN":     2,
+	"CHANNEL_CLOSE":    3,
+	"SWEEP":            4,
+	"FORWARD_FEE":      5,
+	"PAYMENT_SENT":     6,
+	"PAYMENT_RECEIVED": 7,
+}
+
+func (x LedgerEntry_EntryType) String() string {
+	return proto.EnumName(LedgerEntry_EntryType_name, int32(x))
+}
+func (LedgerEntry_EntryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{124, 0}
+}
+
+type ForwardHtlcInterceptResponse_Action int32
+
+const (
+	// / Resume forwarding the htlc as if it was never intercepted.
+	ForwardHtlcInterceptResponse_RESUME ForwardHtlcInterceptResponse_Action = 0
+	// / Fail the htlc back to the sender with the given failure code.
+	ForwardHtlcInterceptResponse_FAIL ForwardHtlcInterceptResponse_Action = 1
+	// / Settle the htlc with the given preimage.
+	ForwardHtlcInterceptResponse_SETTLE ForwardHtlcInterceptResponse_Action = 2
+)
+
+var ForwardHtlcInterceptResponse_Action_name = map[int32]string{
+	0: "RESUME",
+	1: "FAIL",
+	2: "SETTLE",
+}
+var ForwardHtlcInterceptResponse_Action_value = map[string]int32{
+	"RESUME": 0,
+	"FAIL":   1,
+	"SETTLE": 2,
+}
+
+func (x ForwardHtlcInterceptResponse_Action) String() string {
+	return proto.EnumName(ForwardHtlcInterceptResponse_Action_name, int32(x))
+}
+func (ForwardHtlcInterceptResponse_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{128, 0}
+}
+
+type ForwardHtlcInterceptResponse_FailureCode int32
+
+const (
+	ForwardHtlcInterceptResponse_TEMPORARY_CHANNEL_FAILURE ForwardHtlcInterceptResponse_FailureCode = 0
+	ForwardHtlcInterceptResponse_TEMPORARY_NODE_FAILURE    ForwardHtlcInterceptResponse_FailureCode = 1
+	ForwardHtlcInterceptResponse_PERMANENT_NODE_FAILURE    ForwardHtlcInterceptResponse_FailureCode = 2
+	ForwardHtlcInterceptResponse_PERMANENT_CHANNEL_FAILURE ForwardHtlcInterceptResponse_FailureCode = 3
+	ForwardHtlcInterceptResponse_UNKNOWN_NEXT_PEER         ForwardHtlcInterceptResponse_FailureCode = 4
+	ForwardHtlcInterceptResponse_UNKNOWN_PAYMENT_HASH      ForwardHtlcInterceptResponse_FailureCode = 5
+)
+
+var ForwardHtlcInterceptResponse_FailureCode_name = map[int32]string{
+	0: "TEMPORARY_CHANNEL_FAILURE",
+	1: "TEMPORARY_NODE_FAILURE",
+	2: "PERMANENT_NODE_FAILURE",
+	3: "PERMANENT_CHANNEL_FAILURE",
+	4: "UNKNOWN_NEXT_PEER",
+	5: "UNKNOWN_PAYMENT_HASH",
+}
+var ForwardHtlcInterceptResponse_FailureCode_value = map[string]int32{
+	"TEMPORARY_CHANNEL_FAILURE": 0,
+	"TEMPORARY_NODE_FAILURE":    1,
+	"PERMANENT_NODE_FAILURE":    2,
+	"PERMANENT_CHANNEL_FAILURE": 3,
+	"UNKNOWN_NEXT_PEER":         4,
+	"UNKNOWN_PAYMENT_HASH":      5,
+}
+
+func (x ForwardHtlcInterceptResponse_FailureCode) String() string {
+	return proto.EnumName(ForwardHtlcInterceptResponse_FailureCode_name, int32(x))
+}
+func (ForwardHtlcInterceptResponse_FailureCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{128, 1}
+}
+
+type HtlcEvent_EventType int32
+
+const (
+	// / The htlc was added to the channel of its outgoing link.
+	HtlcEvent_FORWARD HtlcEvent_EventType = 0
+	// / The forwarded htlc was settled by the downstream peer.
+	HtlcEvent_SETTLE HtlcEvent_EventType = 1
+	// / The forwarded htlc was failed by the downstream peer, or on-chain.
+	HtlcEvent_FORWARD_FAIL HtlcEvent_EventType = 2
+	// / The htlc was failed by our node.
+	HtlcEvent_LINK_FAIL HtlcEvent_EventType = 3
+)
+
+var HtlcEvent_EventType_name = map[int32]string{
+	0: "FORWARD",
+	1: "SETTLE",
+	2: "FORWARD_FAIL",
+	3: "LINK_FAIL",
+}
+var HtlcEvent_EventType_value = map[string]int32{
+	"FORWARD":      0,
+	"SETTLE":       1,
+	"FORWARD_FAIL": 2,
+	"LINK_FAIL":    3,
+}
+
+func (x HtlcEvent_EventType) String() string {
+	return proto.EnumName(HtlcEvent_EventType_name, int32(x))
+}
+func (HtlcEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{130, 0}
 }
 
 type GenSeedRequest struct {
@@ -105,7 +388,7 @@ func (m *GenSeedRequest) Reset()         { *m = GenSeedRequest{} }
 func (m *GenSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*GenSeedRequest) ProtoMessage()    {}
 func (*GenSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{0}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{0}
 }
 func (m *GenSeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedRequest.Unmarshal(m, b)
@@ -160,7 +443,7 @@ func (m *GenSeedResponse) Reset()         { *m = GenSeedResponse{} }
 func (m *GenSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*GenSeedResponse) ProtoMessage()    {}
 func (*GenSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{1}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{1}
 }
 func (m *GenSeedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedResponse.Unmarshal(m, b)
@@ -225,7 +508,7 @@ func (m *InitWalletRequest) Reset()         { *m = InitWalletRequest{} }
 func (m *InitWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*InitWalletRequest) ProtoMessage()    {}
 func (*InitWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{2}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{2}
 }
 func (m *InitWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletRequest.Unmarshal(m, b)
@@ -283,7 +566,7 @@ func (m *InitWalletResponse) Reset()         { *m = InitWalletResponse{} }
 func (m *InitWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*InitWalletResponse) ProtoMessage()    {}
 func (*InitWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{3}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{3}
 }
 func (m *InitWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletResponse.Unmarshal(m, b)
@@ -325,7 +608,7 @@ func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
 func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletRequest) ProtoMessage()    {}
 func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{4}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{4}
 }
 func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
@@ -369,7 +652,7 @@ func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
 func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletResponse) ProtoMessage()    {}
 func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{5}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{5}
 }
 func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
@@ -407,7 +690,7 @@ func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
 func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordRequest) ProtoMessage()    {}
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{6}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{6}
 }
 func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
@@ -451,7 +734,7 @@ func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{}
 func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordResponse) ProtoMessage()    {}
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{7}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{7}
 }
 func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
@@ -495,7 +778,7 @@ func (m *Utxo) Reset()         { *m = Utxo{} }
 func (m *Utxo) String() string { return proto.CompactTextString(m) }
 func (*Utxo) ProtoMessage()    {}
 func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{8}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{8}
 }
 func (m *Utxo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Utxo.Unmarshal(m, b)
@@ -583,7 +866,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{9}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{9}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -669,7 +952,7 @@ func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{}
 func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()    {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{10}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{10}
 }
 func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
@@ -701,7 +984,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{11}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{11}
 }
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails.Unmarshal(m, b)
@@ -742,7 +1025,7 @@ func (m *FeeLimit) Reset()         { *m = FeeLimit{} }
 func (m *FeeLimit) String() string { return proto.CompactTextString(m) }
 func (*FeeLimit) ProtoMessage()    {}
 func (*FeeLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{12}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{12}
 }
 func (m *FeeLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeLimit.Unmarshal(m, b)
@@ -898,7 +1181,7 @@ func (m *SendRequest) Reset()         { *m = SendRequest{} }
 func (m *SendRequest) String() string { return proto.CompactTextString(m) }
 func (*SendRequest) ProtoMessage()    {}
 func (*SendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{13}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{13}
 }
 func (m *SendRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendRequest.Unmarshal(m, b)
@@ -988,7 +1271,7 @@ func (m *SendResponse) Reset()         { *m = SendResponse{} }
 func (m *SendResponse) String() string { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()    {}
 func (*SendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{14}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{14}
 }
 func (m *SendResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendResponse.Unmarshal(m, b)
@@ -1052,7 +1335,7 @@ func (m *SendToRouteRequest) Reset()         { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()    {}
 func (*SendToRouteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{15}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{15}
 }
 func (m *SendToRouteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendToRouteRequest.Unmarshal(m, b)
@@ -1109,7 +1392,7 @@ func (m *ChannelPoint) Reset()         { *m = ChannelPoint{} }
 func (m *ChannelPoint) String() string { return proto.CompactTextString(m) }
 func (*ChannelPoint) ProtoMessage()    {}
 func (*ChannelPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{16}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{16}
 }
 func (m *ChannelPoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelPoint.Unmarshal(m, b)
@@ -1253,7 +1536,7 @@ func (m *LightningAddress) Reset()         { *m = LightningAddress{} }
 func (m *LightningAddress) String() string { return proto.CompactTextString(m) }
 func (*LightningAddress) ProtoMessage()    {}
 func (*LightningAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{17}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{17}
 }
 func (m *LightningAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightningAddress.Unmarshal(m, b)
@@ -1303,7 +1586,7 @@ func (m *SendManyRequest) Reset()         { *m = SendManyRequest{} }
 func (m *SendManyRequest) String() string { return proto.CompactTextString(m) }
 func (*SendManyRequest) ProtoMessage()    {}
 func (*SendManyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{18}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{18}
 }
 func (m *SendManyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyRequest.Unmarshal(m, b)
@@ -1356,7 +1639,7 @@ func (m *SendManyResponse) Reset()         { *m = SendManyResponse{} }
 func (m *SendManyResponse) String() string { return proto.CompactTextString(m) }
 func (*SendManyResponse) ProtoMessage()    {}
 func (*SendManyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{19}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{19}
 }
 func (m *SendManyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyResponse.Unmarshal(m, b)
@@ -1401,7 +1684,7 @@ func (m *SendCoinsRequest) Reset()         { *m = SendCoinsRequest{} }
 func (m *SendCoinsRequest) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsRequest) ProtoMessage()    {}
 func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{20}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{20}
 }
 func (m *SendCoinsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsRequest.Unmarshal(m, b)
@@ -1461,7 +1744,7 @@ func (m *SendCoinsResponse) Reset()         { *m = SendCoinsResponse{} }
 func (m *SendCoinsResponse) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsResponse) ProtoMessage()    {}
 func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{21}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{21}
 }
 func (m *SendCoinsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsResponse.Unmarshal(m, b)
@@ -1502,7 +1785,7 @@ func (m *ListUnspentRequest) Reset()         { *m = ListUnspentRequest{} }
 func (m *ListUnspentRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentRequest) ProtoMessage()    {}
 func (*ListUnspentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{22}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{22}
 }
 func (m *ListUnspentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentRequest.Unmarshal(m, b)
@@ -1548,7 +1831,7 @@ func (m *ListUnspentResponse) Reset()         { *m = ListUnspentResponse{} }
 func (m *ListUnspentResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentResponse) ProtoMessage()    {}
 func (*ListUnspentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{23}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{23}
 }
 func (m *ListUnspentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentResponse.Unmarshal(m, b)
@@ -1587,7 +1870,7 @@ func (m *NewAddressRequest) Reset()         { *m = NewAddressRequest{} }
 func (m *NewAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*NewAddressRequest) ProtoMessage()    {}
 func (*NewAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{24}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{24}
 }
 func (m *NewAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressRequest.Unmarshal(m, b)
@@ -1626,7 +1909,7 @@ func (m *NewAddressResponse) Reset()         { *m = NewAddressResponse{} }
 func (m *NewAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*NewAddressResponse) ProtoMessage()    {}
 func (*NewAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{25}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{25}
 }
 func (m *NewAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressResponse.Unmarshal(m, b)
@@ -1665,7 +1948,7 @@ func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()    {}
 func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{26}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{26}
 }
 func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
@@ -1704,7 +1987,7 @@ func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()    {}
 func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{27}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{27}
 }
 func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
@@ -1745,7 +2028,7 @@ func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()    {}
 func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{28}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{28}
 }
 func (m *VerifyMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageRequest.Unmarshal(m, b)
@@ -1793,7 +2076,7 @@ func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()    {}
 func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{29}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{29}
 }
 func (m *VerifyMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageResponse.Unmarshal(m, b)
@@ -1842,7 +2125,7 @@ func (m *ConnectPeerRequest) Reset()         { *m = ConnectPeerRequest{} }
 func (m *ConnectPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerRequest) ProtoMessage()    {}
 func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{30}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{30}
 }
 func (m *ConnectPeerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerRequest.Unmarshal(m, b)
@@ -1886,7 +2169,7 @@ func (m *ConnectPeerResponse) Reset()         { *m = ConnectPeerResponse{} }
 func (m *ConnectPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerResponse) ProtoMessage()    {}
 func (*ConnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{31}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{31}
 }
 func (m *ConnectPeerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerResponse.Unmarshal(m, b)
@@ -1918,7 +2201,7 @@ func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
 func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*DisconnectPeerRequest) ProtoMessage()    {}
 func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{32}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{32}
 }
 func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisconnectPeerRequest.Unmarshal(m, b)
@@ -1955,7 +2238,7 @@ func (m *DisconnectPeerResponse) Reset()         { *m = DisconnectPeerResponse{}
 func (m *DisconnectPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*DisconnectPeerResponse) ProtoMessage()    {}
 func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{33}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{33}
 }
 func (m *DisconnectPeerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisconnectPeerResponse.Unmarshal(m, b)
@@ -1989,7 +2272,7 @@ func (m *HTLC) Reset()         { *m = HTLC{} }
 func (m *HTLC) String() string { return proto.CompactTextString(m) }
 func (*HTLC) ProtoMessage()    {}
 func (*HTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{34}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{34}
 }
 func (m *HTLC) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTLC.Unmarshal(m, b)
@@ -2091,7 +2374,15 @@ type Channel struct {
 	// funds.
 	CsvDelay uint32 `protobuf:"varint,16,opt,name=csv_delay,proto3" json:"csv_delay,omitempty"`
 	// / Whether this channel is advertised to the network or not
-	Private              bool     `protobuf:"varint,17,opt,name=private,proto3" json:"private,omitempty"`
+	Private bool `protobuf:"varint,17,opt,name=private,proto3" json:"private,omitempty"`
+	// *
+	// The number of seconds the channel's peer has been online, since we first
+	// connected to it.
+	Uptime int64 `protobuf:"varint,18,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// *
+	// The number of seconds since we first connected to the channel's peer, over
+	// which its uptime has been tracked.
+	Lifetime             int64    `protobuf:"varint,19,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2101,7 +2392,7 @@ func (m *Channel) Reset()         { *m = Channel{} }
 func (m *Channel) String() string { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()    {}
 func (*Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{35}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{35}
 }
 func (m *Channel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Channel.Unmarshal(m, b)
@@ -2240,6 +2531,20 @@ func (m *Channel) GetPrivate() bool {
 	return false
 }
 
+func (m *Channel) GetUptime() int64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
+func (m *Channel) GetLifetime() int64 {
+	if m != nil {
+		return m.Lifetime
+	}
+	return 0
+}
+
 type ListChannelsRequest struct {
 	ActiveOnly           bool     `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	InactiveOnly         bool     `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
@@ -2254,7 +2559,7 @@ func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
 func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListChannelsRequest) ProtoMessage()    {}
 func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{36}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{36}
 }
 func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
@@ -2314,7 +2619,7 @@ func (m *ListChannelsResponse) Reset()         { *m = ListChannelsResponse{} }
 func (m *ListChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListChannelsResponse) ProtoMessage()    {}
 func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{37}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{37}
 }
 func (m *ListChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListChannelsResponse.Unmarshal(m, b)
@@ -2371,7 +2676,7 @@ func (m *ChannelCloseSummary) Reset()         { *m = ChannelCloseSummary{} }
 func (m *ChannelCloseSummary) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseSummary) ProtoMessage()    {}
 func (*ChannelCloseSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{38}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{38}
 }
 func (m *ChannelCloseSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelCloseSummary.Unmarshal(m, b)
@@ -2477,7 +2782,7 @@ func (m *ClosedChannelsRequest) Reset()         { *m = ClosedChannelsRequest{} }
 func (m *ClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsRequest) ProtoMessage()    {}
 func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{39}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{39}
 }
 func (m *ClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelsRequest.Unmarshal(m, b)
@@ -2550,7 +2855,7 @@ func (m *ClosedChannelsResponse) Reset()         { *m = ClosedChannelsResponse{}
 func (m *ClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()    {}
 func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{40}
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{40}
 }
 func (m *ClosedChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelsResponse.Unmarshal(m, b)
@@ -2577,1053 +2882,1177 @@ func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	return nil
 }
 
-type Peer struct {
-	// / The identity pubkey of the peer
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	// / Network address of the peer; eg `127.0.0.1:10011`
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	// / Bytes of data transmitted to this peer
-	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent,proto3" json:"bytes_sent,omitempty"`
-	// / Bytes of data transmitted from this peer
-	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv,proto3" json:"bytes_recv,omitempty"`
-	// / Satoshis sent to this peer
-	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent,proto3" json:"sat_sent,omitempty"`
-	// / Satoshis received from this peer
-	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv,proto3" json:"sat_recv,omitempty"`
-	// / A channel is inbound if the counterparty initiated the channel
-	Inbound bool `protobuf:"varint,8,opt,name=inbound,proto3" json:"inbound,omitempty"`
-	// / Ping time to this peer
-	PingTime             int64    `protobuf:"varint,9,opt,name=ping_time,proto3" json:"ping_time,omitempty"`
+type ChannelEventSubscription struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Peer) Reset()         { *m = Peer{} }
-func (m *Peer) String() string { return proto.CompactTextString(m) }
-func (*Peer) ProtoMessage()    {}
-func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{41}
+func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscription{} }
+func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventSubscription) ProtoMessage()    {}
+func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{41}
 }
-func (m *Peer) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Peer.Unmarshal(m, b)
+func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
 }
-func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
+func (m *ChannelEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventSubscription.Marshal(b, m, deterministic)
 }
-func (dst *Peer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Peer.Merge(dst, src)
+func (dst *ChannelEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventSubscription.Merge(dst, src)
 }
-func (m *Peer) XXX_Size() int {
-	return xxx_messageInfo_Peer.Size(m)
+func (m *ChannelEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventSubscription.Size(m)
 }
-func (m *Peer) XXX_DiscardUnknown() {
-	xxx_messageInfo_Peer.DiscardUnknown(m)
+func (m *ChannelEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Peer proto.InternalMessageInfo
+var xxx_messageInfo_ChannelEventSubscription proto.InternalMessageInfo
 
-func (m *Peer) GetPubKey() string {
-	if m != nil {
-		return m.PubKey
-	}
-	return ""
+type ChannelEventUpdate struct {
+	// Types that are valid to be assigned to Channel:
+	//	*ChannelEventUpdate_OpenChannel
+	//	*ChannelEventUpdate_ClosedChannel
+	//	*ChannelEventUpdate_ActiveChannel
+	//	*ChannelEventUpdate_InactiveChannel
+	//	*ChannelEventUpdate_PendingOpenChannel
+	//	*ChannelEventUpdate_FullyResolvedChannel
+	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
+	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *Peer) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
+func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
+func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventUpdate) ProtoMessage()    {}
+func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{42}
 }
-
-func (m *Peer) GetBytesSent() uint64 {
-	if m != nil {
-		return m.BytesSent
-	}
-	return 0
+func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
 }
-
-func (m *Peer) GetBytesRecv() uint64 {
-	if m != nil {
-		return m.BytesRecv
-	}
-	return 0
+func (m *ChannelEventUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventUpdate.Marshal(b, m, deterministic)
 }
-
-func (m *Peer) GetSatSent() int64 {
-	if m != nil {
-		return m.SatSent
-	}
-	return 0
+func (dst *ChannelEventUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventUpdate.Merge(dst, src)
 }
-
-func (m *Peer) GetSatRecv() int64 {
-	if m != nil {
-		return m.SatRecv
-	}
-	return 0
+func (m *ChannelEventUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventUpdate.Size(m)
 }
-
-func (m *Peer) GetInbound() bool {
-	if m != nil {
-		return m.Inbound
-	}
-	return false
+func (m *ChannelEventUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventUpdate.DiscardUnknown(m)
 }
 
-func (m *Peer) GetPingTime() int64 {
-	if m != nil {
-		return m.PingTime
-	}
-	return 0
-}
+var xxx_messageInfo_ChannelEventUpdate proto.InternalMessageInfo
 
-type ListPeersRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type isChannelEventUpdate_Channel interface {
+	isChannelEventUpdate_Channel()
 }
 
-func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
-func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
-func (*ListPeersRequest) ProtoMessage()    {}
-func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{42}
+type ChannelEventUpdate_OpenChannel struct {
+	OpenChannel *Channel `protobuf:"bytes,1,opt,name=open_channel,proto3,oneof"`
 }
-func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPeersRequest.Unmarshal(m, b)
+
+type ChannelEventUpdate_ClosedChannel struct {
+	ClosedChannel *ChannelCloseSummary `protobuf:"bytes,2,opt,name=closed_channel,proto3,oneof"`
 }
-func (m *ListPeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPeersRequest.Marshal(b, m, deterministic)
+
+type ChannelEventUpdate_ActiveChannel struct {
+	ActiveChannel *ChannelPoint `protobuf:"bytes,3,opt,name=active_channel,proto3,oneof"`
 }
-func (dst *ListPeersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPeersRequest.Merge(dst, src)
+
+type ChannelEventUpdate_InactiveChannel struct {
+	InactiveChannel *ChannelPoint `protobuf:"bytes,4,opt,name=inactive_channel,proto3,oneof"`
 }
-func (m *ListPeersRequest) XXX_Size() int {
-	return xxx_messageInfo_ListPeersRequest.Size(m)
+
+type ChannelEventUpdate_PendingOpenChannel struct {
+	PendingOpenChannel *PendingUpdate `protobuf:"bytes,6,opt,name=pending_open_channel,proto3,oneof"`
 }
-func (m *ListPeersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPeersRequest.DiscardUnknown(m)
+
+type ChannelEventUpdate_FullyResolvedChannel struct {
+	FullyResolvedChannel *ChannelPoint `protobuf:"bytes,7,opt,name=fully_resolved_channel,proto3,oneof"`
 }
 
-var xxx_messageInfo_ListPeersRequest proto.InternalMessageInfo
+func (*ChannelEventUpdate_OpenChannel) isChannelEventUpdate_Channel() {}
 
-type ListPeersResponse struct {
-	// / The list of currently connected peers
-	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
+func (*ChannelEventUpdate_ClosedChannel) isChannelEventUpdate_Channel() {}
 
-func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
-func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
-func (*ListPeersResponse) ProtoMessage()    {}
-func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{43}
-}
-func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPeersResponse.Unmarshal(m, b)
-}
-func (m *ListPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPeersResponse.Marshal(b, m, deterministic)
-}
-func (dst *ListPeersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPeersResponse.Merge(dst, src)
-}
-func (m *ListPeersResponse) XXX_Size() int {
-	return xxx_messageInfo_ListPeersResponse.Size(m)
-}
-func (m *ListPeersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPeersResponse.DiscardUnknown(m)
-}
+func (*ChannelEventUpdate_ActiveChannel) isChannelEventUpdate_Channel() {}
 
-var xxx_messageInfo_ListPeersResponse proto.InternalMessageInfo
+func (*ChannelEventUpdate_InactiveChannel) isChannelEventUpdate_Channel() {}
 
-func (m *ListPeersResponse) GetPeers() []*Peer {
+func (*ChannelEventUpdate_PendingOpenChannel) isChannelEventUpdate_Channel() {}
+
+func (*ChannelEventUpdate_FullyResolvedChannel) isChannelEventUpdate_Channel() {}
+
+func (m *ChannelEventUpdate) GetChannel() isChannelEventUpdate_Channel {
 	if m != nil {
-		return m.Peers
+		return m.Channel
 	}
 	return nil
 }
 
-type GetInfoRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ChannelEventUpdate) GetOpenChannel() *Channel {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_OpenChannel); ok {
+		return x.OpenChannel
+	}
+	return nil
 }
 
-func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
-func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetInfoRequest) ProtoMessage()    {}
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{44}
+func (m *ChannelEventUpdate) GetClosedChannel() *ChannelCloseSummary {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_ClosedChannel); ok {
+		return x.ClosedChannel
+	}
+	return nil
 }
-func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
+
+func (m *ChannelEventUpdate) GetActiveChannel() *ChannelPoint {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_ActiveChannel); ok {
+		return x.ActiveChannel
+	}
+	return nil
 }
-func (m *GetInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetInfoRequest.Marshal(b, m, deterministic)
+
+func (m *ChannelEventUpdate) GetInactiveChannel() *ChannelPoint {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_InactiveChannel); ok {
+		return x.InactiveChannel
+	}
+	return nil
 }
-func (dst *GetInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInfoRequest.Merge(dst, src)
+
+func (m *ChannelEventUpdate) GetPendingOpenChannel() *PendingUpdate {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_PendingOpenChannel); ok {
+		return x.PendingOpenChannel
+	}
+	return nil
 }
-func (m *GetInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetInfoRequest.Size(m)
+
+func (m *ChannelEventUpdate) GetFullyResolvedChannel() *ChannelPoint {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_FullyResolvedChannel); ok {
+		return x.FullyResolvedChannel
+	}
+	return nil
 }
-func (m *GetInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInfoRequest.DiscardUnknown(m)
+
+func (m *ChannelEventUpdate) GetType() ChannelEventUpdate_UpdateType {
+	if m != nil {
+		return m.Type
+	}
+	return ChannelEventUpdate_OPEN_CHANNEL
 }
 
-var xxx_messageInfo_GetInfoRequest proto.InternalMessageInfo
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ChannelEventUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ChannelEventUpdate_OneofMarshaler, _ChannelEventUpdate_OneofUnmarshaler, _ChannelEventUpdate_OneofSizer, []interface{}{
+		(*ChannelEventUpdate_OpenChannel)(nil),
+		(*ChannelEventUpdate_ClosedChannel)(nil),
+		(*ChannelEventUpdate_ActiveChannel)(nil),
+		(*ChannelEventUpdate_InactiveChannel)(nil),
+		(*ChannelEventUpdate_PendingOpenChannel)(nil),
+		(*ChannelEventUpdate_FullyResolvedChannel)(nil),
+	}
+}
+
+func _ChannelEventUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ChannelEventUpdate)
+	// channel
+	switch x := m.Channel.(type) {
+	case *ChannelEventUpdate_OpenChannel:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.OpenChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_ClosedChannel:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ClosedChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_ActiveChannel:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ActiveChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_InactiveChannel:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.InactiveChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_PendingOpenChannel:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PendingOpenChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_FullyResolvedChannel:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FullyResolvedChannel); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ChannelEventUpdate.Channel has unexpected type %T", x)
+	}
+	return nil
+}
 
-type GetInfoResponse struct {
-	// / The identity pubkey of the current node.
-	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey,proto3" json:"identity_pubkey,omitempty"`
-	// / If applicable, the alias of the current node, e.g. "bob"
-	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	// / Number of pending channels
-	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels,proto3" json:"num_pending_channels,omitempty"`
-	// / Number of active channels
-	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels,proto3" json:"num_active_channels,omitempty"`
-	// / Number of peers
-	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers,proto3" json:"num_peers,omitempty"`
-	// / The node's current view of the height of the best block
-	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height,proto3" json:"block_height,omitempty"`
-	// / The node's current view of the hash of the best block
-	BlockHash string `protobuf:"bytes,8,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
-	// / Whether the wallet's view is synced to the main chain
-	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain,proto3" json:"synced_to_chain,omitempty"`
-	// / Whether the current node is connected to testnet
-	Testnet bool `protobuf:"varint,10,opt,name=testnet,proto3" json:"testnet,omitempty"`
-	// / A list of active chains the node is connected to
-	Chains []string `protobuf:"bytes,11,rep,name=chains,proto3" json:"chains,omitempty"`
-	// / The URIs of the current node.
-	Uris []string `protobuf:"bytes,12,rep,name=uris,proto3" json:"uris,omitempty"`
-	// / Timestamp of the block best known to the wallet
-	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp,proto3" json:"best_header_timestamp,omitempty"`
-	// / The version of the LND software that the node is running.
-	Version string `protobuf:"bytes,14,opt,name=version,proto3" json:"version,omitempty"`
-	// / Number of inactive channels
-	NumInactiveChannels  uint32   `protobuf:"varint,15,opt,name=num_inactive_channels,proto3" json:"num_inactive_channels,omitempty"`
+func _ChannelEventUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ChannelEventUpdate)
+	switch tag {
+	case 1: // channel.open_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Channel)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_OpenChannel{msg}
+		return true, err
+	case 2: // channel.closed_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelCloseSummary)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_ClosedChannel{msg}
+		return true, err
+	case 3: // channel.active_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelPoint)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_ActiveChannel{msg}
+		return true, err
+	case 4: // channel.inactive_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelPoint)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_InactiveChannel{msg}
+		return true, err
+	case 6: // channel.pending_open_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingUpdate)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_PendingOpenChannel{msg}
+		return true, err
+	case 7: // channel.fully_resolved_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelPoint)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_FullyResolvedChannel{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ChannelEventUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ChannelEventUpdate)
+	// channel
+	switch x := m.Channel.(type) {
+	case *ChannelEventUpdate_OpenChannel:
+		s := proto.Size(x.OpenChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_ClosedChannel:
+		s := proto.Size(x.ClosedChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_ActiveChannel:
+		s := proto.Size(x.ActiveChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_InactiveChannel:
+		s := proto.Size(x.InactiveChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_PendingOpenChannel:
+		s := proto.Size(x.PendingOpenChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_FullyResolvedChannel:
+		s := proto.Size(x.FullyResolvedChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Peer struct {
+	// / The identity pubkey of the peer
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / Network address of the peer; eg `127.0.0.1:10011`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// / Bytes of data transmitted to this peer
+	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent,proto3" json:"bytes_sent,omitempty"`
+	// / Bytes of data transmitted from this peer
+	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv,proto3" json:"bytes_recv,omitempty"`
+	// / Satoshis sent to this peer
+	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent,proto3" json:"sat_sent,omitempty"`
+	// / Satoshis received from this peer
+	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv,proto3" json:"sat_recv,omitempty"`
+	// / A channel is inbound if the counterparty initiated the channel
+	Inbound bool `protobuf:"varint,8,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// / Ping time to this peer
+	PingTime int64 `protobuf:"varint,9,opt,name=ping_time,proto3" json:"ping_time,omitempty"`
+	// The type of sync we are currently performing with this peer.
+	SyncType Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
+	// *
+	// The number of channel updates sent to us by this peer that were dropped due
+	// to rate limiting.
+	NumRateLimitedUpdates uint64 `protobuf:"varint,11,opt,name=num_rate_limited_updates,proto3" json:"num_rate_limited_updates,omitempty"`
+	// *
+	// The current gossip ban score of this peer. Each rate limited channel update
+	// signed by the peer itself increments the ban score, and all gossip from the
+	// peer not concerning our own channels is ignored for a period of time once it
+	// reaches the configured ban threshold.
+	GossipBanScore uint32 `protobuf:"varint,12,opt,name=gossip_ban_score,proto3" json:"gossip_ban_score,omitempty"`
+	// *
+	// The unix timestamp until which gossip from this peer is ignored, or 0 if the
+	// peer is not banned.
+	GossipBannedUntil int64 `protobuf:"varint,13,opt,name=gossip_banned_until,proto3" json:"gossip_banned_until,omitempty"`
+	// *
+	// The number of times we have recorded this peer going offline or coming
+	// online. The connection history is only recorded for peers we have
+	// channels with.
+	FlapCount uint64 `protobuf:"varint,14,opt,name=flap_count,proto3" json:"flap_count,omitempty"`
+	// / The timestamp of the last flap we observed for this peer, in nanoseconds since the unix epoch.
+	LastFlapNs int64 `protobuf:"varint,15,opt,name=last_flap_ns,proto3" json:"last_flap_ns,omitempty"`
+	// *
+	// The number of seconds this peer has been online, since we first connected
+	// to it.
+	Uptime int64 `protobuf:"varint,16,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// / The number of seconds since we first connected to this peer.
+	Lifetime             int64    `protobuf:"varint,17,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
-func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetInfoResponse) ProtoMessage()    {}
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{45}
+func (m *Peer) Reset()         { *m = Peer{} }
+func (m *Peer) String() string { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()    {}
+func (*Peer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{43}
 }
-func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetInfoResponse.Unmarshal(m, b)
+func (m *Peer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Peer.Unmarshal(m, b)
 }
-func (m *GetInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetInfoResponse.Marshal(b, m, deterministic)
+func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
 }
-func (dst *GetInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInfoResponse.Merge(dst, src)
+func (dst *Peer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Peer.Merge(dst, src)
 }
-func (m *GetInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetInfoResponse.Size(m)
+func (m *Peer) XXX_Size() int {
+	return xxx_messageInfo_Peer.Size(m)
 }
-func (m *GetInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInfoResponse.DiscardUnknown(m)
+func (m *Peer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Peer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_Peer proto.InternalMessageInfo
 
-func (m *GetInfoResponse) GetIdentityPubkey() string {
+func (m *Peer) GetPubKey() string {
 	if m != nil {
-		return m.IdentityPubkey
+		return m.PubKey
 	}
 	return ""
 }
 
-func (m *GetInfoResponse) GetAlias() string {
+func (m *Peer) GetAddress() string {
 	if m != nil {
-		return m.Alias
+		return m.Address
 	}
 	return ""
 }
 
-func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
+func (m *Peer) GetBytesSent() uint64 {
 	if m != nil {
-		return m.NumPendingChannels
+		return m.BytesSent
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
+func (m *Peer) GetBytesRecv() uint64 {
 	if m != nil {
-		return m.NumActiveChannels
+		return m.BytesRecv
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetNumPeers() uint32 {
+func (m *Peer) GetSatSent() int64 {
 	if m != nil {
-		return m.NumPeers
+		return m.SatSent
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHeight() uint32 {
+func (m *Peer) GetSatRecv() int64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.SatRecv
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHash() string {
+func (m *Peer) GetInbound() bool {
 	if m != nil {
-		return m.BlockHash
+		return m.Inbound
 	}
-	return ""
+	return false
 }
 
-func (m *GetInfoResponse) GetSyncedToChain() bool {
+func (m *Peer) GetPingTime() int64 {
 	if m != nil {
-		return m.SyncedToChain
+		return m.PingTime
 	}
-	return false
+	return 0
 }
 
-func (m *GetInfoResponse) GetTestnet() bool {
+func (m *Peer) GetSyncType() Peer_SyncType {
 	if m != nil {
-		return m.Testnet
+		return m.SyncType
 	}
-	return false
+	return Peer_UNKNOWN_SYNC
 }
 
-func (m *GetInfoResponse) GetChains() []string {
+func (m *Peer) GetNumRateLimitedUpdates() uint64 {
 	if m != nil {
-		return m.Chains
+		return m.NumRateLimitedUpdates
 	}
-	return nil
+	return 0
 }
 
-func (m *GetInfoResponse) GetUris() []string {
+func (m *Peer) GetGossipBanScore() uint32 {
 	if m != nil {
-		return m.Uris
+		return m.GossipBanScore
 	}
-	return nil
+	return 0
 }
 
-func (m *GetInfoResponse) GetBestHeaderTimestamp() int64 {
+func (m *Peer) GetGossipBannedUntil() int64 {
 	if m != nil {
-		return m.BestHeaderTimestamp
+		return m.GossipBannedUntil
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetVersion() string {
+func (m *Peer) GetFlapCount() uint64 {
 	if m != nil {
-		return m.Version
+		return m.FlapCount
 	}
-	return ""
+	return 0
 }
 
-func (m *GetInfoResponse) GetNumInactiveChannels() uint32 {
+func (m *Peer) GetLastFlapNs() int64 {
 	if m != nil {
-		return m.NumInactiveChannels
+		return m.LastFlapNs
 	}
 	return 0
 }
 
-type ConfirmationUpdate struct {
-	BlockSha             []byte   `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
-	BlockHeight          int32    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	NumConfsLeft         uint32   `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft,proto3" json:"num_confs_left,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Peer) GetUptime() int64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
 }
 
-func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
-func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
-func (*ConfirmationUpdate) ProtoMessage()    {}
-func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{46}
+func (m *Peer) GetLifetime() int64 {
+	if m != nil {
+		return m.Lifetime
+	}
+	return 0
 }
-func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
+
+type ListPeersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ConfirmationUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConfirmationUpdate.Marshal(b, m, deterministic)
+
+func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
+func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPeersRequest) ProtoMessage()    {}
+func (*ListPeersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{44}
 }
-func (dst *ConfirmationUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConfirmationUpdate.Merge(dst, src)
+func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPeersRequest.Unmarshal(m, b)
 }
-func (m *ConfirmationUpdate) XXX_Size() int {
-	return xxx_messageInfo_ConfirmationUpdate.Size(m)
+func (m *ListPeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPeersRequest.Marshal(b, m, deterministic)
 }
-func (m *ConfirmationUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConfirmationUpdate.DiscardUnknown(m)
+func (dst *ListPeersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPeersRequest.Merge(dst, src)
 }
-
-var xxx_messageInfo_ConfirmationUpdate proto.InternalMessageInfo
-
-func (m *ConfirmationUpdate) GetBlockSha() []byte {
-	if m != nil {
-		return m.BlockSha
-	}
-	return nil
+func (m *ListPeersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPeersRequest.Size(m)
 }
-
-func (m *ConfirmationUpdate) GetBlockHeight() int32 {
-	if m != nil {
-		return m.BlockHeight
-	}
-	return 0
+func (m *ListPeersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPeersRequest.DiscardUnknown(m)
 }
 
-func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
-	if m != nil {
-		return m.NumConfsLeft
-	}
-	return 0
-}
+var xxx_messageInfo_ListPeersRequest proto.InternalMessageInfo
 
-type ChannelOpenUpdate struct {
-	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type ListPeersResponse struct {
+	// / The list of currently connected peers
+	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
-func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelOpenUpdate) ProtoMessage()    {}
-func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{47}
+func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
+func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPeersResponse) ProtoMessage()    {}
+func (*ListPeersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{45}
 }
-func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
+func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPeersResponse.Unmarshal(m, b)
 }
-func (m *ChannelOpenUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelOpenUpdate.Marshal(b, m, deterministic)
+func (m *ListPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPeersResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChannelOpenUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelOpenUpdate.Merge(dst, src)
+func (dst *ListPeersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPeersResponse.Merge(dst, src)
 }
-func (m *ChannelOpenUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelOpenUpdate.Size(m)
+func (m *ListPeersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPeersResponse.Size(m)
 }
-func (m *ChannelOpenUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelOpenUpdate.DiscardUnknown(m)
+func (m *ListPeersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPeersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelOpenUpdate proto.InternalMessageInfo
+var xxx_messageInfo_ListPeersResponse proto.InternalMessageInfo
 
-func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
+func (m *ListPeersResponse) GetPeers() []*Peer {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Peers
 	}
 	return nil
 }
 
-type ChannelCloseUpdate struct {
-	ClosingTxid          []byte   `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+type PeerEventSubscription struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
-func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelCloseUpdate) ProtoMessage()    {}
-func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{48}
+func (m *PeerEventSubscription) Reset()         { *m = PeerEventSubscription{} }
+func (m *PeerEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*PeerEventSubscription) ProtoMessage()    {}
+func (*PeerEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{46}
 }
-func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
+func (m *PeerEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerEventSubscription.Unmarshal(m, b)
 }
-func (m *ChannelCloseUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelCloseUpdate.Marshal(b, m, deterministic)
+func (m *PeerEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerEventSubscription.Marshal(b, m, deterministic)
 }
-func (dst *ChannelCloseUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelCloseUpdate.Merge(dst, src)
+func (dst *PeerEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerEventSubscription.Merge(dst, src)
 }
-func (m *ChannelCloseUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelCloseUpdate.Size(m)
+func (m *PeerEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_PeerEventSubscription.Size(m)
 }
-func (m *ChannelCloseUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelCloseUpdate.DiscardUnknown(m)
+func (m *PeerEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerEventSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelCloseUpdate proto.InternalMessageInfo
+var xxx_messageInfo_PeerEventSubscription proto.InternalMessageInfo
 
-func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
+type PeerEvent struct {
+	// / The identity pubkey of the peer.
+	PubKey               string              `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	Type                 PeerEvent_EventType `protobuf:"varint,2,opt,name=type,proto3,enum=lnrpc.PeerEvent_EventType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PeerEvent) Reset()         { *m = PeerEvent{} }
+func (m *PeerEvent) String() string { return proto.CompactTextString(m) }
+func (*PeerEvent) ProtoMessage()    {}
+func (*PeerEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{47}
+}
+func (m *PeerEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerEvent.Unmarshal(m, b)
+}
+func (m *PeerEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerEvent.Marshal(b, m, deterministic)
+}
+func (dst *PeerEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerEvent.Merge(dst, src)
+}
+func (m *PeerEvent) XXX_Size() int {
+	return xxx_messageInfo_PeerEvent.Size(m)
+}
+func (m *PeerEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerEvent proto.InternalMessageInfo
+
+func (m *PeerEvent) GetPubKey() string {
 	if m != nil {
-		return m.ClosingTxid
+		return m.PubKey
 	}
-	return nil
+	return ""
 }
 
-func (m *ChannelCloseUpdate) GetSuccess() bool {
+func (m *PeerEvent) GetType() PeerEvent_EventType {
 	if m != nil {
-		return m.Success
+		return m.Type
 	}
-	return false
+	return PeerEvent_PEER_ONLINE
 }
 
-type CloseChannelRequest struct {
-	// *
-	// The outpoint (txid:index) of the funding transaction. With this value, Bob
-	// will be able to generate a signature for Alice's version of the commitment
-	// transaction.
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
-	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	// / The target number of blocks that the closure transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
-	SatPerByte           int64    `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+type GetInfoRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
-func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
-func (*CloseChannelRequest) ProtoMessage()    {}
-func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{49}
+func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
+func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetInfoRequest) ProtoMessage()    {}
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{48}
 }
-func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CloseChannelRequest.Unmarshal(m, b)
+func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
 }
-func (m *CloseChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CloseChannelRequest.Marshal(b, m, deterministic)
+func (m *GetInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfoRequest.Marshal(b, m, deterministic)
 }
-func (dst *CloseChannelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseChannelRequest.Merge(dst, src)
+func (dst *GetInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfoRequest.Merge(dst, src)
 }
-func (m *CloseChannelRequest) XXX_Size() int {
-	return xxx_messageInfo_CloseChannelRequest.Size(m)
+func (m *GetInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetInfoRequest.Size(m)
 }
-func (m *CloseChannelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseChannelRequest.DiscardUnknown(m)
+func (m *GetInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CloseChannelRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetInfoRequest proto.InternalMessageInfo
 
-func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
-	if m != nil {
-		return m.ChannelPoint
-	}
-	return nil
+type GetInfoResponse struct {
+	// / The identity pubkey of the current node.
+	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey,proto3" json:"identity_pubkey,omitempty"`
+	// / If applicable, the alias of the current node, e.g. "bob"
+	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	// / Number of pending channels
+	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels,proto3" json:"num_pending_channels,omitempty"`
+	// / Number of active channels
+	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels,proto3" json:"num_active_channels,omitempty"`
+	// / Number of peers
+	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers,proto3" json:"num_peers,omitempty"`
+	// / The node's current view of the height of the best block
+	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height,proto3" json:"block_height,omitempty"`
+	// / The node's current view of the hash of the best block
+	BlockHash string `protobuf:"bytes,8,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
+	// / Whether the wallet's view is synced to the main chain
+	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain,proto3" json:"synced_to_chain,omitempty"`
+	// / Whether the current node is connected to testnet
+	Testnet bool `protobuf:"varint,10,opt,name=testnet,proto3" json:"testnet,omitempty"`
+	// / A list of active chains the node is connected to
+	Chains []string `protobuf:"bytes,11,rep,name=chains,proto3" json:"chains,omitempty"`
+	// / The URIs of the current node.
+	Uris []string `protobuf:"bytes,12,rep,name=uris,proto3" json:"uris,omitempty"`
+	// / Timestamp of the block best known to the wallet
+	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp,proto3" json:"best_header_timestamp,omitempty"`
+	// / The version of the LND software that the node is running.
+	Version string `protobuf:"bytes,14,opt,name=version,proto3" json:"version,omitempty"`
+	// / Number of inactive channels
+	NumInactiveChannels  uint32   `protobuf:"varint,15,opt,name=num_inactive_channels,proto3" json:"num_inactive_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseChannelRequest) GetForce() bool {
+func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
+func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetInfoResponse) ProtoMessage()    {}
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{49}
+}
+func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfoResponse.Unmarshal(m, b)
+}
+func (m *GetInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfoResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfoResponse.Merge(dst, src)
+}
+func (m *GetInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetInfoResponse.Size(m)
+}
+func (m *GetInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfoResponse proto.InternalMessageInfo
+
+func (m *GetInfoResponse) GetIdentityPubkey() string {
 	if m != nil {
-		return m.Force
+		return m.IdentityPubkey
 	}
-	return false
+	return ""
 }
 
-func (m *CloseChannelRequest) GetTargetConf() int32 {
+func (m *GetInfoResponse) GetAlias() string {
 	if m != nil {
-		return m.TargetConf
+		return m.Alias
 	}
-	return 0
+	return ""
 }
 
-func (m *CloseChannelRequest) GetSatPerByte() int64 {
+func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
 	if m != nil {
-		return m.SatPerByte
+		return m.NumPendingChannels
 	}
 	return 0
 }
 
-type CloseStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
-	//	*CloseStatusUpdate_ClosePending
-	//	*CloseStatusUpdate_Confirmation
-	//	*CloseStatusUpdate_ChanClose
-	Update               isCloseStatusUpdate_Update `protobuf_oneof:"update"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
-}
-
-func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
-func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
-func (*CloseStatusUpdate) ProtoMessage()    {}
-func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{50}
-}
-func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CloseStatusUpdate.Unmarshal(m, b)
-}
-func (m *CloseStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CloseStatusUpdate.Marshal(b, m, deterministic)
-}
-func (dst *CloseStatusUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseStatusUpdate.Merge(dst, src)
-}
-func (m *CloseStatusUpdate) XXX_Size() int {
-	return xxx_messageInfo_CloseStatusUpdate.Size(m)
-}
-func (m *CloseStatusUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseStatusUpdate.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CloseStatusUpdate proto.InternalMessageInfo
-
-type isCloseStatusUpdate_Update interface {
-	isCloseStatusUpdate_Update()
-}
-
-type CloseStatusUpdate_ClosePending struct {
-	ClosePending *PendingUpdate `protobuf:"bytes,1,opt,name=close_pending,proto3,oneof"`
+func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
+	if m != nil {
+		return m.NumActiveChannels
+	}
+	return 0
 }
 
-type CloseStatusUpdate_Confirmation struct {
-	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,proto3,oneof"`
+func (m *GetInfoResponse) GetNumPeers() uint32 {
+	if m != nil {
+		return m.NumPeers
+	}
+	return 0
 }
 
-type CloseStatusUpdate_ChanClose struct {
-	ChanClose *ChannelCloseUpdate `protobuf:"bytes,3,opt,name=chan_close,proto3,oneof"`
+func (m *GetInfoResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (*CloseStatusUpdate_ClosePending) isCloseStatusUpdate_Update() {}
-
-func (*CloseStatusUpdate_Confirmation) isCloseStatusUpdate_Update() {}
-
-func (*CloseStatusUpdate_ChanClose) isCloseStatusUpdate_Update() {}
-
-func (m *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
+func (m *GetInfoResponse) GetBlockHash() string {
 	if m != nil {
-		return m.Update
+		return m.BlockHash
 	}
-	return nil
+	return ""
 }
 
-func (m *CloseStatusUpdate) GetClosePending() *PendingUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ClosePending); ok {
-		return x.ClosePending
+func (m *GetInfoResponse) GetSyncedToChain() bool {
+	if m != nil {
+		return m.SyncedToChain
 	}
-	return nil
+	return false
 }
 
-func (m *CloseStatusUpdate) GetConfirmation() *ConfirmationUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_Confirmation); ok {
-		return x.Confirmation
+func (m *GetInfoResponse) GetTestnet() bool {
+	if m != nil {
+		return m.Testnet
 	}
-	return nil
+	return false
 }
 
-func (m *CloseStatusUpdate) GetChanClose() *ChannelCloseUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ChanClose); ok {
-		return x.ChanClose
+func (m *GetInfoResponse) GetChains() []string {
+	if m != nil {
+		return m.Chains
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*CloseStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _CloseStatusUpdate_OneofMarshaler, _CloseStatusUpdate_OneofUnmarshaler, _CloseStatusUpdate_OneofSizer, []interface{}{
-		(*CloseStatusUpdate_ClosePending)(nil),
-		(*CloseStatusUpdate_Confirmation)(nil),
-		(*CloseStatusUpdate_ChanClose)(nil),
+func (m *GetInfoResponse) GetUris() []string {
+	if m != nil {
+		return m.Uris
 	}
+	return nil
 }
 
-func _CloseStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*CloseStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *CloseStatusUpdate_ClosePending:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ClosePending); err != nil {
-			return err
-		}
-	case *CloseStatusUpdate_Confirmation:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Confirmation); err != nil {
-			return err
-		}
-	case *CloseStatusUpdate_ChanClose:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanClose); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("CloseStatusUpdate.Update has unexpected type %T", x)
+func (m *GetInfoResponse) GetBestHeaderTimestamp() int64 {
+	if m != nil {
+		return m.BestHeaderTimestamp
 	}
-	return nil
+	return 0
 }
 
-func _CloseStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*CloseStatusUpdate)
-	switch tag {
-	case 1: // update.close_pending
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(PendingUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_ClosePending{msg}
-		return true, err
-	case 2: // update.confirmation
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ConfirmationUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_Confirmation{msg}
-		return true, err
-	case 3: // update.chan_close
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelCloseUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_ChanClose{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *GetInfoResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
+	return ""
 }
 
-func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*CloseStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *CloseStatusUpdate_ClosePending:
-		s := proto.Size(x.ClosePending)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *CloseStatusUpdate_Confirmation:
-		s := proto.Size(x.Confirmation)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *CloseStatusUpdate_ChanClose:
-		s := proto.Size(x.ChanClose)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *GetInfoResponse) GetNumInactiveChannels() uint32 {
+	if m != nil {
+		return m.NumInactiveChannels
 	}
-	return n
+	return 0
 }
 
-type PendingUpdate struct {
-	Txid                 []byte   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,proto3" json:"output_index,omitempty"`
+type ConfirmationUpdate struct {
+	BlockSha             []byte   `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
+	BlockHeight          int32    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	NumConfsLeft         uint32   `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft,proto3" json:"num_confs_left,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
-func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
-func (*PendingUpdate) ProtoMessage()    {}
-func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{51}
+func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
+func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationUpdate) ProtoMessage()    {}
+func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{50}
 }
-func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingUpdate.Unmarshal(m, b)
+func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
 }
-func (m *PendingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingUpdate.Marshal(b, m, deterministic)
+func (m *ConfirmationUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmationUpdate.Marshal(b, m, deterministic)
 }
-func (dst *PendingUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingUpdate.Merge(dst, src)
+func (dst *ConfirmationUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationUpdate.Merge(dst, src)
 }
-func (m *PendingUpdate) XXX_Size() int {
-	return xxx_messageInfo_PendingUpdate.Size(m)
+func (m *ConfirmationUpdate) XXX_Size() int {
+	return xxx_messageInfo_ConfirmationUpdate.Size(m)
 }
-func (m *PendingUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingUpdate.DiscardUnknown(m)
+func (m *ConfirmationUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingUpdate proto.InternalMessageInfo
+var xxx_messageInfo_ConfirmationUpdate proto.InternalMessageInfo
 
-func (m *PendingUpdate) GetTxid() []byte {
+func (m *ConfirmationUpdate) GetBlockSha() []byte {
 	if m != nil {
-		return m.Txid
+		return m.BlockSha
 	}
 	return nil
 }
 
-func (m *PendingUpdate) GetOutputIndex() uint32 {
+func (m *ConfirmationUpdate) GetBlockHeight() int32 {
 	if m != nil {
-		return m.OutputIndex
+		return m.BlockHeight
 	}
 	return 0
 }
 
-type OpenChannelRequest struct {
-	// / The pubkey of the node to open a channel with
-	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
-	// / The hex encoded pubkey of the node to open a channel with
-	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string,proto3" json:"node_pubkey_string,omitempty"`
-	// / The number of satoshis the wallet should commit to the channel
-	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount,proto3" json:"local_funding_amount,omitempty"`
-	// / The number of satoshis to push to the remote side as part of the initial commitment state
-	PushSat int64 `protobuf:"varint,5,opt,name=push_sat,proto3" json:"push_sat,omitempty"`
-	// / The target number of blocks that the funding transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
-	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
-	// / Whether this channel should be private, not announced to the greater network.
-	Private bool `protobuf:"varint,8,opt,name=private,proto3" json:"private,omitempty"`
-	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
-	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat,proto3" json:"min_htlc_msat,omitempty"`
-	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
-	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay,proto3" json:"remote_csv_delay,omitempty"`
-	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
-	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
-	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
-	SpendUnconfirmed     bool     `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
+	if m != nil {
+		return m.NumConfsLeft
+	}
+	return 0
 }
 
-func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
-func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
-func (*OpenChannelRequest) ProtoMessage()    {}
-func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{52}
+type ChannelOpenUpdate struct {
+	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OpenChannelRequest.Unmarshal(m, b)
+
+func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
+func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelOpenUpdate) ProtoMessage()    {}
+func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{51}
 }
-func (m *OpenChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OpenChannelRequest.Marshal(b, m, deterministic)
+func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
 }
-func (dst *OpenChannelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OpenChannelRequest.Merge(dst, src)
+func (m *ChannelOpenUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelOpenUpdate.Marshal(b, m, deterministic)
 }
-func (m *OpenChannelRequest) XXX_Size() int {
-	return xxx_messageInfo_OpenChannelRequest.Size(m)
+func (dst *ChannelOpenUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelOpenUpdate.Merge(dst, src)
 }
-func (m *OpenChannelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OpenChannelRequest.DiscardUnknown(m)
+func (m *ChannelOpenUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelOpenUpdate.Size(m)
+}
+func (m *ChannelOpenUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelOpenUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OpenChannelRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChannelOpenUpdate proto.InternalMessageInfo
 
-func (m *OpenChannelRequest) GetNodePubkey() []byte {
+func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.NodePubkey
+		return m.ChannelPoint
 	}
 	return nil
 }
 
-func (m *OpenChannelRequest) GetNodePubkeyString() string {
-	if m != nil {
-		return m.NodePubkeyString
-	}
-	return ""
+type ChannelCloseUpdate struct {
+	ClosingTxid          []byte   `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
-	if m != nil {
-		return m.LocalFundingAmount
-	}
-	return 0
+func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
+func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelCloseUpdate) ProtoMessage()    {}
+func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{52}
+}
+func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
+}
+func (m *ChannelCloseUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCloseUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ChannelCloseUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCloseUpdate.Merge(dst, src)
+}
+func (m *ChannelCloseUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelCloseUpdate.Size(m)
+}
+func (m *ChannelCloseUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCloseUpdate.DiscardUnknown(m)
 }
 
-func (m *OpenChannelRequest) GetPushSat() int64 {
+var xxx_messageInfo_ChannelCloseUpdate proto.InternalMessageInfo
+
+func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
 	if m != nil {
-		return m.PushSat
+		return m.ClosingTxid
 	}
-	return 0
+	return nil
 }
 
-func (m *OpenChannelRequest) GetTargetConf() int32 {
+func (m *ChannelCloseUpdate) GetSuccess() bool {
 	if m != nil {
-		return m.TargetConf
+		return m.Success
 	}
-	return 0
+	return false
 }
 
-func (m *OpenChannelRequest) GetSatPerByte() int64 {
-	if m != nil {
-		return m.SatPerByte
-	}
-	return 0
+type CloseChannelRequest struct {
+	// *
+	// The outpoint (txid:index) of the funding transaction. With this value, Bob
+	// will be able to generate a signature for Alice's version of the commitment
+	// transaction.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// / The target number of blocks that the closure transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
+	SatPerByte           int64    `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *OpenChannelRequest) GetPrivate() bool {
-	if m != nil {
-		return m.Private
-	}
-	return false
+func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
+func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseChannelRequest) ProtoMessage()    {}
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{53}
+}
+func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseChannelRequest.Unmarshal(m, b)
+}
+func (m *CloseChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseChannelRequest.Marshal(b, m, deterministic)
+}
+func (dst *CloseChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseChannelRequest.Merge(dst, src)
+}
+func (m *CloseChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseChannelRequest.Size(m)
+}
+func (m *CloseChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseChannelRequest.DiscardUnknown(m)
 }
 
-func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
+var xxx_messageInfo_CloseChannelRequest proto.InternalMessageInfo
+
+func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.MinHtlcMsat
+		return m.ChannelPoint
 	}
-	return 0
+	return nil
 }
 
-func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
+func (m *CloseChannelRequest) GetForce() bool {
 	if m != nil {
-		return m.RemoteCsvDelay
+		return m.Force
 	}
-	return 0
+	return false
 }
 
-func (m *OpenChannelRequest) GetMinConfs() int32 {
+func (m *CloseChannelRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.MinConfs
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
+func (m *CloseChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.SpendUnconfirmed
+		return m.SatPerByte
 	}
-	return false
+	return 0
 }
 
-type OpenStatusUpdate struct {
+type CloseStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
-	//	*OpenStatusUpdate_ChanPending
-	//	*OpenStatusUpdate_Confirmation
-	//	*OpenStatusUpdate_ChanOpen
-	Update               isOpenStatusUpdate_Update `protobuf_oneof:"update"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	//	*CloseStatusUpdate_ClosePending
+	//	*CloseStatusUpdate_Confirmation
+	//	*CloseStatusUpdate_ChanClose
+	Update               isCloseStatusUpdate_Update `protobuf_oneof:"update"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
-func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
-func (*OpenStatusUpdate) ProtoMessage()    {}
-func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{53}
+func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
+func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
+func (*CloseStatusUpdate) ProtoMessage()    {}
+func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{54}
 }
-func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
+func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseStatusUpdate.Unmarshal(m, b)
 }
-func (m *OpenStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OpenStatusUpdate.Marshal(b, m, deterministic)
+func (m *CloseStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseStatusUpdate.Marshal(b, m, deterministic)
 }
-func (dst *OpenStatusUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OpenStatusUpdate.Merge(dst, src)
+func (dst *CloseStatusUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseStatusUpdate.Merge(dst, src)
 }
-func (m *OpenStatusUpdate) XXX_Size() int {
-	return xxx_messageInfo_OpenStatusUpdate.Size(m)
+func (m *CloseStatusUpdate) XXX_Size() int {
+	return xxx_messageInfo_CloseStatusUpdate.Size(m)
 }
-func (m *OpenStatusUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_OpenStatusUpdate.DiscardUnknown(m)
+func (m *CloseStatusUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseStatusUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OpenStatusUpdate proto.InternalMessageInfo
+var xxx_messageInfo_CloseStatusUpdate proto.InternalMessageInfo
 
-type isOpenStatusUpdate_Update interface {
-	isOpenStatusUpdate_Update()
+type isCloseStatusUpdate_Update interface {
+	isCloseStatusUpdate_Update()
 }
 
-type OpenStatusUpdate_ChanPending struct {
-	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,proto3,oneof"`
+type CloseStatusUpdate_ClosePending struct {
+	ClosePending *PendingUpdate `protobuf:"bytes,1,opt,name=close_pending,proto3,oneof"`
 }
 
-type OpenStatusUpdate_Confirmation struct {
+type CloseStatusUpdate_Confirmation struct {
 	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,proto3,oneof"`
 }
 
-type OpenStatusUpdate_ChanOpen struct {
-	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,proto3,oneof"`
+type CloseStatusUpdate_ChanClose struct {
+	ChanClose *ChannelCloseUpdate `protobuf:"bytes,3,opt,name=chan_close,proto3,oneof"`
 }
 
-func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update() {}
+func (*CloseStatusUpdate_ClosePending) isCloseStatusUpdate_Update() {}
 
-func (*OpenStatusUpdate_Confirmation) isOpenStatusUpdate_Update() {}
+func (*CloseStatusUpdate_Confirmation) isCloseStatusUpdate_Update() {}
 
-func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update() {}
+func (*CloseStatusUpdate_ChanClose) isCloseStatusUpdate_Update() {}
 
-func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
+func (m *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
 	if m != nil {
 		return m.Update
 	}
 	return nil
 }
 
-func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
-		return x.ChanPending
+func (m *CloseStatusUpdate) GetClosePending() *PendingUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ClosePending); ok {
+		return x.ClosePending
 	}
 	return nil
 }
 
-func (m *OpenStatusUpdate) GetConfirmation() *ConfirmationUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_Confirmation); ok {
+func (m *CloseStatusUpdate) GetConfirmation() *ConfirmationUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_Confirmation); ok {
 		return x.Confirmation
 	}
 	return nil
 }
 
-func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
-		return x.ChanOpen
+func (m *CloseStatusUpdate) GetChanClose() *ChannelCloseUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ChanClose); ok {
+		return x.ChanClose
 	}
 	return nil
 }
 
 // XXX_OneofFuncs is for the internal use of the proto package.
-func (*OpenStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _OpenStatusUpdate_OneofMarshaler, _OpenStatusUpdate_OneofUnmarshaler, _OpenStatusUpdate_OneofSizer, []interface{}{
-		(*OpenStatusUpdate_ChanPending)(nil),
-		(*OpenStatusUpdate_Confirmation)(nil),
-		(*OpenStatusUpdate_ChanOpen)(nil),
+func (*CloseStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CloseStatusUpdate_OneofMarshaler, _CloseStatusUpdate_OneofUnmarshaler, _CloseStatusUpdate_OneofSizer, []interface{}{
+		(*CloseStatusUpdate_ClosePending)(nil),
+		(*CloseStatusUpdate_Confirmation)(nil),
+		(*CloseStatusUpdate_ChanClose)(nil),
 	}
 }
 
-func _OpenStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*OpenStatusUpdate)
+func _CloseStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CloseStatusUpdate)
 	// update
 	switch x := m.Update.(type) {
-	case *OpenStatusUpdate_ChanPending:
+	case *CloseStatusUpdate_ClosePending:
 		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanPending); err != nil {
+		if err := b.EncodeMessage(x.ClosePending); err != nil {
 			return err
 		}
-	case *OpenStatusUpdate_Confirmation:
+	case *CloseStatusUpdate_Confirmation:
 		b.EncodeVarint(2<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Confirmation); err != nil {
 			return err
 		}
-	case *OpenStatusUpdate_ChanOpen:
+	case *CloseStatusUpdate_ChanClose:
 		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanOpen); err != nil {
+		if err := b.EncodeMessage(x.ChanClose); err != nil {
 			return err
 		}
 	case nil:
 	default:
-		return fmt.Errorf("OpenStatusUpdate.Update has unexpected type %T", x)
+		return fmt.Errorf("CloseStatusUpdate.Update has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*OpenStatusUpdate)
+func _CloseStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CloseStatusUpdate)
 	switch tag {
-	case 1: // update.chan_pending
+	case 1: // update.close_pending
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
 		msg := new(PendingUpdate)
 		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_ChanPending{msg}
+		m.Update = &CloseStatusUpdate_ClosePending{msg}
 		return true, err
 	case 2: // update.confirmation
 		if wire != proto.WireBytes {
@@ -3631,37 +4060,37 @@ func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *pro
 		}
 		msg := new(ConfirmationUpdate)
 		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_Confirmation{msg}
+		m.Update = &CloseStatusUpdate_Confirmation{msg}
 		return true, err
-	case 3: // update.chan_open
+	case 3: // update.chan_close
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(ChannelOpenUpdate)
+		msg := new(ChannelCloseUpdate)
 		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_ChanOpen{msg}
+		m.Update = &CloseStatusUpdate_ChanClose{msg}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*OpenStatusUpdate)
+func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CloseStatusUpdate)
 	// update
 	switch x := m.Update.(type) {
-	case *OpenStatusUpdate_ChanPending:
-		s := proto.Size(x.ChanPending)
+	case *CloseStatusUpdate_ClosePending:
+		s := proto.Size(x.ClosePending)
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case *OpenStatusUpdate_Confirmation:
+	case *CloseStatusUpdate_Confirmation:
 		s := proto.Size(x.Confirmation)
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case *OpenStatusUpdate_ChanOpen:
-		s := proto.Size(x.ChanOpen)
+	case *CloseStatusUpdate_ChanClose:
+		s := proto.Size(x.ChanClose)
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
@@ -3672,3828 +4101,6143 @@ func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-type PendingHTLC struct {
-	// / The direction within the channel that the htlc was sent
-	Incoming bool `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
-	// / The total value of the htlc
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// / The final output to be swept back to the user's wallet
-	Outpoint string `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// / The next block height at which we can spend the current stage
-	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
-	// *
-	// The number of blocks remaining until the current stage can be swept.
-	// Negative values indicate how many blocks have passed since becoming
-	// mature.
-	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
-	// / Indicates whether the htlc is in its first or second stage of recovery
-	Stage                uint32   `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"`
+type PendingUpdate struct {
+	Txid                 []byte   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,proto3" json:"output_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
-func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
-func (*PendingHTLC) ProtoMessage()    {}
-func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{54}
-}
-func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
-}
-func (m *PendingHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingHTLC.Marshal(b, m, deterministic)
-}
-func (dst *PendingHTLC) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingHTLC.Merge(dst, src)
+func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
+func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingUpdate) ProtoMessage()    {}
+func (*PendingUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{55}
 }
-func (m *PendingHTLC) XXX_Size() int {
-	return xxx_messageInfo_PendingHTLC.Size(m)
+func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingUpdate.Unmarshal(m, b)
 }
-func (m *PendingHTLC) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingHTLC.DiscardUnknown(m)
+func (m *PendingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingUpdate.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_PendingHTLC proto.InternalMessageInfo
-
-func (m *PendingHTLC) GetIncoming() bool {
-	if m != nil {
-		return m.Incoming
-	}
-	return false
+func (dst *PendingUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUpdate.Merge(dst, src)
 }
-
-func (m *PendingHTLC) GetAmount() int64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
+func (m *PendingUpdate) XXX_Size() int {
+	return xxx_messageInfo_PendingUpdate.Size(m)
 }
-
-func (m *PendingHTLC) GetOutpoint() string {
-	if m != nil {
-		return m.Outpoint
-	}
-	return ""
+func (m *PendingUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUpdate.DiscardUnknown(m)
 }
 
-func (m *PendingHTLC) GetMaturityHeight() uint32 {
-	if m != nil {
-		return m.MaturityHeight
-	}
-	return 0
-}
+var xxx_messageInfo_PendingUpdate proto.InternalMessageInfo
 
-func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
+func (m *PendingUpdate) GetTxid() []byte {
 	if m != nil {
-		return m.BlocksTilMaturity
+		return m.Txid
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingHTLC) GetStage() uint32 {
+func (m *PendingUpdate) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.Stage
+		return m.OutputIndex
 	}
 	return 0
 }
 
-type PendingChannelsRequest struct {
+type OpenChannelRequest struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hex encoded pubkey of the node to open a channel with
+	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string,proto3" json:"node_pubkey_string,omitempty"`
+	// / The number of satoshis the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount,proto3" json:"local_funding_amount,omitempty"`
+	// / The number of satoshis to push to the remote side as part of the initial commitment state
+	PushSat int64 `protobuf:"varint,5,opt,name=push_sat,proto3" json:"push_sat,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,8,opt,name=private,proto3" json:"private,omitempty"`
+	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat,proto3" json:"min_htlc_msat,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay,proto3" json:"remote_csv_delay,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
+	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
+	SpendUnconfirmed     bool     `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{} }
-func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsRequest) ProtoMessage()    {}
-func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{55}
+func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
+func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*OpenChannelRequest) ProtoMessage()    {}
+func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{56}
 }
-func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
+func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenChannelRequest.Unmarshal(m, b)
 }
-func (m *PendingChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsRequest.Marshal(b, m, deterministic)
+func (m *OpenChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenChannelRequest.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsRequest.Merge(dst, src)
+func (dst *OpenChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenChannelRequest.Merge(dst, src)
 }
-func (m *PendingChannelsRequest) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsRequest.Size(m)
+func (m *OpenChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_OpenChannelRequest.Size(m)
 }
-func (m *PendingChannelsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsRequest.DiscardUnknown(m)
+func (m *OpenChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenChannelRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsRequest proto.InternalMessageInfo
+var xxx_messageInfo_OpenChannelRequest proto.InternalMessageInfo
 
-type PendingChannelsResponse struct {
-	// / The balance in satoshis encumbered in pending channels
-	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance,proto3" json:"total_limbo_balance,omitempty"`
-	// / Channels pending opening
-	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels,proto3" json:"pending_open_channels,omitempty"`
-	// / Channels pending closing
-	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels,proto3" json:"pending_closing_channels,omitempty"`
-	// / Channels pending force closing
-	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,proto3" json:"pending_force_closing_channels,omitempty"`
-	// / Channels waiting for closing tx to confirm
-	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,proto3" json:"waiting_close_channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+func (m *OpenChannelRequest) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
 }
 
-func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
-func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse) ProtoMessage()    {}
-func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{56}
-}
-func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
-}
-func (m *PendingChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse.Marshal(b, m, deterministic)
-}
-func (dst *PendingChannelsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse.Merge(dst, src)
-}
-func (m *PendingChannelsResponse) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse.Size(m)
-}
-func (m *PendingChannelsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse.DiscardUnknown(m)
+func (m *OpenChannelRequest) GetNodePubkeyString() string {
+	if m != nil {
+		return m.NodePubkeyString
+	}
+	return ""
 }
 
-var xxx_messageInfo_PendingChannelsResponse proto.InternalMessageInfo
-
-func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
 	if m != nil {
-		return m.TotalLimboBalance
+		return m.LocalFundingAmount
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
+func (m *OpenChannelRequest) GetPushSat() int64 {
 	if m != nil {
-		return m.PendingOpenChannels
+		return m.PushSat
 	}
-	return nil
+	return 0
 }
 
-func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
+func (m *OpenChannelRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.PendingClosingChannels
+		return m.TargetConf
 	}
-	return nil
+	return 0
 }
 
-func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
+func (m *OpenChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.PendingForceClosingChannels
-	}
-	return nil
-}
-
-func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
-	if m != nil {
-		return m.WaitingCloseChannels
+		return m.SatPerByte
 	}
-	return nil
-}
-
-type PendingChannelsResponse_PendingChannel struct {
-	RemoteNodePub        string   `protobuf:"bytes,1,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
-	ChannelPoint         string   `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	LocalBalance         int64    `protobuf:"varint,4,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
-	RemoteBalance        int64    `protobuf:"varint,5,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *PendingChannelsResponse_PendingChannel) Reset() {
-	*m = PendingChannelsResponse_PendingChannel{}
-}
-func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{56, 0}
-}
-func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
-}
-func (m *PendingChannelsResponse_PendingChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Marshal(b, m, deterministic)
-}
-func (dst *PendingChannelsResponse_PendingChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_PendingChannel.Merge(dst, src)
-}
-func (m *PendingChannelsResponse_PendingChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Size(m)
-}
-func (m *PendingChannelsResponse_PendingChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_PendingChannel.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_PendingChannelsResponse_PendingChannel proto.InternalMessageInfo
-
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
+func (m *OpenChannelRequest) GetPrivate() bool {
 	if m != nil {
-		return m.RemoteNodePub
+		return m.Private
 	}
-	return ""
+	return false
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
+func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
 	if m != nil {
-		return m.ChannelPoint
+		return m.MinHtlcMsat
 	}
-	return ""
+	return 0
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
+func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
 	if m != nil {
-		return m.Capacity
+		return m.RemoteCsvDelay
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
+func (m *OpenChannelRequest) GetMinConfs() int32 {
 	if m != nil {
-		return m.LocalBalance
+		return m.MinConfs
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
+func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
 	if m != nil {
-		return m.RemoteBalance
+		return m.SpendUnconfirmed
 	}
-	return 0
+	return false
 }
 
-type PendingChannelsResponse_PendingOpenChannel struct {
-	// / The pending channel
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The height at which this channel will be confirmed
-	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height,proto3" json:"confirmation_height,omitempty"`
-	// *
-	// The amount calculated to be paid in fees for the current set of
-	// commitment transactions. The fee amount is persisted with the channel
-	// in order to allow the fee amount to be removed and recalculated with
-	// each channel state update, including updates that happen after a system
-	// restart.
-	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
-	// / The weight of the commitment transaction
-	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
-	// *
-	// The required number of satoshis per kilo-weight that the requester will
-	// pay at all times, for both the funding transaction and commitment
-	// transaction. This value can later be updated once the channel is open.
-	FeePerKw             int64    `protobuf:"varint,6,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type OpenStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
+	//	*OpenStatusUpdate_ChanPending
+	//	*OpenStatusUpdate_Confirmation
+	//	*OpenStatusUpdate_ChanOpen
+	Update               isOpenStatusUpdate_Update `protobuf_oneof:"update"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
-	*m = PendingChannelsResponse_PendingOpenChannel{}
-}
-func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{56, 1}
+func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
+func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
+func (*OpenStatusUpdate) ProtoMessage()    {}
+func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{57}
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
+func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Marshal(b, m, deterministic)
+func (m *OpenStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenStatusUpdate.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse_PendingOpenChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Merge(dst, src)
+func (dst *OpenStatusUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenStatusUpdate.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Size(m)
+func (m *OpenStatusUpdate) XXX_Size() int {
+	return xxx_messageInfo_OpenStatusUpdate.Size(m)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.DiscardUnknown(m)
+func (m *OpenStatusUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenStatusUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel proto.InternalMessageInfo
-
-func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
-	if m != nil {
-		return m.Channel
-	}
-	return nil
-}
+var xxx_messageInfo_OpenStatusUpdate proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
-	if m != nil {
-		return m.ConfirmationHeight
-	}
-	return 0
+type isOpenStatusUpdate_Update interface {
+	isOpenStatusUpdate_Update()
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
-	if m != nil {
-		return m.CommitFee
-	}
-	return 0
+type OpenStatusUpdate_ChanPending struct {
+	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,proto3,oneof"`
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
-	if m != nil {
-		return m.CommitWeight
-	}
-	return 0
+type OpenStatusUpdate_Confirmation struct {
+	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,proto3,oneof"`
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
-	if m != nil {
-		return m.FeePerKw
-	}
-	return 0
+type OpenStatusUpdate_ChanOpen struct {
+	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,proto3,oneof"`
 }
 
-type PendingChannelsResponse_WaitingCloseChannel struct {
-	// / The pending channel waiting for closing tx to confirm
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The balance in satoshis encumbered in this channel
-	LimboBalance         int64    `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
+func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update() {}
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
-	*m = PendingChannelsResponse_WaitingCloseChannel{}
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{56, 2}
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Marshal(b, m, deterministic)
-}
-func (dst *PendingChannelsResponse_WaitingCloseChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Merge(dst, src)
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Size(m)
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.DiscardUnknown(m)
-}
+func (*OpenStatusUpdate_Confirmation) isOpenStatusUpdate_Update() {}
 
-var xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel proto.InternalMessageInfo
+func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update() {}
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
 	if m != nil {
-		return m.Channel
+		return m.Update
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
-	if m != nil {
-		return m.LimboBalance
+func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
+		return x.ChanPending
 	}
-	return 0
+	return nil
 }
 
-type PendingChannelsResponse_ClosedChannel struct {
-	// / The pending channel to be closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid          string   `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *OpenStatusUpdate) GetConfirmation() *ConfirmationUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_Confirmation); ok {
+		return x.Confirmation
+	}
+	return nil
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
-func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{56, 3}
+func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
+		return x.ChanOpen
+	}
+	return nil
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*OpenStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _OpenStatusUpdate_OneofMarshaler, _OpenStatusUpdate_OneofUnmarshaler, _OpenStatusUpdate_OneofSizer, []interface{}{
+		(*OpenStatusUpdate_ChanPending)(nil),
+		(*OpenStatusUpdate_Confirmation)(nil),
+		(*OpenStatusUpdate_ChanOpen)(nil),
+	}
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Marshal(b, m, deterministic)
+
+func _OpenStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*OpenStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *OpenStatusUpdate_ChanPending:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanPending); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_Confirmation:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Confirmation); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_ChanOpen:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanOpen); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("OpenStatusUpdate.Update has unexpected type %T", x)
+	}
+	return nil
 }
-func (dst *PendingChannelsResponse_ClosedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Merge(dst, src)
+
+func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*OpenStatusUpdate)
+	switch tag {
+	case 1: // update.chan_pending
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_ChanPending{msg}
+		return true, err
+	case 2: // update.confirmation
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfirmationUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_Confirmation{msg}
+		return true, err
+	case 3: // update.chan_open
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelOpenUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_ChanOpen{msg}
+		return true, err
+	default:
+		return false, nil
+	}
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Size(m)
+
+func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*OpenStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *OpenStatusUpdate_ChanPending:
+		s := proto.Size(x.ChanPending)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_Confirmation:
+		s := proto.Size(x.Confirmation)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_ChanOpen:
+		s := proto.Size(x.ChanOpen)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.DiscardUnknown(m)
+
+type PendingHTLC struct {
+	// / The direction within the channel that the htlc was sent
+	Incoming bool `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	// / The total value of the htlc
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The final output to be swept back to the user's wallet
+	Outpoint string `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The next block height at which we can spend the current stage
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
+	// *
+	// The number of blocks remaining until the current stage can be swept.
+	// Negative values indicate how many blocks have passed since becoming
+	// mature.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
+	// / Indicates whether the htlc is in its first or second stage of recovery
+	Stage                uint32   `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var xxx_messageInfo_PendingChannelsResponse_ClosedChannel proto.InternalMessageInfo
+func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
+func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
+func (*PendingHTLC) ProtoMessage()    {}
+func (*PendingHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{58}
+}
+func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
+}
+func (m *PendingHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingHTLC.Marshal(b, m, deterministic)
+}
+func (dst *PendingHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingHTLC.Merge(dst, src)
+}
+func (m *PendingHTLC) XXX_Size() int {
+	return xxx_messageInfo_PendingHTLC.Size(m)
+}
+func (m *PendingHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingHTLC.DiscardUnknown(m)
+}
 
-func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+var xxx_messageInfo_PendingHTLC proto.InternalMessageInfo
+
+func (m *PendingHTLC) GetIncoming() bool {
 	if m != nil {
-		return m.Channel
+		return m.Incoming
 	}
-	return nil
+	return false
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
+func (m *PendingHTLC) GetAmount() int64 {
 	if m != nil {
-		return m.ClosingTxid
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PendingHTLC) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
 	}
 	return ""
 }
 
-type PendingChannelsResponse_ForceClosedChannel struct {
-	// / The pending channel to be force closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	// / The balance in satoshis encumbered in this pending channel
-	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
-	// / The height at which funds can be sweeped into the wallet
-	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
-	//
-	// Remaining # of blocks until the commitment output can be swept.
-	// Negative values indicate how many blocks have passed since becoming
-	// mature.
-	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
-	// / The total value of funds successfully recovered from this channel
-	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
-	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *PendingHTLC) GetMaturityHeight() uint32 {
+	if m != nil {
+		return m.MaturityHeight
+	}
+	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
-	*m = PendingChannelsResponse_ForceClosedChannel{}
+func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
+	if m != nil {
+		return m.BlocksTilMaturity
+	}
+	return 0
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
-	return proto.CompactTextString(m)
+
+func (m *PendingHTLC) GetStage() uint32 {
+	if m != nil {
+		return m.Stage
+	}
+	return 0
 }
-func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{56, 4}
+
+type PendingChannelsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
+
+func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{} }
+func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsRequest) ProtoMessage()    {}
+func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{59}
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Marshal(b, m, deterministic)
+func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
 }
-func (dst *PendingChannelsResponse_ForceClosedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Merge(dst, src)
+func (m *PendingChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsRequest.Merge(dst, src)
+}
+func (m *PendingChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsRequest.Size(m)
+}
+func (m *PendingChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsRequest proto.InternalMessageInfo
+
+type PendingChannelsResponse struct {
+	// / The balance in satoshis encumbered in pending channels
+	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance,proto3" json:"total_limbo_balance,omitempty"`
+	// / Channels pending opening
+	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels,proto3" json:"pending_open_channels,omitempty"`
+	// / Channels pending closing
+	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels,proto3" json:"pending_closing_channels,omitempty"`
+	// / Channels pending force closing
+	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,proto3" json:"pending_force_closing_channels,omitempty"`
+	// / Channels waiting for closing tx to confirm
+	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,proto3" json:"waiting_close_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
+func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse) ProtoMessage()    {}
+func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{60}
+}
+func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse.Merge(dst, src)
+}
+func (m *PendingChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse.Size(m)
+}
+func (m *PendingChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+	if m != nil {
+		return m.TotalLimboBalance
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
+	if m != nil {
+		return m.PendingOpenChannels
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
+	if m != nil {
+		return m.PendingClosingChannels
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
+	if m != nil {
+		return m.PendingForceClosingChannels
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
+	if m != nil {
+		return m.WaitingCloseChannels
+	}
+	return nil
+}
+
+type PendingChannelsResponse_PendingChannel struct {
+	RemoteNodePub        string   `protobuf:"bytes,1,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
+	ChannelPoint         string   `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	LocalBalance         int64    `protobuf:"varint,4,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
+	RemoteBalance        int64    `protobuf:"varint,5,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingChannelsResponse_PendingChannel) Reset() {
+	*m = PendingChannelsResponse_PendingChannel{}
+}
+func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{60, 0}
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_PendingChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_PendingChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Size(m)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_PendingChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_PendingChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
+	if m != nil {
+		return m.RemoteNodePub
+	}
+	return ""
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
+	if m != nil {
+		return m.LocalBalance
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
+	if m != nil {
+		return m.RemoteBalance
+	}
+	return 0
+}
+
+type PendingChannelsResponse_PendingOpenChannel struct {
+	// / The pending channel
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The height at which this channel will be confirmed
+	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height,proto3" json:"confirmation_height,omitempty"`
+	// *
+	// The amount calculated to be paid in fees for the current set of
+	// commitment transactions. The fee amount is persisted with the channel
+	// in order to allow the fee amount to be removed and recalculated with
+	// each channel state update, including updates that happen after a system
+	// restart.
+	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
+	// / The weight of the commitment transaction
+	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
+	// *
+	// The required number of satoshis per kilo-weight that the requester will
+	// pay at all times, for both the funding transaction and commitment
+	// transaction. This value can later be updated once the channel is open.
+	FeePerKw             int64    `protobuf:"varint,6,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
+	*m = PendingChannelsResponse_PendingOpenChannel{}
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{60, 1}
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_PendingOpenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Size(m)
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
+	if m != nil {
+		return m.ConfirmationHeight
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
+	if m != nil {
+		return m.CommitFee
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
+	if m != nil {
+		return m.CommitWeight
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
+	if m != nil {
+		return m.FeePerKw
+	}
+	return 0
+}
+
+type PendingChannelsResponse_WaitingCloseChannel struct {
+	// / The pending channel waiting for closing tx to confirm
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The balance in satoshis encumbered in this channel
+	LimboBalance         int64    `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
+	*m = PendingChannelsResponse_WaitingCloseChannel{}
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{60, 2}
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_WaitingCloseChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Size(m)
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
+	if m != nil {
+		return m.LimboBalance
+	}
+	return 0
+}
+
+type PendingChannelsResponse_ClosedChannel struct {
+	// / The pending channel to be closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid          string   `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
+func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{60, 3}
+}
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_ClosedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Size(m)
+}
+func (m *PendingChannelsResponse_ClosedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_ClosedChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
+	if m != nil {
+		return m.ClosingTxid
+	}
+	return ""
+}
+
+type PendingChannelsResponse_ForceClosedChannel struct {
+	// / The pending channel to be force closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	// / The balance in satoshis encumbered in this pending channel
+	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
+	// / The height at which funds can be sweeped into the wallet
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
+	//
+	// Remaining # of blocks until the commitment output can be swept.
+	// Negative values indicate how many blocks have passed since becoming
+	// mature.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
+	// / The total value of funds successfully recovered from this channel
+	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
+	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
+	*m = PendingChannelsResponse_ForceClosedChannel{}
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{60, 4}
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_ForceClosedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Size(m)
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
+	if m != nil {
+		return m.ClosingTxid
+	}
+	return ""
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
+	if m != nil {
+		return m.LimboBalance
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
+	if m != nil {
+		return m.MaturityHeight
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
+	if m != nil {
+		return m.BlocksTilMaturity
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
+	if m != nil {
+		return m.RecoveredBalance
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
+	if m != nil {
+		return m.PendingHtlcs
+	}
+	return nil
+}
+
+type WalletBalanceRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
+func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletBalanceRequest) ProtoMessage()    {}
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{61}
+}
+func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
+}
+func (m *WalletBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalanceRequest.Marshal(b, m, deterministic)
+}
+func (dst *WalletBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalanceRequest.Merge(dst, src)
+}
+func (m *WalletBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletBalanceRequest.Size(m)
+}
+func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
+
+type WalletBalanceResponse struct {
+	// / The balance of the wallet
+	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
+	// / The confirmed balance of a wallet(with >= 1 confirmations)
+	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
+	// / The unconfirmed balance of a wallet(with 0 confirmations)
+	UnconfirmedBalance   int64    `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
+func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*WalletBalanceResponse) ProtoMessage()    {}
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{62}
+}
+func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
+}
+func (m *WalletBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalanceResponse.Marshal(b, m, deterministic)
+}
+func (dst *WalletBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalanceResponse.Merge(dst, src)
+}
+func (m *WalletBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_WalletBalanceResponse.Size(m)
+}
+func (m *WalletBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletBalanceResponse proto.InternalMessageInfo
+
+func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+	if m != nil {
+		return m.TotalBalance
+	}
+	return 0
+}
+
+func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+	if m != nil {
+		return m.ConfirmedBalance
+	}
+	return 0
+}
+
+func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
+	if m != nil {
+		return m.UnconfirmedBalance
+	}
+	return 0
+}
+
+type ChannelBalanceRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
+func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalanceRequest) ProtoMessage()    {}
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{63}
+}
+func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
+}
+func (m *ChannelBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalanceRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChannelBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalanceRequest.Merge(dst, src)
+}
+func (m *ChannelBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalanceRequest.Size(m)
+}
+func (m *ChannelBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBalanceRequest proto.InternalMessageInfo
+
+type ChannelBalanceResponse struct {
+	// / Sum of channels balances denominated in satoshis
+	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// / Sum of channels pending balances denominated in satoshis
+	PendingOpenBalance   int64    `protobuf:"varint,2,opt,name=pending_open_balance,proto3" json:"pending_open_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{} }
+func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalanceResponse) ProtoMessage()    {}
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{64}
+}
+func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
+}
+func (m *ChannelBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalanceResponse.Marshal(b, m, deterministic)
+}
+func (dst *ChannelBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalanceResponse.Merge(dst, src)
+}
+func (m *ChannelBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalanceResponse.Size(m)
+}
+func (m *ChannelBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBalanceResponse proto.InternalMessageInfo
+
+func (m *ChannelBalanceResponse) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
+	if m != nil {
+		return m.PendingOpenBalance
+	}
+	return 0
+}
+
+type QueryRoutesRequest struct {
+	// / The 33-byte hex-encoded public key for the payment destination
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// / The amount to send expressed in satoshis
+	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	// / The max number of routes to return.
+	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes,proto3" json:"num_routes,omitempty"`
+	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+	// *
+	// The maximum number of satoshis that will be paid as a fee of the payment.
+	// This value can be represented either as a percentage of the amount being
+	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
+	// send the payment.
+	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// *
+	// An optional payment request to query routes for. If set, the destination,
+	// amount, final CLTV delta and route hints will be taken from the decoded
+	// payment request. The amount only needs to be specified when querying routes
+	// for a zero amount payment request.
+	PaymentRequest string `protobuf:"bytes,6,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	// *
+	// An optional set of route hints that will be used to assist in reaching
+	// destinations that are only connected to the network through private
+	// channels.
+	RouteHints           []*RouteHint `protobuf:"bytes,7,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
+func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesRequest) ProtoMessage()    {}
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{65}
+}
+func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
+}
+func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
+}
+func (dst *QueryRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesRequest.Merge(dst, src)
+}
+func (m *QueryRoutesRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRoutesRequest.Size(m)
+}
+func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
+
+func (m *QueryRoutesRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *QueryRoutesRequest) GetAmt() int64 {
+	if m != nil {
+		return m.Amt
+	}
+	return 0
+}
+
+func (m *QueryRoutesRequest) GetNumRoutes() int32 {
+	if m != nil {
+		return m.NumRoutes
+	}
+	return 0
+}
+
+func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
+	if m != nil {
+		return m.FinalCltvDelta
+	}
+	return 0
+}
+
+func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
+}
+
+func (m *QueryRoutesRequest) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *QueryRoutesRequest) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
+	}
+	return nil
+}
+
+type QueryRoutesResponse struct {
+	Routes               []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
+func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesResponse) ProtoMessage()    {}
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{66}
+}
+func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
+}
+func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
+}
+func (dst *QueryRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesResponse.Merge(dst, src)
+}
+func (m *QueryRoutesResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRoutesResponse.Size(m)
+}
+func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryRoutesResponse) GetRoutes() []*Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type Hop struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity,proto3" json:"chan_capacity,omitempty"`
+	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward,proto3" json:"amt_to_forward,omitempty"` // Deprecated: Do not use.
+	Fee              int64  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`                       // Deprecated: Do not use.
+	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat,proto3" json:"amt_to_forward_msat,omitempty"`
+	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	// *
+	// An optional public key of the hop. If the public key is given, the payment
+	// can be executed without relying on a copy of the channel graph.
+	PubKey               string   `protobuf:"bytes,8,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Hop) Reset()         { *m = Hop{} }
+func (m *Hop) String() string { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()    {}
+func (*Hop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{67}
+}
+func (m *Hop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hop.Unmarshal(m, b)
+}
+func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
+}
+func (dst *Hop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hop.Merge(dst, src)
+}
+func (m *Hop) XXX_Size() int {
+	return xxx_messageInfo_Hop.Size(m)
+}
+func (m *Hop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hop proto.InternalMessageInfo
+
+func (m *Hop) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *Hop) GetChanCapacity() int64 {
+	if m != nil {
+		return m.ChanCapacity
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Hop) GetAmtToForward() int64 {
+	if m != nil {
+		return m.AmtToForward
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Hop) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *Hop) GetExpiry() uint32 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *Hop) GetAmtToForwardMsat() int64 {
+	if m != nil {
+		return m.AmtToForwardMsat
+	}
+	return 0
+}
+
+func (m *Hop) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *Hop) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+// *
+// A path through the channel graph which runs over one or more channels in
+// succession. This struct carries all the information required to craft the
+// Sphinx onion packet, and send the payment along the first hop in the path. A
+// route is only selected as valid if all the channels have sufficient capacity to
+// carry the initial payment amount after fees are accounted for.
+type Route struct {
+	// *
+	// The cumulative (final) time lock across the entire route.  This is the CLTV
+	// value that should be extended to the first hop in the route. All other hops
+	// will decrement the time-lock as advertised, leaving enough time for all
+	// hops to wait for or present the payment preimage to complete the payment.
+	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock,proto3" json:"total_time_lock,omitempty"`
+	// *
+	// The sum of the fees paid at each hop within the final route.  In the case
+	// of a one-hop payment, this value will be zero as we don't need to pay a fee
+	// it ourself.
+	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees,proto3" json:"total_fees,omitempty"` // Deprecated: Do not use.
+	// *
+	// The total amount of funds required to complete a payment over this route.
+	// This value includes the cumulative fees at each hop. As a result, the HTLC
+	// extended to the first-hop in the route will need to have at least this many
+	// satoshis, otherwise the route will fail at an intermediate node due to an
+	// insufficient amount of fees.
+	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt,proto3" json:"total_amt,omitempty"` // Deprecated: Do not use.
+	// *
+	// Contains details concerning the specific forwarding details at each hop.
+	Hops []*Hop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+	// *
+	// The total fees in millisatoshis.
+	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat,proto3" json:"total_fees_msat,omitempty"`
+	// *
+	// The total amount in millisatoshis.
+	TotalAmtMsat         int64    `protobuf:"varint,6,opt,name=total_amt_msat,proto3" json:"total_amt_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{68}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Route.Unmarshal(m, b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+}
+func (dst *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(dst, src)
+}
+func (m *Route) XXX_Size() int {
+	return xxx_messageInfo_Route.Size(m)
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetTotalTimeLock() uint32 {
+	if m != nil {
+		return m.TotalTimeLock
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Route) GetTotalFees() int64 {
+	if m != nil {
+		return m.TotalFees
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Route) GetTotalAmt() int64 {
+	if m != nil {
+		return m.TotalAmt
+	}
+	return 0
+}
+
+func (m *Route) GetHops() []*Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *Route) GetTotalFeesMsat() int64 {
+	if m != nil {
+		return m.TotalFeesMsat
+	}
+	return 0
+}
+
+func (m *Route) GetTotalAmtMsat() int64 {
+	if m != nil {
+		return m.TotalAmtMsat
+	}
+	return 0
+}
+
+type NodeInfoRequest struct {
+	// / The 33-byte hex-encoded compressed public of the target node
+	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
+func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeInfoRequest) ProtoMessage()    {}
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{69}
+}
+func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
+}
+func (m *NodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *NodeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfoRequest.Merge(dst, src)
+}
+func (m *NodeInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeInfoRequest.Size(m)
+}
+func (m *NodeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInfoRequest proto.InternalMessageInfo
+
+func (m *NodeInfoRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type NodeInfo struct {
+	// *
+	// An individual vertex/node within the channel graph. A node is
+	// connected to other nodes by one or more channel edges emanating from it. As
+	// the graph is directed, a node will also have an incoming edge attached to
+	// it for each outgoing edge.
+	Node                 *LightningNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	NumChannels          uint32         `protobuf:"varint,2,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
+	TotalCapacity        int64          `protobuf:"varint,3,opt,name=total_capacity,proto3" json:"total_capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
+func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeInfo) ProtoMessage()    {}
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{70}
+}
+func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
+}
+func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+}
+func (dst *NodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfo.Merge(dst, src)
+}
+func (m *NodeInfo) XXX_Size() int {
+	return xxx_messageInfo_NodeInfo.Size(m)
+}
+func (m *NodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+
+func (m *NodeInfo) GetNode() *LightningNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *NodeInfo) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetTotalCapacity() int64 {
+	if m != nil {
+		return m.TotalCapacity
+	}
+	return 0
+}
+
+// *
+// An individual vertex/node within the channel graph. A node is
+// connected to other nodes by one or more channel edges emanating from it. As the
+// graph is directed, a node will also have an incoming edge attached to it for
+// each outgoing edge.
+type LightningNode struct {
+	LastUpdate           uint32         `protobuf:"varint,1,opt,name=last_update,proto3" json:"last_update,omitempty"`
+	PubKey               string         `protobuf:"bytes,2,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	Alias                string         `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	Addresses            []*NodeAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Color                string         `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LightningNode) Reset()         { *m = LightningNode{} }
+func (m *LightningNode) String() string { return proto.CompactTextString(m) }
+func (*LightningNode) ProtoMessage()    {}
+func (*LightningNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{71}
+}
+func (m *LightningNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
+}
+func (m *LightningNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LightningNode.Marshal(b, m, deterministic)
+}
+func (dst *LightningNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightningNode.Merge(dst, src)
+}
+func (m *LightningNode) XXX_Size() int {
+	return xxx_messageInfo_LightningNode.Size(m)
+}
+func (m *LightningNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightningNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightningNode proto.InternalMessageInfo
+
+func (m *LightningNode) GetLastUpdate() uint32 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func (m *LightningNode) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *LightningNode) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *LightningNode) GetAddresses() []*NodeAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *LightningNode) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+type NodeAddress struct {
+	Network              string   `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
+func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
+func (*NodeAddress) ProtoMessage()    {}
+func (*NodeAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{72}
+}
+func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
+}
+func (m *NodeAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeAddress.Marshal(b, m, deterministic)
+}
+func (dst *NodeAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeAddress.Merge(dst, src)
+}
+func (m *NodeAddress) XXX_Size() int {
+	return xxx_messageInfo_NodeAddress.Size(m)
+}
+func (m *NodeAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeAddress proto.InternalMessageInfo
+
+func (m *NodeAddress) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *NodeAddress) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type RoutingPolicy struct {
+	TimeLockDelta        uint32   `protobuf:"varint,1,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+	MinHtlc              int64    `protobuf:"varint,2,opt,name=min_htlc,proto3" json:"min_htlc,omitempty"`
+	FeeBaseMsat          int64    `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
+	FeeRateMilliMsat     int64    `protobuf:"varint,4,opt,name=fee_rate_milli_msat,proto3" json:"fee_rate_milli_msat,omitempty"`
+	Disabled             bool     `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
+func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
+func (*RoutingPolicy) ProtoMessage()    {}
+func (*RoutingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{73}
+}
+func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
+}
+func (m *RoutingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoutingPolicy.Marshal(b, m, deterministic)
+}
+func (dst *RoutingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutingPolicy.Merge(dst, src)
+}
+func (m *RoutingPolicy) XXX_Size() int {
+	return xxx_messageInfo_RoutingPolicy.Size(m)
+}
+func (m *RoutingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutingPolicy proto.InternalMessageInfo
+
+func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
+	if m != nil {
+		return m.TimeLockDelta
+	}
+	return 0
+}
+
+func (m *RoutingPolicy) GetMinHtlc() int64 {
+	if m != nil {
+		return m.MinHtlc
+	}
+	return 0
+}
+
+func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
+	if m != nil {
+		return m.FeeBaseMsat
+	}
+	return 0
+}
+
+func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
+	if m != nil {
+		return m.FeeRateMilliMsat
+	}
+	return 0
+}
+
+func (m *RoutingPolicy) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+// *
+// A fully authenticated channel along with all its unique attributes.
+// Once an authenticated channel announcement has been processed on the network,
+// then an instance of ChannelEdgeInfo encapsulating the channels attributes is
+// stored. The other portions relevant to routing policy of a channel are stored
+// within a ChannelEdgePolicy for each direction of the channel.
+type ChannelEdge struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChannelId            uint64         `protobuf:"varint,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	ChanPoint            string         `protobuf:"bytes,2,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	LastUpdate           uint32         `protobuf:"varint,3,opt,name=last_update,proto3" json:"last_update,omitempty"`
+	Node1Pub             string         `protobuf:"bytes,4,opt,name=node1_pub,proto3" json:"node1_pub,omitempty"`
+	Node2Pub             string         `protobuf:"bytes,5,opt,name=node2_pub,proto3" json:"node2_pub,omitempty"`
+	Capacity             int64          `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Node1Policy          *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy,proto3" json:"node1_policy,omitempty"`
+	Node2Policy          *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy,proto3" json:"node2_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
+func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
+func (*ChannelEdge) ProtoMessage()    {}
+func (*ChannelEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{74}
+}
+func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
+}
+func (m *ChannelEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEdge.Marshal(b, m, deterministic)
+}
+func (dst *ChannelEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEdge.Merge(dst, src)
+}
+func (m *ChannelEdge) XXX_Size() int {
+	return xxx_messageInfo_ChannelEdge.Size(m)
+}
+func (m *ChannelEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEdge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEdge proto.InternalMessageInfo
+
+func (m *ChannelEdge) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *ChannelEdge) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *ChannelEdge) GetLastUpdate() uint32 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func (m *ChannelEdge) GetNode1Pub() string {
+	if m != nil {
+		return m.Node1Pub
+	}
+	return ""
+}
+
+func (m *ChannelEdge) GetNode2Pub() string {
+	if m != nil {
+		return m.Node2Pub
+	}
+	return ""
+}
+
+func (m *ChannelEdge) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
+	if m != nil {
+		return m.Node1Policy
+	}
+	return nil
+}
+
+func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
+	if m != nil {
+		return m.Node2Policy
+	}
+	return nil
+}
+
+type ChannelGraphRequest struct {
+	// *
+	// Whether unannounced channels are included in the response or not. If set,
+	// unannounced channels are included. Unannounced channels are both private
+	// channels, and public channels that are not yet announced to the network.
+	IncludeUnannounced   bool     `protobuf:"varint,1,opt,name=include_unannounced,proto3" json:"include_unannounced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
+func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelGraphRequest) ProtoMessage()    {}
+func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{75}
+}
+func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
+}
+func (m *ChannelGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelGraphRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChannelGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelGraphRequest.Merge(dst, src)
+}
+func (m *ChannelGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelGraphRequest.Size(m)
+}
+func (m *ChannelGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelGraphRequest proto.InternalMessageInfo
+
+func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
+	if m != nil {
+		return m.IncludeUnannounced
+	}
+	return false
+}
+
+// / Returns a new instance of the directed channel graph.
+type ChannelGraph struct {
+	// / The list of `LightningNode`s in this channel graph
+	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// / The list of `ChannelEdge`s in this channel graph
+	Edges                []*ChannelEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
+func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
+func (*ChannelGraph) ProtoMessage()    {}
+func (*ChannelGraph) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{76}
+}
+func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
+}
+func (m *ChannelGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelGraph.Marshal(b, m, deterministic)
+}
+func (dst *ChannelGraph) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelGraph.Merge(dst, src)
+}
+func (m *ChannelGraph) XXX_Size() int {
+	return xxx_messageInfo_ChannelGraph.Size(m)
+}
+func (m *ChannelGraph) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelGraph.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelGraph proto.InternalMessageInfo
+
+func (m *ChannelGraph) GetNodes() []*LightningNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ChannelGraph) GetEdges() []*ChannelEdge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
+type ChanInfoRequest struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId               uint64   `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
+func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ChanInfoRequest) ProtoMessage()    {}
+func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{77}
+}
+func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
+}
+func (m *ChanInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChanInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChanInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChanInfoRequest.Merge(dst, src)
+}
+func (m *ChanInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_ChanInfoRequest.Size(m)
+}
+func (m *ChanInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChanInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChanInfoRequest proto.InternalMessageInfo
+
+func (m *ChanInfoRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+type NetworkInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
+func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*NetworkInfoRequest) ProtoMessage()    {}
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{78}
+}
+func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
+}
+func (m *NetworkInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *NetworkInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkInfoRequest.Merge(dst, src)
+}
+func (m *NetworkInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_NetworkInfoRequest.Size(m)
+}
+func (m *NetworkInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkInfoRequest proto.InternalMessageInfo
+
+type NetworkInfo struct {
+	GraphDiameter        uint32   `protobuf:"varint,1,opt,name=graph_diameter,proto3" json:"graph_diameter,omitempty"`
+	AvgOutDegree         float64  `protobuf:"fixed64,2,opt,name=avg_out_degree,proto3" json:"avg_out_degree,omitempty"`
+	MaxOutDegree         uint32   `protobuf:"varint,3,opt,name=max_out_degree,proto3" json:"max_out_degree,omitempty"`
+	NumNodes             uint32   `protobuf:"varint,4,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
+	NumChannels          uint32   `protobuf:"varint,5,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
+	TotalNetworkCapacity int64    `protobuf:"varint,6,opt,name=total_network_capacity,proto3" json:"total_network_capacity,omitempty"`
+	AvgChannelSize       float64  `protobuf:"fixed64,7,opt,name=avg_channel_size,proto3" json:"avg_channel_size,omitempty"`
+	MinChannelSize       int64    `protobuf:"varint,8,opt,name=min_channel_size,proto3" json:"min_channel_size,omitempty"`
+	MaxChannelSize       int64    `protobuf:"varint,9,opt,name=max_channel_size,proto3" json:"max_channel_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
+func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
+func (*NetworkInfo) ProtoMessage()    {}
+func (*NetworkInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{79}
+}
+func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
+}
+func (m *NetworkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkInfo.Marshal(b, m, deterministic)
+}
+func (dst *NetworkInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkInfo.Merge(dst, src)
+}
+func (m *NetworkInfo) XXX_Size() int {
+	return xxx_messageInfo_NetworkInfo.Size(m)
+}
+func (m *NetworkInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkInfo proto.InternalMessageInfo
+
+func (m *NetworkInfo) GetGraphDiameter() uint32 {
+	if m != nil {
+		return m.GraphDiameter
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetAvgOutDegree() float64 {
+	if m != nil {
+		return m.AvgOutDegree
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetMaxOutDegree() uint32 {
+	if m != nil {
+		return m.MaxOutDegree
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
+	if m != nil {
+		return m.TotalNetworkCapacity
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetAvgChannelSize() float64 {
+	if m != nil {
+		return m.AvgChannelSize
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetMinChannelSize() int64 {
+	if m != nil {
+		return m.MinChannelSize
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetMaxChannelSize() int64 {
+	if m != nil {
+		return m.MaxChannelSize
+	}
+	return 0
+}
+
+type StopRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopRequest) Reset()         { *m = StopRequest{} }
+func (m *StopRequest) String() string { return proto.CompactTextString(m) }
+func (*StopRequest) ProtoMessage()    {}
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{80}
+}
+func (m *StopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
+}
+func (m *StopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopRequest.Marshal(b, m, deterministic)
+}
+func (dst *StopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopRequest.Merge(dst, src)
+}
+func (m *StopRequest) XXX_Size() int {
+	return xxx_messageInfo_StopRequest.Size(m)
+}
+func (m *StopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopRequest proto.InternalMessageInfo
+
+type StopResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopResponse) Reset()         { *m = StopResponse{} }
+func (m *StopResponse) String() string { return proto.CompactTextString(m) }
+func (*StopResponse) ProtoMessage()    {}
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{81}
+}
+func (m *StopResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
+}
+func (m *StopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopResponse.Marshal(b, m, deterministic)
+}
+func (dst *StopResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopResponse.Merge(dst, src)
+}
+func (m *StopResponse) XXX_Size() int {
+	return xxx_messageInfo_StopResponse.Size(m)
+}
+func (m *StopResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopResponse proto.InternalMessageInfo
+
+type GraphTopologySubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscription{} }
+func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
+func (*GraphTopologySubscription) ProtoMessage()    {}
+func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{82}
+}
+func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
+}
+func (m *GraphTopologySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTopologySubscription.Marshal(b, m, deterministic)
+}
+func (dst *GraphTopologySubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTopologySubscription.Merge(dst, src)
+}
+func (m *GraphTopologySubscription) XXX_Size() int {
+	return xxx_messageInfo_GraphTopologySubscription.Size(m)
+}
+func (m *GraphTopologySubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTopologySubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTopologySubscription proto.InternalMessageInfo
+
+type GraphTopologyUpdate struct {
+	NodeUpdates          []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates,proto3" json:"node_updates,omitempty"`
+	ChannelUpdates       []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates,proto3" json:"channel_updates,omitempty"`
+	ClosedChans          []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans,proto3" json:"closed_chans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
+func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
+func (*GraphTopologyUpdate) ProtoMessage()    {}
+func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{83}
+}
+func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
+}
+func (m *GraphTopologyUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTopologyUpdate.Marshal(b, m, deterministic)
+}
+func (dst *GraphTopologyUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTopologyUpdate.Merge(dst, src)
+}
+func (m *GraphTopologyUpdate) XXX_Size() int {
+	return xxx_messageInfo_GraphTopologyUpdate.Size(m)
+}
+func (m *GraphTopologyUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTopologyUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTopologyUpdate proto.InternalMessageInfo
+
+func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
+	if m != nil {
+		return m.NodeUpdates
+	}
+	return nil
+}
+
+func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
+	if m != nil {
+		return m.ChannelUpdates
+	}
+	return nil
+}
+
+func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
+	if m != nil {
+		return m.ClosedChans
+	}
+	return nil
+}
+
+type NodeUpdate struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	IdentityKey          string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	GlobalFeatures       []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
+	Alias                string   `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
+func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
+func (*NodeUpdate) ProtoMessage()    {}
+func (*NodeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{84}
+}
+func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
+}
+func (m *NodeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeUpdate.Marshal(b, m, deterministic)
+}
+func (dst *NodeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeUpdate.Merge(dst, src)
+}
+func (m *NodeUpdate) XXX_Size() int {
+	return xxx_messageInfo_NodeUpdate.Size(m)
+}
+func (m *NodeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeUpdate proto.InternalMessageInfo
+
+func (m *NodeUpdate) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *NodeUpdate) GetIdentityKey() string {
+	if m != nil {
+		return m.IdentityKey
+	}
+	return ""
+}
+
+func (m *NodeUpdate) GetGlobalFeatures() []byte {
+	if m != nil {
+		return m.GlobalFeatures
+	}
+	return nil
+}
+
+func (m *NodeUpdate) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+type ChannelEdgeUpdate struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId               uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	ChanPoint            *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	Capacity             int64          `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	RoutingPolicy        *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy,proto3" json:"routing_policy,omitempty"`
+	AdvertisingNode      string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode,proto3" json:"advertising_node,omitempty"`
+	ConnectingNode       string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode,proto3" json:"connecting_node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
+func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelEdgeUpdate) ProtoMessage()    {}
+func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{85}
+}
+func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
+}
+func (m *ChannelEdgeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEdgeUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ChannelEdgeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEdgeUpdate.Merge(dst, src)
+}
+func (m *ChannelEdgeUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelEdgeUpdate.Size(m)
+}
+func (m *ChannelEdgeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEdgeUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEdgeUpdate proto.InternalMessageInfo
+
+func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *ChannelEdgeUpdate) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
+	if m != nil {
+		return m.RoutingPolicy
+	}
+	return nil
+}
+
+func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
+	if m != nil {
+		return m.AdvertisingNode
+	}
+	return ""
+}
+
+func (m *ChannelEdgeUpdate) GetConnectingNode() string {
+	if m != nil {
+		return m.ConnectingNode
+	}
+	return ""
+}
+
+type ClosedChannelUpdate struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId               uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	Capacity             int64         `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	ClosedHeight         uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
+	ChanPoint            *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
+func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
+func (*ClosedChannelUpdate) ProtoMessage()    {}
+func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{86}
+}
+func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
+}
+func (m *ClosedChannelUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClosedChannelUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ClosedChannelUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosedChannelUpdate.Merge(dst, src)
+}
+func (m *ClosedChannelUpdate) XXX_Size() int {
+	return xxx_messageInfo_ClosedChannelUpdate.Size(m)
+}
+func (m *ClosedChannelUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosedChannelUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClosedChannelUpdate proto.InternalMessageInfo
+
+func (m *ClosedChannelUpdate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
+	if m != nil {
+		return m.ClosedHeight
+	}
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+type HopHint struct {
+	// / The public key of the node at the start of the channel.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,proto3" json:"node_id,omitempty"`
+	// / The unique identifier of the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The base fee of the channel denominated in millisatoshis.
+	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
+	// *
+	// The fee rate of the channel for sending one satoshi across it denominated in
+	// millionths of a satoshi.
+	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths,proto3" json:"fee_proportional_millionths,omitempty"`
+	// / The time-lock delta of the channel.
+	CltvExpiryDelta      uint32   `protobuf:"varint,5,opt,name=cltv_expiry_delta,proto3" json:"cltv_expiry_delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HopHint) Reset()         { *m = HopHint{} }
+func (m *HopHint) String() string { return proto.CompactTextString(m) }
+func (*HopHint) ProtoMessage()    {}
+func (*HopHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{87}
+}
+func (m *HopHint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HopHint.Unmarshal(m, b)
+}
+func (m *HopHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HopHint.Marshal(b, m, deterministic)
+}
+func (dst *HopHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopHint.Merge(dst, src)
+}
+func (m *HopHint) XXX_Size() int {
+	return xxx_messageInfo_HopHint.Size(m)
+}
+func (m *HopHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopHint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopHint proto.InternalMessageInfo
+
+func (m *HopHint) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *HopHint) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *HopHint) GetFeeBaseMsat() uint32 {
+	if m != nil {
+		return m.FeeBaseMsat
+	}
+	return 0
+}
+
+func (m *HopHint) GetFeeProportionalMillionths() uint32 {
+	if m != nil {
+		return m.FeeProportionalMillionths
+	}
+	return 0
+}
+
+func (m *HopHint) GetCltvExpiryDelta() uint32 {
+	if m != nil {
+		return m.CltvExpiryDelta
+	}
+	return 0
+}
+
+type RouteHint struct {
+	// *
+	// A list of hop hints that when chained together can assist in reaching a
+	// specific destination.
+	HopHints             []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,proto3" json:"hop_hints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RouteHint) Reset()         { *m = RouteHint{} }
+func (m *RouteHint) String() string { return proto.CompactTextString(m) }
+func (*RouteHint) ProtoMessage()    {}
+func (*RouteHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{88}
+}
+func (m *RouteHint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
+}
+func (m *RouteHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteHint.Marshal(b, m, deterministic)
+}
+func (dst *RouteHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteHint.Merge(dst, src)
+}
+func (m *RouteHint) XXX_Size() int {
+	return xxx_messageInfo_RouteHint.Size(m)
+}
+func (m *RouteHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteHint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteHint proto.InternalMessageInfo
+
+func (m *RouteHint) GetHopHints() []*HopHint {
+	if m != nil {
+		return m.HopHints
+	}
+	return nil
+}
+
+type Invoice struct {
+	// *
+	// An optional memo to attach along with the invoice. Used for record keeping
+	// purposes for the invoice's creator, and will also be set in the description
+	// field of the encoded payment request if the description_hash field is not
+	// being used.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// / An optional cryptographic receipt of payment
+	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// *
+	// The hex-encoded preimage (32 byte) which will allow settling an incoming
+	// HTLC payable to this preimage
+	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
+	// / The hash of the preimage
+	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// / The value of this invoice in satoshis
+	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// / Whether this invoice has been fulfilled
+	Settled bool `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"`
+	// / When this invoice was created
+	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
+	// / When this invoice was settled
+	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date,proto3" json:"settle_date,omitempty"`
+	// *
+	// A bare-bones invoice for a payment within the Lightning Network.  With the
+	// details of the invoice, the sender has all the data necessary to send a
+	// payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
+	// *
+	// Hash (SHA-256) of a description of the payment. Used if the description of
+	// payment (memo) is too long to naturally fit within the description field
+	// of an encoded payment request.
+	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	// / Payment request expiry time in seconds. Default is 3600 (1 hour).
+	Expiry int64 `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// *
+	// Fallback on-chain address. When adding an invoice, this may only be set if
+	// fallback_addr_policy is FALLBACK_ADDR_PROVIDED.
+	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
+	// *
+	// Delta to use for the time-lock of the CLTV extended to the final hop. This
+	// is the minimum final CLTV delta HTLCs paying to the invoice must carry. If
+	// not set when adding an invoice, the configured time lock delta is used.
+	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
+	// *
+	// Route hints that can each be individually used to assist in reaching the
+	// invoice's destination. When adding an invoice, these are encoded within
+	// the payment request as is, along with any hints selected due to private
+	// being set.
+	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
+	// / Whether this invoice should include routing hints for private channels.
+	Private bool `protobuf:"varint,15,opt,name=private,proto3" json:"private,omitempty"`
+	// *
+	// The "add" index of this invoice. Each newly created invoice will increment
+	// this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all added
+	// invoices with an add_index greater than this one.
+	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
+	// *
+	// The "settle" index of this invoice. Each newly settled invoice will
+	// increment this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all
+	// settled invoices with an settle_index greater than this one.
+	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
+	// / Deprecated, use amt_paid_sat or amt_paid_msat.
+	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid,proto3" json:"amt_paid,omitempty"` // Deprecated: Do not use.
+	// *
+	// The amount that was accepted for this invoice, in satoshis. This will ONLY
+	// be set if this invoice has been settled. We provide this field as if the
+	// invoice was created with a zero value, then we need to record what amount
+	// was ultimately accepted. Additionally, it's possible that the sender paid
+	// MORE that was specified in the original invoice. So we'll record that here
+	// as well.
+	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat,proto3" json:"amt_paid_sat,omitempty"`
+	// *
+	// The amount that was accepted for this invoice, in millisatoshis. This will
+	// ONLY be set if this invoice has been settled. We provide this field as if
+	// the invoice was created with a zero value, then we need to record what
+	// amount was ultimately accepted. Additionally, it's possible that the sender
+	// paid MORE that was specified in the original invoice. So we'll record that
+	// here as well.
+	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat,proto3" json:"amt_paid_msat,omitempty"`
+	// *
+	// The state the invoice is in. An open invoice can still be paid, while a
+	// canceled invoice, either canceled explicitly or because it expired, will
+	// no longer accept any payments.
+	State Invoice_InvoiceState `protobuf:"varint,21,opt,name=state,proto3,enum=lnrpc.Invoice_InvoiceState" json:"state,omitempty"`
+	// *
+	// If private is set, only the private channels with these channel IDs will
+	// be considered when selecting route hints. If empty, all private channels
+	// are considered.
+	HintChanIds []uint64 `protobuf:"varint,22,rep,packed,name=hint_chan_ids,proto3" json:"hint_chan_ids,omitempty"`
+	// *
+	// The policy used to determine the fallback on-chain address encoded within
+	// the payment request when adding an invoice.
+	FallbackAddrPolicy   Invoice_FallbackAddrPolicy `protobuf:"varint,23,opt,name=fallback_addr_policy,proto3,enum=lnrpc.Invoice_FallbackAddrPolicy" json:"fallback_addr_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *Invoice) Reset()         { *m = Invoice{} }
+func (m *Invoice) String() string { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()    {}
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{89}
+}
+func (m *Invoice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Invoice.Unmarshal(m, b)
+}
+func (m *Invoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Invoice.Marshal(b, m, deterministic)
+}
+func (dst *Invoice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Invoice.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Size(m)
+func (m *Invoice) XXX_Size() int {
+	return xxx_messageInfo_Invoice.Size(m)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.DiscardUnknown(m)
+func (m *Invoice) XXX_DiscardUnknown() {
+	xxx_messageInfo_Invoice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel proto.InternalMessageInfo
-
-func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
-	if m != nil {
-		return m.Channel
-	}
-	return nil
-}
+var xxx_messageInfo_Invoice proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
+func (m *Invoice) GetMemo() string {
 	if m != nil {
-		return m.ClosingTxid
+		return m.Memo
 	}
 	return ""
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
+func (m *Invoice) GetReceipt() []byte {
 	if m != nil {
-		return m.LimboBalance
+		return m.Receipt
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
+func (m *Invoice) GetRPreimage() []byte {
 	if m != nil {
-		return m.MaturityHeight
+		return m.RPreimage
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
+func (m *Invoice) GetRHash() []byte {
 	if m != nil {
-		return m.BlocksTilMaturity
+		return m.RHash
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
+func (m *Invoice) GetValue() int64 {
 	if m != nil {
-		return m.RecoveredBalance
+		return m.Value
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
+func (m *Invoice) GetSettled() bool {
 	if m != nil {
-		return m.PendingHtlcs
+		return m.Settled
 	}
-	return nil
-}
-
-type WalletBalanceRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
-func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*WalletBalanceRequest) ProtoMessage()    {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{57}
-}
-func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
-}
-func (m *WalletBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletBalanceRequest.Marshal(b, m, deterministic)
-}
-func (dst *WalletBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletBalanceRequest.Merge(dst, src)
-}
-func (m *WalletBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_WalletBalanceRequest.Size(m)
-}
-func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletBalanceRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
-
-type WalletBalanceResponse struct {
-	// / The balance of the wallet
-	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
-	// / The confirmed balance of a wallet(with >= 1 confirmations)
-	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
-	// / The unconfirmed balance of a wallet(with 0 confirmations)
-	UnconfirmedBalance   int64    `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
-func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*WalletBalanceResponse) ProtoMessage()    {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{58}
-}
-func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
-}
-func (m *WalletBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletBalanceResponse.Marshal(b, m, deterministic)
-}
-func (dst *WalletBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletBalanceResponse.Merge(dst, src)
-}
-func (m *WalletBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_WalletBalanceResponse.Size(m)
-}
-func (m *WalletBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletBalanceResponse.DiscardUnknown(m)
+	return false
 }
 
-var xxx_messageInfo_WalletBalanceResponse proto.InternalMessageInfo
-
-func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+func (m *Invoice) GetCreationDate() int64 {
 	if m != nil {
-		return m.TotalBalance
+		return m.CreationDate
 	}
 	return 0
 }
 
-func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+func (m *Invoice) GetSettleDate() int64 {
 	if m != nil {
-		return m.ConfirmedBalance
+		return m.SettleDate
 	}
 	return 0
 }
 
-func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
+func (m *Invoice) GetPaymentRequest() string {
 	if m != nil {
-		return m.UnconfirmedBalance
+		return m.PaymentRequest
 	}
-	return 0
-}
-
-type ChannelBalanceRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
-func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*ChannelBalanceRequest) ProtoMessage()    {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{59}
-}
-func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
-}
-func (m *ChannelBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBalanceRequest.Marshal(b, m, deterministic)
-}
-func (dst *ChannelBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBalanceRequest.Merge(dst, src)
-}
-func (m *ChannelBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_ChannelBalanceRequest.Size(m)
-}
-func (m *ChannelBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBalanceRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ChannelBalanceRequest proto.InternalMessageInfo
-
-type ChannelBalanceResponse struct {
-	// / Sum of channels balances denominated in satoshis
-	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	// / Sum of channels pending balances denominated in satoshis
-	PendingOpenBalance   int64    `protobuf:"varint,2,opt,name=pending_open_balance,proto3" json:"pending_open_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return ""
 }
 
-func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{} }
-func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*ChannelBalanceResponse) ProtoMessage()    {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{60}
-}
-func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
-}
-func (m *ChannelBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBalanceResponse.Marshal(b, m, deterministic)
-}
-func (dst *ChannelBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBalanceResponse.Merge(dst, src)
-}
-func (m *ChannelBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_ChannelBalanceResponse.Size(m)
-}
-func (m *ChannelBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBalanceResponse.DiscardUnknown(m)
+func (m *Invoice) GetDescriptionHash() []byte {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return nil
 }
 
-var xxx_messageInfo_ChannelBalanceResponse proto.InternalMessageInfo
-
-func (m *ChannelBalanceResponse) GetBalance() int64 {
+func (m *Invoice) GetExpiry() int64 {
 	if m != nil {
-		return m.Balance
+		return m.Expiry
 	}
 	return 0
 }
 
-func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
+func (m *Invoice) GetFallbackAddr() string {
 	if m != nil {
-		return m.PendingOpenBalance
+		return m.FallbackAddr
 	}
-	return 0
+	return ""
 }
 
-type QueryRoutesRequest struct {
-	// / The 33-byte hex-encoded public key for the payment destination
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// / The amount to send expressed in satoshis
-	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
-	// / The max number of routes to return.
-	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes,proto3" json:"num_routes,omitempty"`
-	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
-	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
-	// *
-	// The maximum number of satoshis that will be paid as a fee of the payment.
-	// This value can be represented either as a percentage of the amount being
-	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
-	// send the payment.
-	FeeLimit             *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *Invoice) GetCltvExpiry() uint64 {
+	if m != nil {
+		return m.CltvExpiry
+	}
+	return 0
 }
 
-func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
-func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRoutesRequest) ProtoMessage()    {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{61}
-}
-func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
-}
-func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
-}
-func (dst *QueryRoutesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRoutesRequest.Merge(dst, src)
-}
-func (m *QueryRoutesRequest) XXX_Size() int {
-	return xxx_messageInfo_QueryRoutesRequest.Size(m)
-}
-func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
+func (m *Invoice) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
+	}
+	return nil
 }
 
-var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
+func (m *Invoice) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
 
-func (m *QueryRoutesRequest) GetPubKey() string {
+func (m *Invoice) GetAddIndex() uint64 {
 	if m != nil {
-		return m.PubKey
+		return m.AddIndex
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryRoutesRequest) GetAmt() int64 {
+func (m *Invoice) GetSettleIndex() uint64 {
 	if m != nil {
-		return m.Amt
+		return m.SettleIndex
 	}
 	return 0
 }
 
-func (m *QueryRoutesRequest) GetNumRoutes() int32 {
+// Deprecated: Do not use.
+func (m *Invoice) GetAmtPaid() int64 {
 	if m != nil {
-		return m.NumRoutes
+		return m.AmtPaid
 	}
 	return 0
 }
 
-func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
+func (m *Invoice) GetAmtPaidSat() int64 {
 	if m != nil {
-		return m.FinalCltvDelta
+		return m.AmtPaidSat
 	}
 	return 0
 }
 
-func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
+func (m *Invoice) GetAmtPaidMsat() int64 {
 	if m != nil {
-		return m.FeeLimit
+		return m.AmtPaidMsat
 	}
-	return nil
+	return 0
 }
 
-type QueryRoutesResponse struct {
-	Routes               []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Invoice) GetState() Invoice_InvoiceState {
+	if m != nil {
+		return m.State
+	}
+	return Invoice_OPEN
 }
 
-func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
-func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRoutesResponse) ProtoMessage()    {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{62}
-}
-func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
-}
-func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
-}
-func (dst *QueryRoutesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRoutesResponse.Merge(dst, src)
-}
-func (m *QueryRoutesResponse) XXX_Size() int {
-	return xxx_messageInfo_QueryRoutesResponse.Size(m)
-}
-func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
+func (m *Invoice) GetHintChanIds() []uint64 {
+	if m != nil {
+		return m.HintChanIds
+	}
+	return nil
 }
 
-var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
-
-func (m *QueryRoutesResponse) GetRoutes() []*Route {
+func (m *Invoice) GetFallbackAddrPolicy() Invoice_FallbackAddrPolicy {
 	if m != nil {
-		return m.Routes
+		return m.FallbackAddrPolicy
 	}
-	return nil
+	return Invoice_FALLBACK_ADDR_PROVIDED
 }
 
-type Hop struct {
+type AddInvoiceResponse struct {
+	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
 	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity,proto3" json:"chan_capacity,omitempty"`
-	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward,proto3" json:"amt_to_forward,omitempty"` // Deprecated: Do not use.
-	Fee              int64  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`                       // Deprecated: Do not use.
-	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat,proto3" json:"amt_to_forward_msat,omitempty"`
-	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	// A bare-bones invoice for a payment within the Lightning Network.  With the
+	// details of the invoice, the sender has all the data necessary to send a
+	// payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
 	// *
-	// An optional public key of the hop. If the public key is given, the payment
-	// can be executed without relying on a copy of the channel graph.
-	PubKey               string   `protobuf:"bytes,8,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// The "add" index of this invoice. Each newly created invoice will increment
+	// this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all added
+	// invoices with an add_index greater than this one.
+	AddIndex             uint64   `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Hop) Reset()         { *m = Hop{} }
-func (m *Hop) String() string { return proto.CompactTextString(m) }
-func (*Hop) ProtoMessage()    {}
-func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{63}
+func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
+func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*AddInvoiceResponse) ProtoMessage()    {}
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{90}
 }
-func (m *Hop) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Hop.Unmarshal(m, b)
+func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
 }
-func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
+func (m *AddInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddInvoiceResponse.Marshal(b, m, deterministic)
 }
-func (dst *Hop) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Hop.Merge(dst, src)
+func (dst *AddInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInvoiceResponse.Merge(dst, src)
 }
-func (m *Hop) XXX_Size() int {
-	return xxx_messageInfo_Hop.Size(m)
+func (m *AddInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_AddInvoiceResponse.Size(m)
 }
-func (m *Hop) XXX_DiscardUnknown() {
-	xxx_messageInfo_Hop.DiscardUnknown(m)
+func (m *AddInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddInvoiceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Hop proto.InternalMessageInfo
+var xxx_messageInfo_AddInvoiceResponse proto.InternalMessageInfo
 
-func (m *Hop) GetChanId() uint64 {
+func (m *AddInvoiceResponse) GetRHash() []byte {
 	if m != nil {
-		return m.ChanId
+		return m.RHash
 	}
-	return 0
+	return nil
 }
 
-func (m *Hop) GetChanCapacity() int64 {
+func (m *AddInvoiceResponse) GetPaymentRequest() string {
 	if m != nil {
-		return m.ChanCapacity
+		return m.PaymentRequest
 	}
-	return 0
+	return ""
 }
 
-// Deprecated: Do not use.
-func (m *Hop) GetAmtToForward() int64 {
+func (m *AddInvoiceResponse) GetAddIndex() uint64 {
 	if m != nil {
-		return m.AmtToForward
+		return m.AddIndex
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (m *Hop) GetFee() int64 {
-	if m != nil {
-		return m.Fee
-	}
-	return 0
+type PaymentHash struct {
+	// *
+	// The hex-encoded payment hash of the invoice to be looked up. The passed
+	// payment hash must be exactly 32 bytes, otherwise an error is returned.
+	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str,proto3" json:"r_hash_str,omitempty"`
+	// / The payment hash of the invoice to be looked up.
+	RHash                []byte   `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Hop) GetExpiry() uint32 {
-	if m != nil {
-		return m.Expiry
-	}
-	return 0
+func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
+func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
+func (*PaymentHash) ProtoMessage()    {}
+func (*PaymentHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{91}
 }
-
-func (m *Hop) GetAmtToForwardMsat() int64 {
-	if m != nil {
-		return m.AmtToForwardMsat
-	}
-	return 0
+func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
+}
+func (m *PaymentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentHash.Marshal(b, m, deterministic)
+}
+func (dst *PaymentHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentHash.Merge(dst, src)
+}
+func (m *PaymentHash) XXX_Size() int {
+	return xxx_messageInfo_PaymentHash.Size(m)
+}
+func (m *PaymentHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentHash.DiscardUnknown(m)
 }
 
-func (m *Hop) GetFeeMsat() int64 {
+var xxx_messageInfo_PaymentHash proto.InternalMessageInfo
+
+func (m *PaymentHash) GetRHashStr() string {
 	if m != nil {
-		return m.FeeMsat
+		return m.RHashStr
 	}
-	return 0
+	return ""
 }
 
-func (m *Hop) GetPubKey() string {
+func (m *PaymentHash) GetRHash() []byte {
 	if m != nil {
-		return m.PubKey
+		return m.RHash
 	}
-	return ""
+	return nil
 }
 
-// *
-// A path through the channel graph which runs over one or more channels in
-// succession. This struct carries all the information required to craft the
-// Sphinx onion packet, and send the payment along the first hop in the path. A
-// route is only selected as valid if all the channels have sufficient capacity to
-// carry the initial payment amount after fees are accounted for.
-type Route struct {
-	// *
-	// The cumulative (final) time lock across the entire route.  This is the CLTV
-	// value that should be extended to the first hop in the route. All other hops
-	// will decrement the time-lock as advertised, leaving enough time for all
-	// hops to wait for or present the payment preimage to complete the payment.
-	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock,proto3" json:"total_time_lock,omitempty"`
-	// *
-	// The sum of the fees paid at each hop within the final route.  In the case
-	// of a one-hop payment, this value will be zero as we don't need to pay a fee
-	// it ourself.
-	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees,proto3" json:"total_fees,omitempty"` // Deprecated: Do not use.
-	// *
-	// The total amount of funds required to complete a payment over this route.
-	// This value includes the cumulative fees at each hop. As a result, the HTLC
-	// extended to the first-hop in the route will need to have at least this many
-	// satoshis, otherwise the route will fail at an intermediate node due to an
-	// insufficient amount of fees.
-	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt,proto3" json:"total_amt,omitempty"` // Deprecated: Do not use.
-	// *
-	// Contains details concerning the specific forwarding details at each hop.
-	Hops []*Hop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+type ListInvoiceRequest struct {
+	// / If set, only unsettled invoices will be returned in the response.
+	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only,proto3" json:"pending_only,omitempty"`
 	// *
-	// The total fees in millisatoshis.
-	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat,proto3" json:"total_fees_msat,omitempty"`
+	// The index of an invoice that will be used as either the start or end of a
+	// query to determine which invoices should be returned in the response.
+	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
+	// / The max number of invoices to return in the response to this query.
+	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices,proto3" json:"num_max_invoices,omitempty"`
 	// *
-	// The total amount in millisatoshis.
-	TotalAmtMsat         int64    `protobuf:"varint,6,opt,name=total_amt_msat,proto3" json:"total_amt_msat,omitempty"`
+	// If set, the invoices returned will result from seeking backwards from the
+	// specified index offset. This can be used to paginate backwards.
+	Reversed             bool     `protobuf:"varint,6,opt,name=reversed,proto3" json:"reversed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Route) Reset()         { *m = Route{} }
-func (m *Route) String() string { return proto.CompactTextString(m) }
-func (*Route) ProtoMessage()    {}
-func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{64}
+func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
+func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ListInvoiceRequest) ProtoMessage()    {}
+func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{92}
 }
-func (m *Route) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Route.Unmarshal(m, b)
+func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
 }
-func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+func (m *ListInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvoiceRequest.Marshal(b, m, deterministic)
 }
-func (dst *Route) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Route.Merge(dst, src)
+func (dst *ListInvoiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvoiceRequest.Merge(dst, src)
 }
-func (m *Route) XXX_Size() int {
-	return xxx_messageInfo_Route.Size(m)
+func (m *ListInvoiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ListInvoiceRequest.Size(m)
 }
-func (m *Route) XXX_DiscardUnknown() {
-	xxx_messageInfo_Route.DiscardUnknown(m)
+func (m *ListInvoiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvoiceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Route proto.InternalMessageInfo
-
-func (m *Route) GetTotalTimeLock() uint32 {
-	if m != nil {
-		return m.TotalTimeLock
-	}
-	return 0
-}
+var xxx_messageInfo_ListInvoiceRequest proto.InternalMessageInfo
 
-// Deprecated: Do not use.
-func (m *Route) GetTotalFees() int64 {
+func (m *ListInvoiceRequest) GetPendingOnly() bool {
 	if m != nil {
-		return m.TotalFees
+		return m.PendingOnly
 	}
-	return 0
+	return false
 }
 
-// Deprecated: Do not use.
-func (m *Route) GetTotalAmt() int64 {
+func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
 	if m != nil {
-		return m.TotalAmt
+		return m.IndexOffset
 	}
 	return 0
 }
 
-func (m *Route) GetHops() []*Hop {
-	if m != nil {
-		return m.Hops
-	}
-	return nil
-}
-
-func (m *Route) GetTotalFeesMsat() int64 {
+func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
 	if m != nil {
-		return m.TotalFeesMsat
+		return m.NumMaxInvoices
 	}
 	return 0
 }
 
-func (m *Route) GetTotalAmtMsat() int64 {
+func (m *ListInvoiceRequest) GetReversed() bool {
 	if m != nil {
-		return m.TotalAmtMsat
+		return m.Reversed
 	}
-	return 0
+	return false
 }
 
-type NodeInfoRequest struct {
-	// / The 33-byte hex-encoded compressed public of the target node
-	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+type ListInvoiceResponse struct {
+	// *
+	// A list of invoices from the time slice of the time series specified in the
+	// request.
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	// *
+	// The index of the last item in the set of returned invoices. This can be used
+	// to seek further, pagination style.
+	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset,proto3" json:"last_index_offset,omitempty"`
+	// *
+	// The index of the last item in the set of returned invoices. This can be used
+	// to seek backwards, pagination style.
+	FirstIndexOffset     uint64   `protobuf:"varint,3,opt,name=first_index_offset,proto3" json:"first_index_offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
-func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*NodeInfoRequest) ProtoMessage()    {}
-func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{65}
+func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
+func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ListInvoiceResponse) ProtoMessage()    {}
+func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{93}
 }
-func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
+func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
 }
-func (m *NodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeInfoRequest.Marshal(b, m, deterministic)
+func (m *ListInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvoiceResponse.Marshal(b, m, deterministic)
 }
-func (dst *NodeInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeInfoRequest.Merge(dst, src)
+func (dst *ListInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvoiceResponse.Merge(dst, src)
 }
-func (m *NodeInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_NodeInfoRequest.Size(m)
+func (m *ListInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_ListInvoiceResponse.Size(m)
 }
-func (m *NodeInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeInfoRequest.DiscardUnknown(m)
+func (m *ListInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvoiceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListInvoiceResponse proto.InternalMessageInfo
 
-func (m *NodeInfoRequest) GetPubKey() string {
+func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
 	if m != nil {
-		return m.PubKey
+		return m.Invoices
 	}
-	return ""
+	return nil
 }
 
-type NodeInfo struct {
+func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
+	if m != nil {
+		return m.LastIndexOffset
+	}
+	return 0
+}
+
+func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
+	if m != nil {
+		return m.FirstIndexOffset
+	}
+	return 0
+}
+
+type InvoiceSubscription struct {
 	// *
-	// An individual vertex/node within the channel graph. A node is
-	// connected to other nodes by one or more channel edges emanating from it. As
-	// the graph is directed, a node will also have an incoming edge attached to
-	// it for each outgoing edge.
-	Node                 *LightningNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	NumChannels          uint32         `protobuf:"varint,2,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
-	TotalCapacity        int64          `protobuf:"varint,3,opt,name=total_capacity,proto3" json:"total_capacity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// If specified (non-zero), then we'll first start by sending out
+	// notifications for all added indexes with an add_index greater than this
+	// value. This allows callers to catch up on any events they missed while they
+	// weren't connected to the streaming RPC.
+	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index,proto3" json:"add_index,omitempty"`
+	// *
+	// If specified (non-zero), then we'll first start by sending out
+	// notifications for all settled indexes with an settle_index greater than
+	// this value. This allows callers to catch up on any events they missed while
+	// they weren't connected to the streaming RPC.
+	SettleIndex          uint64   `protobuf:"varint,2,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
-func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
-func (*NodeInfo) ProtoMessage()    {}
-func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{66}
+func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
+func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
+func (*InvoiceSubscription) ProtoMessage()    {}
+func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{94}
 }
-func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
+func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
 }
-func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+func (m *InvoiceSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceSubscription.Marshal(b, m, deterministic)
 }
-func (dst *NodeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeInfo.Merge(dst, src)
+func (dst *InvoiceSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceSubscription.Merge(dst, src)
 }
-func (m *NodeInfo) XXX_Size() int {
-	return xxx_messageInfo_NodeInfo.Size(m)
+func (m *InvoiceSubscription) XXX_Size() int {
+	return xxx_messageInfo_InvoiceSubscription.Size(m)
 }
-func (m *NodeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+func (m *InvoiceSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
-
-func (m *NodeInfo) GetNode() *LightningNode {
-	if m != nil {
-		return m.Node
-	}
-	return nil
-}
+var xxx_messageInfo_InvoiceSubscription proto.InternalMessageInfo
 
-func (m *NodeInfo) GetNumChannels() uint32 {
+func (m *InvoiceSubscription) GetAddIndex() uint64 {
 	if m != nil {
-		return m.NumChannels
+		return m.AddIndex
 	}
 	return 0
 }
 
-func (m *NodeInfo) GetTotalCapacity() int64 {
+func (m *InvoiceSubscription) GetSettleIndex() uint64 {
 	if m != nil {
-		return m.TotalCapacity
+		return m.SettleIndex
 	}
 	return 0
 }
 
-// *
-// An individual vertex/node within the channel graph. A node is
-// connected to other nodes by one or more channel edges emanating from it. As the
-// graph is directed, a node will also have an incoming edge attached to it for
-// each outgoing edge.
-type LightningNode struct {
-	LastUpdate           uint32         `protobuf:"varint,1,opt,name=last_update,proto3" json:"last_update,omitempty"`
-	PubKey               string         `protobuf:"bytes,2,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	Alias                string         `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
-	Addresses            []*NodeAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Color                string         `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type Payment struct {
+	// / The payment hash
+	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / Deprecated, use value_sat or value_msat.
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
+	// / The date of this payment
+	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
+	// / The path this payment took
+	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	// / The fee paid for this payment in satoshis
+	Fee int64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	// / The payment preimage
+	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
+	// / The value of the payment in satoshis
+	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat,proto3" json:"value_sat,omitempty"`
+	// / The value of the payment in milli-satoshis
+	ValueMsat            int64    `protobuf:"varint,8,opt,name=value_msat,proto3" json:"value_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LightningNode) Reset()         { *m = LightningNode{} }
-func (m *LightningNode) String() string { return proto.CompactTextString(m) }
-func (*LightningNode) ProtoMessage()    {}
-func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{67}
+func (m *Payment) Reset()         { *m = Payment{} }
+func (m *Payment) String() string { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()    {}
+func (*Payment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{95}
 }
-func (m *LightningNode) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
+func (m *Payment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Payment.Unmarshal(m, b)
 }
-func (m *LightningNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LightningNode.Marshal(b, m, deterministic)
+func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
 }
-func (dst *LightningNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LightningNode.Merge(dst, src)
+func (dst *Payment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payment.Merge(dst, src)
 }
-func (m *LightningNode) XXX_Size() int {
-	return xxx_messageInfo_LightningNode.Size(m)
+func (m *Payment) XXX_Size() int {
+	return xxx_messageInfo_Payment.Size(m)
 }
-func (m *LightningNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_LightningNode.DiscardUnknown(m)
+func (m *Payment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LightningNode proto.InternalMessageInfo
+var xxx_messageInfo_Payment proto.InternalMessageInfo
 
-func (m *LightningNode) GetLastUpdate() uint32 {
+func (m *Payment) GetPaymentHash() string {
 	if m != nil {
-		return m.LastUpdate
+		return m.PaymentHash
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (m *Payment) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
 	return 0
 }
 
-func (m *LightningNode) GetPubKey() string {
+func (m *Payment) GetCreationDate() int64 {
 	if m != nil {
-		return m.PubKey
+		return m.CreationDate
 	}
-	return ""
+	return 0
 }
 
-func (m *LightningNode) GetAlias() string {
+func (m *Payment) GetPath() []string {
 	if m != nil {
-		return m.Alias
+		return m.Path
 	}
-	return ""
+	return nil
 }
 
-func (m *LightningNode) GetAddresses() []*NodeAddress {
+func (m *Payment) GetFee() int64 {
 	if m != nil {
-		return m.Addresses
+		return m.Fee
 	}
-	return nil
+	return 0
 }
 
-func (m *LightningNode) GetColor() string {
+func (m *Payment) GetPaymentPreimage() string {
 	if m != nil {
-		return m.Color
+		return m.PaymentPreimage
 	}
 	return ""
 }
 
-type NodeAddress struct {
-	Network              string   `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
-func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
-func (*NodeAddress) ProtoMessage()    {}
-func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{68}
-}
-func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
-}
-func (m *NodeAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeAddress.Marshal(b, m, deterministic)
-}
-func (dst *NodeAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeAddress.Merge(dst, src)
-}
-func (m *NodeAddress) XXX_Size() int {
-	return xxx_messageInfo_NodeAddress.Size(m)
-}
-func (m *NodeAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeAddress.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_NodeAddress proto.InternalMessageInfo
-
-func (m *NodeAddress) GetNetwork() string {
+func (m *Payment) GetValueSat() int64 {
 	if m != nil {
-		return m.Network
+		return m.ValueSat
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeAddress) GetAddr() string {
+func (m *Payment) GetValueMsat() int64 {
 	if m != nil {
-		return m.Addr
+		return m.ValueMsat
 	}
-	return ""
+	return 0
 }
 
-type RoutingPolicy struct {
-	TimeLockDelta        uint32   `protobuf:"varint,1,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
-	MinHtlc              int64    `protobuf:"varint,2,opt,name=min_htlc,proto3" json:"min_htlc,omitempty"`
-	FeeBaseMsat          int64    `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
-	FeeRateMilliMsat     int64    `protobuf:"varint,4,opt,name=fee_rate_milli_msat,proto3" json:"fee_rate_milli_msat,omitempty"`
-	Disabled             bool     `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+type ListPaymentsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
-func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
-func (*RoutingPolicy) ProtoMessage()    {}
-func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{69}
+func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
+func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPaymentsRequest) ProtoMessage()    {}
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{96}
 }
-func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
+func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
 }
-func (m *RoutingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RoutingPolicy.Marshal(b, m, deterministic)
+func (m *ListPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPaymentsRequest.Marshal(b, m, deterministic)
 }
-func (dst *RoutingPolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoutingPolicy.Merge(dst, src)
+func (dst *ListPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPaymentsRequest.Merge(dst, src)
 }
-func (m *RoutingPolicy) XXX_Size() int {
-	return xxx_messageInfo_RoutingPolicy.Size(m)
+func (m *ListPaymentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPaymentsRequest.Size(m)
 }
-func (m *RoutingPolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoutingPolicy.DiscardUnknown(m)
+func (m *ListPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPaymentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RoutingPolicy proto.InternalMessageInfo
+var xxx_messageInfo_ListPaymentsRequest proto.InternalMessageInfo
 
-func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
-	if m != nil {
-		return m.TimeLockDelta
-	}
-	return 0
+type ListPaymentsResponse struct {
+	// / The list of payments
+	Payments             []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *RoutingPolicy) GetMinHtlc() int64 {
-	if m != nil {
-		return m.MinHtlc
-	}
-	return 0
+func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
+func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPaymentsResponse) ProtoMessage()    {}
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{97}
 }
-
-func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
-	if m != nil {
-		return m.FeeBaseMsat
-	}
-	return 0
+func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
 }
-
-func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
-	if m != nil {
-		return m.FeeRateMilliMsat
-	}
-	return 0
+func (m *ListPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPaymentsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPaymentsResponse.Merge(dst, src)
+}
+func (m *ListPaymentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPaymentsResponse.Size(m)
+}
+func (m *ListPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPaymentsResponse.DiscardUnknown(m)
 }
 
-func (m *RoutingPolicy) GetDisabled() bool {
+var xxx_messageInfo_ListPaymentsResponse proto.InternalMessageInfo
+
+func (m *ListPaymentsResponse) GetPayments() []*Payment {
 	if m != nil {
-		return m.Disabled
+		return m.Payments
 	}
-	return false
+	return nil
 }
 
-// *
-// A fully authenticated channel along with all its unique attributes.
-// Once an authenticated channel announcement has been processed on the network,
-// then an instance of ChannelEdgeInfo encapsulating the channels attributes is
-// stored. The other portions relevant to routing policy of a channel are stored
-// within a ChannelEdgePolicy for each direction of the channel.
-type ChannelEdge struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChannelId            uint64         `protobuf:"varint,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
-	ChanPoint            string         `protobuf:"bytes,2,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
-	LastUpdate           uint32         `protobuf:"varint,3,opt,name=last_update,proto3" json:"last_update,omitempty"`
-	Node1Pub             string         `protobuf:"bytes,4,opt,name=node1_pub,proto3" json:"node1_pub,omitempty"`
-	Node2Pub             string         `protobuf:"bytes,5,opt,name=node2_pub,proto3" json:"node2_pub,omitempty"`
-	Capacity             int64          `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Node1Policy          *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy,proto3" json:"node1_policy,omitempty"`
-	Node2Policy          *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy,proto3" json:"node2_policy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type DeleteAllPaymentsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
-func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
-func (*ChannelEdge) ProtoMessage()    {}
-func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{70}
+func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsRequest{} }
+func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
+func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{98}
 }
-func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
+func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
 }
-func (m *ChannelEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEdge.Marshal(b, m, deterministic)
+func (m *DeleteAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAllPaymentsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ChannelEdge) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEdge.Merge(dst, src)
+func (dst *DeleteAllPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAllPaymentsRequest.Merge(dst, src)
 }
-func (m *ChannelEdge) XXX_Size() int {
-	return xxx_messageInfo_ChannelEdge.Size(m)
+func (m *DeleteAllPaymentsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAllPaymentsRequest.Size(m)
 }
-func (m *ChannelEdge) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEdge.DiscardUnknown(m)
+func (m *DeleteAllPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAllPaymentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelEdge proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAllPaymentsRequest proto.InternalMessageInfo
 
-func (m *ChannelEdge) GetChannelId() uint64 {
-	if m != nil {
-		return m.ChannelId
-	}
-	return 0
+type DeleteAllPaymentsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelEdge) GetChanPoint() string {
-	if m != nil {
-		return m.ChanPoint
-	}
-	return ""
+func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResponse{} }
+func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
+func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{99}
+}
+func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
+}
+func (m *DeleteAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAllPaymentsResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteAllPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAllPaymentsResponse.Merge(dst, src)
+}
+func (m *DeleteAllPaymentsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAllPaymentsResponse.Size(m)
+}
+func (m *DeleteAllPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAllPaymentsResponse.DiscardUnknown(m)
 }
 
-func (m *ChannelEdge) GetLastUpdate() uint32 {
-	if m != nil {
-		return m.LastUpdate
-	}
-	return 0
+var xxx_messageInfo_DeleteAllPaymentsResponse proto.InternalMessageInfo
+
+type AbandonChannelRequest struct {
+	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ChannelEdge) GetNode1Pub() string {
-	if m != nil {
-		return m.Node1Pub
-	}
-	return ""
+func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
+func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*AbandonChannelRequest) ProtoMessage()    {}
+func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{100}
 }
-
-func (m *ChannelEdge) GetNode2Pub() string {
-	if m != nil {
-		return m.Node2Pub
-	}
-	return ""
+func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
 }
-
-func (m *ChannelEdge) GetCapacity() int64 {
-	if m != nil {
-		return m.Capacity
-	}
-	return 0
+func (m *AbandonChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonChannelRequest.Marshal(b, m, deterministic)
 }
-
-func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
-	if m != nil {
-		return m.Node1Policy
-	}
-	return nil
+func (dst *AbandonChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonChannelRequest.Merge(dst, src)
+}
+func (m *AbandonChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_AbandonChannelRequest.Size(m)
+}
+func (m *AbandonChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonChannelRequest.DiscardUnknown(m)
 }
 
-func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
+var xxx_messageInfo_AbandonChannelRequest proto.InternalMessageInfo
+
+func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.Node2Policy
+		return m.ChannelPoint
 	}
 	return nil
 }
 
-type ChannelGraphRequest struct {
-	// *
-	// Whether unannounced channels are included in the response or not. If set,
-	// unannounced channels are included. Unannounced channels are both private
-	// channels, and public channels that are not yet announced to the network.
-	IncludeUnannounced   bool     `protobuf:"varint,1,opt,name=include_unannounced,proto3" json:"include_unannounced,omitempty"`
+type AbandonChannelResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
-func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
-func (*ChannelGraphRequest) ProtoMessage()    {}
-func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{71}
+func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{} }
+func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*AbandonChannelResponse) ProtoMessage()    {}
+func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{101}
 }
-func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
+func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
 }
-func (m *ChannelGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelGraphRequest.Marshal(b, m, deterministic)
+func (m *AbandonChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonChannelResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChannelGraphRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelGraphRequest.Merge(dst, src)
+func (dst *AbandonChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonChannelResponse.Merge(dst, src)
 }
-func (m *ChannelGraphRequest) XXX_Size() int {
-	return xxx_messageInfo_ChannelGraphRequest.Size(m)
+func (m *AbandonChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_AbandonChannelResponse.Size(m)
 }
-func (m *ChannelGraphRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelGraphRequest.DiscardUnknown(m)
+func (m *AbandonChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonChannelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelGraphRequest proto.InternalMessageInfo
-
-func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
-	if m != nil {
-		return m.IncludeUnannounced
-	}
-	return false
-}
+var xxx_messageInfo_AbandonChannelResponse proto.InternalMessageInfo
 
-// / Returns a new instance of the directed channel graph.
-type ChannelGraph struct {
-	// / The list of `LightningNode`s in this channel graph
-	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	// / The list of `ChannelEdge`s in this channel graph
-	Edges                []*ChannelEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type DebugLevelRequest struct {
+	Show                 bool     `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
+	LevelSpec            string   `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
-func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
-func (*ChannelGraph) ProtoMessage()    {}
-func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{72}
+func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
+func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*DebugLevelRequest) ProtoMessage()    {}
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{102}
 }
-func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
+func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
 }
-func (m *ChannelGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelGraph.Marshal(b, m, deterministic)
+func (m *DebugLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DebugLevelRequest.Marshal(b, m, deterministic)
 }
-func (dst *ChannelGraph) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelGraph.Merge(dst, src)
+func (dst *DebugLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugLevelRequest.Merge(dst, src)
 }
-func (m *ChannelGraph) XXX_Size() int {
-	return xxx_messageInfo_ChannelGraph.Size(m)
+func (m *DebugLevelRequest) XXX_Size() int {
+	return xxx_messageInfo_DebugLevelRequest.Size(m)
 }
-func (m *ChannelGraph) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelGraph.DiscardUnknown(m)
+func (m *DebugLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugLevelRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelGraph proto.InternalMessageInfo
+var xxx_messageInfo_DebugLevelRequest proto.InternalMessageInfo
 
-func (m *ChannelGraph) GetNodes() []*LightningNode {
+func (m *DebugLevelRequest) GetShow() bool {
 	if m != nil {
-		return m.Nodes
+		return m.Show
 	}
-	return nil
+	return false
 }
 
-func (m *ChannelGraph) GetEdges() []*ChannelEdge {
+func (m *DebugLevelRequest) GetLevelSpec() string {
 	if m != nil {
-		return m.Edges
+		return m.LevelSpec
 	}
-	return nil
+	return ""
 }
 
-type ChanInfoRequest struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId               uint64   `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+type DebugLevelResponse struct {
+	SubSystems           string   `protobuf:"bytes,1,opt,name=sub_systems,proto3" json:"sub_systems,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
-func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*ChanInfoRequest) ProtoMessage()    {}
-func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{73}
+func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
+func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*DebugLevelResponse) ProtoMessage()    {}
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{103}
 }
-func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
+func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
 }
-func (m *ChanInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChanInfoRequest.Marshal(b, m, deterministic)
+func (m *DebugLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DebugLevelResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChanInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChanInfoRequest.Merge(dst, src)
+func (dst *DebugLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugLevelResponse.Merge(dst, src)
 }
-func (m *ChanInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_ChanInfoRequest.Size(m)
+func (m *DebugLevelResponse) XXX_Size() int {
+	return xxx_messageInfo_DebugLevelResponse.Size(m)
 }
-func (m *ChanInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChanInfoRequest.DiscardUnknown(m)
+func (m *DebugLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugLevelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChanInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_DebugLevelResponse proto.InternalMessageInfo
 
-func (m *ChanInfoRequest) GetChanId() uint64 {
+func (m *DebugLevelResponse) GetSubSystems() string {
 	if m != nil {
-		return m.ChanId
+		return m.SubSystems
 	}
-	return 0
+	return ""
 }
 
-type NetworkInfoRequest struct {
+type GetDBStatsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
-func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*NetworkInfoRequest) ProtoMessage()    {}
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{74}
+func (m *GetDBStatsRequest) Reset()         { *m = GetDBStatsRequest{} }
+func (m *GetDBStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDBStatsRequest) ProtoMessage()    {}
+func (*GetDBStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{104}
 }
-func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
+func (m *GetDBStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDBStatsRequest.Unmarshal(m, b)
 }
-func (m *NetworkInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NetworkInfoRequest.Marshal(b, m, deterministic)
+func (m *GetDBStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDBStatsRequest.Marshal(b, m, deterministic)
 }
-func (dst *NetworkInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NetworkInfoRequest.Merge(dst, src)
+func (dst *GetDBStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDBStatsRequest.Merge(dst, src)
 }
-func (m *NetworkInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_NetworkInfoRequest.Size(m)
+func (m *GetDBStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDBStatsRequest.Size(m)
 }
-func (m *NetworkInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NetworkInfoRequest.DiscardUnknown(m)
+func (m *GetDBStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDBStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NetworkInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetDBStatsRequest proto.InternalMessageInfo
 
-type NetworkInfo struct {
-	GraphDiameter        uint32   `protobuf:"varint,1,opt,name=graph_diameter,proto3" json:"graph_diameter,omitempty"`
-	AvgOutDegree         float64  `protobuf:"fixed64,2,opt,name=avg_out_degree,proto3" json:"avg_out_degree,omitempty"`
-	MaxOutDegree         uint32   `protobuf:"varint,3,opt,name=max_out_degree,proto3" json:"max_out_degree,omitempty"`
-	NumNodes             uint32   `protobuf:"varint,4,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
-	NumChannels          uint32   `protobuf:"varint,5,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
-	TotalNetworkCapacity int64    `protobuf:"varint,6,opt,name=total_network_capacity,proto3" json:"total_network_capacity,omitempty"`
-	AvgChannelSize       float64  `protobuf:"fixed64,7,opt,name=avg_channel_size,proto3" json:"avg_channel_size,omitempty"`
-	MinChannelSize       int64    `protobuf:"varint,8,opt,name=min_channel_size,proto3" json:"min_channel_size,omitempty"`
-	MaxChannelSize       int64    `protobuf:"varint,9,opt,name=max_channel_size,proto3" json:"max_channel_size,omitempty"`
+type DBSubsystemStats struct {
+	// / The name of the subsystem.
+	Subsystem string `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	// / The number of key/value pairs stored by the subsystem.
+	NumKeys uint64 `protobuf:"varint,2,opt,name=num_keys,proto3" json:"num_keys,omitempty"`
+	// / The number of buckets used by the subsystem.
+	NumBuckets uint64 `protobuf:"varint,3,opt,name=num_buckets,proto3" json:"num_buckets,omitempty"`
+	// / The total size of all keys and values stored by the subsystem, in bytes.
+	SizeBytes            uint64   `protobuf:"varint,4,opt,name=size_bytes,proto3" json:"size_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
-func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
-func (*NetworkInfo) ProtoMessage()    {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{75}
-}
-func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
+func (m *DBSubsystemStats) Reset()         { *m = DBSubsystemStats{} }
+func (m *DBSubsystemStats) String() string { return proto.CompactTextString(m) }
+func (*DBSubsystemStats) ProtoMessage()    {}
+func (*DBSubsystemStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{105}
 }
-func (m *NetworkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NetworkInfo.Marshal(b, m, deterministic)
-}
-func (dst *NetworkInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NetworkInfo.Merge(dst, src)
+func (m *DBSubsystemStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBSubsystemStats.Unmarshal(m, b)
 }
-func (m *NetworkInfo) XXX_Size() int {
-	return xxx_messageInfo_NetworkInfo.Size(m)
+func (m *DBSubsystemStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBSubsystemStats.Marshal(b, m, deterministic)
 }
-func (m *NetworkInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NetworkInfo.DiscardUnknown(m)
+func (dst *DBSubsystemStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBSubsystemStats.Merge(dst, src)
 }
-
-var xxx_messageInfo_NetworkInfo proto.InternalMessageInfo
-
-func (m *NetworkInfo) GetGraphDiameter() uint32 {
-	if m != nil {
-		return m.GraphDiameter
-	}
-	return 0
+func (m *DBSubsystemStats) XXX_Size() int {
+	return xxx_messageInfo_DBSubsystemStats.Size(m)
 }
-
-func (m *NetworkInfo) GetAvgOutDegree() float64 {
-	if m != nil {
-		return m.AvgOutDegree
-	}
-	return 0
+func (m *DBSubsystemStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBSubsystemStats.DiscardUnknown(m)
 }
 
-func (m *NetworkInfo) GetMaxOutDegree() uint32 {
-	if m != nil {
-		return m.MaxOutDegree
-	}
-	return 0
-}
+var xxx_messageInfo_DBSubsystemStats proto.InternalMessageInfo
 
-func (m *NetworkInfo) GetNumNodes() uint32 {
+func (m *DBSubsystemStats) GetSubsystem() string {
 	if m != nil {
-		return m.NumNodes
+		return m.Subsystem
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkInfo) GetNumChannels() uint32 {
+func (m *DBSubsystemStats) GetNumKeys() uint64 {
 	if m != nil {
-		return m.NumChannels
+		return m.NumKeys
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
+func (m *DBSubsystemStats) GetNumBuckets() uint64 {
 	if m != nil {
-		return m.TotalNetworkCapacity
+		return m.NumBuckets
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetAvgChannelSize() float64 {
+func (m *DBSubsystemStats) GetSizeBytes() uint64 {
 	if m != nil {
-		return m.AvgChannelSize
+		return m.SizeBytes
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetMinChannelSize() int64 {
+type GetDBStatsResponse struct {
+	// / The size of the database file in bytes, only set for the bolt backend.
+	FileSizeBytes int64 `protobuf:"varint,1,opt,name=file_size_bytes,proto3" json:"file_size_bytes,omitempty"`
+	// / The contents of the database, broken down by subsystem.
+	Subsystems           []*DBSubsystemStats `protobuf:"bytes,2,rep,name=subsystems,proto3" json:"subsystems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetDBStatsResponse) Reset()         { *m = GetDBStatsResponse{} }
+func (m *GetDBStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDBStatsResponse) ProtoMessage()    {}
+func (*GetDBStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{106}
+}
+func (m *GetDBStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDBStatsResponse.Unmarshal(m, b)
+}
+func (m *GetDBStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDBStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDBStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDBStatsResponse.Merge(dst, src)
+}
+func (m *GetDBStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDBStatsResponse.Size(m)
+}
+func (m *GetDBStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDBStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDBStatsResponse proto.InternalMessageInfo
+
+func (m *GetDBStatsResponse) GetFileSizeBytes() int64 {
 	if m != nil {
-		return m.MinChannelSize
+		return m.FileSizeBytes
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetMaxChannelSize() int64 {
+func (m *GetDBStatsResponse) GetSubsystems() []*DBSubsystemStats {
 	if m != nil {
-		return m.MaxChannelSize
+		return m.Subsystems
 	}
-	return 0
+	return nil
 }
 
-type StopRequest struct {
+type BackupDatabaseRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopRequest) Reset()         { *m = StopRequest{} }
-func (m *StopRequest) String() string { return proto.CompactTextString(m) }
-func (*StopRequest) ProtoMessage()    {}
-func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{76}
+func (m *BackupDatabaseRequest) Reset()         { *m = BackupDatabaseRequest{} }
+func (m *BackupDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupDatabaseRequest) ProtoMessage()    {}
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{107}
 }
-func (m *StopRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
+func (m *BackupDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupDatabaseRequest.Unmarshal(m, b)
 }
-func (m *StopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StopRequest.Marshal(b, m, deterministic)
+func (m *BackupDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (dst *StopRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopRequest.Merge(dst, src)
+func (dst *BackupDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupDatabaseRequest.Merge(dst, src)
 }
-func (m *StopRequest) XXX_Size() int {
-	return xxx_messageInfo_StopRequest.Size(m)
+func (m *BackupDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_BackupDatabaseRequest.Size(m)
 }
-func (m *StopRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopRequest.DiscardUnknown(m)
+func (m *BackupDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StopRequest proto.InternalMessageInfo
+var xxx_messageInfo_BackupDatabaseRequest proto.InternalMessageInfo
 
-type StopResponse struct {
+type DatabaseBackupMetadata struct {
+	// / The schema version of the backed up database.
+	DbVersion uint32 `protobuf:"varint,1,opt,name=db_version,proto3" json:"db_version,omitempty"`
+	// / The height of the best block known to the node at the time of the backup.
+	BestBlockHeight uint32 `protobuf:"varint,2,opt,name=best_block_height,proto3" json:"best_block_height,omitempty"`
+	// / The hash of the best block known to the node at the time of the backup.
+	BestBlockHash string `protobuf:"bytes,3,opt,name=best_block_hash,proto3" json:"best_block_hash,omitempty"`
+	// / The unix timestamp at which the backup was taken.
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopResponse) Reset()         { *m = StopResponse{} }
-func (m *StopResponse) String() string { return proto.CompactTextString(m) }
-func (*StopResponse) ProtoMessage()    {}
-func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{77}
+func (m *DatabaseBackupMetadata) Reset()         { *m = DatabaseBackupMetadata{} }
+func (m *DatabaseBackupMetadata) String() string { return proto.CompactTextString(m) }
+func (*DatabaseBackupMetadata) ProtoMessage()    {}
+func (*DatabaseBackupMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{108}
 }
-func (m *StopResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
+func (m *DatabaseBackupMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseBackupMetadata.Unmarshal(m, b)
 }
-func (m *StopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StopResponse.Marshal(b, m, deterministic)
+func (m *DatabaseBackupMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseBackupMetadata.Marshal(b, m, deterministic)
 }
-func (dst *StopResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopResponse.Merge(dst, src)
+func (dst *DatabaseBackupMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseBackupMetadata.Merge(dst, src)
 }
-func (m *StopResponse) XXX_Size() int {
-	return xxx_messageInfo_StopResponse.Size(m)
+func (m *DatabaseBackupMetadata) XXX_Size() int {
+	return xxx_messageInfo_DatabaseBackupMetadata.Size(m)
 }
-func (m *StopResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopResponse.DiscardUnknown(m)
+func (m *DatabaseBackupMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseBackupMetadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StopResponse proto.InternalMessageInfo
+var xxx_messageInfo_DatabaseBackupMetadata proto.InternalMessageInfo
 
-type GraphTopologySubscription struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DatabaseBackupMetadata) GetDbVersion() uint32 {
+	if m != nil {
+		return m.DbVersion
+	}
+	return 0
 }
 
-func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscription{} }
-func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
-func (*GraphTopologySubscription) ProtoMessage()    {}
-func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{78}
-}
-func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
-}
-func (m *GraphTopologySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GraphTopologySubscription.Marshal(b, m, deterministic)
-}
-func (dst *GraphTopologySubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GraphTopologySubscription.Merge(dst, src)
-}
-func (m *GraphTopologySubscription) XXX_Size() int {
-	return xxx_messageInfo_GraphTopologySubscription.Size(m)
+func (m *DatabaseBackupMetadata) GetBestBlockHeight() uint32 {
+	if m != nil {
+		return m.BestBlockHeight
+	}
+	return 0
 }
-func (m *GraphTopologySubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_GraphTopologySubscription.DiscardUnknown(m)
+
+func (m *DatabaseBackupMetadata) GetBestBlockHash() string {
+	if m != nil {
+		return m.BestBlockHash
+	}
+	return ""
 }
 
-var xxx_messageInfo_GraphTopologySubscription proto.InternalMessageInfo
+func (m *DatabaseBackupMetadata) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
 
-type GraphTopologyUpdate struct {
-	NodeUpdates          []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates,proto3" json:"node_updates,omitempty"`
-	ChannelUpdates       []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates,proto3" json:"channel_updates,omitempty"`
-	ClosedChans          []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans,proto3" json:"closed_chans,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+type DatabaseBackupChunk struct {
+	// *
+	// The next chunk of the backup. Concatenating the data of all chunks yields
+	// the complete backup, including its header.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// *
+	// The metadata of the backup. This is only set within the last message of
+	// the stream, once the backup is complete.
+	Metadata             *DatabaseBackupMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
-func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
-func (*GraphTopologyUpdate) ProtoMessage()    {}
-func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{79}
+func (m *DatabaseBackupChunk) Reset()         { *m = DatabaseBackupChunk{} }
+func (m *DatabaseBackupChunk) String() string { return proto.CompactTextString(m) }
+func (*DatabaseBackupChunk) ProtoMessage()    {}
+func (*DatabaseBackupChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{109}
 }
-func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
+func (m *DatabaseBackupChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseBackupChunk.Unmarshal(m, b)
 }
-func (m *GraphTopologyUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GraphTopologyUpdate.Marshal(b, m, deterministic)
+func (m *DatabaseBackupChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseBackupChunk.Marshal(b, m, deterministic)
 }
-func (dst *GraphTopologyUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GraphTopologyUpdate.Merge(dst, src)
+func (dst *DatabaseBackupChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseBackupChunk.Merge(dst, src)
 }
-func (m *GraphTopologyUpdate) XXX_Size() int {
-	return xxx_messageInfo_GraphTopologyUpdate.Size(m)
+func (m *DatabaseBackupChunk) XXX_Size() int {
+	return xxx_messageInfo_DatabaseBackupChunk.Size(m)
 }
-func (m *GraphTopologyUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_GraphTopologyUpdate.DiscardUnknown(m)
+func (m *DatabaseBackupChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseBackupChunk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GraphTopologyUpdate proto.InternalMessageInfo
-
-func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
-	if m != nil {
-		return m.NodeUpdates
-	}
-	return nil
-}
+var xxx_messageInfo_DatabaseBackupChunk proto.InternalMessageInfo
 
-func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
+func (m *DatabaseBackupChunk) GetData() []byte {
 	if m != nil {
-		return m.ChannelUpdates
+		return m.Data
 	}
 	return nil
 }
 
-func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
+func (m *DatabaseBackupChunk) GetMetadata() *DatabaseBackupMetadata {
 	if m != nil {
-		return m.ClosedChans
+		return m.Metadata
 	}
 	return nil
 }
 
-type NodeUpdate struct {
-	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	IdentityKey          string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
-	GlobalFeatures       []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
-	Alias                string   `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+type PayReqString struct {
+	// / The payment request string to be decoded
+	PayReq               string   `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
-func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
-func (*NodeUpdate) ProtoMessage()    {}
-func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{80}
+func (m *PayReqString) Reset()         { *m = PayReqString{} }
+func (m *PayReqString) String() string { return proto.CompactTextString(m) }
+func (*PayReqString) ProtoMessage()    {}
+func (*PayReqString) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{110}
 }
-func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
+func (m *PayReqString) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
 }
-func (m *NodeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeUpdate.Marshal(b, m, deterministic)
+func (m *PayReqString) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayReqString.Marshal(b, m, deterministic)
 }
-func (dst *NodeUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeUpdate.Merge(dst, src)
+func (dst *PayReqString) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayReqString.Merge(dst, src)
 }
-func (m *NodeUpdate) XXX_Size() int {
-	return xxx_messageInfo_NodeUpdate.Size(m)
+func (m *PayReqString) XXX_Size() int {
+	return xxx_messageInfo_PayReqString.Size(m)
 }
-func (m *NodeUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeUpdate.DiscardUnknown(m)
+func (m *PayReqString) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayReqString.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeUpdate proto.InternalMessageInfo
-
-func (m *NodeUpdate) GetAddresses() []string {
-	if m != nil {
-		return m.Addresses
-	}
-	return nil
-}
+var xxx_messageInfo_PayReqString proto.InternalMessageInfo
 
-func (m *NodeUpdate) GetIdentityKey() string {
+func (m *PayReqString) GetPayReq() string {
 	if m != nil {
-		return m.IdentityKey
+		return m.PayReq
 	}
 	return ""
 }
 
-func (m *NodeUpdate) GetGlobalFeatures() []byte {
-	if m != nil {
-		return m.GlobalFeatures
-	}
-	return nil
+type PayReq struct {
+	Destination          string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	PaymentHash          string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	NumSatoshis          int64        `protobuf:"varint,3,opt,name=num_satoshis,proto3" json:"num_satoshis,omitempty"`
+	Timestamp            int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Expiry               int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Description          string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	DescriptionHash      string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	FallbackAddr         string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
+	CltvExpiry           int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
+	RouteHints           []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *NodeUpdate) GetAlias() string {
-	if m != nil {
-		return m.Alias
-	}
-	return ""
+func (m *PayReq) Reset()         { *m = PayReq{} }
+func (m *PayReq) String() string { return proto.CompactTextString(m) }
+func (*PayReq) ProtoMessage()    {}
+func (*PayReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{111}
 }
-
-type ChannelEdgeUpdate struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId               uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	ChanPoint            *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
-	Capacity             int64          `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	RoutingPolicy        *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy,proto3" json:"routing_policy,omitempty"`
-	AdvertisingNode      string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode,proto3" json:"advertising_node,omitempty"`
-	ConnectingNode       string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode,proto3" json:"connecting_node,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *PayReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayReq.Unmarshal(m, b)
 }
-
-func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
-func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelEdgeUpdate) ProtoMessage()    {}
-func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{81}
+func (m *PayReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayReq.Marshal(b, m, deterministic)
 }
-func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
+func (dst *PayReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayReq.Merge(dst, src)
 }
-func (m *ChannelEdgeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEdgeUpdate.Marshal(b, m, deterministic)
+func (m *PayReq) XXX_Size() int {
+	return xxx_messageInfo_PayReq.Size(m)
 }
-func (dst *ChannelEdgeUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEdgeUpdate.Merge(dst, src)
+func (m *PayReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayReq.DiscardUnknown(m)
 }
-func (m *ChannelEdgeUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelEdgeUpdate.Size(m)
+
+var xxx_messageInfo_PayReq proto.InternalMessageInfo
+
+func (m *PayReq) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
 }
-func (m *ChannelEdgeUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEdgeUpdate.DiscardUnknown(m)
+
+func (m *PayReq) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
 }
 
-var xxx_messageInfo_ChannelEdgeUpdate proto.InternalMessageInfo
-
-func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+func (m *PayReq) GetNumSatoshis() int64 {
 	if m != nil {
-		return m.ChanId
+		return m.NumSatoshis
 	}
 	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+func (m *PayReq) GetTimestamp() int64 {
 	if m != nil {
-		return m.ChanPoint
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetCapacity() int64 {
+func (m *PayReq) GetExpiry() int64 {
 	if m != nil {
-		return m.Capacity
+		return m.Expiry
 	}
 	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
+func (m *PayReq) GetDescription() string {
 	if m != nil {
-		return m.RoutingPolicy
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
+func (m *PayReq) GetDescriptionHash() string {
 	if m != nil {
-		return m.AdvertisingNode
+		return m.DescriptionHash
 	}
 	return ""
 }
 
-func (m *ChannelEdgeUpdate) GetConnectingNode() string {
+func (m *PayReq) GetFallbackAddr() string {
 	if m != nil {
-		return m.ConnectingNode
+		return m.FallbackAddr
 	}
 	return ""
 }
 
-type ClosedChannelUpdate struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId               uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	Capacity             int64         `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	ClosedHeight         uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
-	ChanPoint            *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *PayReq) GetCltvExpiry() int64 {
+	if m != nil {
+		return m.CltvExpiry
+	}
+	return 0
 }
 
-func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
-func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
-func (*ClosedChannelUpdate) ProtoMessage()    {}
-func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{82}
+func (m *PayReq) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
+	}
+	return nil
 }
-func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
+
+type FeeReportRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ClosedChannelUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ClosedChannelUpdate.Marshal(b, m, deterministic)
+
+func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
+func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeReportRequest) ProtoMessage()    {}
+func (*FeeReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{112}
 }
-func (dst *ClosedChannelUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClosedChannelUpdate.Merge(dst, src)
+func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
 }
-func (m *ClosedChannelUpdate) XXX_Size() int {
-	return xxx_messageInfo_ClosedChannelUpdate.Size(m)
+func (m *FeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeReportRequest.Marshal(b, m, deterministic)
 }
-func (m *ClosedChannelUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClosedChannelUpdate.DiscardUnknown(m)
+func (dst *FeeReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReportRequest.Merge(dst, src)
+}
+func (m *FeeReportRequest) XXX_Size() int {
+	return xxx_messageInfo_FeeReportRequest.Size(m)
+}
+func (m *FeeReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClosedChannelUpdate proto.InternalMessageInfo
+var xxx_messageInfo_FeeReportRequest proto.InternalMessageInfo
 
-func (m *ClosedChannelUpdate) GetChanId() uint64 {
+type ChannelFeeReport struct {
+	// / The channel that this fee report belongs to.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
+	// / The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	// / The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
+	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
+	// / The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
+	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
+func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
+func (*ChannelFeeReport) ProtoMessage()    {}
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{113}
+}
+func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
+}
+func (m *ChannelFeeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelFeeReport.Marshal(b, m, deterministic)
+}
+func (dst *ChannelFeeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFeeReport.Merge(dst, src)
+}
+func (m *ChannelFeeReport) XXX_Size() int {
+	return xxx_messageInfo_ChannelFeeReport.Size(m)
+}
+func (m *ChannelFeeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFeeReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFeeReport proto.InternalMessageInfo
+
+func (m *ChannelFeeReport) GetChanPoint() string {
 	if m != nil {
-		return m.ChanId
+		return m.ChanPoint
 	}
-	return 0
+	return ""
 }
 
-func (m *ClosedChannelUpdate) GetCapacity() int64 {
+func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
 	if m != nil {
-		return m.Capacity
+		return m.BaseFeeMsat
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
+func (m *ChannelFeeReport) GetFeePerMil() int64 {
 	if m != nil {
-		return m.ClosedHeight
+		return m.FeePerMil
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
+func (m *ChannelFeeReport) GetFeeRate() float64 {
 	if m != nil {
-		return m.ChanPoint
+		return m.FeeRate
 	}
-	return nil
+	return 0
 }
 
-type HopHint struct {
-	// / The public key of the node at the start of the channel.
-	NodeId string `protobuf:"bytes,1,opt,name=node_id,proto3" json:"node_id,omitempty"`
-	// / The unique identifier of the channel.
-	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	// / The base fee of the channel denominated in millisatoshis.
-	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
-	// *
-	// The fee rate of the channel for sending one satoshi across it denominated in
-	// millionths of a satoshi.
-	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths,proto3" json:"fee_proportional_millionths,omitempty"`
-	// / The time-lock delta of the channel.
-	CltvExpiryDelta      uint32   `protobuf:"varint,5,opt,name=cltv_expiry_delta,proto3" json:"cltv_expiry_delta,omitempty"`
+type FeeReportResponse struct {
+	// / An array of channel fee reports which describes the current fee schedule for each channel.
+	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
+	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum,proto3" json:"day_fee_sum,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
+	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum,proto3" json:"week_fee_sum,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
+	MonthFeeSum          uint64   `protobuf:"varint,4,opt,name=month_fee_sum,proto3" json:"month_fee_sum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HopHint) Reset()         { *m = HopHint{} }
-func (m *HopHint) String() string { return proto.CompactTextString(m) }
-func (*HopHint) ProtoMessage()    {}
-func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{83}
+func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
+func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeReportResponse) ProtoMessage()    {}
+func (*FeeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{114}
 }
-func (m *HopHint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HopHint.Unmarshal(m, b)
+func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
 }
-func (m *HopHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HopHint.Marshal(b, m, deterministic)
+func (m *FeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeReportResponse.Marshal(b, m, deterministic)
 }
-func (dst *HopHint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HopHint.Merge(dst, src)
+func (dst *FeeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReportResponse.Merge(dst, src)
 }
-func (m *HopHint) XXX_Size() int {
-	return xxx_messageInfo_HopHint.Size(m)
+func (m *FeeReportResponse) XXX_Size() int {
+	return xxx_messageInfo_FeeReportResponse.Size(m)
 }
-func (m *HopHint) XXX_DiscardUnknown() {
-	xxx_messageInfo_HopHint.DiscardUnknown(m)
+func (m *FeeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HopHint proto.InternalMessageInfo
-
-func (m *HopHint) GetNodeId() string {
-	if m != nil {
-		return m.NodeId
-	}
-	return ""
-}
+var xxx_messageInfo_FeeReportResponse proto.InternalMessageInfo
 
-func (m *HopHint) GetChanId() uint64 {
+func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
 	if m != nil {
-		return m.ChanId
+		return m.ChannelFees
 	}
-	return 0
+	return nil
 }
 
-func (m *HopHint) GetFeeBaseMsat() uint32 {
+func (m *FeeReportResponse) GetDayFeeSum() uint64 {
 	if m != nil {
-		return m.FeeBaseMsat
+		return m.DayFeeSum
 	}
 	return 0
 }
 
-func (m *HopHint) GetFeeProportionalMillionths() uint32 {
+func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
 	if m != nil {
-		return m.FeeProportionalMillionths
+		return m.WeekFeeSum
 	}
 	return 0
 }
 
-func (m *HopHint) GetCltvExpiryDelta() uint32 {
+func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
 	if m != nil {
-		return m.CltvExpiryDelta
+		return m.MonthFeeSum
 	}
 	return 0
 }
 
-type RouteHint struct {
-	// *
-	// A list of hop hints that when chained together can assist in reaching a
-	// specific destination.
-	HopHints             []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,proto3" json:"hop_hints,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type PolicyUpdateRequest struct {
+	// Types that are valid to be assigned to Scope:
+	//	*PolicyUpdateRequest_Global
+	//	*PolicyUpdateRequest_ChanPoint
+	//	*PolicyUpdateRequest_PeerPubKey
+	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
+	// / The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	// / The required timelock delta for HTLCs forwarded over the channel.
+	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+	// / The largest amount in milli-satoshis we'll forward for an HTLC arriving over the channel. If unset, the current limit is left unchanged.
+	MaxForwardAmtMsat uint64 `protobuf:"varint,6,opt,name=max_forward_amt_msat,proto3" json:"max_forward_amt_msat,omitempty"`
+	// / The maximum number of HTLCs the channel's peer may have pending forwarding through us at once. If unset, the current limit is left unchanged.
+	MaxPendingForwards uint32 `protobuf:"varint,7,opt,name=max_pending_forwards,proto3" json:"max_pending_forwards,omitempty"`
+	// / The maximum number of blocks in the future the timelock of an HTLC arriving over the channel may be set to. If unset, the current limit is left unchanged.
+	MaxCltvDelta uint32 `protobuf:"varint,8,opt,name=max_cltv_delta,proto3" json:"max_cltv_delta,omitempty"`
+	// / If set, all inbound limits of the channel are removed before any of the limits above are applied. Otherwise, an unset limit is left unchanged.
+	ClearInboundLimits   bool     `protobuf:"varint,10,opt,name=clear_inbound_limits,proto3" json:"clear_inbound_limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RouteHint) Reset()         { *m = RouteHint{} }
-func (m *RouteHint) String() string { return proto.CompactTextString(m) }
-func (*RouteHint) ProtoMessage()    {}
-func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{84}
+func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
+func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*PolicyUpdateRequest) ProtoMessage()    {}
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{115}
 }
-func (m *RouteHint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
+func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
 }
-func (m *RouteHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RouteHint.Marshal(b, m, deterministic)
+func (m *PolicyUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyUpdateRequest.Marshal(b, m, deterministic)
 }
-func (dst *RouteHint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RouteHint.Merge(dst, src)
+func (dst *PolicyUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyUpdateRequest.Merge(dst, src)
 }
-func (m *RouteHint) XXX_Size() int {
-	return xxx_messageInfo_RouteHint.Size(m)
+func (m *PolicyUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_PolicyUpdateRequest.Size(m)
 }
-func (m *RouteHint) XXX_DiscardUnknown() {
-	xxx_messageInfo_RouteHint.DiscardUnknown(m)
+func (m *PolicyUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyUpdateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RouteHint proto.InternalMessageInfo
+var xxx_messageInfo_PolicyUpdateRequest proto.InternalMessageInfo
 
-func (m *RouteHint) GetHopHints() []*HopHint {
-	if m != nil {
-		return m.HopHints
-	}
-	return nil
+type isPolicyUpdateRequest_Scope interface {
+	isPolicyUpdateRequest_Scope()
 }
 
-type Invoice struct {
-	// *
-	// An optional memo to attach along with the invoice. Used for record keeping
-	// purposes for the invoice's creator, and will also be set in the description
-	// field of the encoded payment request if the description_hash field is not
-	// being used.
-	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// / An optional cryptographic receipt of payment
-	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
-	// *
-	// The hex-encoded preimage (32 byte) which will allow settling an incoming
-	// HTLC payable to this preimage
-	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
-	// / The hash of the preimage
-	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	// / The value of this invoice in satoshis
-	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
-	// / Whether this invoice has been fulfilled
-	Settled bool `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"`
-	// / When this invoice was created
-	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
-	// / When this invoice was settled
-	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date,proto3" json:"settle_date,omitempty"`
-	// *
-	// A bare-bones invoice for a payment within the Lightning Network.  With the
-	// details of the invoice, the sender has all the data necessary to send a
-	// payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
-	// *
-	// Hash (SHA-256) of a description of the payment. Used if the description of
-	// payment (memo) is too long to naturally fit within the description field
-	// of an encoded payment request.
-	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	// / Payment request expiry time in seconds. Default is 3600 (1 hour).
-	Expiry int64 `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	// / Fallback on-chain address.
-	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
-	// / Delta to use for the time-lock of the CLTV extended to the final hop.
-	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
-	// *
-	// Route hints that can each be individually used to assist in reaching the
-	// invoice's destination.
-	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
-	// / Whether this invoice should include routing hints for private channels.
-	Private bool `protobuf:"varint,15,opt,name=private,proto3" json:"private,omitempty"`
-	// *
-	// The "add" index of this invoice. Each newly created invoice will increment
-	// this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all added
-	// invoices with an add_index greater than this one.
-	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
-	// *
-	// The "settle" index of this invoice. Each newly settled invoice will
-	// increment this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all
-	// settled invoices with an settle_index greater than this one.
-	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
-	// / Deprecated, use amt_paid_sat or amt_paid_msat.
-	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid,proto3" json:"amt_paid,omitempty"` // Deprecated: Do not use.
-	// *
-	// The amount that was accepted for this invoice, in satoshis. This will ONLY
-	// be set if this invoice has been settled. We provide this field as if the
-	// invoice was created with a zero value, then we need to record what amount
-	// was ultimately accepted. Additionally, it's possible that the sender paid
-	// MORE that was specified in the original invoice. So we'll record that here
-	// as well.
-	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat,proto3" json:"amt_paid_sat,omitempty"`
-	// *
-	// The amount that was accepted for this invoice, in millisatoshis. This will
-	// ONLY be set if this invoice has been settled. We provide this field as if
-	// the invoice was created with a zero value, then we need to record what
-	// amount was ultimately accepted. Additionally, it's possible that the sender
-	// paid MORE that was specified in the original invoice. So we'll record that
-	// here as well.
-	AmtPaidMsat          int64    `protobuf:"varint,20,opt,name=amt_paid_msat,proto3" json:"amt_paid_msat,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type PolicyUpdateRequest_Global struct {
+	Global bool `protobuf:"varint,1,opt,name=global,proto3,oneof"`
 }
 
-func (m *Invoice) Reset()         { *m = Invoice{} }
-func (m *Invoice) String() string { return proto.CompactTextString(m) }
-func (*Invoice) ProtoMessage()    {}
-func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{85}
-}
-func (m *Invoice) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Invoice.Unmarshal(m, b)
-}
-func (m *Invoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Invoice.Marshal(b, m, deterministic)
-}
-func (dst *Invoice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Invoice.Merge(dst, src)
-}
-func (m *Invoice) XXX_Size() int {
-	return xxx_messageInfo_Invoice.Size(m)
+type PolicyUpdateRequest_ChanPoint struct {
+	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,proto3,oneof"`
 }
-func (m *Invoice) XXX_DiscardUnknown() {
-	xxx_messageInfo_Invoice.DiscardUnknown(m)
+
+type PolicyUpdateRequest_PeerPubKey struct {
+	PeerPubKey string `protobuf:"bytes,9,opt,name=peer_pub_key,proto3,oneof"`
 }
 
-var xxx_messageInfo_Invoice proto.InternalMessageInfo
+func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope() {}
 
-func (m *Invoice) GetMemo() string {
+func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+
+func (*PolicyUpdateRequest_PeerPubKey) isPolicyUpdateRequest_Scope() {}
+
+func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
 	if m != nil {
-		return m.Memo
+		return m.Scope
 	}
-	return ""
+	return nil
 }
 
-func (m *Invoice) GetReceipt() []byte {
-	if m != nil {
-		return m.Receipt
+func (m *PolicyUpdateRequest) GetGlobal() bool {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
+		return x.Global
 	}
-	return nil
+	return false
 }
 
-func (m *Invoice) GetRPreimage() []byte {
-	if m != nil {
-		return m.RPreimage
+func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
+		return x.ChanPoint
 	}
 	return nil
 }
 
-func (m *Invoice) GetRHash() []byte {
+func (m *PolicyUpdateRequest) GetPeerPubKey() string {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_PeerPubKey); ok {
+		return x.PeerPubKey
+	}
+	return ""
+}
+
+func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
 	if m != nil {
-		return m.RHash
+		return m.BaseFeeMsat
 	}
-	return nil
+	return 0
 }
 
-func (m *Invoice) GetValue() int64 {
+func (m *PolicyUpdateRequest) GetFeeRate() float64 {
 	if m != nil {
-		return m.Value
+		return m.FeeRate
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettled() bool {
+func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
 	if m != nil {
-		return m.Settled
+		return m.TimeLockDelta
 	}
-	return false
+	return 0
 }
 
-func (m *Invoice) GetCreationDate() int64 {
+func (m *PolicyUpdateRequest) GetMaxForwardAmtMsat() uint64 {
 	if m != nil {
-		return m.CreationDate
+		return m.MaxForwardAmtMsat
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettleDate() int64 {
+func (m *PolicyUpdateRequest) GetMaxPendingForwards() uint32 {
 	if m != nil {
-		return m.SettleDate
+		return m.MaxPendingForwards
 	}
 	return 0
 }
 
-func (m *Invoice) GetPaymentRequest() string {
+func (m *PolicyUpdateRequest) GetMaxCltvDelta() uint32 {
 	if m != nil {
-		return m.PaymentRequest
+		return m.MaxCltvDelta
 	}
-	return ""
+	return 0
 }
 
-func (m *Invoice) GetDescriptionHash() []byte {
+func (m *PolicyUpdateRequest) GetClearInboundLimits() bool {
 	if m != nil {
-		return m.DescriptionHash
+		return m.ClearInboundLimits
+	}
+	return false
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
+		(*PolicyUpdateRequest_Global)(nil),
+		(*PolicyUpdateRequest_ChanPoint)(nil),
+		(*PolicyUpdateRequest_PeerPubKey)(nil),
+	}
+}
+
+func _PolicyUpdateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*PolicyUpdateRequest)
+	// scope
+	switch x := m.Scope.(type) {
+	case *PolicyUpdateRequest_Global:
+		t := uint64(0)
+		if x.Global {
+			t = 1
+		}
+		b.EncodeVarint(1<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case *PolicyUpdateRequest_ChanPoint:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanPoint); err != nil {
+			return err
+		}
+	case *PolicyUpdateRequest_PeerPubKey:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.PeerPubKey)
+	case nil:
+	default:
+		return fmt.Errorf("PolicyUpdateRequest.Scope has unexpected type %T", x)
 	}
 	return nil
 }
 
-func (m *Invoice) GetExpiry() int64 {
-	if m != nil {
-		return m.Expiry
+func _PolicyUpdateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*PolicyUpdateRequest)
+	switch tag {
+	case 1: // scope.global
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Scope = &PolicyUpdateRequest_Global{x != 0}
+		return true, err
+	case 2: // scope.chan_point
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelPoint)
+		err := b.DecodeMessage(msg)
+		m.Scope = &PolicyUpdateRequest_ChanPoint{msg}
+		return true, err
+	case 9: // scope.peer_pub_key
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Scope = &PolicyUpdateRequest_PeerPubKey{x}
+		return true, err
+	default:
+		return false, nil
 	}
-	return 0
 }
 
-func (m *Invoice) GetFallbackAddr() string {
-	if m != nil {
-		return m.FallbackAddr
+func _PolicyUpdateRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*PolicyUpdateRequest)
+	// scope
+	switch x := m.Scope.(type) {
+	case *PolicyUpdateRequest_Global:
+		n += 1 // tag and wire
+		n += 1
+	case *PolicyUpdateRequest_ChanPoint:
+		s := proto.Size(x.ChanPoint)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PolicyUpdateRequest_PeerPubKey:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.PeerPubKey)))
+		n += len(x.PeerPubKey)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
-	return ""
+	return n
 }
 
-func (m *Invoice) GetCltvExpiry() uint64 {
-	if m != nil {
-		return m.CltvExpiry
-	}
-	return 0
+type PolicyUpdateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Invoice) GetRouteHints() []*RouteHint {
-	if m != nil {
-		return m.RouteHints
-	}
-	return nil
+func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
+func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*PolicyUpdateResponse) ProtoMessage()    {}
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{116}
 }
-
-func (m *Invoice) GetPrivate() bool {
-	if m != nil {
-		return m.Private
-	}
-	return false
+func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
 }
-
-func (m *Invoice) GetAddIndex() uint64 {
-	if m != nil {
-		return m.AddIndex
-	}
-	return 0
+func (m *PolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyUpdateResponse.Marshal(b, m, deterministic)
 }
-
-func (m *Invoice) GetSettleIndex() uint64 {
-	if m != nil {
-		return m.SettleIndex
-	}
-	return 0
+func (dst *PolicyUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyUpdateResponse.Merge(dst, src)
 }
-
-// Deprecated: Do not use.
-func (m *Invoice) GetAmtPaid() int64 {
-	if m != nil {
-		return m.AmtPaid
-	}
-	return 0
+func (m *PolicyUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_PolicyUpdateResponse.Size(m)
 }
-
-func (m *Invoice) GetAmtPaidSat() int64 {
-	if m != nil {
-		return m.AmtPaidSat
-	}
-	return 0
+func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
 }
 
-func (m *Invoice) GetAmtPaidMsat() int64 {
-	if m != nil {
-		return m.AmtPaidMsat
-	}
-	return 0
-}
+var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
 
-type AddInvoiceResponse struct {
-	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	// *
-	// A bare-bones invoice for a payment within the Lightning Network.  With the
-	// details of the invoice, the sender has all the data necessary to send a
-	// payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
-	// *
-	// The "add" index of this invoice. Each newly created invoice will increment
-	// this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all added
-	// invoices with an add_index greater than this one.
-	AddIndex             uint64   `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
+type FeeDecisionsRequest struct {
+	// / If set, only the decisions made for this channel will be returned.
+	ChanPoint            string   `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
-func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
-func (*AddInvoiceResponse) ProtoMessage()    {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{86}
+func (m *FeeDecisionsRequest) Reset()         { *m = FeeDecisionsRequest{} }
+func (m *FeeDecisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeDecisionsRequest) ProtoMessage()    {}
+func (*FeeDecisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{117}
 }
-func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
+func (m *FeeDecisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeDecisionsRequest.Unmarshal(m, b)
 }
-func (m *AddInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddInvoiceResponse.Marshal(b, m, deterministic)
+func (m *FeeDecisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeDecisionsRequest.Marshal(b, m, deterministic)
 }
-func (dst *AddInvoiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddInvoiceResponse.Merge(dst, src)
+func (dst *FeeDecisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDecisionsRequest.Merge(dst, src)
 }
-func (m *AddInvoiceResponse) XXX_Size() int {
-	return xxx_messageInfo_AddInvoiceResponse.Size(m)
+func (m *FeeDecisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_FeeDecisionsRequest.Size(m)
 }
-func (m *AddInvoiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddInvoiceResponse.DiscardUnknown(m)
+func (m *FeeDecisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDecisionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddInvoiceResponse proto.InternalMessageInfo
-
-func (m *AddInvoiceResponse) GetRHash() []byte {
-	if m != nil {
-		return m.RHash
-	}
-	return nil
-}
+var xxx_messageInfo_FeeDecisionsRequest proto.InternalMessageInfo
 
-func (m *AddInvoiceResponse) GetPaymentRequest() string {
+func (m *FeeDecisionsRequest) GetChanPoint() string {
 	if m != nil {
-		return m.PaymentRequest
+		return m.ChanPoint
 	}
 	return ""
 }
 
-func (m *AddInvoiceResponse) GetAddIndex() uint64 {
-	if m != nil {
-		return m.AddIndex
-	}
-	return 0
-}
-
-type PaymentHash struct {
-	// *
-	// The hex-encoded payment hash of the invoice to be looked up. The passed
-	// payment hash must be exactly 32 bytes, otherwise an error is returned.
-	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str,proto3" json:"r_hash_str,omitempty"`
-	// / The payment hash of the invoice to be looked up.
-	RHash                []byte   `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+type FeeDecision struct {
+	// / The channel that this decision was made for.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	// / The unix timestamp in seconds at which the decision was made.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / The fraction of the channel's capacity that was on our side.
+	LocalRatio float64 `protobuf:"fixed64,3,opt,name=local_ratio,proto3" json:"local_ratio,omitempty"`
+	// / The amount in milli-satoshis forwarded out over the channel within the forwarding window.
+	ForwardVolumeMsat uint64 `protobuf:"varint,4,opt,name=forward_volume_msat,proto3" json:"forward_volume_msat,omitempty"`
+	// / The base fee in milli-satoshis before the decision.
+	OldBaseFeeMsat int64 `protobuf:"varint,5,opt,name=old_base_fee_msat,proto3" json:"old_base_fee_msat,omitempty"`
+	// / The fee rate expressed in millionths before the decision.
+	OldFeePerMil int64 `protobuf:"varint,6,opt,name=old_fee_per_mil,proto3" json:"old_fee_per_mil,omitempty"`
+	// / The base fee in milli-satoshis computed for the channel.
+	NewBaseFeeMsat int64 `protobuf:"varint,7,opt,name=new_base_fee_msat,proto3" json:"new_base_fee_msat,omitempty"`
+	// / The fee rate expressed in millionths computed for the channel.
+	NewFeePerMil int64 `protobuf:"varint,8,opt,name=new_fee_per_mil,proto3" json:"new_fee_per_mil,omitempty"`
+	// / Whether the computed fees were applied to the channel.
+	Applied bool `protobuf:"varint,9,opt,name=applied,proto3" json:"applied,omitempty"`
+	// / A human readable explanation of the decision.
+	Reason               string   `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
-func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
-func (*PaymentHash) ProtoMessage()    {}
-func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{87}
+func (m *FeeDecision) Reset()         { *m = FeeDecision{} }
+func (m *FeeDecision) String() string { return proto.CompactTextString(m) }
+func (*FeeDecision) ProtoMessage()    {}
+func (*FeeDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{118}
 }
-func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
+func (m *FeeDecision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeDecision.Unmarshal(m, b)
 }
-func (m *PaymentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaymentHash.Marshal(b, m, deterministic)
+func (m *FeeDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeDecision.Marshal(b, m, deterministic)
 }
-func (dst *PaymentHash) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentHash.Merge(dst, src)
+func (dst *FeeDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDecision.Merge(dst, src)
 }
-func (m *PaymentHash) XXX_Size() int {
-	return xxx_messageInfo_PaymentHash.Size(m)
+func (m *FeeDecision) XXX_Size() int {
+	return xxx_messageInfo_FeeDecision.Size(m)
 }
-func (m *PaymentHash) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentHash.DiscardUnknown(m)
+func (m *FeeDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDecision.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaymentHash proto.InternalMessageInfo
+var xxx_messageInfo_FeeDecision proto.InternalMessageInfo
 
-func (m *PaymentHash) GetRHashStr() string {
+func (m *FeeDecision) GetChanPoint() string {
 	if m != nil {
-		return m.RHashStr
+		return m.ChanPoint
 	}
 	return ""
 }
 
-func (m *PaymentHash) GetRHash() []byte {
+func (m *FeeDecision) GetTimestamp() int64 {
 	if m != nil {
-		return m.RHash
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-type ListInvoiceRequest struct {
-	// / If set, only unsettled invoices will be returned in the response.
-	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only,proto3" json:"pending_only,omitempty"`
-	// *
-	// The index of an invoice that will be used as either the start or end of a
-	// query to determine which invoices should be returned in the response.
-	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
-	// / The max number of invoices to return in the response to this query.
-	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices,proto3" json:"num_max_invoices,omitempty"`
-	// *
-	// If set, the invoices returned will result from seeking backwards from the
-	// specified index offset. This can be used to paginate backwards.
-	Reversed             bool     `protobuf:"varint,6,opt,name=reversed,proto3" json:"reversed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *FeeDecision) GetLocalRatio() float64 {
+	if m != nil {
+		return m.LocalRatio
+	}
+	return 0
 }
 
-func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
-func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
-func (*ListInvoiceRequest) ProtoMessage()    {}
-func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{88}
-}
-func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
-}
-func (m *ListInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListInvoiceRequest.Marshal(b, m, deterministic)
-}
-func (dst *ListInvoiceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListInvoiceRequest.Merge(dst, src)
-}
-func (m *ListInvoiceRequest) XXX_Size() int {
-	return xxx_messageInfo_ListInvoiceRequest.Size(m)
-}
-func (m *ListInvoiceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListInvoiceRequest.DiscardUnknown(m)
+func (m *FeeDecision) GetForwardVolumeMsat() uint64 {
+	if m != nil {
+		return m.ForwardVolumeMsat
+	}
+	return 0
 }
 
-var xxx_messageInfo_ListInvoiceRequest proto.InternalMessageInfo
+func (m *FeeDecision) GetOldBaseFeeMsat() int64 {
+	if m != nil {
+		return m.OldBaseFeeMsat
+	}
+	return 0
+}
 
-func (m *ListInvoiceRequest) GetPendingOnly() bool {
+func (m *FeeDecision) GetOldFeePerMil() int64 {
 	if m != nil {
-		return m.PendingOnly
+		return m.OldFeePerMil
 	}
-	return false
+	return 0
 }
 
-func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
+func (m *FeeDecision) GetNewBaseFeeMsat() int64 {
 	if m != nil {
-		return m.IndexOffset
+		return m.NewBaseFeeMsat
 	}
 	return 0
 }
 
-func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
+func (m *FeeDecision) GetNewFeePerMil() int64 {
 	if m != nil {
-		return m.NumMaxInvoices
+		return m.NewFeePerMil
 	}
 	return 0
 }
 
-func (m *ListInvoiceRequest) GetReversed() bool {
+func (m *FeeDecision) GetApplied() bool {
 	if m != nil {
-		return m.Reversed
+		return m.Applied
 	}
 	return false
 }
 
-type ListInvoiceResponse struct {
-	// *
-	// A list of invoices from the time slice of the time series specified in the
-	// request.
-	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
-	// *
-	// The index of the last item in the set of returned invoices. This can be used
-	// to seek further, pagination style.
-	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset,proto3" json:"last_index_offset,omitempty"`
-	// *
-	// The index of the last item in the set of returned invoices. This can be used
-	// to seek backwards, pagination style.
-	FirstIndexOffset     uint64   `protobuf:"varint,3,opt,name=first_index_offset,proto3" json:"first_index_offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *FeeDecision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
-func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
-func (*ListInvoiceResponse) ProtoMessage()    {}
-func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{89}
+type FeeDecisionsResponse struct {
+	// / The recent fee decisions, ordered by the time they were made.
+	Decisions            []*FeeDecision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
+
+func (m *FeeDecisionsResponse) Reset()         { *m = FeeDecisionsResponse{} }
+func (m *FeeDecisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeDecisionsResponse) ProtoMessage()    {}
+func (*FeeDecisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{119}
 }
-func (m *ListInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListInvoiceResponse.Marshal(b, m, deterministic)
+func (m *FeeDecisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeDecisionsResponse.Unmarshal(m, b)
 }
-func (dst *ListInvoiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListInvoiceResponse.Merge(dst, src)
+func (m *FeeDecisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeDecisionsResponse.Marshal(b, m, deterministic)
 }
-func (m *ListInvoiceResponse) XXX_Size() int {
-	return xxx_messageInfo_ListInvoiceResponse.Size(m)
+func (dst *FeeDecisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDecisionsResponse.Merge(dst, src)
 }
-func (m *ListInvoiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListInvoiceResponse.DiscardUnknown(m)
+func (m *FeeDecisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_FeeDecisionsResponse.Size(m)
 }
-
-var xxx_messageInfo_ListInvoiceResponse proto.InternalMessageInfo
-
-func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
-	if m != nil {
-		return m.Invoices
-	}
-	return nil
+func (m *FeeDecisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDecisionsResponse.DiscardUnknown(m)
 }
 
-func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
-	if m != nil {
-		return m.LastIndexOffset
-	}
-	return 0
-}
+var xxx_messageInfo_FeeDecisionsResponse proto.InternalMessageInfo
 
-func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
+func (m *FeeDecisionsResponse) GetDecisions() []*FeeDecision {
 	if m != nil {
-		return m.FirstIndexOffset
+		return m.Decisions
 	}
-	return 0
+	return nil
 }
 
-type InvoiceSubscription struct {
-	// *
-	// If specified (non-zero), then we'll first start by sending out
-	// notifications for all added indexes with an add_index greater than this
-	// value. This allows callers to catch up on any events they missed while they
-	// weren't connected to the streaming RPC.
-	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index,proto3" json:"add_index,omitempty"`
-	// *
-	// If specified (non-zero), then we'll first start by sending out
-	// notifications for all settled indexes with an settle_index greater than
-	// this value. This allows callers to catch up on any events they missed while
-	// they weren't connected to the streaming RPC.
-	SettleIndex          uint64   `protobuf:"varint,2,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
+type ForwardingHistoryRequest struct {
+	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	// / End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	// / Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
+	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
+	// / The max number of events to return in the response to this query.
+	NumMaxEvents         uint32   `protobuf:"varint,4,opt,name=num_max_events,proto3" json:"num_max_events,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
-func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
-func (*InvoiceSubscription) ProtoMessage()    {}
-func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{90}
+func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryRequest{} }
+func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ForwardingHistoryRequest) ProtoMessage()    {}
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{120}
 }
-func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
+func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
 }
-func (m *InvoiceSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InvoiceSubscription.Marshal(b, m, deterministic)
+func (m *ForwardingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingHistoryRequest.Marshal(b, m, deterministic)
 }
-func (dst *InvoiceSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvoiceSubscription.Merge(dst, src)
+func (dst *ForwardingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingHistoryRequest.Merge(dst, src)
 }
-func (m *InvoiceSubscription) XXX_Size() int {
-	return xxx_messageInfo_InvoiceSubscription.Size(m)
+func (m *ForwardingHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ForwardingHistoryRequest.Size(m)
 }
-func (m *InvoiceSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvoiceSubscription.DiscardUnknown(m)
+func (m *ForwardingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InvoiceSubscription proto.InternalMessageInfo
+var xxx_messageInfo_ForwardingHistoryRequest proto.InternalMessageInfo
 
-func (m *InvoiceSubscription) GetAddIndex() uint64 {
+func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
 	if m != nil {
-		return m.AddIndex
+		return m.StartTime
 	}
 	return 0
 }
 
-func (m *InvoiceSubscription) GetSettleIndex() uint64 {
+func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
 	if m != nil {
-		return m.SettleIndex
+		return m.EndTime
 	}
 	return 0
 }
 
-type Payment struct {
-	// / The payment hash
-	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
-	// / Deprecated, use value_sat or value_msat.
-	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
-	// / The date of this payment
-	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
-	// / The path this payment took
-	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
-	// / The fee paid for this payment in satoshis
-	Fee int64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
-	// / The payment preimage
-	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
-	// / The value of the payment in satoshis
-	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat,proto3" json:"value_sat,omitempty"`
-	// / The value of the payment in milli-satoshis
-	ValueMsat            int64    `protobuf:"varint,8,opt,name=value_msat,proto3" json:"value_msat,omitempty"`
+func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
+	if m != nil {
+		return m.NumMaxEvents
+	}
+	return 0
+}
+
+type ForwardingEvent struct {
+	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / The incoming channel ID that carried the HTLC that created the circuit.
+	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in,proto3" json:"chan_id_in,omitempty"`
+	// / The outgoing channel ID that carried the preimage that completed the circuit.
+	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out,proto3" json:"chan_id_out,omitempty"`
+	// / The total amount (in satoshis) of the incoming HTLC that created half the circuit.
+	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in,proto3" json:"amt_in,omitempty"`
+	// / The total amount (in satoshis) of the outgoing HTLC that created the second half of the circuit.
+	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out,proto3" json:"amt_out,omitempty"`
+	// / The total fee (in satoshis) that this payment circuit carried.
+	Fee uint64 `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	// / The total fee (in milli-satoshis) that this payment circuit carried.
+	FeeMsat              uint64   `protobuf:"varint,8,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Payment) Reset()         { *m = Payment{} }
-func (m *Payment) String() string { return proto.CompactTextString(m) }
-func (*Payment) ProtoMessage()    {}
-func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{91}
+func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
+func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardingEvent) ProtoMessage()    {}
+func (*ForwardingEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{121}
 }
-func (m *Payment) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Payment.Unmarshal(m, b)
+func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
 }
-func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
+func (m *ForwardingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingEvent.Marshal(b, m, deterministic)
 }
-func (dst *Payment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Payment.Merge(dst, src)
+func (dst *ForwardingEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingEvent.Merge(dst, src)
 }
-func (m *Payment) XXX_Size() int {
-	return xxx_messageInfo_Payment.Size(m)
+func (m *ForwardingEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardingEvent.Size(m)
 }
-func (m *Payment) XXX_DiscardUnknown() {
-	xxx_messageInfo_Payment.DiscardUnknown(m)
+func (m *ForwardingEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Payment proto.InternalMessageInfo
-
-func (m *Payment) GetPaymentHash() string {
-	if m != nil {
-		return m.PaymentHash
-	}
-	return ""
-}
+var xxx_messageInfo_ForwardingEvent proto.InternalMessageInfo
 
-// Deprecated: Do not use.
-func (m *Payment) GetValue() int64 {
+func (m *ForwardingEvent) GetTimestamp() uint64 {
 	if m != nil {
-		return m.Value
+		return m.Timestamp
 	}
 	return 0
 }
 
-func (m *Payment) GetCreationDate() int64 {
+func (m *ForwardingEvent) GetChanIdIn() uint64 {
 	if m != nil {
-		return m.CreationDate
+		return m.ChanIdIn
 	}
 	return 0
 }
 
-func (m *Payment) GetPath() []string {
+func (m *ForwardingEvent) GetChanIdOut() uint64 {
 	if m != nil {
-		return m.Path
+		return m.ChanIdOut
 	}
-	return nil
+	return 0
 }
 
-func (m *Payment) GetFee() int64 {
+func (m *ForwardingEvent) GetAmtIn() uint64 {
 	if m != nil {
-		return m.Fee
+		return m.AmtIn
 	}
 	return 0
 }
 
-func (m *Payment) GetPaymentPreimage() string {
+func (m *ForwardingEvent) GetAmtOut() uint64 {
 	if m != nil {
-		return m.PaymentPreimage
+		return m.AmtOut
 	}
-	return ""
+	return 0
 }
 
-func (m *Payment) GetValueSat() int64 {
+func (m *ForwardingEvent) GetFee() uint64 {
 	if m != nil {
-		return m.ValueSat
+		return m.Fee
 	}
 	return 0
 }
 
-func (m *Payment) GetValueMsat() int64 {
+func (m *ForwardingEvent) GetFeeMsat() uint64 {
 	if m != nil {
-		return m.ValueMsat
+		return m.FeeMsat
 	}
 	return 0
 }
 
-type ListPaymentsRequest struct {
+type ForwardingHistoryResponse struct {
+	// / A list of forwarding events from the time slice of the time series specified in the request.
+	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events,proto3" json:"forwarding_events,omitempty"`
+	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
+	LastOffsetIndex      uint32   `protobuf:"varint,2,opt,name=last_offset_index,proto3" json:"last_offset_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
-func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListPaymentsRequest) ProtoMessage()    {}
-func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{92}
+func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResponse{} }
+func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ForwardingHistoryResponse) ProtoMessage()    {}
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{122}
 }
-func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
+func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
 }
-func (m *ListPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPaymentsRequest.Marshal(b, m, deterministic)
+func (m *ForwardingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingHistoryResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPaymentsRequest.Merge(dst, src)
+func (dst *ForwardingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingHistoryResponse.Merge(dst, src)
 }
-func (m *ListPaymentsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListPaymentsRequest.Size(m)
+func (m *ForwardingHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ForwardingHistoryResponse.Size(m)
 }
-func (m *ListPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPaymentsRequest.DiscardUnknown(m)
+func (m *ForwardingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ForwardingHistoryResponse proto.InternalMessageInfo
 
-type ListPaymentsResponse struct {
-	// / The list of payments
-	Payments             []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+	if m != nil {
+		return m.ForwardingEvents
+	}
+	return nil
 }
 
-func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
-func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListPaymentsResponse) ProtoMessage()    {}
-func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{93}
+func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+	if m != nil {
+		return m.LastOffsetIndex
+	}
+	return 0
 }
-func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
+
+type AccountingReportRequest struct {
+	// / The start of the time range (unix epoch offset) to return the ledger for, inclusive.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	// / The end of the time range (unix epoch offset) to return the ledger for, inclusive. If zero, the current time is used.
+	EndTime              uint64   `protobuf:"varint,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ListPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPaymentsResponse.Marshal(b, m, deterministic)
+
+func (m *AccountingReportRequest) Reset()         { *m = AccountingReportRequest{} }
+func (m *AccountingReportRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountingReportRequest) ProtoMessage()    {}
+func (*AccountingReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{123}
 }
-func (dst *ListPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPaymentsResponse.Merge(dst, src)
+func (m *AccountingReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountingReportRequest.Unmarshal(m, b)
 }
-func (m *ListPaymentsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListPaymentsResponse.Size(m)
+func (m *AccountingReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountingReportRequest.Marshal(b, m, deterministic)
 }
-func (m *ListPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPaymentsResponse.DiscardUnknown(m)
+func (dst *AccountingReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountingReportRequest.Merge(dst, src)
+}
+func (m *AccountingReportRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountingReportRequest.Size(m)
+}
+func (m *AccountingReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountingReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountingReportRequest proto.InternalMessageInfo
+
+func (m *AccountingReportRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
 }
 
-var xxx_messageInfo_ListPaymentsResponse proto.InternalMessageInfo
-
-func (m *ListPaymentsResponse) GetPayments() []*Payment {
+func (m *AccountingReportRequest) GetEndTime() uint64 {
 	if m != nil {
-		return m.Payments
+		return m.EndTime
 	}
-	return nil
+	return 0
 }
 
-type DeleteAllPaymentsRequest struct {
+type LedgerEntry struct {
+	// / The time (unix epoch offset) the entry was recorded at.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / The type of the entry.
+	Type LedgerEntry_EntryType `protobuf:"varint,2,opt,name=type,proto3,enum=lnrpc.LedgerEntry_EntryType" json:"type,omitempty"`
+	// / The net change in our balance in millisatoshis, including any fees paid. Negative amounts are debits.
+	AmountMsat int64 `protobuf:"varint,3,opt,name=amount_msat,proto3" json:"amount_msat,omitempty"`
+	// / The fee paid by us as part of the entry in millisatoshis, which is already included in the amount.
+	FeeMsat uint64 `protobuf:"varint,4,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	// *
+	// The source record of the entry. This is the txid of the wallet transaction
+	// for on-chain entries, the payment hash for payments, and the timestamp in
+	// nanoseconds under which the event is stored in the forwarding log for
+	// routing fee income.
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	// / The channel the entry relates to, only set for channel opens and closes.
+	ChannelPoint         string   `protobuf:"bytes,6,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsRequest{} }
-func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
-func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{94}
+func (m *LedgerEntry) Reset()         { *m = LedgerEntry{} }
+func (m *LedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*LedgerEntry) ProtoMessage()    {}
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{124}
 }
-func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
+func (m *LedgerEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerEntry.Unmarshal(m, b)
 }
-func (m *DeleteAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAllPaymentsRequest.Marshal(b, m, deterministic)
+func (m *LedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerEntry.Marshal(b, m, deterministic)
 }
-func (dst *DeleteAllPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAllPaymentsRequest.Merge(dst, src)
+func (dst *LedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerEntry.Merge(dst, src)
 }
-func (m *DeleteAllPaymentsRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteAllPaymentsRequest.Size(m)
+func (m *LedgerEntry) XXX_Size() int {
+	return xxx_messageInfo_LedgerEntry.Size(m)
 }
-func (m *DeleteAllPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAllPaymentsRequest.DiscardUnknown(m)
+func (m *LedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAllPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_LedgerEntry proto.InternalMessageInfo
 
-type DeleteAllPaymentsResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *LedgerEntry) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResponse{} }
-func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
-func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{95}
-}
-func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
-}
-func (m *DeleteAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAllPaymentsResponse.Marshal(b, m, deterministic)
+func (m *LedgerEntry) GetType() LedgerEntry_EntryType {
+	if m != nil {
+		return m.Type
+	}
+	return LedgerEntry_ONCHAIN_RECEIVE
 }
-func (dst *DeleteAllPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAllPaymentsResponse.Merge(dst, src)
+
+func (m *LedgerEntry) GetAmountMsat() int64 {
+	if m != nil {
+		return m.AmountMsat
+	}
+	return 0
 }
-func (m *DeleteAllPaymentsResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteAllPaymentsResponse.Size(m)
+
+func (m *LedgerEntry) GetFeeMsat() uint64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
 }
-func (m *DeleteAllPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAllPaymentsResponse.DiscardUnknown(m)
+
+func (m *LedgerEntry) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
 }
 
-var xxx_messageInfo_DeleteAllPaymentsResponse proto.InternalMessageInfo
+func (m *LedgerEntry) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
 
-type AbandonChannelRequest struct {
-	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type AccountingReportResponse struct {
+	// / The entries within the time range, ordered by their timestamp.
+	Entries              []*LedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
-func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
-func (*AbandonChannelRequest) ProtoMessage()    {}
-func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{96}
+func (m *AccountingReportResponse) Reset()         { *m = AccountingReportResponse{} }
+func (m *AccountingReportResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountingReportResponse) ProtoMessage()    {}
+func (*AccountingReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{125}
 }
-func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
+func (m *AccountingReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountingReportResponse.Unmarshal(m, b)
 }
-func (m *AbandonChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AbandonChannelRequest.Marshal(b, m, deterministic)
+func (m *AccountingReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountingReportResponse.Marshal(b, m, deterministic)
 }
-func (dst *AbandonChannelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AbandonChannelRequest.Merge(dst, src)
+func (dst *AccountingReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountingReportResponse.Merge(dst, src)
 }
-func (m *AbandonChannelRequest) XXX_Size() int {
-	return xxx_messageInfo_AbandonChannelRequest.Size(m)
+func (m *AccountingReportResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountingReportResponse.Size(m)
 }
-func (m *AbandonChannelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AbandonChannelRequest.DiscardUnknown(m)
+func (m *AccountingReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountingReportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AbandonChannelRequest proto.InternalMessageInfo
+var xxx_messageInfo_AccountingReportResponse proto.InternalMessageInfo
 
-func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
+func (m *AccountingReportResponse) GetEntries() []*LedgerEntry {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Entries
 	}
 	return nil
 }
 
-type AbandonChannelResponse struct {
+type CircuitKey struct {
+	// / The id of the channel that is part of this circuit.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The index of the incoming htlc in the incoming channel.
+	HtlcId               uint64   `protobuf:"varint,2,opt,name=htlc_id,proto3" json:"htlc_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{} }
-func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
-func (*AbandonChannelResponse) ProtoMessage()    {}
-func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{97}
+func (m *CircuitKey) Reset()         { *m = CircuitKey{} }
+func (m *CircuitKey) String() string { return proto.CompactTextString(m) }
+func (*CircuitKey) ProtoMessage()    {}
+func (*CircuitKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{126}
 }
-func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
+func (m *CircuitKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitKey.Unmarshal(m, b)
 }
-func (m *AbandonChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AbandonChannelResponse.Marshal(b, m, deterministic)
+func (m *CircuitKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitKey.Marshal(b, m, deterministic)
 }
-func (dst *AbandonChannelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AbandonChannelResponse.Merge(dst, src)
+func (dst *CircuitKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitKey.Merge(dst, src)
 }
-func (m *AbandonChannelResponse) XXX_Size() int {
-	return xxx_messageInfo_AbandonChannelResponse.Size(m)
+func (m *CircuitKey) XXX_Size() int {
+	return xxx_messageInfo_CircuitKey.Size(m)
 }
-func (m *AbandonChannelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AbandonChannelResponse.DiscardUnknown(m)
+func (m *CircuitKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AbandonChannelResponse proto.InternalMessageInfo
+var xxx_messageInfo_CircuitKey proto.InternalMessageInfo
 
-type DebugLevelRequest struct {
-	Show                 bool     `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
-	LevelSpec            string   `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
+func (m *CircuitKey) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *CircuitKey) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+type ForwardHtlcInterceptRequest struct {
+	// / The key of this forwarded htlc, which is used to identify it when resolving it.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key,proto3" json:"incoming_circuit_key,omitempty"`
+	// / The incoming htlc amount in milli-satoshis.
+	IncomingAmountMsat uint64 `protobuf:"varint,2,opt,name=incoming_amount_msat,proto3" json:"incoming_amount_msat,omitempty"`
+	// / The incoming htlc expiry.
+	IncomingExpiry uint32 `protobuf:"varint,3,opt,name=incoming_expiry,proto3" json:"incoming_expiry,omitempty"`
+	// / The htlc payment hash.
+	PaymentHash []byte `protobuf:"bytes,4,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The id of the channel the sender requested the htlc be forwarded over.
+	OutgoingRequestedChanId uint64 `protobuf:"varint,5,opt,name=outgoing_requested_chan_id,proto3" json:"outgoing_requested_chan_id,omitempty"`
+	// / The outgoing htlc amount in milli-satoshis.
+	OutgoingAmountMsat uint64 `protobuf:"varint,6,opt,name=outgoing_amount_msat,proto3" json:"outgoing_amount_msat,omitempty"`
+	// / The outgoing htlc expiry.
+	OutgoingExpiry       uint32   `protobuf:"varint,7,opt,name=outgoing_expiry,proto3" json:"outgoing_expiry,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
-func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
-func (*DebugLevelRequest) ProtoMessage()    {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{98}
+func (m *ForwardHtlcInterceptRequest) Reset()         { *m = ForwardHtlcInterceptRequest{} }
+func (m *ForwardHtlcInterceptRequest) String() string { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptRequest) ProtoMessage()    {}
+func (*ForwardHtlcInterceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{127}
 }
-func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
+func (m *ForwardHtlcInterceptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Unmarshal(m, b)
 }
-func (m *DebugLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DebugLevelRequest.Marshal(b, m, deterministic)
+func (m *ForwardHtlcInterceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Marshal(b, m, deterministic)
 }
-func (dst *DebugLevelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugLevelRequest.Merge(dst, src)
+func (dst *ForwardHtlcInterceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHtlcInterceptRequest.Merge(dst, src)
 }
-func (m *DebugLevelRequest) XXX_Size() int {
-	return xxx_messageInfo_DebugLevelRequest.Size(m)
+func (m *ForwardHtlcInterceptRequest) XXX_Size() int {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Size(m)
 }
-func (m *DebugLevelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugLevelRequest.DiscardUnknown(m)
+func (m *ForwardHtlcInterceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHtlcInterceptRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DebugLevelRequest proto.InternalMessageInfo
+var xxx_messageInfo_ForwardHtlcInterceptRequest proto.InternalMessageInfo
 
-func (m *DebugLevelRequest) GetShow() bool {
+func (m *ForwardHtlcInterceptRequest) GetIncomingCircuitKey() *CircuitKey {
 	if m != nil {
-		return m.Show
+		return m.IncomingCircuitKey
 	}
-	return false
+	return nil
 }
 
-func (m *DebugLevelRequest) GetLevelSpec() string {
+func (m *ForwardHtlcInterceptRequest) GetIncomingAmountMsat() uint64 {
 	if m != nil {
-		return m.LevelSpec
+		return m.IncomingAmountMsat
 	}
-	return ""
+	return 0
 }
 
-type DebugLevelResponse struct {
-	SubSystems           string   `protobuf:"bytes,1,opt,name=sub_systems,proto3" json:"sub_systems,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ForwardHtlcInterceptRequest) GetIncomingExpiry() uint32 {
+	if m != nil {
+		return m.IncomingExpiry
+	}
+	return 0
 }
 
-func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
-func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
-func (*DebugLevelResponse) ProtoMessage()    {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{99}
-}
-func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
-}
-func (m *DebugLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DebugLevelResponse.Marshal(b, m, deterministic)
-}
-func (dst *DebugLevelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugLevelResponse.Merge(dst, src)
-}
-func (m *DebugLevelResponse) XXX_Size() int {
-	return xxx_messageInfo_DebugLevelResponse.Size(m)
+func (m *ForwardHtlcInterceptRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
 }
-func (m *DebugLevelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugLevelResponse.DiscardUnknown(m)
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingRequestedChanId() uint64 {
+	if m != nil {
+		return m.OutgoingRequestedChanId
+	}
+	return 0
 }
 
-var xxx_messageInfo_DebugLevelResponse proto.InternalMessageInfo
+func (m *ForwardHtlcInterceptRequest) GetOutgoingAmountMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmountMsat
+	}
+	return 0
+}
 
-func (m *DebugLevelResponse) GetSubSystems() string {
+func (m *ForwardHtlcInterceptRequest) GetOutgoingExpiry() uint32 {
 	if m != nil {
-		return m.SubSystems
+		return m.OutgoingExpiry
 	}
-	return ""
+	return 0
 }
 
-type PayReqString struct {
-	// / The payment request string to be decoded
-	PayReq               string   `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ForwardHtlcInterceptResponse struct {
+	// / The key of the intercepted htlc to resolve.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key,proto3" json:"incoming_circuit_key,omitempty"`
+	// / The resolution of the intercepted htlc.
+	Action ForwardHtlcInterceptResponse_Action `protobuf:"varint,2,opt,name=action,proto3,enum=lnrpc.ForwardHtlcInterceptResponse_Action" json:"action,omitempty"`
+	// / The preimage used to settle the htlc, if the action is SETTLE.
+	Preimage []byte `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// / The failure code sent back to the sender, if the action is FAIL.
+	FailureCode          ForwardHtlcInterceptResponse_FailureCode `protobuf:"varint,4,opt,name=failure_code,proto3,enum=lnrpc.ForwardHtlcInterceptResponse_FailureCode" json:"failure_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
 }
 
-func (m *PayReqString) Reset()         { *m = PayReqString{} }
-func (m *PayReqString) String() string { return proto.CompactTextString(m) }
-func (*PayReqString) ProtoMessage()    {}
-func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{100}
+func (m *ForwardHtlcInterceptResponse) Reset()         { *m = ForwardHtlcInterceptResponse{} }
+func (m *ForwardHtlcInterceptResponse) String() string { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptResponse) ProtoMessage()    {}
+func (*ForwardHtlcInterceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{128}
 }
-func (m *PayReqString) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
+func (m *ForwardHtlcInterceptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Unmarshal(m, b)
 }
-func (m *PayReqString) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayReqString.Marshal(b, m, deterministic)
+func (m *ForwardHtlcInterceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Marshal(b, m, deterministic)
 }
-func (dst *PayReqString) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayReqString.Merge(dst, src)
+func (dst *ForwardHtlcInterceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHtlcInterceptResponse.Merge(dst, src)
 }
-func (m *PayReqString) XXX_Size() int {
-	return xxx_messageInfo_PayReqString.Size(m)
+func (m *ForwardHtlcInterceptResponse) XXX_Size() int {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Size(m)
 }
-func (m *PayReqString) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayReqString.DiscardUnknown(m)
+func (m *ForwardHtlcInterceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHtlcInterceptResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayReqString proto.InternalMessageInfo
+var xxx_messageInfo_ForwardHtlcInterceptResponse proto.InternalMessageInfo
 
-func (m *PayReqString) GetPayReq() string {
+func (m *ForwardHtlcInterceptResponse) GetIncomingCircuitKey() *CircuitKey {
 	if m != nil {
-		return m.PayReq
+		return m.IncomingCircuitKey
 	}
-	return ""
+	return nil
 }
 
-type PayReq struct {
-	Destination          string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
-	PaymentHash          string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
-	NumSatoshis          int64        `protobuf:"varint,3,opt,name=num_satoshis,proto3" json:"num_satoshis,omitempty"`
-	Timestamp            int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Expiry               int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	Description          string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	DescriptionHash      string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	FallbackAddr         string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
-	CltvExpiry           int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
-	RouteHints           []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *ForwardHtlcInterceptResponse) GetAction() ForwardHtlcInterceptResponse_Action {
+	if m != nil {
+		return m.Action
+	}
+	return ForwardHtlcInterceptResponse_RESUME
 }
 
-func (m *PayReq) Reset()         { *m = PayReq{} }
-func (m *PayReq) String() string { return proto.CompactTextString(m) }
-func (*PayReq) ProtoMessage()    {}
-func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{101}
+func (m *ForwardHtlcInterceptResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptResponse) GetFailureCode() ForwardHtlcInterceptResponse_FailureCode {
+	if m != nil {
+		return m.FailureCode
+	}
+	return ForwardHtlcInterceptResponse_TEMPORARY_CHANNEL_FAILURE
+}
+
+type SubscribeHtlcEventsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeHtlcEventsRequest) Reset()         { *m = SubscribeHtlcEventsRequest{} }
+func (m *SubscribeHtlcEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeHtlcEventsRequest) ProtoMessage()    {}
+func (*SubscribeHtlcEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{129}
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Unmarshal(m, b)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeHtlcEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.Merge(dst, src)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Size(m)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeHtlcEventsRequest proto.InternalMessageInfo
+
+type HtlcEvent struct {
+	// / The type of the event.
+	EventType HtlcEvent_EventType `protobuf:"varint,1,opt,name=event_type,proto3,enum=lnrpc.HtlcEvent_EventType" json:"event_type,omitempty"`
+	// / The id of the channel the htlc arrived over.
+	IncomingChanId uint64 `protobuf:"varint,2,opt,name=incoming_chan_id,proto3" json:"incoming_chan_id,omitempty"`
+	// / The index of the htlc within the incoming channel.
+	IncomingHtlcId uint64 `protobuf:"varint,3,opt,name=incoming_htlc_id,proto3" json:"incoming_htlc_id,omitempty"`
+	// / The id of the channel the htlc was forwarded over, if any.
+	OutgoingChanId uint64 `protobuf:"varint,4,opt,name=outgoing_chan_id,proto3" json:"outgoing_chan_id,omitempty"`
+	// / The index of the htlc within the outgoing channel, if any.
+	OutgoingHtlcId uint64 `protobuf:"varint,5,opt,name=outgoing_htlc_id,proto3" json:"outgoing_htlc_id,omitempty"`
+	// / The incoming htlc amount in milli-satoshis.
+	IncomingAmtMsat uint64 `protobuf:"varint,6,opt,name=incoming_amt_msat,proto3" json:"incoming_amt_msat,omitempty"`
+	// / The outgoing htlc amount in milli-satoshis, if any.
+	OutgoingAmtMsat uint64 `protobuf:"varint,7,opt,name=outgoing_amt_msat,proto3" json:"outgoing_amt_msat,omitempty"`
+	// / The incoming htlc expiry, only set for FORWARD and LINK_FAIL events.
+	IncomingTimelock uint32 `protobuf:"varint,8,opt,name=incoming_timelock,proto3" json:"incoming_timelock,omitempty"`
+	// / The outgoing htlc expiry, only set for FORWARD and LINK_FAIL events.
+	OutgoingTimelock uint32 `protobuf:"varint,9,opt,name=outgoing_timelock,proto3" json:"outgoing_timelock,omitempty"`
+	// / The time at which the event occurred, in nanoseconds since the unix epoch.
+	TimestampNs uint64 `protobuf:"varint,10,opt,name=timestamp_ns,proto3" json:"timestamp_ns,omitempty"`
+	// / The failure code sent back to the sender, only set for LINK_FAIL events.
+	FailureCode uint32 `protobuf:"varint,11,opt,name=failure_code,proto3" json:"failure_code,omitempty"`
+	// / A description of the failure sent back to the sender, only set for LINK_FAIL events.
+	FailureString string `protobuf:"bytes,12,opt,name=failure_string,proto3" json:"failure_string,omitempty"`
+	// / Additional information on why our node failed the htlc, only set for LINK_FAIL events.
+	FailureDetail        string   `protobuf:"bytes,13,opt,name=failure_detail,proto3" json:"failure_detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *PayReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayReq.Unmarshal(m, b)
+
+func (m *HtlcEvent) Reset()         { *m = HtlcEvent{} }
+func (m *HtlcEvent) String() string { return proto.CompactTextString(m) }
+func (*HtlcEvent) ProtoMessage()    {}
+func (*HtlcEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{130}
 }
-func (m *PayReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayReq.Marshal(b, m, deterministic)
+func (m *HtlcEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcEvent.Unmarshal(m, b)
 }
-func (dst *PayReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayReq.Merge(dst, src)
+func (m *HtlcEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcEvent.Marshal(b, m, deterministic)
 }
-func (m *PayReq) XXX_Size() int {
-	return xxx_messageInfo_PayReq.Size(m)
+func (dst *HtlcEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcEvent.Merge(dst, src)
 }
-func (m *PayReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayReq.DiscardUnknown(m)
+func (m *HtlcEvent) XXX_Size() int {
+	return xxx_messageInfo_HtlcEvent.Size(m)
+}
+func (m *HtlcEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayReq proto.InternalMessageInfo
+var xxx_messageInfo_HtlcEvent proto.InternalMessageInfo
 
-func (m *PayReq) GetDestination() string {
+func (m *HtlcEvent) GetEventType() HtlcEvent_EventType {
 	if m != nil {
-		return m.Destination
+		return m.EventType
 	}
-	return ""
+	return HtlcEvent_FORWARD
 }
 
-func (m *PayReq) GetPaymentHash() string {
+func (m *HtlcEvent) GetIncomingChanId() uint64 {
 	if m != nil {
-		return m.PaymentHash
+		return m.IncomingChanId
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetNumSatoshis() int64 {
+func (m *HtlcEvent) GetIncomingHtlcId() uint64 {
 	if m != nil {
-		return m.NumSatoshis
+		return m.IncomingHtlcId
 	}
 	return 0
 }
 
-func (m *PayReq) GetTimestamp() int64 {
+func (m *HtlcEvent) GetOutgoingChanId() uint64 {
 	if m != nil {
-		return m.Timestamp
+		return m.OutgoingChanId
 	}
 	return 0
 }
 
-func (m *PayReq) GetExpiry() int64 {
+func (m *HtlcEvent) GetOutgoingHtlcId() uint64 {
 	if m != nil {
-		return m.Expiry
+		return m.OutgoingHtlcId
 	}
 	return 0
 }
 
-func (m *PayReq) GetDescription() string {
+func (m *HtlcEvent) GetIncomingAmtMsat() uint64 {
 	if m != nil {
-		return m.Description
+		return m.IncomingAmtMsat
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetDescriptionHash() string {
+func (m *HtlcEvent) GetOutgoingAmtMsat() uint64 {
 	if m != nil {
-		return m.DescriptionHash
+		return m.OutgoingAmtMsat
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetFallbackAddr() string {
+func (m *HtlcEvent) GetIncomingTimelock() uint32 {
 	if m != nil {
-		return m.FallbackAddr
+		return m.IncomingTimelock
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetCltvExpiry() int64 {
+func (m *HtlcEvent) GetOutgoingTimelock() uint32 {
 	if m != nil {
-		return m.CltvExpiry
+		return m.OutgoingTimelock
 	}
 	return 0
 }
 
-func (m *PayReq) GetRouteHints() []*RouteHint {
+func (m *HtlcEvent) GetTimestampNs() uint64 {
 	if m != nil {
-		return m.RouteHints
+		return m.TimestampNs
 	}
-	return nil
+	return 0
 }
 
-type FeeReportRequest struct {
+func (m *HtlcEvent) GetFailureCode() uint32 {
+	if m != nil {
+		return m.FailureCode
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetFailureString() string {
+	if m != nil {
+		return m.FailureString
+	}
+	return ""
+}
+
+func (m *HtlcEvent) GetFailureDetail() string {
+	if m != nil {
+		return m.FailureDetail
+	}
+	return ""
+}
+
+type MacaroonPermission struct {
+	// / The entity a permission grants access to.
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// / The action that is granted.
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
-func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
-func (*FeeReportRequest) ProtoMessage()    {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{102}
+func (m *MacaroonPermission) Reset()         { *m = MacaroonPermission{} }
+func (m *MacaroonPermission) String() string { return proto.CompactTextString(m) }
+func (*MacaroonPermission) ProtoMessage()    {}
+func (*MacaroonPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{131}
 }
-func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
+func (m *MacaroonPermission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacaroonPermission.Unmarshal(m, b)
 }
-func (m *FeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FeeReportRequest.Marshal(b, m, deterministic)
+func (m *MacaroonPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacaroonPermission.Marshal(b, m, deterministic)
 }
-func (dst *FeeReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeeReportRequest.Merge(dst, src)
+func (dst *MacaroonPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacaroonPermission.Merge(dst, src)
 }
-func (m *FeeReportRequest) XXX_Size() int {
-	return xxx_messageInfo_FeeReportRequest.Size(m)
+func (m *MacaroonPermission) XXX_Size() int {
+	return xxx_messageInfo_MacaroonPermission.Size(m)
 }
-func (m *FeeReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeeReportRequest.DiscardUnknown(m)
+func (m *MacaroonPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacaroonPermission.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeeReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_MacaroonPermission proto.InternalMessageInfo
 
-type ChannelFeeReport struct {
-	// / The channel that this fee report belongs to.
-	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
-	// / The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
-	// / The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
-	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
-	// / The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
-	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+func (m *MacaroonPermission) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *MacaroonPermission) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type BakeMacaroonRequest struct {
+	// / The list of permissions the new macaroon should grant.
+	Permissions []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// *
+	// The root key ID used to create the macaroon. If not set, the default root
+	// key ID 0 is used.
+	RootKeyId            uint64   `protobuf:"varint,2,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
-func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
-func (*ChannelFeeReport) ProtoMessage()    {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{103}
+func (m *BakeMacaroonRequest) Reset()         { *m = BakeMacaroonRequest{} }
+func (m *BakeMacaroonRequest) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonRequest) ProtoMessage()    {}
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{132}
 }
-func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
+func (m *BakeMacaroonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonRequest.Unmarshal(m, b)
 }
-func (m *ChannelFeeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelFeeReport.Marshal(b, m, deterministic)
+func (m *BakeMacaroonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonRequest.Marshal(b, m, deterministic)
 }
-func (dst *ChannelFeeReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelFeeReport.Merge(dst, src)
+func (dst *BakeMacaroonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonRequest.Merge(dst, src)
 }
-func (m *ChannelFeeReport) XXX_Size() int {
-	return xxx_messageInfo_ChannelFeeReport.Size(m)
+func (m *BakeMacaroonRequest) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonRequest.Size(m)
 }
-func (m *ChannelFeeReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelFeeReport.DiscardUnknown(m)
+func (m *BakeMacaroonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelFeeReport proto.InternalMessageInfo
+var xxx_messageInfo_BakeMacaroonRequest proto.InternalMessageInfo
 
-func (m *ChannelFeeReport) GetChanPoint() string {
+func (m *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
 	if m != nil {
-		return m.ChanPoint
+		return m.Permissions
 	}
-	return ""
+	return nil
 }
 
-func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
+func (m *BakeMacaroonRequest) GetRootKeyId() uint64 {
 	if m != nil {
-		return m.BaseFeeMsat
+		return m.RootKeyId
 	}
 	return 0
 }
 
-func (m *ChannelFeeReport) GetFeePerMil() int64 {
-	if m != nil {
-		return m.FeePerMil
-	}
-	return 0
+type BakeMacaroonResponse struct {
+	// / The hex encoded macaroon, serialized in binary format.
+	Macaroon             string   `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelFeeReport) GetFeeRate() float64 {
+func (m *BakeMacaroonResponse) Reset()         { *m = BakeMacaroonResponse{} }
+func (m *BakeMacaroonResponse) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonResponse) ProtoMessage()    {}
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{133}
+}
+func (m *BakeMacaroonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonResponse.Unmarshal(m, b)
+}
+func (m *BakeMacaroonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonResponse.Marshal(b, m, deterministic)
+}
+func (dst *BakeMacaroonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonResponse.Merge(dst, src)
+}
+func (m *BakeMacaroonResponse) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonResponse.Size(m)
+}
+func (m *BakeMacaroonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BakeMacaroonResponse proto.InternalMessageInfo
+
+func (m *BakeMacaroonResponse) GetMacaroon() string {
 	if m != nil {
-		return m.FeeRate
+		return m.Macaroon
 	}
-	return 0
+	return ""
 }
 
-type FeeReportResponse struct {
-	// / An array of channel fee reports which describes the current fee schedule for each channel.
-	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
-	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum,proto3" json:"day_fee_sum,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
-	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum,proto3" json:"week_fee_sum,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
-	MonthFeeSum          uint64   `protobuf:"varint,4,opt,name=month_fee_sum,proto3" json:"month_fee_sum,omitempty"`
+type ListMacaroonIDsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
-func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
-func (*FeeReportResponse) ProtoMessage()    {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{104}
+func (m *ListMacaroonIDsRequest) Reset()         { *m = ListMacaroonIDsRequest{} }
+func (m *ListMacaroonIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsRequest) ProtoMessage()    {}
+func (*ListMacaroonIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{134}
 }
-func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
+func (m *ListMacaroonIDsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Unmarshal(m, b)
 }
-func (m *FeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FeeReportResponse.Marshal(b, m, deterministic)
+func (m *ListMacaroonIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Marshal(b, m, deterministic)
 }
-func (dst *FeeReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeeReportResponse.Merge(dst, src)
+func (dst *ListMacaroonIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsRequest.Merge(dst, src)
 }
-func (m *FeeReportResponse) XXX_Size() int {
-	return xxx_messageInfo_FeeReportResponse.Size(m)
+func (m *ListMacaroonIDsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Size(m)
 }
-func (m *FeeReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeeReportResponse.DiscardUnknown(m)
+func (m *ListMacaroonIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeeReportResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListMacaroonIDsRequest proto.InternalMessageInfo
 
-func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
-	if m != nil {
-		return m.ChannelFees
-	}
-	return nil
+type ListMacaroonIDsResponse struct {
+	// / The list of root key IDs that are in use.
+	RootKeyIds           []uint64 `protobuf:"varint,1,rep,packed,name=root_key_ids,proto3" json:"root_key_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FeeReportResponse) GetDayFeeSum() uint64 {
-	if m != nil {
-		return m.DayFeeSum
-	}
-	return 0
+func (m *ListMacaroonIDsResponse) Reset()         { *m = ListMacaroonIDsResponse{} }
+func (m *ListMacaroonIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsResponse) ProtoMessage()    {}
+func (*ListMacaroonIDsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{135}
 }
-
-func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
-	if m != nil {
-		return m.WeekFeeSum
-	}
-	return 0
+func (m *ListMacaroonIDsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Unmarshal(m, b)
+}
+func (m *ListMacaroonIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListMacaroonIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsResponse.Merge(dst, src)
+}
+func (m *ListMacaroonIDsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Size(m)
+}
+func (m *ListMacaroonIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsResponse.DiscardUnknown(m)
 }
 
-func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
+var xxx_messageInfo_ListMacaroonIDsResponse proto.InternalMessageInfo
+
+func (m *ListMacaroonIDsResponse) GetRootKeyIds() []uint64 {
 	if m != nil {
-		return m.MonthFeeSum
+		return m.RootKeyIds
 	}
-	return 0
+	return nil
 }
 
-type PolicyUpdateRequest struct {
-	// Types that are valid to be assigned to Scope:
-	//	*PolicyUpdateRequest_Global
-	//	*PolicyUpdateRequest_ChanPoint
-	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
-	// / The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
-	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
-	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
-	// / The required timelock delta for HTLCs forwarded over the channel.
-	TimeLockDelta        uint32   `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+type DeleteMacaroonIDRequest struct {
+	// / The root key ID to be removed.
+	RootKeyId            uint64   `protobuf:"varint,1,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
-func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
-func (*PolicyUpdateRequest) ProtoMessage()    {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{105}
+func (m *DeleteMacaroonIDRequest) Reset()         { *m = DeleteMacaroonIDRequest{} }
+func (m *DeleteMacaroonIDRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDRequest) ProtoMessage()    {}
+func (*DeleteMacaroonIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{136}
 }
-func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
+func (m *DeleteMacaroonIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Unmarshal(m, b)
 }
-func (m *PolicyUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyUpdateRequest.Marshal(b, m, deterministic)
+func (m *DeleteMacaroonIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Marshal(b, m, deterministic)
 }
-func (dst *PolicyUpdateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyUpdateRequest.Merge(dst, src)
+func (dst *DeleteMacaroonIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDRequest.Merge(dst, src)
 }
-func (m *PolicyUpdateRequest) XXX_Size() int {
-	return xxx_messageInfo_PolicyUpdateRequest.Size(m)
+func (m *DeleteMacaroonIDRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Size(m)
 }
-func (m *PolicyUpdateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyUpdateRequest.DiscardUnknown(m)
+func (m *DeleteMacaroonIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PolicyUpdateRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteMacaroonIDRequest proto.InternalMessageInfo
 
-type isPolicyUpdateRequest_Scope interface {
-	isPolicyUpdateRequest_Scope()
+func (m *DeleteMacaroonIDRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
 }
 
-type PolicyUpdateRequest_Global struct {
-	Global bool `protobuf:"varint,1,opt,name=global,proto3,oneof"`
+type DeleteMacaroonIDResponse struct {
+	// / A boolean indicates that the deletion is successful.
+	Deleted              bool     `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type PolicyUpdateRequest_ChanPoint struct {
-	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,proto3,oneof"`
+func (m *DeleteMacaroonIDResponse) Reset()         { *m = DeleteMacaroonIDResponse{} }
+func (m *DeleteMacaroonIDResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDResponse) ProtoMessage()    {}
+func (*DeleteMacaroonIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{137}
+}
+func (m *DeleteMacaroonIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Unmarshal(m, b)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteMacaroonIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDResponse.Merge(dst, src)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Size(m)
+}
+func (m *DeleteMacaroonIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDResponse.DiscardUnknown(m)
 }
 
-func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope() {}
-
-func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+var xxx_messageInfo_DeleteMacaroonIDResponse proto.InternalMessageInfo
 
-func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+func (m *DeleteMacaroonIDResponse) GetDeleted() bool {
 	if m != nil {
-		return m.Scope
+		return m.Deleted
 	}
-	return nil
+	return false
 }
 
-func (m *PolicyUpdateRequest) GetGlobal() bool {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
-		return x.Global
-	}
-	return false
+type Account struct {
+	// / The ID of the account.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// / The label of the account.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// / The balance of the account in millisatoshi.
+	BalanceMsat uint64 `protobuf:"varint,3,opt,name=balance_msat,proto3" json:"balance_msat,omitempty"`
+	// / The unix timestamp of when the account was created.
+	CreationDate         int64    `protobuf:"varint,4,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
-		return x.ChanPoint
-	}
-	return nil
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{138}
+}
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (dst *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(dst, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
 }
 
-func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetId() uint64 {
 	if m != nil {
-		return m.BaseFeeMsat
+		return m.Id
 	}
 	return 0
 }
 
-func (m *PolicyUpdateRequest) GetFeeRate() float64 {
+func (m *Account) GetLabel() string {
 	if m != nil {
-		return m.FeeRate
+		return m.Label
 	}
-	return 0
+	return ""
 }
 
-func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
+func (m *Account) GetBalanceMsat() uint64 {
 	if m != nil {
-		return m.TimeLockDelta
+		return m.BalanceMsat
 	}
 	return 0
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
-		(*PolicyUpdateRequest_Global)(nil),
-		(*PolicyUpdateRequest_ChanPoint)(nil),
+func (m *Account) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
 	}
+	return 0
 }
 
-func _PolicyUpdateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*PolicyUpdateRequest)
-	// scope
-	switch x := m.Scope.(type) {
-	case *PolicyUpdateRequest_Global:
-		t := uint64(0)
-		if x.Global {
-			t = 1
-		}
-		b.EncodeVarint(1<<3 | proto.WireVarint)
-		b.EncodeVarint(t)
-	case *PolicyUpdateRequest_ChanPoint:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanPoint); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("PolicyUpdateRequest.Scope has unexpected type %T", x)
-	}
-	return nil
+type CreateAccountRequest struct {
+	// / An optional label describing the account.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// / The initial balance of the account in millisatoshi.
+	BalanceMsat          uint64   `protobuf:"varint,2,opt,name=balance_msat,proto3" json:"balance_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _PolicyUpdateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*PolicyUpdateRequest)
-	switch tag {
-	case 1: // scope.global
-		if wire != proto.WireVarint {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeVarint()
-		m.Scope = &PolicyUpdateRequest_Global{x != 0}
-		return true, err
-	case 2: // scope.chan_point
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelPoint)
-		err := b.DecodeMessage(msg)
-		m.Scope = &PolicyUpdateRequest_ChanPoint{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *CreateAccountRequest) Reset()         { *m = CreateAccountRequest{} }
+func (m *CreateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAccountRequest) ProtoMessage()    {}
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{139}
+}
+func (m *CreateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccountRequest.Unmarshal(m, b)
+}
+func (m *CreateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountRequest.Merge(dst, src)
+}
+func (m *CreateAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAccountRequest.Size(m)
+}
+func (m *CreateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountRequest proto.InternalMessageInfo
+
+func (m *CreateAccountRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
 	}
+	return ""
 }
 
-func _PolicyUpdateRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*PolicyUpdateRequest)
-	// scope
-	switch x := m.Scope.(type) {
-	case *PolicyUpdateRequest_Global:
-		n += 1 // tag and wire
-		n += 1
-	case *PolicyUpdateRequest_ChanPoint:
-		s := proto.Size(x.ChanPoint)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *CreateAccountRequest) GetBalanceMsat() uint64 {
+	if m != nil {
+		return m.BalanceMsat
 	}
-	return n
+	return 0
 }
 
-type PolicyUpdateResponse struct {
+type CreateAccountResponse struct {
+	// / The newly created account.
+	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
-func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
-func (*PolicyUpdateResponse) ProtoMessage()    {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{106}
+func (m *CreateAccountResponse) Reset()         { *m = CreateAccountResponse{} }
+func (m *CreateAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAccountResponse) ProtoMessage()    {}
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{140}
 }
-func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
+func (m *CreateAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccountResponse.Unmarshal(m, b)
 }
-func (m *PolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyUpdateResponse.Marshal(b, m, deterministic)
+func (m *CreateAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccountResponse.Marshal(b, m, deterministic)
 }
-func (dst *PolicyUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyUpdateResponse.Merge(dst, src)
+func (dst *CreateAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountResponse.Merge(dst, src)
 }
-func (m *PolicyUpdateResponse) XXX_Size() int {
-	return xxx_messageInfo_PolicyUpdateResponse.Size(m)
+func (m *CreateAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAccountResponse.Size(m)
 }
-func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
+func (m *CreateAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateAccountResponse proto.InternalMessageInfo
 
-type ForwardingHistoryRequest struct {
-	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
-	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
-	// / End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
-	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
-	// / Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
-	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
-	// / The max number of events to return in the response to this query.
-	NumMaxEvents         uint32   `protobuf:"varint,4,opt,name=num_max_events,proto3" json:"num_max_events,omitempty"`
+func (m *CreateAccountResponse) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+type ListAccountsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryRequest{} }
-func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
-func (*ForwardingHistoryRequest) ProtoMessage()    {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{107}
+func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()    {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{141}
 }
-func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsRequest.Unmarshal(m, b)
 }
-func (m *ForwardingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingHistoryRequest.Marshal(b, m, deterministic)
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ForwardingHistoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingHistoryRequest.Merge(dst, src)
+func (dst *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(dst, src)
 }
-func (m *ForwardingHistoryRequest) XXX_Size() int {
-	return xxx_messageInfo_ForwardingHistoryRequest.Size(m)
+func (m *ListAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsRequest.Size(m)
 }
-func (m *ForwardingHistoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingHistoryRequest.DiscardUnknown(m)
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForwardingHistoryRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
 
-func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
-	if m != nil {
-		return m.StartTime
-	}
-	return 0
+type ListAccountsResponse struct {
+	// / All virtual accounts, ordered by their ID.
+	Accounts             []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
+func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()    {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{142}
+}
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsResponse.Unmarshal(m, b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(dst, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsResponse.Size(m)
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetAccounts() []*Account {
 	if m != nil {
-		return m.EndTime
+		return m.Accounts
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+type FundAccountRequest struct {
+	// / The ID of the account to fund.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// / The amount in millisatoshi to add to the balance of the account.
+	AmtMsat              uint64   `protobuf:"varint,2,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundAccountRequest) Reset()         { *m = FundAccountRequest{} }
+func (m *FundAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*FundAccountRequest) ProtoMessage()    {}
+func (*FundAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{143}
+}
+func (m *FundAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundAccountRequest.Unmarshal(m, b)
+}
+func (m *FundAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *FundAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundAccountRequest.Merge(dst, src)
+}
+func (m *FundAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_FundAccountRequest.Size(m)
+}
+func (m *FundAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundAccountRequest proto.InternalMessageInfo
+
+func (m *FundAccountRequest) GetId() uint64 {
 	if m != nil {
-		return m.IndexOffset
+		return m.Id
 	}
 	return 0
 }
 
-func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
+func (m *FundAccountRequest) GetAmtMsat() uint64 {
 	if m != nil {
-		return m.NumMaxEvents
+		return m.AmtMsat
 	}
 	return 0
 }
 
-type ForwardingEvent struct {
-	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// / The incoming channel ID that carried the HTLC that created the circuit.
-	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in,proto3" json:"chan_id_in,omitempty"`
-	// / The outgoing channel ID that carried the preimage that completed the circuit.
-	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out,proto3" json:"chan_id_out,omitempty"`
-	// / The total amount (in satoshis) of the incoming HTLC that created half the circuit.
-	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in,proto3" json:"amt_in,omitempty"`
-	// / The total amount (in satoshis) of the outgoing HTLC that created the second half of the circuit.
-	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out,proto3" json:"amt_out,omitempty"`
-	// / The total fee (in satoshis) that this payment circuit carried.
-	Fee uint64 `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
-	// / The total fee (in milli-satoshis) that this payment circuit carried.
-	FeeMsat              uint64   `protobuf:"varint,8,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+type FundAccountResponse struct {
+	// / The funded account, including its updated balance.
+	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
-func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
-func (*ForwardingEvent) ProtoMessage()    {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{108}
+func (m *FundAccountResponse) Reset()         { *m = FundAccountResponse{} }
+func (m *FundAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*FundAccountResponse) ProtoMessage()    {}
+func (*FundAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{144}
 }
-func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
+func (m *FundAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundAccountResponse.Unmarshal(m, b)
 }
-func (m *ForwardingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingEvent.Marshal(b, m, deterministic)
+func (m *FundAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundAccountResponse.Marshal(b, m, deterministic)
 }
-func (dst *ForwardingEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingEvent.Merge(dst, src)
+func (dst *FundAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundAccountResponse.Merge(dst, src)
 }
-func (m *ForwardingEvent) XXX_Size() int {
-	return xxx_messageInfo_ForwardingEvent.Size(m)
+func (m *FundAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_FundAccountResponse.Size(m)
 }
-func (m *ForwardingEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingEvent.DiscardUnknown(m)
+func (m *FundAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForwardingEvent proto.InternalMessageInfo
+var xxx_messageInfo_FundAccountResponse proto.InternalMessageInfo
 
-func (m *ForwardingEvent) GetTimestamp() uint64 {
+func (m *FundAccountResponse) GetAccount() *Account {
 	if m != nil {
-		return m.Timestamp
+		return m.Account
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetChanIdIn() uint64 {
-	if m != nil {
-		return m.ChanIdIn
-	}
-	return 0
+type SendCustomMessageRequest struct {
+	// / The compressed public key of the peer to send the message to.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// *
+	// The message type. Must be an odd type within the custom range, starting at
+	// 32768.
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// / The raw data of the message.
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingEvent) GetChanIdOut() uint64 {
+func (m *SendCustomMessageRequest) Reset()         { *m = SendCustomMessageRequest{} }
+func (m *SendCustomMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*SendCustomMessageRequest) ProtoMessage()    {}
+func (*SendCustomMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{145}
+}
+func (m *SendCustomMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCustomMessageRequest.Unmarshal(m, b)
+}
+func (m *SendCustomMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCustomMessageRequest.Marshal(b, m, deterministic)
+}
+func (dst *SendCustomMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCustomMessageRequest.Merge(dst, src)
+}
+func (m *SendCustomMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_SendCustomMessageRequest.Size(m)
+}
+func (m *SendCustomMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCustomMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendCustomMessageRequest proto.InternalMessageInfo
+
+func (m *SendCustomMessageRequest) GetPeer() []byte {
 	if m != nil {
-		return m.ChanIdOut
+		return m.Peer
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetAmtIn() uint64 {
+func (m *SendCustomMessageRequest) GetType() uint32 {
 	if m != nil {
-		return m.AmtIn
+		return m.Type
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetAmtOut() uint64 {
+func (m *SendCustomMessageRequest) GetData() []byte {
 	if m != nil {
-		return m.AmtOut
+		return m.Data
 	}
-	return 0
+	return nil
+}
+
+type SendCustomMessageResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendCustomMessageResponse) Reset()         { *m = SendCustomMessageResponse{} }
+func (m *SendCustomMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*SendCustomMessageResponse) ProtoMessage()    {}
+func (*SendCustomMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{146}
+}
+func (m *SendCustomMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCustomMessageResponse.Unmarshal(m, b)
+}
+func (m *SendCustomMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCustomMessageResponse.Marshal(b, m, deterministic)
+}
+func (dst *SendCustomMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCustomMessageResponse.Merge(dst, src)
+}
+func (m *SendCustomMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_SendCustomMessageResponse.Size(m)
+}
+func (m *SendCustomMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCustomMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendCustomMessageResponse proto.InternalMessageInfo
+
+type SubscribeCustomMessagesRequest struct {
+	// *
+	// The even custom message types the subscriber understands. Peers sending
+	// these types won't be disconnected for as long as the subscription is
+	// active.
+	EvenTypes            []uint32 `protobuf:"varint,1,rep,packed,name=even_types,proto3" json:"even_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeCustomMessagesRequest) Reset()         { *m = SubscribeCustomMessagesRequest{} }
+func (m *SubscribeCustomMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeCustomMessagesRequest) ProtoMessage()    {}
+func (*SubscribeCustomMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{147}
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Unmarshal(m, b)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Marshal(b, m, deterministic)
 }
-
-func (m *ForwardingEvent) GetFee() uint64 {
-	if m != nil {
-		return m.Fee
-	}
-	return 0
+func (dst *SubscribeCustomMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeCustomMessagesRequest.Merge(dst, src)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Size(m)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeCustomMessagesRequest.DiscardUnknown(m)
 }
 
-func (m *ForwardingEvent) GetFeeMsat() uint64 {
+var xxx_messageInfo_SubscribeCustomMessagesRequest proto.InternalMessageInfo
+
+func (m *SubscribeCustomMessagesRequest) GetEvenTypes() []uint32 {
 	if m != nil {
-		return m.FeeMsat
+		return m.EvenTypes
 	}
-	return 0
+	return nil
 }
 
-type ForwardingHistoryResponse struct {
-	// / A list of forwarding events from the time slice of the time series specified in the request.
-	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events,proto3" json:"forwarding_events,omitempty"`
-	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
-	LastOffsetIndex      uint32   `protobuf:"varint,2,opt,name=last_offset_index,proto3" json:"last_offset_index,omitempty"`
+type CustomMessage struct {
+	// / The compressed public key of the peer the message was received from.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / The message type.
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// / The raw data of the message.
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResponse{} }
-func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
-func (*ForwardingHistoryResponse) ProtoMessage()    {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cad88c6305c67548, []int{109}
+func (m *CustomMessage) Reset()         { *m = CustomMessage{} }
+func (m *CustomMessage) String() string { return proto.CompactTextString(m) }
+func (*CustomMessage) ProtoMessage()    {}
+func (*CustomMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_52358d9b93345ad6, []int{148}
 }
-func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
+func (m *CustomMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomMessage.Unmarshal(m, b)
 }
-func (m *ForwardingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingHistoryResponse.Marshal(b, m, deterministic)
+func (m *CustomMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomMessage.Marshal(b, m, deterministic)
 }
-func (dst *ForwardingHistoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingHistoryResponse.Merge(dst, src)
+func (dst *CustomMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomMessage.Merge(dst, src)
 }
-func (m *ForwardingHistoryResponse) XXX_Size() int {
-	return xxx_messageInfo_ForwardingHistoryResponse.Size(m)
+func (m *CustomMessage) XXX_Size() int {
+	return xxx_messageInfo_CustomMessage.Size(m)
 }
-func (m *ForwardingHistoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingHistoryResponse.DiscardUnknown(m)
+func (m *CustomMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomMessage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForwardingHistoryResponse proto.InternalMessageInfo
+var xxx_messageInfo_CustomMessage proto.InternalMessageInfo
 
-func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+func (m *CustomMessage) GetPeer() []byte {
 	if m != nil {
-		return m.ForwardingEvents
+		return m.Peer
 	}
 	return nil
 }
 
-func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+func (m *CustomMessage) GetType() uint32 {
 	if m != nil {
-		return m.LastOffsetIndex
+		return m.Type
 	}
 	return 0
 }
 
+func (m *CustomMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
 	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
@@ -7537,9 +10281,13 @@ func init() {
 	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
 	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
 	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
+	proto.RegisterType((*ChannelEventSubscription)(nil), "lnrpc.ChannelEventSubscription")
+	proto.RegisterType((*ChannelEventUpdate)(nil), "lnrpc.ChannelEventUpdate")
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
 	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
 	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
+	proto.RegisterType((*PeerEventSubscription)(nil), "lnrpc.PeerEventSubscription")
+	proto.RegisterType((*PeerEvent)(nil), "lnrpc.PeerEvent")
 	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
 	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
 	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
@@ -7601,6 +10349,12 @@ func init() {
 	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
 	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
 	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
+	proto.RegisterType((*GetDBStatsRequest)(nil), "lnrpc.GetDBStatsRequest")
+	proto.RegisterType((*DBSubsystemStats)(nil), "lnrpc.DBSubsystemStats")
+	proto.RegisterType((*GetDBStatsResponse)(nil), "lnrpc.GetDBStatsResponse")
+	proto.RegisterType((*BackupDatabaseRequest)(nil), "lnrpc.BackupDatabaseRequest")
+	proto.RegisterType((*DatabaseBackupMetadata)(nil), "lnrpc.DatabaseBackupMetadata")
+	proto.RegisterType((*DatabaseBackupChunk)(nil), "lnrpc.DatabaseBackupChunk")
 	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
 	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
 	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
@@ -7608,11 +10362,49 @@ func init() {
 	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
 	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
 	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
+	proto.RegisterType((*FeeDecisionsRequest)(nil), "lnrpc.FeeDecisionsRequest")
+	proto.RegisterType((*FeeDecision)(nil), "lnrpc.FeeDecision")
+	proto.RegisterType((*FeeDecisionsResponse)(nil), "lnrpc.FeeDecisionsResponse")
 	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
 	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*AccountingReportRequest)(nil), "lnrpc.AccountingReportRequest")
+	proto.RegisterType((*LedgerEntry)(nil), "lnrpc.LedgerEntry")
+	proto.RegisterType((*AccountingReportResponse)(nil), "lnrpc.AccountingReportResponse")
+	proto.RegisterType((*CircuitKey)(nil), "lnrpc.CircuitKey")
+	proto.RegisterType((*ForwardHtlcInterceptRequest)(nil), "lnrpc.ForwardHtlcInterceptRequest")
+	proto.RegisterType((*ForwardHtlcInterceptResponse)(nil), "lnrpc.ForwardHtlcInterceptResponse")
+	proto.RegisterType((*SubscribeHtlcEventsRequest)(nil), "lnrpc.SubscribeHtlcEventsRequest")
+	proto.RegisterType((*HtlcEvent)(nil), "lnrpc.HtlcEvent")
+	proto.RegisterType((*MacaroonPermission)(nil), "lnrpc.MacaroonPermission")
+	proto.RegisterType((*BakeMacaroonRequest)(nil), "lnrpc.BakeMacaroonRequest")
+	proto.RegisterType((*BakeMacaroonResponse)(nil), "lnrpc.BakeMacaroonResponse")
+	proto.RegisterType((*ListMacaroonIDsRequest)(nil), "lnrpc.ListMacaroonIDsRequest")
+	proto.RegisterType((*ListMacaroonIDsResponse)(nil), "lnrpc.ListMacaroonIDsResponse")
+	proto.RegisterType((*DeleteMacaroonIDRequest)(nil), "lnrpc.DeleteMacaroonIDRequest")
+	proto.RegisterType((*DeleteMacaroonIDResponse)(nil), "lnrpc.DeleteMacaroonIDResponse")
+	proto.RegisterType((*Account)(nil), "lnrpc.Account")
+	proto.RegisterType((*CreateAccountRequest)(nil), "lnrpc.CreateAccountRequest")
+	proto.RegisterType((*CreateAccountResponse)(nil), "lnrpc.CreateAccountResponse")
+	proto.RegisterType((*ListAccountsRequest)(nil), "lnrpc.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "lnrpc.ListAccountsResponse")
+	proto.RegisterType((*FundAccountRequest)(nil), "lnrpc.FundAccountRequest")
+	proto.RegisterType((*FundAccountResponse)(nil), "lnrpc.FundAccountResponse")
+	proto.RegisterType((*SendCustomMessageRequest)(nil), "lnrpc.SendCustomMessageRequest")
+	proto.RegisterType((*SendCustomMessageResponse)(nil), "lnrpc.SendCustomMessageResponse")
+	proto.RegisterType((*SubscribeCustomMessagesRequest)(nil), "lnrpc.SubscribeCustomMessagesRequest")
+	proto.RegisterType((*CustomMessage)(nil), "lnrpc.CustomMessage")
 	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
+	proto.RegisterEnum("lnrpc.Peer_SyncType", Peer_SyncType_name, Peer_SyncType_value)
+	proto.RegisterEnum("lnrpc.PeerEvent_EventType", PeerEvent_EventType_name, PeerEvent_EventType_value)
+	proto.RegisterEnum("lnrpc.Invoice_InvoiceState", Invoice_InvoiceState_name, Invoice_InvoiceState_value)
+	proto.RegisterEnum("lnrpc.Invoice_FallbackAddrPolicy", Invoice_FallbackAddrPolicy_name, Invoice_FallbackAddrPolicy_value)
+	proto.RegisterEnum("lnrpc.LedgerEntry_EntryType", LedgerEntry_EntryType_name, LedgerEntry_EntryType_value)
+	proto.RegisterEnum("lnrpc.ForwardHtlcInterceptResponse_Action", ForwardHtlcInterceptResponse_Action_name, ForwardHtlcInterceptResponse_Action_value)
+	proto.RegisterEnum("lnrpc.ForwardHtlcInterceptResponse_FailureCode", ForwardHtlcInterceptResponse_FailureCode_name, ForwardHtlcInterceptResponse_FailureCode_value)
+	proto.RegisterEnum("lnrpc.HtlcEvent_EventType", HtlcEvent_EventType_name, HtlcEvent_EventType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -7908,6 +10700,11 @@ type LightningClient interface {
 	// * lncli: `listpeers`
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	// *
+	// SubscribePeerEvents creates a uni-directional stream from the server to
+	// the client in which any events relevant to the state of peers are sent
+	// over. Events include peers going online and offline.
+	SubscribePeerEvents(ctx context.Context, in *PeerEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeerEventsClient, error)
 	// * lncli: `getinfo`
 	// GetInfo returns general information concerning the lightning node including
 	// it's identity pubkey, alias, the chains it is connected to, and information
@@ -7928,6 +10725,12 @@ type LightningClient interface {
 	// this node was a participant in.
 	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
 	// *
+	// SubscribeChannelEvents creates a uni-directional stream from the server to
+	// the client in which any updates relevant to the state of the channels are
+	// sent over. Events include new pending, open, active, inactive, closed and
+	// fully resolved channels.
+	SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error)
+	// *
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
 	// other sync calls, all byte slices are intended to be populated as hex
@@ -7960,6 +10763,12 @@ type LightningClient interface {
 	// through the Lightning Network. A single RPC invocation creates a persistent
 	// bi-directional stream allowing clients to rapidly send payments through the
 	// Lightning Network with a single persistent connection.
+	//
+	// If the macaroon used is restricted by a maximum payment amount, a spending
+	// budget or an account, the payment is checked against and charged to them.
+	// A macaroon restricted by a maximum payment amount or a spending budget
+	// can only be used to call SendPayment, SendPaymentSync, SendToRoute and
+	// SendToRouteSync, as these are the only methods enforcing them.
 	SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error)
 	// *
 	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
@@ -8007,7 +10816,8 @@ type LightningClient interface {
 	// settle_index is specified, the next, we'll send out all settle events for
 	// invoices with a settle_index greater than the specified value.  One or both
 	// of these fields can be set. If no fields are set, then we'll only send out
-	// the latest add/settle events.
+	// the latest add/settle events. Invoices canceled as they expire are sent
+	// out as they're canceled, but never as part of the backlog.
 	SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error)
 	// * lncli: `decodepayreq`
 	// DecodePayReq takes an encoded payment request string and attempts to decode
@@ -8067,6 +10877,22 @@ type LightningClient interface {
 	// level, or in a granular fashion to specify the logging for a target
 	// sub-system.
 	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
+	// * lncli: `getdbstats`
+	// GetDBStats returns the size of the channel database, along with a breakdown
+	// of its contents by the subsystem storing them. This can be used to find out
+	// which subsystem is responsible for the growth of the database.
+	GetDBStats(ctx context.Context, in *GetDBStatsRequest, opts ...grpc.CallOption) (*GetDBStatsResponse, error)
+	// * lncli: `backupdb`
+	// BackupDatabase streams a consistent, point-in-time backup of the entire
+	// channel database. The backup is taken within a single read transaction, so
+	// it's safe to take while lnd is running. The backup is preceded by a header
+	// holding the database version and the best block known to the node, which
+	// is used to refuse restoring outdated backups with `lncli restoredb`. As the
+	// backup contains all channel secrets, it requires the backup:read permission
+	// of the admin macaroon. Admin macaroons created before this call was added
+	// lack this permission. To regenerate them, all three default macaroon files
+	// must be deleted before restarting lnd.
+	BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (Lightning_BackupDatabaseClient, error)
 	// * lncli: `feereport`
 	// FeeReport allows the caller to obtain a report detailing the current fee
 	// schedule enforced by the node globally for each channel.
@@ -8075,6 +10901,11 @@ type LightningClient interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
+	// * lncli: `feedecisions`
+	// FeeDecisions allows the caller to query the recent decisions made by the
+	// fee manager when automatically adjusting the fees of our channels. This
+	// call fails if the fee manager isn't active.
+	FeeDecisions(ctx context.Context, in *FeeDecisionsRequest, opts ...grpc.CallOption) (*FeeDecisionsResponse, error)
 	// * lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLC's forwarded within the target time range, and integer offset
@@ -8087,6 +10918,70 @@ type LightningClient interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// * lncli: `accountingreport`
+	// AccountingReport returns a ledger of all on-chain and off-chain activity
+	// of the node within the target time range. This includes on-chain receipts
+	// and sends, channel opens and closes along with the fees paid for them,
+	// sweeps, routing fee income, and payments sent and received. Each entry
+	// references the wallet transaction or channeldb record it was created
+	// from. If no time range is specified, the ledger of the past 24 hours is
+	// returned.
+	AccountingReport(ctx context.Context, in *AccountingReportRequest, opts ...grpc.CallOption) (*AccountingReportResponse, error)
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC which allows an
+	// external process to decide the fate of each HTLC forwarded through our
+	// node. While the stream is open, every forwarded HTLC is held and sent to
+	// the client, which must respond with whether it should be resumed, failed
+	// with a particular failure code, or settled with a preimage. HTLCs that
+	// aren't resolved within the intercept timeout are resumed, as are all held
+	// HTLCs once the stream is closed. Only a single interceptor may be active
+	// at a time.
+	HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_HtlcInterceptorClient, error)
+	// *
+	// SubscribeHtlcEvents launches a streaming RPC that allows the caller to
+	// receive notifications whenever an HTLC that arrived over one of our
+	// channels is forwarded, settled or failed. Unlike ForwardingHistory, this
+	// includes HTLCs that were failed by our node, along with the reason for
+	// the failure.
+	SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Lightning_SubscribeHtlcEventsClient, error)
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom read and
+	// write permissions. No first-party caveats are added since this can be done
+	// offline. The macaroon is derived from the root key with the given ID, such
+	// that all macaroons sharing a root key ID can be revoked at once.
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns all root key IDs that are in use.
+	ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the root key with the given ID, revoking all
+	// macaroons that were baked using it. The default root key ID 0 can't be
+	// deleted.
+	DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error)
+	// * lncli: `createaccount`
+	// CreateAccount creates a new virtual account with its own balance. A
+	// macaroon bound to the account can only spend the balance of the account,
+	// while invoices created using it credit the account once settled. Such a
+	// macaroon can only call SendPayment, SendPaymentSync, SendToRoute,
+	// SendToRouteSync, AddInvoice, ListAccounts and DecodePayReq.
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	// * lncli: `listaccounts`
+	// ListAccounts returns all virtual accounts along with their balances.
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// * lncli: `fundaccount`
+	// FundAccount adds the given amount to the balance of a virtual account.
+	FundAccount(ctx context.Context, in *FundAccountRequest, opts ...grpc.CallOption) (*FundAccountResponse, error)
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a message of a type within the odd custom range
+	// to a connected peer. This allows applications to build their own protocols
+	// on top of existing peer connections.
+	SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages subscribes to a stream of incoming custom peer
+	// messages, which are messages of a type within the custom range. As
+	// required by BOLT 1, peers sending an even custom message type are
+	// disconnected, unless a subscriber claimed the type.
+	SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error)
 }
 
 type lightningClient struct {
@@ -8237,6 +11132,38 @@ func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, o
 	return out, nil
 }
 
+func (c *lightningClient) SubscribePeerEvents(ctx context.Context, in *PeerEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeerEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/SubscribePeerEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribePeerEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribePeerEventsClient interface {
+	Recv() (*PeerEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribePeerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribePeerEventsClient) Recv() (*PeerEvent, error) {
+	m := new(PeerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, opts...)
@@ -8273,6 +11200,38 @@ func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannels
 	return out, nil
 }
 
+func (c *lightningClient) SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChannelEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChannelEventsClient interface {
+	Recv() (*ChannelEventUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChannelEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChannelEventsClient) Recv() (*ChannelEventUpdate, error) {
+	m := new(ChannelEventUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
 	out := new(ChannelPoint)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, opts...)
@@ -8283,7 +11242,7 @@ func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRe
 }
 
 func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/OpenChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/OpenChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8315,7 +11274,7 @@ func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
 }
 
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/CloseChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8356,7 +11315,7 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 }
 
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/SendPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8396,7 +11355,7 @@ func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest,
 }
 
 func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/SendToRoute", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SendToRoute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8463,7 +11422,7 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 }
 
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8576,7 +11535,7 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 }
 
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8616,6 +11575,47 @@ func (c *lightningClient) DebugLevel(ctx context.Context, in *DebugLevelRequest,
 	return out, nil
 }
 
+func (c *lightningClient) GetDBStats(ctx context.Context, in *GetDBStatsRequest, opts ...grpc.CallOption) (*GetDBStatsResponse, error) {
+	out := new(GetDBStatsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetDBStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (Lightning_BackupDatabaseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/BackupDatabase", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningBackupDatabaseClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_BackupDatabaseClient interface {
+	Recv() (*DatabaseBackupChunk, error)
+	grpc.ClientStream
+}
+
+type lightningBackupDatabaseClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningBackupDatabaseClient) Recv() (*DatabaseBackupChunk, error) {
+	m := new(DatabaseBackupChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
 	out := new(FeeReportResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FeeReport", in, out, opts...)
@@ -8634,6 +11634,15 @@ func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpd
 	return out, nil
 }
 
+func (c *lightningClient) FeeDecisions(ctx context.Context, in *FeeDecisionsRequest, opts ...grpc.CallOption) (*FeeDecisionsResponse, error) {
+	out := new(FeeDecisionsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FeeDecisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
 	out := new(ForwardingHistoryResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, opts...)
@@ -8643,6 +11652,173 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 	return out, nil
 }
 
+func (c *lightningClient) AccountingReport(ctx context.Context, in *AccountingReportRequest, opts ...grpc.CallOption) (*AccountingReportResponse, error) {
+	out := new(AccountingReportResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AccountingReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_HtlcInterceptorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[10], "/lnrpc.Lightning/HtlcInterceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningHtlcInterceptorClient{stream}
+	return x, nil
+}
+
+type Lightning_HtlcInterceptorClient interface {
+	Send(*ForwardHtlcInterceptResponse) error
+	Recv() (*ForwardHtlcInterceptRequest, error)
+	grpc.ClientStream
+}
+
+type lightningHtlcInterceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningHtlcInterceptorClient) Send(m *ForwardHtlcInterceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningHtlcInterceptorClient) Recv() (*ForwardHtlcInterceptRequest, error) {
+	m := new(ForwardHtlcInterceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Lightning_SubscribeHtlcEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[11], "/lnrpc.Lightning/SubscribeHtlcEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeHtlcEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeHtlcEventsClient interface {
+	Recv() (*HtlcEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeHtlcEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
+	m := new(HtlcEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BakeMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error) {
+	out := new(ListMacaroonIDsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListMacaroonIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error) {
+	out := new(DeleteMacaroonIDResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeleteMacaroonID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FundAccount(ctx context.Context, in *FundAccountRequest, opts ...grpc.CallOption) (*FundAccountResponse, error) {
+	out := new(FundAccountResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FundAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error) {
+	out := new(SendCustomMessageResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendCustomMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[12], "/lnrpc.Lightning/SubscribeCustomMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeCustomMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeCustomMessagesClient interface {
+	Recv() (*CustomMessage, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeCustomMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeCustomMessagesClient) Recv() (*CustomMessage, error) {
+	m := new(CustomMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LightningServer is the server API for Lightning service.
 type LightningServer interface {
 	// * lncli: `walletbalance`
@@ -8707,6 +11883,11 @@ type LightningServer interface {
 	// * lncli: `listpeers`
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	// *
+	// SubscribePeerEvents creates a uni-directional stream from the server to
+	// the client in which any events relevant to the state of peers are sent
+	// over. Events include peers going online and offline.
+	SubscribePeerEvents(*PeerEventSubscription, Lightning_SubscribePeerEventsServer) error
 	// * lncli: `getinfo`
 	// GetInfo returns general information concerning the lightning node including
 	// it's identity pubkey, alias, the chains it is connected to, and information
@@ -8727,6 +11908,12 @@ type LightningServer interface {
 	// this node was a participant in.
 	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
 	// *
+	// SubscribeChannelEvents creates a uni-directional stream from the server to
+	// the client in which any updates relevant to the state of the channels are
+	// sent over. Events include new pending, open, active, inactive, closed and
+	// fully resolved channels.
+	SubscribeChannelEvents(*ChannelEventSubscription, Lightning_SubscribeChannelEventsServer) error
+	// *
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
 	// other sync calls, all byte slices are intended to be populated as hex
@@ -8759,6 +11946,12 @@ type LightningServer interface {
 	// through the Lightning Network. A single RPC invocation creates a persistent
 	// bi-directional stream allowing clients to rapidly send payments through the
 	// Lightning Network with a single persistent connection.
+	//
+	// If the macaroon used is restricted by a maximum payment amount, a spending
+	// budget or an account, the payment is checked against and charged to them.
+	// A macaroon restricted by a maximum payment amount or a spending budget
+	// can only be used to call SendPayment, SendPaymentSync, SendToRoute and
+	// SendToRouteSync, as these are the only methods enforcing them.
 	SendPayment(Lightning_SendPaymentServer) error
 	// *
 	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
@@ -8806,7 +11999,8 @@ type LightningServer interface {
 	// settle_index is specified, the next, we'll send out all settle events for
 	// invoices with a settle_index greater than the specified value.  One or both
 	// of these fields can be set. If no fields are set, then we'll only send out
-	// the latest add/settle events.
+	// the latest add/settle events. Invoices canceled as they expire are sent
+	// out as they're canceled, but never as part of the backlog.
 	SubscribeInvoices(*InvoiceSubscription, Lightning_SubscribeInvoicesServer) error
 	// * lncli: `decodepayreq`
 	// DecodePayReq takes an encoded payment request string and attempts to decode
@@ -8866,6 +12060,22 @@ type LightningServer interface {
 	// level, or in a granular fashion to specify the logging for a target
 	// sub-system.
 	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
+	// * lncli: `getdbstats`
+	// GetDBStats returns the size of the channel database, along with a breakdown
+	// of its contents by the subsystem storing them. This can be used to find out
+	// which subsystem is responsible for the growth of the database.
+	GetDBStats(context.Context, *GetDBStatsRequest) (*GetDBStatsResponse, error)
+	// * lncli: `backupdb`
+	// BackupDatabase streams a consistent, point-in-time backup of the entire
+	// channel database. The backup is taken within a single read transaction, so
+	// it's safe to take while lnd is running. The backup is preceded by a header
+	// holding the database version and the best block known to the node, which
+	// is used to refuse restoring outdated backups with `lncli restoredb`. As the
+	// backup contains all channel secrets, it requires the backup:read permission
+	// of the admin macaroon. Admin macaroons created before this call was added
+	// lack this permission. To regenerate them, all three default macaroon files
+	// must be deleted before restarting lnd.
+	BackupDatabase(*BackupDatabaseRequest, Lightning_BackupDatabaseServer) error
 	// * lncli: `feereport`
 	// FeeReport allows the caller to obtain a report detailing the current fee
 	// schedule enforced by the node globally for each channel.
@@ -8874,6 +12084,11 @@ type LightningServer interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
+	// * lncli: `feedecisions`
+	// FeeDecisions allows the caller to query the recent decisions made by the
+	// fee manager when automatically adjusting the fees of our channels. This
+	// call fails if the fee manager isn't active.
+	FeeDecisions(context.Context, *FeeDecisionsRequest) (*FeeDecisionsResponse, error)
 	// * lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLC's forwarded within the target time range, and integer offset
@@ -8886,6 +12101,70 @@ type LightningServer interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// * lncli: `accountingreport`
+	// AccountingReport returns a ledger of all on-chain and off-chain activity
+	// of the node within the target time range. This includes on-chain receipts
+	// and sends, channel opens and closes along with the fees paid for them,
+	// sweeps, routing fee income, and payments sent and received. Each entry
+	// references the wallet transaction or channeldb record it was created
+	// from. If no time range is specified, the ledger of the past 24 hours is
+	// returned.
+	AccountingReport(context.Context, *AccountingReportRequest) (*AccountingReportResponse, error)
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC which allows an
+	// external process to decide the fate of each HTLC forwarded through our
+	// node. While the stream is open, every forwarded HTLC is held and sent to
+	// the client, which must respond with whether it should be resumed, failed
+	// with a particular failure code, or settled with a preimage. HTLCs that
+	// aren't resolved within the intercept timeout are resumed, as are all held
+	// HTLCs once the stream is closed. Only a single interceptor may be active
+	// at a time.
+	HtlcInterceptor(Lightning_HtlcInterceptorServer) error
+	// *
+	// SubscribeHtlcEvents launches a streaming RPC that allows the caller to
+	// receive notifications whenever an HTLC that arrived over one of our
+	// channels is forwarded, settled or failed. Unlike ForwardingHistory, this
+	// includes HTLCs that were failed by our node, along with the reason for
+	// the failure.
+	SubscribeHtlcEvents(*SubscribeHtlcEventsRequest, Lightning_SubscribeHtlcEventsServer) error
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom read and
+	// write permissions. No first-party caveats are added since this can be done
+	// offline. The macaroon is derived from the root key with the given ID, such
+	// that all macaroons sharing a root key ID can be revoked at once.
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns all root key IDs that are in use.
+	ListMacaroonIDs(context.Context, *ListMacaroonIDsRequest) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the root key with the given ID, revoking all
+	// macaroons that were baked using it. The default root key ID 0 can't be
+	// deleted.
+	DeleteMacaroonID(context.Context, *DeleteMacaroonIDRequest) (*DeleteMacaroonIDResponse, error)
+	// * lncli: `createaccount`
+	// CreateAccount creates a new virtual account with its own balance. A
+	// macaroon bound to the account can only spend the balance of the account,
+	// while invoices created using it credit the account once settled. Such a
+	// macaroon can only call SendPayment, SendPaymentSync, SendToRoute,
+	// SendToRouteSync, AddInvoice, ListAccounts and DecodePayReq.
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	// * lncli: `listaccounts`
+	// ListAccounts returns all virtual accounts along with their balances.
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// * lncli: `fundaccount`
+	// FundAccount adds the given amount to the balance of a virtual account.
+	FundAccount(context.Context, *FundAccountRequest) (*FundAccountResponse, error)
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a message of a type within the odd custom range
+	// to a connected peer. This allows applications to build their own protocols
+	// on top of existing peer connections.
+	SendCustomMessage(context.Context, *SendCustomMessageRequest) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages subscribes to a stream of incoming custom peer
+	// messages, which are messages of a type within the custom range. As
+	// required by BOLT 1, peers sending an even custom message type are
+	// disconnected, unless a subscriber claimed the type.
+	SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error
 }
 
 func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
@@ -9129,6 +12408,27 @@ func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SubscribePeerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PeerEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribePeerEvents(m, &lightningSubscribePeerEventsServer{stream})
+}
+
+type Lightning_SubscribePeerEventsServer interface {
+	Send(*PeerEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribePeerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribePeerEventsServer) Send(m *PeerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -9201,6 +12501,27 @@ func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SubscribeChannelEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChannelEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeChannelEvents(m, &lightningSubscribeChannelEventsServer{stream})
+}
+
+type Lightning_SubscribeChannelEventsServer interface {
+	Send(*ChannelEventUpdate) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeChannelEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeChannelEventsServer) Send(m *ChannelEventUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenChannelRequest)
 	if err := dec(in); err != nil {
@@ -9643,6 +12964,45 @@ func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_GetDBStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDBStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetDBStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetDBStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetDBStats(ctx, req.(*GetDBStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BackupDatabase_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupDatabaseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).BackupDatabase(m, &lightningBackupDatabaseServer{stream})
+}
+
+type Lightning_BackupDatabaseServer interface {
+	Send(*DatabaseBackupChunk) error
+	grpc.ServerStream
+}
+
+type lightningBackupDatabaseServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningBackupDatabaseServer) Send(m *DatabaseBackupChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FeeReportRequest)
 	if err := dec(in); err != nil {
@@ -9679,6 +13039,24 @@ func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_FeeDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FeeDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FeeDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FeeDecisions(ctx, req.(*FeeDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ForwardingHistoryRequest)
 	if err := dec(in); err != nil {
@@ -9697,6 +13075,218 @@ func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_AccountingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AccountingReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AccountingReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AccountingReport(ctx, req.(*AccountingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_HtlcInterceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).HtlcInterceptor(&lightningHtlcInterceptorServer{stream})
+}
+
+type Lightning_HtlcInterceptorServer interface {
+	Send(*ForwardHtlcInterceptRequest) error
+	Recv() (*ForwardHtlcInterceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningHtlcInterceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningHtlcInterceptorServer) Send(m *ForwardHtlcInterceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningHtlcInterceptorServer) Recv() (*ForwardHtlcInterceptResponse, error) {
+	m := new(ForwardHtlcInterceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SubscribeHtlcEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHtlcEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeHtlcEvents(m, &lightningSubscribeHtlcEventsServer{stream})
+}
+
+type Lightning_SubscribeHtlcEventsServer interface {
+	Send(*HtlcEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeHtlcEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeHtlcEventsServer) Send(m *HtlcEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BakeMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BakeMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListMacaroonIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMacaroonIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListMacaroonIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, req.(*ListMacaroonIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DeleteMacaroonID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMacaroonIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeleteMacaroonID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, req.(*DeleteMacaroonIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_FundAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FundAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FundAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FundAccount(ctx, req.(*FundAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SendCustomMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCustomMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendCustomMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendCustomMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendCustomMessage(ctx, req.(*SendCustomMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeCustomMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCustomMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeCustomMessages(m, &lightningSubscribeCustomMessagesServer{stream})
+}
+
+type Lightning_SubscribeCustomMessagesServer interface {
+	Send(*CustomMessage) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeCustomMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeCustomMessagesServer) Send(m *CustomMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Lightning_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lnrpc.Lightning",
 	HandlerType: (*LightningServer)(nil),
@@ -9833,6 +13423,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DebugLevel",
 			Handler:    _Lightning_DebugLevel_Handler,
 		},
+		{
+			MethodName: "GetDBStats",
+			Handler:    _Lightning_GetDBStats_Handler,
+		},
 		{
 			MethodName: "FeeReport",
 			Handler:    _Lightning_FeeReport_Handler,
@@ -9841,10 +13435,46 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChannelPolicy",
 			Handler:    _Lightning_UpdateChannelPolicy_Handler,
 		},
+		{
+			MethodName: "FeeDecisions",
+			Handler:    _Lightning_FeeDecisions_Handler,
+		},
 		{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
 		},
+		{
+			MethodName: "AccountingReport",
+			Handler:    _Lightning_AccountingReport_Handler,
+		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _Lightning_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "ListMacaroonIDs",
+			Handler:    _Lightning_ListMacaroonIDs_Handler,
+		},
+		{
+			MethodName: "DeleteMacaroonID",
+			Handler:    _Lightning_DeleteMacaroonID_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _Lightning_CreateAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _Lightning_ListAccounts_Handler,
+		},
+		{
+			MethodName: "FundAccount",
+			Handler:    _Lightning_FundAccount_Handler,
+		},
+		{
+			MethodName: "SendCustomMessage",
+			Handler:    _Lightning_SendCustomMessage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -9852,6 +13482,16 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeTransactions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribePeerEvents",
+			Handler:       _Lightning_SubscribePeerEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeChannelEvents",
+			Handler:       _Lightning_SubscribeChannelEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "OpenChannel",
 			Handler:       _Lightning_OpenChannel_Handler,
@@ -9884,428 +13524,598 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeChannelGraph_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BackupDatabase",
+			Handler:       _Lightning_BackupDatabase_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "HtlcInterceptor",
+			Handler:       _Lightning_HtlcInterceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeHtlcEvents",
+			Handler:       _Lightning_SubscribeHtlcEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeCustomMessages",
+			Handler:       _Lightning_SubscribeCustomMessages_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_cad88c6305c67548) }
-
-var fileDescriptor_rpc_cad88c6305c67548 = []byte{
-	// 6651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0x5b, 0x8c, 0x1c, 0xd9,
-	0x59, 0x76, 0xf5, 0x65, 0xa6, 0xfb, 0xef, 0x9e, 0xee, 0x9e, 0x33, 0xb7, 0x76, 0xaf, 0xd7, 0xeb,
-	0xad, 0x98, 0xb5, 0x33, 0x2c, 0x1e, 0xef, 0x24, 0x59, 0x6d, 0xd6, 0x90, 0x30, 0x9e, 0x19, 0x7b,
-	0x4c, 0x66, 0xed, 0x49, 0x8d, 0x1d, 0x93, 0x0d, 0xa8, 0x53, 0xd3, 0x7d, 0xa6, 0xbb, 0xe2, 0xee,
-	0xaa, 0x4e, 0x55, 0xf5, 0x8c, 0x3b, 0x8b, 0x25, 0x6e, 0x02, 0x09, 0x11, 0x45, 0x88, 0x07, 0x14,
-	0x24, 0x84, 0x14, 0x78, 0x48, 0x1e, 0x79, 0x89, 0x90, 0x80, 0x37, 0x5e, 0x40, 0x42, 0x08, 0xe5,
-	0x09, 0x21, 0xf1, 0x02, 0x2f, 0x80, 0xe0, 0x01, 0x89, 0x47, 0x10, 0x3a, 0xff, 0xb9, 0xd4, 0x39,
-	0x55, 0xd5, 0x1e, 0x27, 0x59, 0x78, 0xeb, 0xf3, 0x9d, 0xbf, 0xce, 0xf5, 0xbf, 0x9d, 0xff, 0xfc,
-	0xa7, 0xa1, 0x1a, 0x4e, 0x7a, 0xb7, 0x26, 0x61, 0x10, 0x07, 0xa4, 0x3c, 0xf2, 0xc3, 0x49, 0xaf,
-	0x73, 0x65, 0x10, 0x04, 0x83, 0x11, 0xdd, 0x72, 0x27, 0xde, 0x96, 0xeb, 0xfb, 0x41, 0xec, 0xc6,
-	0x5e, 0xe0, 0x47, 0x9c, 0xc8, 0xfe, 0x2a, 0x34, 0xee, 0x53, 0xff, 0x98, 0xd2, 0xbe, 0x43, 0xbf,
-	0x3e, 0xa5, 0x51, 0x4c, 0x7e, 0x12, 0x96, 0x5d, 0xfa, 0x0d, 0x4a, 0xfb, 0xdd, 0x89, 0x1b, 0x45,
-	0x93, 0x61, 0xe8, 0x46, 0xb4, 0x6d, 0x5d, 0xb3, 0x6e, 0xd6, 0x9d, 0x16, 0xaf, 0x38, 0x52, 0x38,
-	0x79, 0x13, 0xea, 0x11, 0x23, 0xa5, 0x7e, 0x1c, 0x06, 0x93, 0x59, 0xbb, 0x80, 0x74, 0x35, 0x86,
-	0xed, 0x73, 0xc8, 0x1e, 0x41, 0x53, 0xf5, 0x10, 0x4d, 0x02, 0x3f, 0xa2, 0xe4, 0x36, 0xac, 0xf6,
-	0xbc, 0xc9, 0x90, 0x86, 0x5d, 0xfc, 0x78, 0xec, 0xd3, 0x71, 0xe0, 0x7b, 0xbd, 0xb6, 0x75, 0xad,
-	0x78, 0xb3, 0xea, 0x10, 0x5e, 0xc7, 0xbe, 0xf8, 0x40, 0xd4, 0x90, 0x1b, 0xd0, 0xa4, 0x3e, 0xc7,
-	0x69, 0x1f, 0xbf, 0x12, 0x5d, 0x35, 0x12, 0x98, 0x7d, 0x60, 0xff, 0xa5, 0x05, 0xcb, 0x0f, 0x7c,
-	0x2f, 0x7e, 0xea, 0x8e, 0x46, 0x34, 0x96, 0x73, 0xba, 0x01, 0xcd, 0x73, 0x04, 0x70, 0x4e, 0xe7,
-	0x41, 0xd8, 0x17, 0x33, 0x6a, 0x70, 0xf8, 0x48, 0xa0, 0x73, 0x47, 0x56, 0x98, 0x3b, 0xb2, 0xdc,
-	0xe5, 0x2a, 0xce, 0x59, 0xae, 0x1b, 0xd0, 0x0c, 0x69, 0x2f, 0x38, 0xa3, 0xe1, 0xac, 0x7b, 0xee,
-	0xf9, 0xfd, 0xe0, 0xbc, 0x5d, 0xba, 0x66, 0xdd, 0x2c, 0x3b, 0x0d, 0x09, 0x3f, 0x45, 0xd4, 0x5e,
-	0x05, 0xa2, 0xcf, 0x82, 0xaf, 0x9b, 0x3d, 0x80, 0x95, 0x27, 0xfe, 0x28, 0xe8, 0x3d, 0xfb, 0x11,
-	0x67, 0x97, 0xd3, 0x7d, 0x21, 0xb7, 0xfb, 0x75, 0x58, 0x35, 0x3b, 0x12, 0x03, 0xa0, 0xb0, 0xb6,
-	0x3b, 0x74, 0xfd, 0x01, 0x95, 0x4d, 0xca, 0x21, 0x7c, 0x12, 0x5a, 0xbd, 0x69, 0x18, 0x52, 0x3f,
-	0x33, 0x86, 0xa6, 0xc0, 0xd5, 0x20, 0xde, 0x84, 0xba, 0x4f, 0xcf, 0x13, 0x32, 0xc1, 0x32, 0x3e,
-	0x3d, 0x97, 0x24, 0x76, 0x1b, 0xd6, 0xd3, 0xdd, 0x88, 0x01, 0xfc, 0xbb, 0x05, 0xa5, 0x27, 0xf1,
-	0xf3, 0x80, 0xdc, 0x82, 0x52, 0x3c, 0x9b, 0x70, 0xc6, 0x6c, 0x6c, 0x93, 0x5b, 0xc8, 0xeb, 0xb7,
-	0x76, 0xfa, 0xfd, 0x90, 0x46, 0xd1, 0xe3, 0xd9, 0x84, 0x3a, 0x75, 0x97, 0x17, 0xba, 0x8c, 0x8e,
-	0xb4, 0x61, 0x51, 0x94, 0xb1, 0xc3, 0xaa, 0x23, 0x8b, 0xe4, 0x2a, 0x80, 0x3b, 0x0e, 0xa6, 0x7e,
-	0xdc, 0x8d, 0xdc, 0x18, 0x77, 0xae, 0xe8, 0x68, 0x08, 0xb9, 0x0e, 0x4b, 0x51, 0x2f, 0xf4, 0x26,
-	0x71, 0x77, 0x32, 0x3d, 0x79, 0x46, 0x67, 0xb8, 0x63, 0x55, 0xc7, 0x04, 0xc9, 0x16, 0x54, 0x82,
-	0x69, 0x3c, 0x09, 0x3c, 0x3f, 0x6e, 0x97, 0xaf, 0x59, 0x37, 0x6b, 0xdb, 0x2b, 0x62, 0x4c, 0x6c,
-	0x26, 0x3e, 0x1d, 0x1d, 0xb1, 0x2a, 0x47, 0x11, 0xb1, 0x66, 0x7b, 0x81, 0x7f, 0xea, 0x85, 0x63,
-	0x2e, 0x8f, 0xed, 0x05, 0xec, 0xd9, 0x04, 0xed, 0x6f, 0x17, 0xa0, 0xf6, 0x38, 0x74, 0xfd, 0xc8,
-	0xed, 0x31, 0x80, 0x4d, 0x23, 0x7e, 0xde, 0x1d, 0xba, 0xd1, 0x10, 0x67, 0x5e, 0x75, 0x64, 0x91,
-	0xac, 0xc3, 0x02, 0x1f, 0x34, 0xce, 0xaf, 0xe8, 0x88, 0x12, 0x79, 0x1b, 0x96, 0xfd, 0xe9, 0xb8,
-	0x6b, 0xf6, 0x55, 0xc4, 0x5d, 0xcf, 0x56, 0xb0, 0xc5, 0x38, 0x61, 0xfb, 0xce, 0xbb, 0xe0, 0x33,
-	0xd5, 0x10, 0x62, 0x43, 0x5d, 0x94, 0xa8, 0x37, 0x18, 0xf2, 0xa9, 0x96, 0x1d, 0x03, 0x63, 0x6d,
-	0xc4, 0xde, 0x98, 0x76, 0xa3, 0xd8, 0x1d, 0x4f, 0xc4, 0xb4, 0x34, 0x04, 0xeb, 0x83, 0xd8, 0x1d,
-	0x75, 0x4f, 0x29, 0x8d, 0xda, 0x8b, 0xa2, 0x5e, 0x21, 0xe4, 0x2d, 0x68, 0xf4, 0x69, 0x14, 0x77,
-	0xc5, 0x06, 0xd1, 0xa8, 0x5d, 0x41, 0xe9, 0x4b, 0xa1, 0x8c, 0x4b, 0xee, 0xd3, 0x58, 0x5b, 0x9d,
-	0x48, 0x70, 0xa3, 0x7d, 0x08, 0x44, 0x83, 0xf7, 0x68, 0xec, 0x7a, 0xa3, 0x88, 0xbc, 0x0b, 0xf5,
-	0x58, 0x23, 0x46, 0x6d, 0x53, 0x53, 0xac, 0xa3, 0x7d, 0xe0, 0x18, 0x74, 0xf6, 0x7d, 0xa8, 0xdc,
-	0xa3, 0xf4, 0xd0, 0x1b, 0x7b, 0x31, 0x59, 0x87, 0xf2, 0xa9, 0xf7, 0x9c, 0x72, 0xe6, 0x2e, 0x1e,
-	0x5c, 0x72, 0x78, 0x91, 0x74, 0x60, 0x71, 0x42, 0xc3, 0x1e, 0x95, 0xcb, 0x7f, 0x70, 0xc9, 0x91,
-	0xc0, 0xdd, 0x45, 0x28, 0x8f, 0xd8, 0xc7, 0xf6, 0x77, 0x0b, 0x50, 0x3b, 0xa6, 0xbe, 0x12, 0x1a,
-	0x02, 0x25, 0x36, 0x25, 0x21, 0x28, 0xf8, 0x9b, 0xbc, 0x01, 0x35, 0x9c, 0x66, 0x14, 0x87, 0x9e,
-	0x3f, 0x10, 0xbc, 0x0a, 0x0c, 0x3a, 0x46, 0x84, 0xb4, 0xa0, 0xe8, 0x8e, 0x25, 0x9f, 0xb2, 0x9f,
-	0x4c, 0xa0, 0x26, 0xee, 0x6c, 0xcc, 0x64, 0x4f, 0xed, 0x5a, 0xdd, 0xa9, 0x09, 0xec, 0x80, 0x6d,
-	0xdb, 0x2d, 0x58, 0xd1, 0x49, 0x64, 0xeb, 0x65, 0x6c, 0x7d, 0x59, 0xa3, 0x14, 0x9d, 0xdc, 0x80,
-	0xa6, 0xa4, 0x0f, 0xf9, 0x60, 0x71, 0x1f, 0xab, 0x4e, 0x43, 0xc0, 0x72, 0x0a, 0x37, 0xa1, 0x75,
-	0xea, 0xf9, 0xee, 0xa8, 0xdb, 0x1b, 0xc5, 0x67, 0xdd, 0x3e, 0x1d, 0xc5, 0x2e, 0xee, 0x68, 0xd9,
-	0x69, 0x20, 0xbe, 0x3b, 0x8a, 0xcf, 0xf6, 0x18, 0x4a, 0xde, 0x86, 0xea, 0x29, 0xa5, 0x5d, 0x5c,
-	0x89, 0x76, 0x05, 0x25, 0xa4, 0x29, 0x96, 0x5e, 0xae, 0xae, 0x53, 0x39, 0x15, 0xbf, 0xec, 0x3f,
-	0xb5, 0xa0, 0xce, 0x97, 0x4a, 0x98, 0x8c, 0xeb, 0xb0, 0x24, 0x47, 0x44, 0xc3, 0x30, 0x08, 0x05,
-	0xfb, 0x9b, 0x20, 0xd9, 0x84, 0x96, 0x04, 0x26, 0x21, 0xf5, 0xc6, 0xee, 0x80, 0x0a, 0xfd, 0x92,
-	0xc1, 0xc9, 0x76, 0xd2, 0x62, 0x18, 0x4c, 0x63, 0xae, 0xb4, 0x6b, 0xdb, 0x75, 0x31, 0x28, 0x87,
-	0x61, 0x8e, 0x49, 0xc2, 0xd8, 0x3f, 0x67, 0xa9, 0x0d, 0xcc, 0xfe, 0xa6, 0x05, 0x84, 0x0d, 0xfd,
-	0x71, 0xc0, 0x9b, 0x10, 0x2b, 0x95, 0xde, 0x25, 0xeb, 0x95, 0x77, 0xa9, 0x30, 0x6f, 0x97, 0xae,
-	0xc3, 0x02, 0x0e, 0x8b, 0xc9, 0x73, 0x31, 0x33, 0x74, 0x51, 0x67, 0x7f, 0xc7, 0x82, 0xba, 0xae,
-	0x83, 0xc8, 0x6d, 0x20, 0xa7, 0x53, 0xbf, 0xef, 0xf9, 0x83, 0x6e, 0xfc, 0xdc, 0xeb, 0x77, 0x4f,
-	0x66, 0xac, 0x09, 0x1c, 0xcf, 0xc1, 0x25, 0x27, 0xa7, 0x8e, 0xbc, 0x0d, 0x2d, 0x03, 0x8d, 0xe2,
-	0x90, 0x8f, 0xea, 0xe0, 0x92, 0x93, 0xa9, 0x61, 0x8b, 0xc4, 0xb4, 0xdc, 0x34, 0xee, 0x7a, 0x7e,
-	0x9f, 0x3e, 0xc7, 0x75, 0x5d, 0x72, 0x0c, 0xec, 0x6e, 0x03, 0xea, 0xfa, 0x77, 0xf6, 0xe7, 0xa0,
-	0x75, 0xc8, 0x94, 0x87, 0xef, 0xf9, 0x03, 0xa1, 0xc4, 0x99, 0x46, 0x13, 0x1a, 0x97, 0xef, 0xb5,
-	0x28, 0x31, 0xb1, 0x19, 0x06, 0x51, 0x2c, 0xd6, 0x05, 0x7f, 0xdb, 0xff, 0x64, 0x41, 0x93, 0x2d,
-	0xfa, 0x07, 0xae, 0x3f, 0x93, 0x2b, 0x7e, 0x08, 0x75, 0xd6, 0xd4, 0xe3, 0x60, 0x87, 0xeb, 0x45,
-	0x2e, 0xef, 0x37, 0xc5, 0x22, 0xa5, 0xa8, 0x6f, 0xe9, 0xa4, 0xcc, 0x75, 0x99, 0x39, 0xc6, 0xd7,
-	0x4c, 0x30, 0x63, 0x37, 0x1c, 0xd0, 0x18, 0x35, 0xa6, 0xd0, 0xa0, 0xc0, 0xa1, 0xdd, 0xc0, 0x3f,
-	0x25, 0xd7, 0xa0, 0x1e, 0xb9, 0x71, 0x77, 0x42, 0x43, 0x5c, 0x35, 0x14, 0xae, 0xa2, 0x03, 0x91,
-	0x1b, 0x1f, 0xd1, 0xf0, 0xee, 0x2c, 0xa6, 0x9d, 0xcf, 0xc3, 0x72, 0xa6, 0x17, 0x26, 0xcf, 0xc9,
-	0x14, 0xd9, 0x4f, 0xb2, 0x0a, 0xe5, 0x33, 0x77, 0x34, 0xa5, 0x42, 0x91, 0xf3, 0xc2, 0xfb, 0x85,
-	0xf7, 0x2c, 0xfb, 0x2d, 0x68, 0x25, 0xc3, 0x16, 0x82, 0x41, 0xa0, 0xc4, 0x56, 0x50, 0x34, 0x80,
-	0xbf, 0xed, 0x5f, 0xb1, 0x38, 0xe1, 0x6e, 0xe0, 0x29, 0xa5, 0xc8, 0x08, 0x99, 0xee, 0x94, 0x84,
-	0xec, 0xf7, 0x5c, 0xa3, 0xf1, 0xe3, 0x4f, 0xd6, 0xbe, 0x01, 0xcb, 0xda, 0x10, 0x5e, 0x32, 0xd8,
-	0x87, 0x40, 0x0e, 0xbd, 0x28, 0x7e, 0xe2, 0x47, 0x13, 0x4d, 0xb1, 0xbc, 0x06, 0xd5, 0xb1, 0xe7,
-	0x63, 0xf7, 0x9c, 0x37, 0xcb, 0x4e, 0x65, 0xec, 0xf9, 0xac, 0xf3, 0x08, 0x2b, 0xdd, 0xe7, 0xa2,
-	0xb2, 0x20, 0x2a, 0xdd, 0xe7, 0x58, 0x69, 0xbf, 0x07, 0x2b, 0x46, 0x7b, 0xa2, 0xeb, 0x37, 0xa1,
-	0x3c, 0x8d, 0x9f, 0x07, 0x52, 0xed, 0xd7, 0x04, 0x1b, 0x30, 0x67, 0xc2, 0xe1, 0x35, 0xf6, 0x1d,
-	0x58, 0x7e, 0x48, 0xcf, 0x05, 0xfb, 0xc9, 0x81, 0xbc, 0x75, 0xa1, 0xa3, 0x81, 0xf5, 0xf6, 0x2d,
-	0x20, 0xfa, 0xc7, 0xa2, 0x57, 0xcd, 0xed, 0xb0, 0x0c, 0xb7, 0xc3, 0x7e, 0x0b, 0xc8, 0xb1, 0x37,
-	0xf0, 0x3f, 0xa0, 0x51, 0xe4, 0x0e, 0x94, 0x96, 0x68, 0x41, 0x71, 0x1c, 0x0d, 0x84, 0x72, 0x60,
-	0x3f, 0xed, 0x4f, 0xc1, 0x8a, 0x41, 0x27, 0x1a, 0xbe, 0x02, 0xd5, 0xc8, 0x1b, 0xf8, 0x6e, 0x3c,
-	0x0d, 0xa9, 0x68, 0x3a, 0x01, 0xec, 0x7b, 0xb0, 0xfa, 0x25, 0x1a, 0x7a, 0xa7, 0xb3, 0x8b, 0x9a,
-	0x37, 0xdb, 0x29, 0xa4, 0xdb, 0xd9, 0x87, 0xb5, 0x54, 0x3b, 0xa2, 0x7b, 0xce, 0xa3, 0x62, 0x27,
-	0x2b, 0x0e, 0x2f, 0x68, 0x12, 0x5b, 0xd0, 0x25, 0xd6, 0x7e, 0x02, 0x64, 0x37, 0xf0, 0x7d, 0xda,
-	0x8b, 0x8f, 0x28, 0x0d, 0x93, 0x83, 0x46, 0xc2, 0x90, 0xb5, 0xed, 0x0d, 0xb1, 0xb2, 0x69, 0x35,
-	0x20, 0x38, 0x95, 0x40, 0x69, 0x42, 0xc3, 0x31, 0x36, 0x5c, 0x71, 0xf0, 0xb7, 0xbd, 0x06, 0x2b,
-	0x46, 0xb3, 0xc2, 0x47, 0x7c, 0x07, 0xd6, 0xf6, 0xbc, 0xa8, 0x97, 0xed, 0xb0, 0x0d, 0x8b, 0x93,
-	0xe9, 0x49, 0x37, 0x11, 0x37, 0x59, 0x64, 0xae, 0x44, 0xfa, 0x13, 0xd1, 0xd8, 0x6f, 0x58, 0x50,
-	0x3a, 0x78, 0x7c, 0xb8, 0x4b, 0x3a, 0x50, 0xf1, 0xfc, 0x5e, 0x30, 0x66, 0x1a, 0x99, 0x4f, 0x5a,
-	0x95, 0xe7, 0x8a, 0xd1, 0x15, 0xa8, 0xa2, 0x22, 0x67, 0xde, 0x91, 0x38, 0x13, 0x24, 0x00, 0xf3,
-	0xcc, 0xe8, 0xf3, 0x89, 0x17, 0xa2, 0xeb, 0x25, 0x1d, 0xaa, 0x12, 0x2a, 0xcb, 0x6c, 0x85, 0xfd,
-	0x3f, 0x25, 0x58, 0x14, 0x6a, 0x1c, 0xfb, 0xeb, 0xc5, 0xde, 0x19, 0x15, 0x23, 0x11, 0x25, 0x66,
-	0x24, 0x43, 0x3a, 0x0e, 0x62, 0xda, 0x35, 0xb6, 0xc1, 0x04, 0xd1, 0xf3, 0xe4, 0x0d, 0x75, 0xb9,
-	0xbf, 0x5a, 0xe4, 0x54, 0x06, 0xc8, 0x16, 0x8b, 0x01, 0x5d, 0xaf, 0x8f, 0x63, 0x2a, 0x39, 0xb2,
-	0xc8, 0x56, 0xa2, 0xe7, 0x4e, 0xdc, 0x9e, 0x17, 0xcf, 0x84, 0xdc, 0xab, 0x32, 0x6b, 0x7b, 0x14,
-	0xf4, 0xdc, 0x51, 0xf7, 0xc4, 0x1d, 0xb9, 0x7e, 0x8f, 0x4a, 0xaf, 0xd6, 0x00, 0x99, 0x87, 0x27,
-	0x86, 0x24, 0xc9, 0xb8, 0x17, 0x98, 0x42, 0x99, 0xa7, 0xd8, 0x0b, 0xc6, 0x63, 0x2f, 0x66, 0x8e,
-	0x21, 0x3a, 0x0d, 0x45, 0x47, 0x43, 0xb8, 0x0f, 0x8d, 0xa5, 0x73, 0xbe, 0x7a, 0x55, 0xe9, 0x43,
-	0x6b, 0x20, 0x6b, 0x85, 0x79, 0x1e, 0x4c, 0x57, 0x3d, 0x3b, 0x6f, 0x03, 0x6f, 0x25, 0x41, 0xd8,
-	0x3e, 0x4c, 0xfd, 0x88, 0xc6, 0xf1, 0x88, 0xf6, 0xd5, 0x80, 0x6a, 0x48, 0x96, 0xad, 0x20, 0xb7,
-	0x61, 0x85, 0xfb, 0xaa, 0x91, 0x1b, 0x07, 0xd1, 0xd0, 0x8b, 0xba, 0x11, 0xf3, 0xfa, 0xea, 0x48,
-	0x9f, 0x57, 0x45, 0xde, 0x83, 0x8d, 0x14, 0x1c, 0xd2, 0x1e, 0xf5, 0xce, 0x68, 0xbf, 0xbd, 0x84,
-	0x5f, 0xcd, 0xab, 0x26, 0xd7, 0xa0, 0xc6, 0x5c, 0xf4, 0xe9, 0xa4, 0xef, 0x32, 0x13, 0xdd, 0xc0,
-	0x7d, 0xd0, 0x21, 0xf2, 0x0e, 0x2c, 0x4d, 0x28, 0xb7, 0xa3, 0xc3, 0x78, 0xd4, 0x8b, 0xda, 0x4d,
-	0x43, 0xbb, 0x31, 0xce, 0x75, 0x4c, 0x0a, 0xc6, 0x94, 0xbd, 0x08, 0x7d, 0x35, 0x77, 0xd6, 0x6e,
-	0x21, 0xbb, 0x25, 0x00, 0xca, 0x48, 0xe8, 0x9d, 0xb9, 0x31, 0x6d, 0x2f, 0x23, 0x6f, 0xc9, 0xa2,
-	0xfd, 0x87, 0x16, 0x57, 0xac, 0x82, 0x09, 0x95, 0x82, 0x7c, 0x03, 0x6a, 0x9c, 0xfd, 0xba, 0x81,
-	0x3f, 0x9a, 0x09, 0x8e, 0x04, 0x0e, 0x3d, 0xf2, 0x47, 0x33, 0xf2, 0x09, 0x58, 0xf2, 0x7c, 0x9d,
-	0x84, 0xcb, 0x70, 0x5d, 0x82, 0x48, 0xf4, 0x06, 0xd4, 0x26, 0xd3, 0x93, 0x91, 0xd7, 0xe3, 0x24,
-	0x45, 0xde, 0x0a, 0x87, 0x90, 0x80, 0xf9, 0x4f, 0x7c, 0x24, 0x9c, 0xa2, 0x84, 0x14, 0x35, 0x81,
-	0x31, 0x12, 0xfb, 0x2e, 0xac, 0x9a, 0x03, 0x14, 0xca, 0x6a, 0x13, 0x2a, 0x82, 0xb7, 0xa3, 0x76,
-	0x0d, 0xd7, 0xa7, 0x61, 0x9e, 0xcd, 0x1c, 0x55, 0x6f, 0x7f, 0xbf, 0x04, 0x2b, 0x02, 0xdd, 0x1d,
-	0x05, 0x11, 0x3d, 0x9e, 0x8e, 0xc7, 0x6e, 0x98, 0x23, 0x34, 0xd6, 0x05, 0x42, 0x53, 0x30, 0x85,
-	0x86, 0xb1, 0xf2, 0xd0, 0xf5, 0x7c, 0xee, 0xfc, 0x71, 0x89, 0xd3, 0x10, 0x72, 0x13, 0x9a, 0xbd,
-	0x51, 0x10, 0x71, 0x87, 0x48, 0x3f, 0x7d, 0xa5, 0xe1, 0xac, 0x90, 0x97, 0xf3, 0x84, 0x5c, 0x17,
-	0xd2, 0x85, 0x94, 0x90, 0xda, 0x50, 0x67, 0x8d, 0x52, 0xa9, 0x73, 0x16, 0xb9, 0x83, 0xa6, 0x63,
-	0x6c, 0x3c, 0x69, 0x91, 0xe0, 0xf2, 0xd7, 0xcc, 0x13, 0x08, 0x76, 0xb8, 0x63, 0x3a, 0x4d, 0xa3,
-	0xae, 0x0a, 0x81, 0xc8, 0x56, 0x91, 0x7b, 0x00, 0xbc, 0x2f, 0x34, 0xac, 0x80, 0x86, 0xf5, 0x2d,
-	0x73, 0x47, 0xf4, 0xb5, 0xbf, 0xc5, 0x0a, 0xd3, 0x90, 0xa2, 0xb1, 0xd5, 0xbe, 0xb4, 0x7f, 0xcb,
-	0x82, 0x9a, 0x56, 0x47, 0xd6, 0x60, 0x79, 0xf7, 0xd1, 0xa3, 0xa3, 0x7d, 0x67, 0xe7, 0xf1, 0x83,
-	0x2f, 0xed, 0x77, 0x77, 0x0f, 0x1f, 0x1d, 0xef, 0xb7, 0x2e, 0x31, 0xf8, 0xf0, 0xd1, 0xee, 0xce,
-	0x61, 0xf7, 0xde, 0x23, 0x67, 0x57, 0xc2, 0x16, 0x59, 0x07, 0xe2, 0xec, 0x7f, 0xf0, 0xe8, 0xf1,
-	0xbe, 0x81, 0x17, 0x48, 0x0b, 0xea, 0x77, 0x9d, 0xfd, 0x9d, 0xdd, 0x03, 0x81, 0x14, 0xc9, 0x2a,
-	0xb4, 0xee, 0x3d, 0x79, 0xb8, 0xf7, 0xe0, 0xe1, 0xfd, 0xee, 0xee, 0xce, 0xc3, 0xdd, 0xfd, 0xc3,
-	0xfd, 0xbd, 0x56, 0x89, 0x2c, 0x41, 0x75, 0xe7, 0xee, 0xce, 0xc3, 0xbd, 0x47, 0x0f, 0xf7, 0xf7,
-	0x5a, 0x65, 0xfb, 0x1f, 0x2d, 0x58, 0xc3, 0x51, 0xf7, 0xd3, 0x02, 0x72, 0x0d, 0x6a, 0xbd, 0x20,
-	0x98, 0x50, 0xa6, 0xcf, 0x95, 0xca, 0xd6, 0x21, 0xc6, 0xfc, 0x5c, 0x41, 0x9e, 0x06, 0x61, 0x8f,
-	0x0a, 0xf9, 0x00, 0x84, 0xee, 0x31, 0x84, 0x31, 0xbf, 0xd8, 0x5e, 0x4e, 0xc1, 0xc5, 0xa3, 0xc6,
-	0x31, 0x4e, 0xb2, 0x0e, 0x0b, 0x27, 0x21, 0x75, 0x7b, 0x43, 0x21, 0x19, 0xa2, 0x44, 0x3e, 0x99,
-	0xf8, 0xee, 0x3d, 0xb6, 0xfa, 0x23, 0xda, 0x47, 0x8e, 0xa9, 0x38, 0x4d, 0x81, 0xef, 0x0a, 0x98,
-	0x69, 0x06, 0xf7, 0xc4, 0xf5, 0xfb, 0x81, 0x4f, 0xfb, 0xc8, 0x34, 0x15, 0x27, 0x01, 0xec, 0x23,
-	0x58, 0x4f, 0xcf, 0x4f, 0xc8, 0xd7, 0xbb, 0x9a, 0x7c, 0x71, 0xef, 0xaa, 0x33, 0x7f, 0x37, 0x35,
-	0x59, 0xfb, 0x57, 0x0b, 0x4a, 0xcc, 0xd8, 0xce, 0x37, 0xcc, 0xba, 0xff, 0x54, 0xcc, 0x84, 0x6d,
-	0xf0, 0x70, 0xc2, 0xd5, 0x2f, 0x37, 0x51, 0x1a, 0x92, 0xd4, 0x87, 0xb4, 0x77, 0x86, 0x33, 0x56,
-	0xf5, 0x0c, 0x61, 0x02, 0xc2, 0x3c, 0x58, 0xfc, 0x5a, 0x08, 0x88, 0x2c, 0xcb, 0x3a, 0xfc, 0x72,
-	0x31, 0xa9, 0xc3, 0xef, 0xda, 0xb0, 0xe8, 0xf9, 0x27, 0xc1, 0xd4, 0xef, 0xa3, 0x40, 0x54, 0x1c,
-	0x59, 0x64, 0xcb, 0x37, 0x41, 0x41, 0xf5, 0xc6, 0x92, 0xfd, 0x13, 0xc0, 0x26, 0xec, 0x84, 0x13,
-	0xa1, 0x73, 0xa1, 0xe2, 0x14, 0xef, 0xc2, 0xb2, 0x86, 0x25, 0x8e, 0xea, 0x84, 0x01, 0x29, 0x47,
-	0x15, 0xbd, 0x12, 0x5e, 0x63, 0xb7, 0xa0, 0x71, 0x9f, 0xc6, 0x0f, 0xfc, 0xd3, 0x40, 0xb6, 0xf4,
-	0xad, 0x12, 0x34, 0x15, 0x24, 0x1a, 0xba, 0x09, 0x4d, 0xaf, 0x4f, 0xfd, 0xd8, 0x8b, 0x67, 0x5d,
-	0xe3, 0x20, 0x95, 0x86, 0x99, 0x37, 0xe7, 0x8e, 0x3c, 0x57, 0x86, 0xc6, 0x78, 0x81, 0x6c, 0xc3,
-	0x2a, 0x33, 0x35, 0xd2, 0x7a, 0xa8, 0x2d, 0xe6, 0xe7, 0xb9, 0xdc, 0x3a, 0xa6, 0x0c, 0x18, 0x2e,
-	0xb4, 0xbd, 0xfa, 0x84, 0x7b, 0x35, 0x79, 0x55, 0x6c, 0xd5, 0x78, 0x4b, 0x6c, 0xca, 0x65, 0x6e,
-	0x8e, 0x14, 0x90, 0x89, 0x37, 0x2d, 0x70, 0x55, 0x95, 0x8e, 0x37, 0x69, 0x31, 0xab, 0x4a, 0x26,
-	0x66, 0xc5, 0x54, 0xd9, 0xcc, 0xef, 0xd1, 0x7e, 0x37, 0x0e, 0xba, 0xa8, 0x72, 0x71, 0x77, 0x2a,
-	0x4e, 0x1a, 0xc6, 0xe8, 0x1a, 0x8d, 0x62, 0x9f, 0xc6, 0xa8, 0x95, 0x2a, 0x8e, 0x2c, 0x32, 0xe9,
-	0x42, 0x12, 0x6e, 0x40, 0xaa, 0x8e, 0x28, 0x31, 0xb7, 0x74, 0x1a, 0x7a, 0x51, 0xbb, 0x8e, 0x28,
-	0xfe, 0x26, 0x9f, 0x86, 0xb5, 0x13, 0x1a, 0xc5, 0xdd, 0x21, 0x75, 0xfb, 0x34, 0xc4, 0xdd, 0xe7,
-	0xa1, 0x30, 0x6e, 0xed, 0xf3, 0x2b, 0x59, 0xdf, 0x67, 0x34, 0x8c, 0xbc, 0xc0, 0x47, 0x3b, 0x5f,
-	0x75, 0x64, 0x91, 0xb5, 0xc7, 0x16, 0x44, 0xd9, 0x50, 0xb5, 0xaa, 0x4d, 0x5c, 0x8c, 0xfc, 0x4a,
-	0xfb, 0x1b, 0xe8, 0x73, 0xab, 0xd0, 0xde, 0x13, 0x74, 0x18, 0xd8, 0xc9, 0x89, 0xaf, 0x4c, 0x34,
-	0x74, 0xc5, 0x31, 0xa0, 0x82, 0xc0, 0xf1, 0xd0, 0x65, 0x5a, 0xc6, 0x58, 0x6c, 0x7e, 0xb2, 0xaa,
-	0x21, 0x76, 0xc0, 0xd7, 0xfa, 0x3a, 0x34, 0x64, 0xd0, 0x30, 0xea, 0x8e, 0xe8, 0x69, 0x2c, 0x4f,
-	0xf7, 0xfe, 0x74, 0x8c, 0xc7, 0xaf, 0x43, 0x7a, 0x1a, 0xdb, 0x0f, 0x61, 0x59, 0x48, 0xfe, 0xa3,
-	0x09, 0x95, 0x5d, 0x7f, 0x36, 0xcf, 0x82, 0xce, 0x09, 0x93, 0x9a, 0x94, 0xb6, 0x03, 0x44, 0xd7,
-	0x24, 0xa2, 0x41, 0x61, 0xc6, 0x64, 0x0c, 0x41, 0x4c, 0xc7, 0xc0, 0xd8, 0xaa, 0x46, 0xd3, 0x5e,
-	0x4f, 0x86, 0x7d, 0x2b, 0x8e, 0x2c, 0xda, 0xdf, 0xb5, 0x60, 0x05, 0x5b, 0x93, 0x3e, 0x80, 0xd0,
-	0xd6, 0xef, 0xfd, 0x10, 0xc3, 0xac, 0xf7, 0xf4, 0xb8, 0xca, 0x2a, 0x94, 0x75, 0xfd, 0xcd, 0x0b,
-	0x3f, 0xfc, 0x51, 0xba, 0x94, 0x39, 0x4a, 0xff, 0xbd, 0x05, 0xcb, 0x5c, 0x85, 0xc6, 0x6e, 0x3c,
-	0x8d, 0xc4, 0xf4, 0x7f, 0x1a, 0x96, 0xb8, 0x2d, 0x14, 0x42, 0x28, 0x06, 0xba, 0xaa, 0xf4, 0x05,
-	0xa2, 0x9c, 0xf8, 0xe0, 0x92, 0x63, 0x12, 0x93, 0xcf, 0x43, 0x5d, 0x8f, 0xfc, 0xe2, 0x98, 0x6b,
-	0xdb, 0x97, 0xe5, 0x2c, 0x33, 0x9c, 0x73, 0x70, 0xc9, 0x31, 0x3e, 0x20, 0x77, 0xd0, 0xa1, 0xf1,
-	0xbb, 0xd8, 0xac, 0x88, 0x9d, 0x5d, 0xce, 0x51, 0xfb, 0xea, 0x73, 0x8d, 0xfc, 0x6e, 0x05, 0x16,
-	0xb8, 0x07, 0x6b, 0xdf, 0x87, 0x25, 0x63, 0xa4, 0x46, 0x88, 0xa0, 0xce, 0x43, 0x04, 0x99, 0x88,
-	0x52, 0x21, 0x1b, 0x51, 0xb2, 0xff, 0xa4, 0x08, 0x84, 0x71, 0x5b, 0x6a, 0x3b, 0x99, 0x0b, 0x1d,
-	0xf4, 0x8d, 0x03, 0x51, 0xdd, 0xd1, 0x21, 0x72, 0x0b, 0x88, 0x56, 0x94, 0x41, 0x37, 0x6e, 0x6d,
-	0x72, 0x6a, 0x98, 0x5a, 0x14, 0xc6, 0x5a, 0x98, 0x55, 0x71, 0xf4, 0xe3, 0xfb, 0x96, 0x5b, 0xc7,
-	0x0c, 0xca, 0x64, 0x1a, 0x0d, 0xf1, 0x86, 0x41, 0x1c, 0x99, 0x64, 0x39, 0xcd, 0x20, 0x0b, 0x17,
-	0x32, 0xc8, 0x62, 0x9a, 0x41, 0x74, 0xa7, 0xbd, 0x62, 0x38, 0xed, 0xcc, 0x59, 0x1c, 0x33, 0x17,
-	0x33, 0x1e, 0xf5, 0xba, 0x63, 0xd6, 0xbb, 0x38, 0x21, 0x19, 0x20, 0xd9, 0x84, 0x96, 0x70, 0x2f,
-	0x92, 0x93, 0x01, 0xe0, 0x1a, 0x67, 0x70, 0xa6, 0xaf, 0x93, 0xc0, 0x4c, 0x0d, 0x07, 0x9b, 0x00,
-	0xec, 0x2c, 0x15, 0x31, 0x16, 0xeb, 0x4e, 0x7d, 0xc1, 0x2d, 0xb4, 0x8f, 0x67, 0xa3, 0x8a, 0x93,
-	0xad, 0xb0, 0x7f, 0x60, 0x41, 0x8b, 0xed, 0x99, 0xc1, 0xd7, 0xef, 0x03, 0x8a, 0xd5, 0x2b, 0xb2,
-	0xb5, 0x41, 0xfb, 0xe3, 0x73, 0xf5, 0x7b, 0x50, 0xc5, 0x06, 0x83, 0x09, 0xf5, 0x05, 0x53, 0xb7,
-	0x4d, 0xa6, 0x4e, 0x34, 0xda, 0xc1, 0x25, 0x27, 0x21, 0xd6, 0x58, 0xfa, 0x6f, 0x2d, 0xa8, 0x89,
-	0x61, 0xfe, 0xc8, 0x91, 0x83, 0x8e, 0x76, 0x9d, 0xc4, 0x59, 0x31, 0xb9, 0x39, 0xba, 0x09, 0xcd,
-	0xb1, 0x1b, 0x4f, 0x43, 0x66, 0xc0, 0x8d, 0xa8, 0x41, 0x1a, 0x66, 0xd6, 0x18, 0x95, 0x77, 0xd4,
-	0x8d, 0xbd, 0x51, 0x57, 0xd6, 0x8a, 0x4b, 0x9b, 0xbc, 0x2a, 0xa6, 0xc3, 0xa2, 0xd8, 0x1d, 0x50,
-	0x61, 0x68, 0x79, 0xc1, 0x6e, 0xc3, 0xba, 0x98, 0x50, 0xca, 0xb7, 0xb5, 0xff, 0xa2, 0x0e, 0x1b,
-	0x99, 0x2a, 0x75, 0xcb, 0x2b, 0x8e, 0xc3, 0x23, 0x6f, 0x7c, 0x12, 0xa8, 0x83, 0x81, 0xa5, 0x9f,
-	0x94, 0x8d, 0x2a, 0x32, 0x80, 0x35, 0xe9, 0x51, 0xb0, 0x35, 0x4d, 0x2c, 0x5d, 0x01, 0x5d, 0xa1,
-	0x77, 0x4c, 0x1e, 0x48, 0x77, 0x28, 0x71, 0x5d, 0x0b, 0xe4, 0xb7, 0x47, 0x86, 0xd0, 0x56, 0xae,
-	0x8b, 0x30, 0x17, 0x9a, 0x7b, 0xc3, 0xfa, 0x7a, 0xfb, 0x82, 0xbe, 0x0c, 0x57, 0xd8, 0x99, 0xdb,
-	0x1a, 0x99, 0xc1, 0x55, 0x59, 0x87, 0xf6, 0x20, 0xdb, 0x5f, 0xe9, 0x95, 0xe6, 0x86, 0x4e, 0xbe,
-	0xd9, 0xe9, 0x05, 0x0d, 0x93, 0xaf, 0xc1, 0xfa, 0xb9, 0xeb, 0xc5, 0x72, 0x58, 0x9a, 0xe3, 0x50,
-	0xc6, 0x2e, 0xb7, 0x2f, 0xe8, 0xf2, 0x29, 0xff, 0xd8, 0x30, 0x92, 0x73, 0x5a, 0xec, 0xfc, 0xb5,
-	0x05, 0x0d, 0xb3, 0x1d, 0xc6, 0xa6, 0x42, 0x79, 0x48, 0x25, 0x2a, 0xdd, 0xcf, 0x14, 0x9c, 0x3d,
-	0x5b, 0x17, 0xf2, 0xce, 0xd6, 0xfa, 0x89, 0xb6, 0x78, 0x51, 0xd8, 0xa9, 0xf4, 0x6a, 0x61, 0xa7,
-	0x72, 0x5e, 0xd8, 0xa9, 0xf3, 0x5f, 0x16, 0x90, 0x2c, 0x2f, 0x91, 0xfb, 0xfc, 0x70, 0xef, 0xd3,
-	0x91, 0xd0, 0x49, 0x3f, 0xf5, 0x6a, 0xfc, 0x28, 0xd7, 0x4e, 0x7e, 0xcd, 0x04, 0x43, 0x57, 0x3a,
-	0xba, 0xbb, 0xb5, 0xe4, 0xe4, 0x55, 0xa5, 0x02, 0x61, 0xa5, 0x8b, 0x03, 0x61, 0xe5, 0x8b, 0x03,
-	0x61, 0x0b, 0xe9, 0x40, 0x58, 0xe7, 0xd7, 0x2d, 0x58, 0xc9, 0xd9, 0xf4, 0x8f, 0x6f, 0xe2, 0x6c,
-	0x9b, 0x0c, 0x5d, 0x50, 0x10, 0xdb, 0xa4, 0x83, 0x9d, 0x5f, 0x82, 0x25, 0x83, 0xd1, 0x3f, 0xbe,
-	0xfe, 0xd3, 0x1e, 0x23, 0xe7, 0x33, 0x03, 0xeb, 0xfc, 0x5b, 0x01, 0x48, 0x56, 0xd8, 0xfe, 0x5f,
-	0xc7, 0x90, 0x5d, 0xa7, 0x62, 0xce, 0x3a, 0xfd, 0x9f, 0xda, 0x81, 0xb7, 0x61, 0x59, 0xa4, 0x84,
-	0x68, 0x21, 0x1d, 0xce, 0x31, 0xd9, 0x0a, 0xe6, 0x33, 0x9b, 0x51, 0xc8, 0x8a, 0x71, 0xb5, 0xae,
-	0x19, 0xc3, 0x54, 0x30, 0xd2, 0x5e, 0x87, 0x55, 0x9e, 0x62, 0x72, 0x97, 0x37, 0x25, 0xed, 0xca,
-	0x1f, 0x58, 0xb0, 0x96, 0xaa, 0x48, 0x2e, 0x82, 0xb9, 0xe9, 0x30, 0xed, 0x89, 0x09, 0xb2, 0xf1,
-	0x2b, 0x37, 0x23, 0xc5, 0x6d, 0xd9, 0x0a, 0xb6, 0x3e, 0x9a, 0x5b, 0x92, 0x5a, 0xf5, 0xbc, 0x2a,
-	0x7b, 0x83, 0x27, 0xc2, 0xf8, 0x74, 0x94, 0x1a, 0xf8, 0x29, 0x4f, 0x5d, 0xd1, 0x2b, 0x92, 0xab,
-	0x20, 0x73, 0xc8, 0xb2, 0xc8, 0x3c, 0x4a, 0xc3, 0x4c, 0x99, 0xe3, 0xcd, 0xad, 0xb3, 0xbf, 0x6f,
-	0x01, 0xf9, 0xe2, 0x94, 0x86, 0x33, 0xbc, 0xec, 0x55, 0xb1, 0xa6, 0x8d, 0x74, 0x24, 0x65, 0x61,
-	0x32, 0x3d, 0xf9, 0x02, 0x9d, 0xc9, 0xb4, 0x81, 0x42, 0x92, 0x36, 0xf0, 0x3a, 0x00, 0x3b, 0xca,
-	0xa9, 0x1b, 0x64, 0xf4, 0xe4, 0xfc, 0xe9, 0x98, 0x37, 0x98, 0x7b, 0xb3, 0x5f, 0xba, 0xf8, 0x66,
-	0xbf, 0x7c, 0xd1, 0xcd, 0xfe, 0x1d, 0x58, 0x31, 0xc6, 0xad, 0xb6, 0x55, 0xde, 0x65, 0x5b, 0x2f,
-	0xb9, 0xcb, 0xfe, 0xcd, 0x02, 0x14, 0x0f, 0x82, 0x89, 0x1e, 0x67, 0xb5, 0xcc, 0x38, 0xab, 0xb0,
-	0x25, 0x5d, 0x65, 0x2a, 0x84, 0x8a, 0x31, 0x40, 0xb2, 0x09, 0x0d, 0x77, 0x1c, 0xb3, 0x83, 0xff,
-	0x69, 0x10, 0x9e, 0xbb, 0x61, 0x9f, 0xef, 0xf5, 0xdd, 0x42, 0xdb, 0x72, 0x52, 0x35, 0x64, 0x15,
-	0x8a, 0x4a, 0xe9, 0x22, 0x01, 0x2b, 0x32, 0xc7, 0x0d, 0xef, 0x68, 0x66, 0x22, 0x66, 0x21, 0x4a,
-	0x8c, 0x95, 0xcc, 0xef, 0xb9, 0xdb, 0xcd, 0x45, 0x27, 0xaf, 0x8a, 0xd9, 0x35, 0xb6, 0x7c, 0x48,
-	0x26, 0x82, 0x4d, 0xb2, 0xac, 0x07, 0xc6, 0x2a, 0xe6, 0x8d, 0xd5, 0xbf, 0x58, 0x50, 0xc6, 0xb5,
-	0x61, 0x6a, 0x80, 0xf3, 0xbe, 0x0a, 0xb5, 0xe2, 0x9a, 0x2c, 0x39, 0x69, 0x98, 0xd8, 0x46, 0xe2,
-	0x4d, 0x41, 0x4d, 0x48, 0x4f, 0xbe, 0xb9, 0x06, 0x55, 0x5e, 0x52, 0x49, 0x26, 0x48, 0x92, 0x80,
-	0xe4, 0x2a, 0x94, 0x86, 0xc1, 0x44, 0xfa, 0x2d, 0x20, 0x6f, 0x1a, 0x82, 0x89, 0x83, 0x78, 0x32,
-	0x1e, 0xd6, 0x1e, 0x9f, 0x16, 0xb7, 0x46, 0x69, 0x98, 0xd9, 0x63, 0xd5, 0xac, 0xbe, 0x4c, 0x29,
-	0xd4, 0xde, 0x84, 0xe6, 0xc3, 0xa0, 0x4f, 0xb5, 0x78, 0xd7, 0x5c, 0x3e, 0xb7, 0x7f, 0xd9, 0x82,
-	0x8a, 0x24, 0x26, 0x37, 0xa1, 0xc4, 0x9c, 0x8c, 0xd4, 0x11, 0x42, 0xdd, 0x30, 0x32, 0x3a, 0x07,
-	0x29, 0x98, 0x56, 0xc6, 0xb8, 0x46, 0xe2, 0x70, 0xca, 0xa8, 0x46, 0xe2, 0x4f, 0xa9, 0xe1, 0xa6,
-	0xdc, 0x90, 0x14, 0x6a, 0x7f, 0xcf, 0x82, 0x25, 0xa3, 0x0f, 0x76, 0x08, 0x1d, 0xb9, 0x51, 0x2c,
-	0x6e, 0x6d, 0xc4, 0xf6, 0xe8, 0x90, 0xbe, 0xd1, 0x05, 0x33, 0x02, 0xaa, 0x62, 0x73, 0x45, 0x3d,
-	0x36, 0x77, 0x1b, 0xaa, 0x49, 0x7a, 0x54, 0xc9, 0xd0, 0xb6, 0xac, 0x47, 0x79, 0x77, 0x9a, 0x10,
-	0xb1, 0x76, 0x7a, 0xc1, 0x28, 0x08, 0xc5, 0x75, 0x01, 0x2f, 0xd8, 0x77, 0xa0, 0xa6, 0xd1, 0xb3,
-	0x61, 0xf8, 0x34, 0x3e, 0x0f, 0xc2, 0x67, 0x32, 0x10, 0x2b, 0x8a, 0x2a, 0x7b, 0xa0, 0x90, 0x64,
-	0x0f, 0xd8, 0x7f, 0x65, 0xc1, 0x12, 0xe3, 0x41, 0xcf, 0x1f, 0x1c, 0x05, 0x23, 0xaf, 0x37, 0xc3,
-	0xbd, 0x97, 0xec, 0x26, 0x74, 0x86, 0xe4, 0x45, 0x13, 0x66, 0x5c, 0x2f, 0xcf, 0xa0, 0x42, 0x44,
-	0x55, 0x99, 0xc9, 0x30, 0x93, 0x80, 0x13, 0x37, 0x12, 0x62, 0x21, 0xcc, 0x9f, 0x01, 0x32, 0x49,
-	0x63, 0x40, 0xe8, 0xc6, 0xb4, 0x3b, 0xf6, 0x46, 0x23, 0x8f, 0xd3, 0x72, 0xe7, 0x28, 0xaf, 0x8a,
-	0xf5, 0xd9, 0xf7, 0x22, 0xf7, 0x24, 0x09, 0x81, 0xab, 0xb2, 0xfd, 0x67, 0x05, 0xa8, 0x09, 0xc5,
-	0xbd, 0xdf, 0x1f, 0x50, 0x71, 0x5f, 0x83, 0xee, 0xa7, 0x52, 0x32, 0x1a, 0x22, 0xeb, 0x0d, 0x87,
-	0x55, 0x43, 0xd2, 0x5b, 0x5e, 0xcc, 0x6e, 0xf9, 0x15, 0xa8, 0x32, 0xd6, 0x7b, 0x07, 0x3d, 0x63,
-	0x7e, 0xd7, 0x93, 0x00, 0xb2, 0x76, 0x1b, 0x6b, 0xcb, 0x49, 0x2d, 0x02, 0x2f, 0xbd, 0xdd, 0x79,
-	0x0f, 0xea, 0xa2, 0x19, 0xdc, 0x13, 0xd4, 0x29, 0x09, 0xf3, 0x1b, 0xfb, 0xe5, 0x18, 0x94, 0xf2,
-	0xcb, 0x6d, 0xf9, 0x65, 0xe5, 0xa2, 0x2f, 0x25, 0xa5, 0x7d, 0x5f, 0x5d, 0x9a, 0xdd, 0x0f, 0xdd,
-	0xc9, 0x50, 0x4a, 0xe9, 0x6d, 0x58, 0xf1, 0xfc, 0xde, 0x68, 0xda, 0xa7, 0xdd, 0xa9, 0xef, 0xfa,
-	0x7e, 0x30, 0xf5, 0x7b, 0x54, 0xe6, 0x0c, 0xe4, 0x55, 0xd9, 0x7d, 0x95, 0xab, 0x84, 0x0d, 0x91,
-	0x4d, 0x28, 0xb3, 0x8e, 0xa4, 0x55, 0xc8, 0x17, 0x61, 0x4e, 0x42, 0x6e, 0x42, 0x99, 0xf6, 0x07,
-	0x54, 0x9e, 0x16, 0x89, 0x79, 0x6e, 0x67, 0xbb, 0xea, 0x70, 0x02, 0xa6, 0x50, 0x18, 0x9a, 0x52,
-	0x28, 0xa6, 0x45, 0x59, 0x60, 0xc5, 0x07, 0x7d, 0x7b, 0x15, 0xc8, 0x43, 0x2e, 0x03, 0x7a, 0xbc,
-	0xfd, 0xd7, 0x8a, 0x50, 0xd3, 0x60, 0xa6, 0x1b, 0x06, 0x6c, 0xc0, 0xdd, 0xbe, 0xe7, 0x8e, 0x69,
-	0x4c, 0x43, 0xc1, 0xf7, 0x29, 0x94, 0xd1, 0xb9, 0x67, 0x83, 0x6e, 0x30, 0x8d, 0xbb, 0x7d, 0x3a,
-	0x08, 0x29, 0x37, 0xf2, 0xcc, 0xe8, 0x18, 0x28, 0xa3, 0x1b, 0xbb, 0xcf, 0x75, 0x3a, 0xce, 0x41,
-	0x29, 0x54, 0x46, 0xcf, 0xf9, 0x1a, 0x95, 0x92, 0xe8, 0x39, 0x5f, 0x91, 0xb4, 0x56, 0x2b, 0xe7,
-	0x68, 0xb5, 0x77, 0x61, 0x9d, 0xeb, 0x2f, 0x21, 0xe9, 0xdd, 0x14, 0x63, 0xcd, 0xa9, 0x25, 0x9b,
-	0xd0, 0x62, 0x63, 0x96, 0x22, 0x11, 0x79, 0xdf, 0xe0, 0x91, 0x29, 0xcb, 0xc9, 0xe0, 0x8c, 0x16,
-	0x43, 0x44, 0x3a, 0x2d, 0xbf, 0x4d, 0xcc, 0xe0, 0x48, 0xeb, 0x3e, 0x37, 0x69, 0xab, 0x82, 0x36,
-	0x85, 0xdb, 0x4b, 0x50, 0x3b, 0x8e, 0x83, 0x89, 0xdc, 0x94, 0x06, 0xd4, 0x79, 0x51, 0xe4, 0x6e,
-	0xbc, 0x06, 0x97, 0x91, 0x8b, 0x1e, 0x07, 0x93, 0x60, 0x14, 0x0c, 0x66, 0xc7, 0xd3, 0x13, 0x9e,
-	0xb4, 0xeb, 0x05, 0xbe, 0xfd, 0x37, 0x16, 0xac, 0x18, 0xb5, 0x22, 0xfc, 0xf4, 0x69, 0x2e, 0x04,
-	0xea, 0xd2, 0x9d, 0x33, 0xde, 0xb2, 0xa6, 0x5c, 0x39, 0x21, 0x0f, 0x22, 0x3e, 0x11, 0xf7, 0xf0,
-	0x3b, 0xd0, 0x94, 0x23, 0x93, 0x1f, 0x72, 0x2e, 0x6c, 0x67, 0xb9, 0x50, 0x7c, 0xdf, 0x10, 0x1f,
-	0xc8, 0x26, 0x7e, 0x46, 0xdc, 0xca, 0xf6, 0x71, 0x8e, 0x32, 0x0e, 0xa1, 0x6e, 0xd2, 0xf4, 0xd3,
-	0x88, 0x1c, 0x41, 0x4f, 0x81, 0x91, 0xfd, 0xdb, 0x16, 0x40, 0x32, 0x3a, 0xbc, 0xcb, 0x53, 0x06,
-	0x82, 0xe7, 0xd5, 0x6b, 0xc6, 0xe0, 0x4d, 0xa8, 0xab, 0x3b, 0xa0, 0xc4, 0xe6, 0xd4, 0x24, 0xc6,
-	0x1c, 0xc6, 0x1b, 0xd0, 0x1c, 0x8c, 0x82, 0x13, 0x34, 0xd8, 0x98, 0x0c, 0x14, 0x89, 0x0c, 0x96,
-	0x06, 0x87, 0xef, 0x09, 0x34, 0x31, 0x50, 0x25, 0xcd, 0x40, 0xd9, 0xdf, 0x2c, 0xa8, 0x3b, 0x80,
-	0x64, 0xce, 0x73, 0xa5, 0x8c, 0x6c, 0x67, 0xd4, 0xe9, 0x9c, 0x90, 0x3b, 0x46, 0xdc, 0x8e, 0x2e,
-	0x0c, 0x08, 0xdc, 0x81, 0x46, 0xc8, 0xf5, 0x95, 0x54, 0x66, 0xa5, 0x97, 0x28, 0xb3, 0xa5, 0xd0,
-	0xb0, 0x62, 0x9f, 0x84, 0x96, 0xdb, 0x3f, 0xa3, 0x61, 0xec, 0xe1, 0x91, 0x0c, 0x5d, 0x08, 0xae,
-	0x82, 0x9b, 0x1a, 0x8e, 0x96, 0xfd, 0x06, 0x34, 0x45, 0xd6, 0x90, 0xa2, 0x14, 0x89, 0xb2, 0x09,
-	0xcc, 0x08, 0xed, 0x3f, 0x92, 0xd7, 0x0d, 0xe6, 0x1e, 0xce, 0x5f, 0x11, 0x7d, 0x76, 0x85, 0xd4,
-	0xec, 0x3e, 0x21, 0x42, 0xff, 0x7d, 0x79, 0xee, 0x2b, 0x6a, 0x37, 0xf8, 0x7d, 0x71, 0x55, 0x63,
-	0x2e, 0x69, 0xe9, 0x55, 0x96, 0xd4, 0xfe, 0x81, 0x05, 0x8b, 0x07, 0xc1, 0xe4, 0x40, 0xe4, 0x32,
-	0xa0, 0x20, 0xa8, 0x74, 0x3d, 0x59, 0x7c, 0x49, 0x96, 0x43, 0xae, 0xe5, 0x5e, 0x4a, 0x5b, 0xee,
-	0x9f, 0x85, 0xd7, 0x30, 0xea, 0x10, 0x06, 0x93, 0x20, 0x64, 0xc2, 0xe8, 0x8e, 0xb8, 0x99, 0x0e,
-	0xfc, 0x78, 0x28, 0xd5, 0xd8, 0xcb, 0x48, 0xf0, 0x78, 0xc7, 0x8e, 0x25, 0xdc, 0xe9, 0x16, 0x9e,
-	0x06, 0xd7, 0x6e, 0xd9, 0x0a, 0xfb, 0xb3, 0x50, 0x45, 0x57, 0x19, 0xa7, 0xf5, 0x36, 0x54, 0x87,
-	0xc1, 0xa4, 0x3b, 0xf4, 0xfc, 0x58, 0x0a, 0x77, 0x23, 0xf1, 0x61, 0x0f, 0x70, 0x41, 0x14, 0x81,
-	0xfd, 0x7b, 0x65, 0x58, 0x7c, 0xe0, 0x9f, 0x05, 0x5e, 0x0f, 0x6f, 0x26, 0xc6, 0x74, 0x1c, 0xc8,
-	0xe4, 0x45, 0xf6, 0x9b, 0x2d, 0x05, 0x66, 0xeb, 0x4c, 0x62, 0x71, 0xb5, 0x20, 0x8b, 0xcc, 0x41,
-	0x08, 0x93, 0x24, 0x64, 0x2e, 0x3a, 0x1a, 0xc2, 0x0e, 0x10, 0xa1, 0x9e, 0x44, 0x2c, 0x4a, 0x49,
-	0xf6, 0x67, 0x59, 0xcb, 0xfe, 0xc4, 0x7b, 0x2c, 0x9e, 0x77, 0x21, 0x2e, 0xe6, 0x65, 0x11, 0x0f,
-	0x3c, 0x21, 0xe5, 0xd1, 0x22, 0x74, 0x35, 0x16, 0xc5, 0x81, 0x47, 0x07, 0x99, 0x3b, 0xc2, 0x3f,
-	0xe0, 0x34, 0x5c, 0xf9, 0xea, 0x10, 0x73, 0xdd, 0xd2, 0x29, 0xdf, 0x55, 0xce, 0xf3, 0x29, 0x98,
-	0x69, 0xe8, 0x3e, 0x55, 0x8a, 0x94, 0xcf, 0x01, 0x78, 0x92, 0x75, 0x1a, 0xd7, 0x8e, 0x49, 0x3c,
-	0xa1, 0x4a, 0x1e, 0x93, 0x18, 0xa3, 0xb8, 0xa3, 0xd1, 0x89, 0xdb, 0x7b, 0x86, 0x19, 0xfd, 0x78,
-	0x47, 0x50, 0x75, 0x4c, 0x10, 0x33, 0x27, 0x92, 0xdd, 0xc4, 0xfb, 0xd3, 0x92, 0xa3, 0x43, 0x64,
-	0x1b, 0x6a, 0x78, 0x34, 0x14, 0xfb, 0xd9, 0xc0, 0xfd, 0x6c, 0xe9, 0x67, 0x47, 0xdc, 0x51, 0x9d,
-	0x48, 0xbf, 0x2d, 0x69, 0x9a, 0xb7, 0x25, 0x5c, 0x69, 0x8a, 0x4b, 0xa6, 0x16, 0xf6, 0x96, 0x00,
-	0xcc, 0x9a, 0x8a, 0x05, 0xe3, 0x04, 0xcb, 0x48, 0x60, 0x60, 0xe4, 0x2a, 0x54, 0xd8, 0xb1, 0x65,
-	0xe2, 0x7a, 0xfd, 0x36, 0x51, 0xa7, 0x27, 0x85, 0xb1, 0x36, 0xe4, 0x6f, 0xbc, 0x0c, 0x5a, 0xc1,
-	0x55, 0x31, 0x30, 0xb6, 0x36, 0xaa, 0x8c, 0x42, 0xb4, 0xca, 0x77, 0xd4, 0x00, 0xed, 0x18, 0xc8,
-	0x4e, 0xbf, 0x2f, 0x78, 0x53, 0x1d, 0xa3, 0x13, 0xae, 0xb2, 0x0c, 0xae, 0xca, 0xd9, 0xdd, 0x42,
-	0xfe, 0xee, 0xbe, 0x74, 0x0d, 0xec, 0x7d, 0xa8, 0x1d, 0x69, 0x19, 0xeb, 0xc8, 0xe4, 0x32, 0x57,
-	0x5d, 0x08, 0x86, 0x86, 0x68, 0xc3, 0x29, 0xe8, 0xc3, 0xb1, 0xff, 0xd8, 0xe2, 0x49, 0xbf, 0x6a,
-	0xf8, 0xbc, 0x6f, 0x1b, 0xea, 0x2a, 0xd8, 0x91, 0xe4, 0x92, 0x19, 0x18, 0xa3, 0xc1, 0xa1, 0x74,
-	0x83, 0xd3, 0xd3, 0x88, 0xca, 0xcc, 0x0f, 0x03, 0x63, 0x1c, 0xca, 0x7c, 0x1c, 0xe6, 0x2f, 0x78,
-	0xbc, 0x87, 0x48, 0x64, 0x80, 0x64, 0x70, 0xa6, 0x67, 0x43, 0x7a, 0x46, 0xc3, 0x48, 0x89, 0x96,
-	0x2a, 0xab, 0x94, 0xb7, 0xf4, 0x2a, 0x6f, 0x42, 0x45, 0xb5, 0x6b, 0xaa, 0x10, 0x49, 0xa9, 0xea,
-	0x99, 0xaa, 0x42, 0xaf, 0xdf, 0x18, 0x34, 0x57, 0x9b, 0xd9, 0x0a, 0x72, 0x0b, 0xc8, 0xa9, 0x17,
-	0xa6, 0xc9, 0x8b, 0x48, 0x9e, 0x53, 0x63, 0x3f, 0x85, 0x15, 0xd1, 0xa5, 0xee, 0xdc, 0x98, 0x9b,
-	0x68, 0x5d, 0xc4, 0xc8, 0x85, 0x2c, 0x23, 0xdb, 0xff, 0x6d, 0xc1, 0xa2, 0xd8, 0xe9, 0xcc, 0xab,
-	0x07, 0xbe, 0xcf, 0x06, 0x46, 0xda, 0x46, 0xd2, 0x3a, 0x72, 0xbd, 0x50, 0x5d, 0x19, 0x05, 0x55,
-	0xcc, 0x53, 0x50, 0x04, 0x4a, 0x13, 0x37, 0x1e, 0xe2, 0x59, 0xb6, 0xea, 0xe0, 0x6f, 0xd2, 0xe2,
-	0x91, 0x17, 0xae, 0x08, 0x31, 0xea, 0x92, 0xf7, 0xbe, 0x83, 0xdb, 0xdb, 0xec, 0xfb, 0x8e, 0x2b,
-	0x50, 0xc5, 0x01, 0x74, 0x93, 0xc0, 0x4a, 0x02, 0x30, 0xce, 0xe5, 0x05, 0x94, 0x30, 0x91, 0x5a,
-	0x9a, 0x20, 0xf6, 0x1a, 0xdf, 0x79, 0xb1, 0x04, 0xea, 0xbe, 0x4b, 0xa4, 0x18, 0x26, 0x70, 0xc2,
-	0x11, 0x62, 0x00, 0x69, 0x8e, 0x10, 0xa4, 0x8e, 0xaa, 0xb7, 0x3b, 0xd0, 0xde, 0xa3, 0x23, 0x1a,
-	0xd3, 0x9d, 0xd1, 0x28, 0xdd, 0xfe, 0x6b, 0x70, 0x39, 0xa7, 0x4e, 0xf8, 0xb3, 0x5f, 0x84, 0xb5,
-	0x1d, 0x9e, 0x8e, 0xf5, 0x71, 0xe5, 0x2c, 0xd8, 0x6d, 0x58, 0x4f, 0x37, 0x29, 0x3a, 0xbb, 0x07,
-	0xcb, 0x7b, 0xf4, 0x64, 0x3a, 0x38, 0xa4, 0x67, 0x49, 0x47, 0x04, 0x4a, 0xd1, 0x30, 0x38, 0x17,
-	0x82, 0x89, 0xbf, 0xc9, 0xeb, 0x00, 0x23, 0x46, 0xd3, 0x8d, 0x26, 0xb4, 0x27, 0x53, 0xc8, 0x11,
-	0x39, 0x9e, 0xd0, 0x9e, 0xfd, 0x2e, 0x10, 0xbd, 0x1d, 0xb1, 0x5e, 0xcc, 0x1e, 0x4d, 0x4f, 0xba,
-	0xd1, 0x2c, 0x8a, 0xe9, 0x58, 0xe6, 0xc6, 0xeb, 0x90, 0x7d, 0x03, 0xea, 0x47, 0xee, 0xcc, 0xa1,
-	0x5f, 0x17, 0x8f, 0x5d, 0x36, 0x60, 0x71, 0xe2, 0xce, 0x98, 0x9a, 0x52, 0x11, 0x1f, 0xac, 0xb6,
-	0xff, 0xb3, 0x00, 0x0b, 0x9c, 0x92, 0xb5, 0xda, 0xa7, 0x51, 0xec, 0xf9, 0xfc, 0xf6, 0x57, 0xb4,
-	0xaa, 0x41, 0x19, 0x56, 0x2e, 0xe4, 0xb0, 0xb2, 0x38, 0x35, 0xc9, 0x74, 0x5c, 0xc1, 0xaf, 0x06,
-	0xc6, 0x98, 0x2b, 0xc9, 0xeb, 0xe1, 0x21, 0x87, 0x04, 0x48, 0x05, 0x07, 0x13, 0xab, 0xc7, 0xc7,
-	0x27, 0xa5, 0x54, 0x70, 0xae, 0x0e, 0xe5, 0xda, 0xd6, 0x45, 0xce, 0xe0, 0x19, 0xdb, 0x9a, 0xb1,
-	0xa1, 0x95, 0x57, 0xb0, 0xa1, 0xfc, 0x28, 0xf5, 0x32, 0x1b, 0x0a, 0xaf, 0x60, 0x43, 0x6d, 0x02,
-	0xad, 0x7b, 0x94, 0x3a, 0x94, 0x79, 0x67, 0x92, 0x77, 0xbf, 0x6d, 0x41, 0x4b, 0x70, 0x91, 0xaa,
-	0x23, 0x6f, 0x1a, 0x5e, 0x68, 0x6e, 0xd2, 0xec, 0x75, 0x58, 0x42, 0xdf, 0x50, 0x45, 0x41, 0x45,
-	0xc8, 0xd6, 0x00, 0xd9, 0x3c, 0xe4, 0x55, 0xd5, 0xd8, 0x1b, 0x89, 0x4d, 0xd1, 0x21, 0x19, 0x48,
-	0x0d, 0x5d, 0x91, 0x44, 0x63, 0x39, 0xaa, 0x6c, 0xff, 0xb9, 0x05, 0xcb, 0xda, 0x80, 0x05, 0x17,
-	0xde, 0x01, 0x29, 0x0d, 0x3c, 0x24, 0xca, 0x25, 0x77, 0xc3, 0x14, 0x9b, 0xe4, 0x33, 0x83, 0x18,
-	0x37, 0xd3, 0x9d, 0xe1, 0x00, 0xa3, 0xe9, 0x58, 0x28, 0x51, 0x1d, 0x62, 0x8c, 0x74, 0x4e, 0xe9,
-	0x33, 0x45, 0xc2, 0xd5, 0xb8, 0x81, 0x61, 0x82, 0x06, 0xf3, 0x69, 0x15, 0x11, 0xb7, 0x67, 0x26,
-	0x68, 0xff, 0x83, 0x05, 0x2b, 0xfc, 0x70, 0x22, 0x8e, 0x7e, 0xea, 0x45, 0xc3, 0x02, 0x3f, 0x8d,
-	0x71, 0x89, 0x3c, 0xb8, 0xe4, 0x88, 0x32, 0xf9, 0xcc, 0x2b, 0x1e, 0xa8, 0x54, 0x62, 0xce, 0x9c,
-	0xbd, 0x28, 0xe6, 0xed, 0xc5, 0x4b, 0x56, 0x3a, 0x2f, 0x04, 0x58, 0xce, 0x0d, 0x01, 0xde, 0x5d,
-	0x84, 0x72, 0xd4, 0x0b, 0x26, 0xd4, 0x5e, 0x87, 0x55, 0x73, 0x72, 0x42, 0x05, 0x7d, 0xc7, 0x82,
-	0xf6, 0x3d, 0x1e, 0x2a, 0xf7, 0xfc, 0xc1, 0x81, 0x17, 0xc5, 0x41, 0xa8, 0x5e, 0x77, 0x5d, 0x05,
-	0x88, 0x62, 0x37, 0x8c, 0x79, 0xba, 0xa5, 0x08, 0xd0, 0x25, 0x08, 0x1b, 0x23, 0xf5, 0xfb, 0xbc,
-	0x96, 0xef, 0x8d, 0x2a, 0x67, 0x7c, 0x08, 0x71, 0x7c, 0x32, 0x2c, 0xf1, 0x5b, 0x3c, 0xd3, 0x8d,
-	0xf9, 0x0a, 0xf4, 0x0c, 0xf5, 0x3a, 0x3f, 0x97, 0xa4, 0x50, 0xfb, 0xef, 0x2c, 0x68, 0x26, 0x83,
-	0xdc, 0x67, 0xa0, 0xa9, 0x1d, 0x84, 0xf9, 0x4d, 0xb4, 0x83, 0x0c, 0x1d, 0x7a, 0xcc, 0x1e, 0x8b,
-	0xb1, 0x69, 0x08, 0x4a, 0xac, 0x28, 0x05, 0x53, 0xe9, 0xe0, 0xe8, 0x10, 0xcf, 0x1a, 0x61, 0x9e,
-	0x80, 0xf0, 0x6a, 0x44, 0x09, 0xb3, 0x65, 0xc7, 0x31, 0x7e, 0xb5, 0xc0, 0x0f, 0x66, 0xa2, 0x28,
-	0x4d, 0xe9, 0x22, 0xa2, 0x68, 0x4a, 0xf5, 0x6b, 0x87, 0x0a, 0x5f, 0x1f, 0x59, 0xb6, 0xbf, 0x65,
-	0xc1, 0xe5, 0x9c, 0x85, 0x17, 0x52, 0xb3, 0x07, 0xcb, 0xa7, 0xaa, 0x52, 0x2e, 0x0e, 0x17, 0x9d,
-	0x75, 0x79, 0xef, 0x63, 0x2e, 0x88, 0x93, 0xfd, 0x40, 0xf9, 0x45, 0x7c, 0xb9, 0x8d, 0xc4, 0xae,
-	0x6c, 0xc5, 0xe6, 0xe7, 0xa0, 0xa6, 0x3d, 0xb9, 0x22, 0x1b, 0xb0, 0xf2, 0xf4, 0xc1, 0xe3, 0x87,
-	0xfb, 0xc7, 0xc7, 0xdd, 0xa3, 0x27, 0x77, 0xbf, 0xb0, 0xff, 0xe5, 0xee, 0xc1, 0xce, 0xf1, 0x41,
-	0xeb, 0x12, 0x59, 0x07, 0xf2, 0x70, 0xff, 0xf8, 0xf1, 0xfe, 0x9e, 0x81, 0x5b, 0xdb, 0xbf, 0x53,
-	0x84, 0x06, 0xbf, 0x4f, 0xe4, 0xef, 0xda, 0x69, 0x48, 0x3e, 0x80, 0x45, 0xf1, 0xbf, 0x04, 0x64,
-	0x4d, 0x0c, 0xdb, 0xfc, 0x27, 0x84, 0xce, 0x7a, 0x1a, 0x16, 0x7c, 0xb9, 0xf2, 0xab, 0x3f, 0xf8,
-	0xe7, 0xdf, 0x2d, 0x2c, 0x91, 0xda, 0xd6, 0xd9, 0x3b, 0x5b, 0x03, 0xea, 0x47, 0xac, 0x8d, 0x5f,
-	0x00, 0x48, 0x5e, 0xec, 0x93, 0xb6, 0xf2, 0x07, 0x53, 0x7f, 0x45, 0xd0, 0xb9, 0x9c, 0x53, 0x23,
-	0xda, 0xbd, 0x8c, 0xed, 0xae, 0xd8, 0x0d, 0xd6, 0xae, 0xe7, 0x7b, 0x31, 0x7f, 0xbe, 0xff, 0xbe,
-	0xb5, 0x49, 0xfa, 0x50, 0xd7, 0x1f, 0xe4, 0x13, 0x19, 0x16, 0xca, 0xf9, 0x3b, 0x80, 0xce, 0x6b,
-	0xb9, 0x75, 0x32, 0x26, 0x86, 0x7d, 0xac, 0xd9, 0x2d, 0xd6, 0xc7, 0x14, 0x29, 0x92, 0x5e, 0x46,
-	0xd0, 0x30, 0xdf, 0xdd, 0x93, 0x2b, 0x9a, 0xca, 0xc8, 0xbc, 0xfa, 0xef, 0xbc, 0x3e, 0xa7, 0x56,
-	0xf4, 0xf5, 0x3a, 0xf6, 0xb5, 0x61, 0x13, 0xd6, 0x57, 0x0f, 0x69, 0xe4, 0xab, 0xff, 0xf7, 0xad,
-	0xcd, 0xed, 0xff, 0x78, 0x03, 0xaa, 0x2a, 0x90, 0x4b, 0xbe, 0x06, 0x4b, 0xc6, 0x85, 0x2f, 0x91,
-	0xd3, 0xc8, 0xbb, 0x1f, 0xee, 0x5c, 0xc9, 0xaf, 0x14, 0x1d, 0x5f, 0xc5, 0x8e, 0xdb, 0x64, 0x9d,
-	0x75, 0x2c, 0x6e, 0x4c, 0xb7, 0xf0, 0x9a, 0x9b, 0xe7, 0xf9, 0x3e, 0xe3, 0xf3, 0x4c, 0x2e, 0x69,
-	0x8d, 0x79, 0x66, 0x2e, 0x75, 0x8d, 0x79, 0x66, 0x6f, 0x76, 0xed, 0x2b, 0xd8, 0xdd, 0x3a, 0x59,
-	0xd5, 0xbb, 0x53, 0x01, 0x56, 0x8a, 0x99, 0xd9, 0xfa, 0x33, 0x75, 0xf2, 0xba, 0x62, 0xac, 0xbc,
-	0xe7, 0xeb, 0x8a, 0x45, 0xb2, 0x6f, 0xd8, 0xed, 0x36, 0x76, 0x45, 0x08, 0x6e, 0x9f, 0xfe, 0x4a,
-	0x9d, 0x7c, 0x05, 0xaa, 0xea, 0xbd, 0x25, 0xd9, 0xd0, 0x1e, 0xb9, 0xea, 0x8f, 0x40, 0x3b, 0xed,
-	0x6c, 0x45, 0x1e, 0x63, 0xe8, 0x2d, 0x33, 0xc6, 0x78, 0x0a, 0x35, 0xed, 0x4d, 0x25, 0xb9, 0xac,
-	0xc2, 0xf0, 0xe9, 0x77, 0x9b, 0x9d, 0x4e, 0x5e, 0x95, 0xe8, 0x62, 0x19, 0xbb, 0xa8, 0x91, 0x2a,
-	0xf2, 0x5e, 0xfc, 0x3c, 0x88, 0xc8, 0x21, 0xac, 0x89, 0x83, 0xcb, 0x09, 0xfd, 0x61, 0x96, 0x28,
-	0xe7, 0xd5, 0xfe, 0x6d, 0x8b, 0xdc, 0x81, 0x8a, 0x7c, 0x1f, 0x4b, 0xd6, 0xf3, 0xdf, 0xf9, 0x76,
-	0x36, 0x32, 0xb8, 0x50, 0x6b, 0x5f, 0x06, 0x48, 0x1e, 0x70, 0x2a, 0x01, 0xce, 0x3c, 0x08, 0x55,
-	0xbb, 0x93, 0x7d, 0xed, 0x69, 0xaf, 0xe3, 0x04, 0x5b, 0x04, 0x05, 0xd8, 0xa7, 0xe7, 0xf2, 0xad,
-	0xc2, 0x57, 0xa1, 0xa6, 0xbd, 0xe1, 0x54, 0xcb, 0x97, 0x7d, 0xff, 0xa9, 0x96, 0x2f, 0xe7, 0xc9,
-	0xa7, 0xdd, 0xc1, 0xd6, 0x57, 0xed, 0x26, 0x6b, 0x3d, 0xf2, 0x06, 0xfe, 0x98, 0x13, 0xb0, 0x0d,
-	0x1a, 0xc2, 0x92, 0xf1, 0x50, 0x53, 0x49, 0x4f, 0xde, 0x33, 0x50, 0x25, 0x3d, 0xb9, 0x6f, 0x3b,
-	0x25, 0x3b, 0xdb, 0xcb, 0xac, 0x9f, 0x33, 0x24, 0xd1, 0x7a, 0xfa, 0x10, 0x6a, 0xda, 0xa3, 0x4b,
-	0xa2, 0xe5, 0x56, 0xa6, 0x9e, 0x5b, 0xaa, 0xb9, 0xe4, 0xbd, 0xd1, 0x5c, 0xc5, 0x3e, 0x1a, 0x36,
-	0xb2, 0x02, 0xa6, 0xfa, 0xb3, 0xb6, 0xbf, 0x06, 0x0d, 0xf3, 0x19, 0xa6, 0x92, 0xcb, 0xdc, 0x07,
-	0x9d, 0x4a, 0x2e, 0xe7, 0xbc, 0xdd, 0x14, 0x2c, 0xbd, 0xb9, 0xa2, 0x3a, 0xd9, 0xfa, 0x48, 0x5c,
-	0xab, 0xbe, 0x20, 0x5f, 0x64, 0xca, 0x47, 0xbc, 0xbd, 0x20, 0x1b, 0x1a, 0xd7, 0xea, 0x2f, 0x34,
-	0x94, 0xbc, 0x64, 0x9e, 0x69, 0x98, 0xcc, 0xcc, 0x1f, 0x2b, 0xa0, 0x45, 0xc1, 0x37, 0x18, 0x9a,
-	0x45, 0xd1, 0x9f, 0x69, 0x68, 0x16, 0xc5, 0x78, 0xaa, 0x91, 0xb6, 0x28, 0xb1, 0xc7, 0xda, 0xf0,
-	0xa1, 0x99, 0x4a, 0x2e, 0x52, 0x52, 0x91, 0x9f, 0x8d, 0xd9, 0xb9, 0xfa, 0xf2, 0x9c, 0x24, 0x53,
-	0x51, 0x49, 0x05, 0xb5, 0x25, 0x93, 0x67, 0x7f, 0x11, 0xea, 0xfa, 0xf3, 0x39, 0xa2, 0x8b, 0x72,
-	0xba, 0xa7, 0xd7, 0x72, 0xeb, 0xcc, 0xcd, 0x25, 0x75, 0xbd, 0x1b, 0xb6, 0xb9, 0xe6, 0xfb, 0xa1,
-	0x44, 0xe9, 0xe6, 0x3d, 0x9b, 0x4a, 0x94, 0x6e, 0xee, 0xa3, 0x23, 0xb9, 0xb9, 0x64, 0xc5, 0x98,
-	0x0b, 0x8f, 0x80, 0x93, 0x0f, 0xa1, 0xa9, 0x65, 0xee, 0x1d, 0xcf, 0xfc, 0x9e, 0x62, 0xd4, 0x6c,
-	0x8e, 0x78, 0x27, 0xcf, 0x2f, 0xb6, 0x37, 0xb0, 0xfd, 0x65, 0xdb, 0x98, 0x04, 0x63, 0xd2, 0x5d,
-	0xa8, 0xe9, 0x59, 0x81, 0x2f, 0x69, 0x77, 0x43, 0xab, 0xd2, 0x53, 0x9c, 0x6f, 0x5b, 0xe4, 0xf7,
-	0x2d, 0xa8, 0x1b, 0x39, 0x76, 0xc6, 0x3d, 0x4f, 0xaa, 0x9d, 0xb6, 0x5e, 0xa7, 0x37, 0x64, 0x3b,
-	0x38, 0xc8, 0xc3, 0xcd, 0x9f, 0x33, 0x16, 0xe1, 0x23, 0xe3, 0x7c, 0x75, 0x2b, 0xfd, 0x07, 0x0d,
-	0x2f, 0xd2, 0x04, 0x7a, 0x1e, 0xfd, 0x8b, 0xdb, 0x16, 0xf9, 0x9e, 0x05, 0x0d, 0x33, 0x2a, 0xa0,
-	0xb6, 0x2a, 0x37, 0xfe, 0xa0, 0xb6, 0x6a, 0x4e, 0x28, 0xe1, 0x43, 0x1c, 0xe5, 0xe3, 0x4d, 0xc7,
-	0x18, 0xa5, 0x78, 0x59, 0xf6, 0xe3, 0x8d, 0x96, 0xbc, 0xcf, 0xff, 0x52, 0x45, 0x86, 0xaa, 0x88,
-	0xa6, 0xdd, 0xd3, 0xdb, 0xab, 0xff, 0x9f, 0xc8, 0x4d, 0xeb, 0xb6, 0x45, 0xbe, 0xca, 0xff, 0x33,
-	0x42, 0x7c, 0x8b, 0x5c, 0xf2, 0xaa, 0xdf, 0xdb, 0xd7, 0x71, 0x4e, 0x57, 0xed, 0xcb, 0xc6, 0x9c,
-	0xd2, 0x76, 0x73, 0x87, 0x8f, 0x4e, 0xfc, 0x15, 0x48, 0xa2, 0xf8, 0x33, 0x7f, 0x0f, 0x32, 0x7f,
-	0x90, 0x63, 0x3e, 0x48, 0x41, 0x6e, 0xb0, 0xf2, 0x2b, 0x36, 0x63, 0x6f, 0xe2, 0x58, 0xaf, 0xdb,
-	0x6f, 0xcc, 0x1d, 0xeb, 0x16, 0x9e, 0xed, 0xd9, 0x88, 0x8f, 0x00, 0x92, 0xb0, 0x32, 0x49, 0x85,
-	0x35, 0x95, 0xed, 0xcb, 0x46, 0x9e, 0x4d, 0x79, 0x91, 0xd1, 0x4f, 0xd6, 0xe2, 0x57, 0xb8, 0x5a,
-	0x79, 0x20, 0x03, 0xa2, 0xba, 0xf3, 0x60, 0xc6, 0x7f, 0x0d, 0xe7, 0x21, 0xdd, 0xbe, 0xa1, 0x54,
-	0x54, 0x74, 0xf5, 0x09, 0x2c, 0x1d, 0x06, 0xc1, 0xb3, 0xe9, 0x44, 0x5d, 0xd2, 0x98, 0x61, 0xb7,
-	0x03, 0x37, 0x1a, 0x76, 0x52, 0xb3, 0xb0, 0xaf, 0x61, 0x53, 0x1d, 0xd2, 0xd6, 0x9a, 0xda, 0xfa,
-	0x28, 0x09, 0x5b, 0xbf, 0x20, 0x2e, 0x2c, 0x2b, 0xb7, 0x44, 0x0d, 0xbc, 0x63, 0x36, 0xa3, 0x07,
-	0x5c, 0x33, 0x5d, 0x18, 0x1e, 0xa8, 0x1c, 0xed, 0x56, 0x24, 0xdb, 0xbc, 0x6d, 0x91, 0x23, 0xa8,
-	0xef, 0xd1, 0x5e, 0xd0, 0xa7, 0x22, 0x76, 0xb5, 0x92, 0x0c, 0x5c, 0x05, 0xbd, 0x3a, 0x4b, 0x06,
-	0x68, 0xea, 0xef, 0x89, 0x3b, 0x0b, 0xe9, 0xd7, 0xb7, 0x3e, 0x12, 0x51, 0xb1, 0x17, 0x52, 0x7f,
-	0xcb, 0xb0, 0xa1, 0xa1, 0xbf, 0x53, 0x71, 0x46, 0x43, 0x7f, 0x67, 0xe2, 0x8c, 0xc6, 0x52, 0xcb,
-	0xb0, 0x25, 0x19, 0xc1, 0x72, 0x26, 0x34, 0x49, 0xde, 0x90, 0x16, 0x78, 0x4e, 0x40, 0xb3, 0x73,
-	0x6d, 0x3e, 0x81, 0xd9, 0xdb, 0xa6, 0xd9, 0xdb, 0x31, 0x2c, 0xed, 0x51, 0xbe, 0x58, 0x3c, 0x13,
-	0x24, 0xf5, 0xa4, 0x54, 0xcf, 0x33, 0x49, 0x2b, 0x70, 0xac, 0x33, 0x0d, 0x34, 0xa6, 0x61, 0x90,
-	0xaf, 0x40, 0xed, 0x3e, 0x8d, 0x65, 0xea, 0x87, 0x72, 0x11, 0x53, 0xb9, 0x20, 0x9d, 0x9c, 0xcc,
-	0x11, 0x93, 0x67, 0xb0, 0xb5, 0x2d, 0xda, 0x1f, 0x50, 0xae, 0x9c, 0xba, 0x5e, 0xff, 0x05, 0xf9,
-	0x79, 0x6c, 0x5c, 0xe5, 0x9e, 0xad, 0x6b, 0x19, 0x03, 0x7a, 0xe3, 0xcd, 0x14, 0x9e, 0xd7, 0xb2,
-	0x1f, 0xf4, 0xa9, 0xe6, 0xaa, 0xf8, 0x50, 0xd3, 0x52, 0x26, 0x95, 0x00, 0x65, 0xd3, 0x3f, 0x95,
-	0x00, 0xe5, 0x64, 0x58, 0xda, 0x37, 0xb1, 0x1f, 0x9b, 0x5c, 0x4b, 0xfa, 0xe1, 0x59, 0x95, 0x49,
-	0x4f, 0x5b, 0x1f, 0xb9, 0xe3, 0xf8, 0x05, 0x79, 0x8a, 0xcf, 0x4b, 0xf5, 0xf4, 0x96, 0xc4, 0xe7,
-	0x4d, 0x67, 0xc2, 0xa8, 0xc5, 0xd2, 0xaa, 0x4c, 0x3f, 0x98, 0x77, 0x85, 0x1e, 0xcd, 0x67, 0x00,
-	0x8e, 0xe3, 0x60, 0xb2, 0xe7, 0xd2, 0x71, 0xe0, 0x27, 0xba, 0x36, 0x49, 0xe1, 0x48, 0xf4, 0x97,
-	0x96, 0xc7, 0x41, 0x9e, 0x6a, 0x87, 0x04, 0x23, 0x3b, 0x48, 0x32, 0xd7, 0xdc, 0x2c, 0x0f, 0xb5,
-	0x20, 0x39, 0x99, 0x1e, 0xb7, 0x2d, 0xb2, 0x03, 0x90, 0xc4, 0xa6, 0x95, 0xcb, 0x9f, 0x09, 0x7b,
-	0x2b, 0xb5, 0x97, 0x13, 0xc8, 0x3e, 0x82, 0x6a, 0x12, 0xec, 0xdc, 0x48, 0xd2, 0x5e, 0x8d, 0xd0,
-	0xa8, 0xb2, 0xe0, 0x99, 0x10, 0xa4, 0xdd, 0xc2, 0xa5, 0x02, 0x52, 0x61, 0x4b, 0x85, 0x71, 0x45,
-	0x0f, 0x56, 0xf8, 0x00, 0x95, 0x3b, 0x82, 0x49, 0x09, 0x72, 0x26, 0x39, 0x61, 0x40, 0x25, 0xcd,
-	0xb9, 0x51, 0x34, 0x23, 0xaa, 0xc0, 0xb8, 0x95, 0x27, 0x44, 0x30, 0xd5, 0x3c, 0x86, 0xe5, 0x4c,
-	0x98, 0x47, 0x89, 0xf4, 0xbc, 0xc8, 0x9b, 0x12, 0xe9, 0xb9, 0x11, 0x22, 0x7b, 0x0d, 0xbb, 0x6c,
-	0xda, 0x80, 0x27, 0x95, 0x73, 0x2f, 0xee, 0x0d, 0xdf, 0xb7, 0x36, 0xef, 0xde, 0xf8, 0xf0, 0x27,
-	0x06, 0x5e, 0x3c, 0x9c, 0x9e, 0xdc, 0xea, 0x05, 0xe3, 0xad, 0x91, 0x3c, 0xfa, 0x8b, 0xd4, 0xa2,
-	0xad, 0x91, 0xdf, 0xdf, 0xc2, 0x96, 0x4f, 0x16, 0xf0, 0xbf, 0x29, 0x3f, 0xf5, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x97, 0x66, 0xfe, 0xa7, 0xcd, 0x52, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_52358d9b93345ad6) }
+
+var fileDescriptor_rpc_52358d9b93345ad6 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x3d, 0x5b, 0x6c, 0x64, 0xc9,
+	0x55, 0xdb, 0xaf, 0xb1, 0x5d, 0xed, 0x67, 0xf9, 0x39, 0x3d, 0xb3, 0xaf, 0x9b, 0xcd, 0x66, 0x19,
+	0xc2, 0x38, 0x3b, 0x21, 0xcb, 0x66, 0x97, 0x3c, 0xfc, 0x68, 0xcf, 0x98, 0xf5, 0xd8, 0xce, 0xb5,
+	0x67, 0x87, 0x4d, 0x80, 0xce, 0x75, 0xf7, 0xb5, 0xdd, 0x99, 0x7e, 0xa5, 0xef, 0xed, 0xf1, 0x3a,
+	0xcb, 0x48, 0x04, 0x02, 0x91, 0x10, 0x0f, 0x11, 0xbe, 0x02, 0x42, 0x48, 0xc0, 0x07, 0xfc, 0x20,
+	0x81, 0x10, 0x42, 0x02, 0xfe, 0xe0, 0x03, 0x24, 0x84, 0x10, 0x12, 0x12, 0x3f, 0xfc, 0xc0, 0x0f,
+	0x42, 0x7c, 0x80, 0xc4, 0x67, 0x24, 0xce, 0x39, 0x75, 0xaa, 0x6e, 0xd5, 0xbd, 0xb7, 0x3d, 0x93,
+	0x6c, 0xe0, 0x67, 0xa6, 0xeb, 0xd4, 0xb9, 0xf5, 0x3c, 0xaf, 0x3a, 0xe7, 0x54, 0x59, 0x4c, 0x0d,
+	0x07, 0xcd, 0xdb, 0x83, 0x61, 0x3f, 0xee, 0xcb, 0x4a, 0xa7, 0x07, 0x85, 0xda, 0xcd, 0xb3, 0x7e,
+	0xff, 0xac, 0x13, 0xae, 0x07, 0x83, 0xf6, 0x7a, 0xd0, 0xeb, 0xf5, 0xe3, 0x20, 0x6e, 0xf7, 0x7b,
+	0x91, 0x42, 0xf2, 0xbe, 0x2c, 0x66, 0xef, 0x86, 0xbd, 0xa3, 0x30, 0x6c, 0xf9, 0xe1, 0x57, 0x47,
+	0x61, 0x14, 0xcb, 0x1f, 0x14, 0x0b, 0x41, 0xf8, 0x35, 0x00, 0x34, 0x06, 0x41, 0x14, 0x0d, 0xce,
+	0x87, 0x41, 0x14, 0xae, 0x15, 0x5e, 0x2a, 0xbc, 0x36, 0xed, 0xcf, 0xab, 0x8a, 0x43, 0x03, 0x97,
+	0x2f, 0x8b, 0xe9, 0x08, 0x51, 0xc3, 0x5e, 0x3c, 0xec, 0x0f, 0x2e, 0xd7, 0x8a, 0x84, 0x57, 0x45,
+	0x58, 0x5d, 0x81, 0xbc, 0x8e, 0x98, 0x33, 0x3d, 0x44, 0x03, 0xe8, 0x39, 0x94, 0x9f, 0x10, 0x4b,
+	0xcd, 0xf6, 0xe0, 0x3c, 0x1c, 0x36, 0xe8, 0xe3, 0x6e, 0x2f, 0xec, 0xf6, 0x7b, 0xed, 0x26, 0xf4,
+	0x52, 0x7a, 0x6d, 0xca, 0x97, 0xaa, 0x0e, 0xbf, 0xb8, 0xcf, 0x35, 0xf2, 0x63, 0x62, 0x2e, 0xec,
+	0x29, 0x38, 0x7c, 0x80, 0x5f, 0x71, 0x57, 0xb3, 0x09, 0x18, 0x3f, 0xf0, 0xfe, 0xaa, 0x20, 0x16,
+	0x76, 0x7b, 0xed, 0xf8, 0x61, 0xd0, 0xe9, 0x84, 0xb1, 0x9e, 0x13, 0x7c, 0x7e, 0x41, 0x00, 0x9a,
+	0xd3, 0x45, 0x7f, 0xd8, 0xe2, 0x19, 0xcd, 0x2a, 0xf0, 0x21, 0x43, 0xc7, 0x8e, 0xac, 0x38, 0x76,
+	0x64, 0xb9, 0xcb, 0x55, 0x1a, 0xb3, 0x5c, 0x30, 0x8e, 0x61, 0xd8, 0xec, 0x3f, 0x0e, 0x87, 0x97,
+	0x8d, 0x8b, 0x76, 0xaf, 0xd5, 0xbf, 0x58, 0x2b, 0x03, 0x6a, 0xc5, 0x9f, 0xd5, 0xe0, 0x87, 0x04,
+	0xf5, 0x96, 0x84, 0xb4, 0x67, 0xa1, 0xd6, 0xcd, 0x3b, 0x13, 0x8b, 0x0f, 0x7a, 0x9d, 0x7e, 0xf3,
+	0xd1, 0xf7, 0x38, 0xbb, 0x9c, 0xee, 0x8b, 0xb9, 0xdd, 0xaf, 0x88, 0x25, 0xb7, 0x23, 0x1e, 0x40,
+	0x28, 0x96, 0xb7, 0xce, 0x83, 0xde, 0x59, 0xa8, 0x9b, 0xd4, 0x43, 0xf8, 0x01, 0x31, 0xdf, 0x1c,
+	0x0d, 0x87, 0x40, 0x06, 0xe9, 0x31, 0xcc, 0x31, 0xdc, 0x0c, 0x02, 0x48, 0xa6, 0x17, 0x5e, 0x24,
+	0x68, 0x4c, 0x32, 0x00, 0xd3, 0x28, 0xde, 0x9a, 0x58, 0x49, 0x77, 0xc3, 0x03, 0xf8, 0xcf, 0x82,
+	0x28, 0x3f, 0x88, 0xdf, 0xef, 0xcb, 0xdb, 0xa2, 0x1c, 0x5f, 0x0e, 0x14, 0x61, 0xce, 0xde, 0x91,
+	0xb7, 0x89, 0xd6, 0x6f, 0x6f, 0xb4, 0x5a, 0xc3, 0x30, 0x8a, 0x8e, 0xa1, 0xc6, 0x9f, 0x0e, 0x54,
+	0xa1, 0x81, 0x78, 0x72, 0x4d, 0x4c, 0x70, 0x99, 0x3a, 0x9c, 0xf2, 0x75, 0x51, 0xbe, 0x20, 0x44,
+	0xd0, 0xed, 0x8f, 0x60, 0xe4, 0x51, 0x10, 0xd3, 0xce, 0x95, 0x7c, 0x0b, 0x22, 0x5f, 0x11, 0x33,
+	0x51, 0x73, 0xd8, 0x1e, 0xc0, 0xcc, 0x46, 0x27, 0x8f, 0xc2, 0x4b, 0xda, 0xb1, 0x29, 0xdf, 0x05,
+	0xca, 0x75, 0x31, 0xd9, 0x1f, 0xc5, 0x83, 0x7e, 0xbb, 0x17, 0xaf, 0x55, 0x00, 0xa1, 0x7a, 0x67,
+	0x91, 0xc7, 0x84, 0x33, 0xe9, 0x85, 0x9d, 0x43, 0xac, 0xf2, 0x0d, 0x12, 0x36, 0xdb, 0xec, 0xf7,
+	0x4e, 0xdb, 0xc3, 0xae, 0xe2, 0xc7, 0xb5, 0x6b, 0xd4, 0xb3, 0x0b, 0xf4, 0xbe, 0x5d, 0x14, 0xd5,
+	0xe3, 0x61, 0xd0, 0x8b, 0x82, 0x26, 0x02, 0x70, 0x1a, 0xf1, 0xfb, 0x8d, 0xf3, 0x20, 0x3a, 0xa7,
+	0x99, 0xc3, 0x34, 0xb8, 0x28, 0x57, 0xc4, 0x35, 0x35, 0x68, 0x9a, 0x5f, 0xc9, 0xe7, 0x92, 0xfc,
+	0xb8, 0x58, 0xe8, 0x8d, 0xba, 0x0d, 0xb7, 0xaf, 0x12, 0xed, 0x7a, 0xb6, 0x02, 0x17, 0xe3, 0x04,
+	0xf7, 0x5d, 0x75, 0xa1, 0x66, 0x6a, 0x41, 0xa4, 0x27, 0xa6, 0xb9, 0x14, 0xb6, 0xcf, 0xce, 0xd5,
+	0x54, 0x2b, 0xbe, 0x03, 0xc3, 0x36, 0xe2, 0x76, 0x37, 0x6c, 0x44, 0x71, 0xd0, 0x1d, 0xf0, 0xb4,
+	0x2c, 0x08, 0xd5, 0x83, 0x14, 0xea, 0x34, 0x4e, 0xc3, 0x30, 0x5a, 0x9b, 0xe0, 0x7a, 0x03, 0x91,
+	0xaf, 0x8a, 0xd9, 0x16, 0xd0, 0x54, 0x83, 0x37, 0x08, 0x70, 0x26, 0x89, 0xfb, 0x52, 0x50, 0xa4,
+	0x92, 0xbb, 0x61, 0x6c, 0xad, 0x4e, 0xc4, 0xd4, 0xe8, 0xed, 0x09, 0x69, 0x81, 0xb7, 0xc3, 0x38,
+	0x68, 0x77, 0x22, 0xf9, 0x86, 0x98, 0x8e, 0x2d, 0x64, 0x92, 0x36, 0x55, 0x43, 0x3a, 0xd6, 0x07,
+	0xbe, 0x83, 0xe7, 0xdd, 0x15, 0x93, 0x3b, 0x61, 0xb8, 0xd7, 0xee, 0xb6, 0x63, 0x58, 0xe5, 0xca,
+	0x69, 0xfb, 0xfd, 0x50, 0x11, 0x77, 0xe9, 0xde, 0x73, 0xbe, 0x2a, 0xca, 0x9a, 0x98, 0x18, 0x84,
+	0xc3, 0x66, 0xa8, 0x97, 0x1f, 0x6a, 0x34, 0x60, 0x73, 0x42, 0x54, 0x3a, 0xf8, 0xb1, 0xf7, 0xfb,
+	0xb0, 0x99, 0x47, 0x61, 0xcf, 0x30, 0x8d, 0x14, 0x65, 0x9c, 0x12, 0x33, 0x0a, 0xfd, 0x96, 0x2f,
+	0x8a, 0x2a, 0x4d, 0x33, 0x8a, 0x87, 0xed, 0xde, 0x19, 0xd3, 0xaa, 0x40, 0xd0, 0x11, 0x41, 0xe4,
+	0xbc, 0x28, 0x05, 0x5d, 0x4d, 0xa7, 0xf8, 0x13, 0x19, 0x6a, 0x10, 0x5c, 0x76, 0x91, 0xf7, 0xcc,
+	0xae, 0x01, 0x43, 0x31, 0xec, 0x1e, 0x6e, 0xdb, 0x6d, 0xb1, 0x68, 0xa3, 0xe8, 0xd6, 0x2b, 0xd4,
+	0xfa, 0x82, 0x85, 0xc9, 0x9d, 0x80, 0xa0, 0xd0, 0xf8, 0x43, 0x35, 0x58, 0xda, 0x47, 0xd8, 0x03,
+	0x06, 0xeb, 0x29, 0xbc, 0x26, 0xe6, 0x4f, 0xdb, 0x3d, 0xd8, 0xb9, 0x66, 0x27, 0x7e, 0xdc, 0x68,
+	0x85, 0x9d, 0x38, 0xa0, 0x1d, 0x05, 0x91, 0x42, 0xf0, 0x2d, 0x00, 0x6f, 0x23, 0x14, 0xe8, 0x70,
+	0x0a, 0x76, 0xb7, 0x41, 0x2b, 0x01, 0x1b, 0x8a, 0x1c, 0x32, 0xc7, 0x4b, 0xaf, 0x57, 0xd7, 0x9f,
+	0x3c, 0xe5, 0x5f, 0xde, 0x9f, 0x15, 0xc4, 0xb4, 0x5a, 0x2a, 0x56, 0x19, 0xc0, 0x2e, 0x7a, 0x44,
+	0xe1, 0x70, 0xd8, 0x1f, 0x32, 0xf9, 0xbb, 0x40, 0x79, 0x4b, 0xcc, 0x6b, 0xc0, 0x60, 0x18, 0xb6,
+	0xbb, 0xc1, 0x59, 0xc8, 0xf2, 0x25, 0x03, 0x97, 0x77, 0x92, 0x16, 0x87, 0xc0, 0x95, 0x4a, 0x68,
+	0x57, 0xef, 0x4c, 0xf3, 0xa0, 0x7c, 0x84, 0xf9, 0x2e, 0x0a, 0x92, 0x7f, 0xce, 0x52, 0x3b, 0x30,
+	0xef, 0x97, 0x0b, 0x42, 0xe2, 0xd0, 0x8f, 0xfb, 0xaa, 0x09, 0x5e, 0xa9, 0xf4, 0x2e, 0x15, 0x9e,
+	0x79, 0x97, 0x8a, 0xe3, 0x76, 0xe9, 0x15, 0x71, 0x8d, 0x86, 0x85, 0xfc, 0x5c, 0xca, 0x0c, 0x9d,
+	0xeb, 0xbc, 0xdf, 0x81, 0xa5, 0xb4, 0x65, 0x10, 0xe8, 0x38, 0x79, 0x3a, 0xea, 0xb5, 0xa0, 0x85,
+	0x46, 0xfc, 0x7e, 0xbb, 0xd5, 0x38, 0xb9, 0xc4, 0x26, 0x68, 0x3c, 0x40, 0xb6, 0x39, 0x75, 0xb0,
+	0x77, 0xf3, 0x0e, 0x14, 0x06, 0xa6, 0x46, 0x05, 0xf8, 0x99, 0x1a, 0x5c, 0x24, 0x94, 0x72, 0xa3,
+	0xb8, 0x01, 0xca, 0x24, 0x7c, 0x9f, 0xd6, 0x75, 0xc6, 0x77, 0x60, 0x9b, 0xb3, 0x62, 0xda, 0xfe,
+	0xce, 0xfb, 0xac, 0x98, 0xdf, 0x43, 0xe1, 0xd1, 0x03, 0x08, 0x0b, 0x71, 0x94, 0x68, 0x2c, 0x71,
+	0xd5, 0x5e, 0x73, 0x09, 0xd9, 0xe6, 0xbc, 0x1f, 0xc5, 0xbc, 0x2e, 0xf4, 0xdb, 0xfb, 0xd7, 0x82,
+	0x98, 0xc3, 0x45, 0xbf, 0x1f, 0xf4, 0x2e, 0xf5, 0x8a, 0xef, 0x89, 0x69, 0x6c, 0xea, 0xb8, 0xbf,
+	0xa1, 0xe4, 0xa2, 0xe2, 0xf7, 0xd7, 0x78, 0x91, 0x52, 0xd8, 0xb7, 0x6d, 0x54, 0x34, 0x5d, 0x2e,
+	0x7d, 0xe7, 0x6b, 0x64, 0xcc, 0x38, 0x18, 0x9e, 0x81, 0x92, 0x45, 0x89, 0xc9, 0x12, 0x54, 0x28,
+	0xd0, 0x16, 0x40, 0xe4, 0x4b, 0x60, 0x0a, 0x05, 0x40, 0x5f, 0x60, 0x3b, 0xe0, 0xaa, 0x11, 0x73,
+	0x81, 0x60, 0x03, 0xd8, 0x61, 0x38, 0xdc, 0x04, 0x48, 0xed, 0x73, 0x62, 0x21, 0xd3, 0x0b, 0xf2,
+	0x73, 0x32, 0x45, 0xfc, 0x29, 0x97, 0x44, 0xe5, 0x71, 0xd0, 0x19, 0x85, 0x2c, 0xc8, 0x55, 0xe1,
+	0xad, 0xe2, 0x9b, 0x05, 0xef, 0x55, 0x31, 0x9f, 0x0c, 0x9b, 0x19, 0x03, 0x56, 0x03, 0x57, 0x90,
+	0x1b, 0xa0, 0xdf, 0xde, 0xd7, 0x0b, 0x0a, 0x71, 0x0b, 0xf6, 0x3b, 0xb2, 0xa4, 0x0d, 0xca, 0x4e,
+	0x8d, 0x88, 0xbf, 0xc7, 0x2a, 0x8d, 0x0f, 0x3f, 0x59, 0xef, 0x63, 0x62, 0xc1, 0x1a, 0xc2, 0x15,
+	0x83, 0xdd, 0x17, 0x72, 0xaf, 0x1d, 0xc5, 0x0f, 0x7a, 0xd1, 0xc0, 0x12, 0x2c, 0x37, 0xc4, 0x54,
+	0xb7, 0xdd, 0xa3, 0xee, 0x15, 0x6d, 0x56, 0xfc, 0x49, 0x00, 0x60, 0xe7, 0x11, 0x55, 0x06, 0xef,
+	0x73, 0x65, 0x91, 0x2b, 0x83, 0xf7, 0xa9, 0xd2, 0x7b, 0x53, 0x2c, 0x3a, 0xed, 0x71, 0xd7, 0x2f,
+	0x8b, 0xca, 0x08, 0x0c, 0x07, 0x2d, 0xf6, 0xab, 0x4c, 0x06, 0x68, 0x4c, 0xf8, 0xaa, 0xc6, 0x7b,
+	0x5b, 0x2c, 0xec, 0x87, 0x17, 0x4c, 0x7e, 0x7a, 0x20, 0xaf, 0x3e, 0xd5, 0xd0, 0xa0, 0x7a, 0xef,
+	0xb6, 0x90, 0xf6, 0xc7, 0xdc, 0xab, 0x65, 0x76, 0x14, 0x1c, 0xb3, 0x03, 0xf6, 0x52, 0x1e, 0xb5,
+	0xcf, 0x7a, 0xf7, 0xe1, 0x37, 0x48, 0x23, 0xdd, 0x1b, 0x50, 0x43, 0x37, 0x3a, 0x63, 0xe1, 0x80,
+	0x3f, 0xbd, 0x4f, 0x8a, 0x45, 0x07, 0x8f, 0x1b, 0xbe, 0x29, 0xa6, 0x22, 0x00, 0x07, 0xf1, 0x68,
+	0x18, 0x72, 0xd3, 0x09, 0xc0, 0xdb, 0x11, 0x4b, 0xef, 0x86, 0xc3, 0xf6, 0xe9, 0xe5, 0xd3, 0x9a,
+	0x77, 0xdb, 0x29, 0xa6, 0xdb, 0xa9, 0x8b, 0xe5, 0x54, 0x3b, 0xdc, 0xbd, 0xa2, 0x51, 0xde, 0xc9,
+	0x49, 0x5f, 0x15, 0x2c, 0x8e, 0x2d, 0xda, 0x1c, 0xeb, 0x3d, 0x10, 0x12, 0xf6, 0xa6, 0x17, 0x36,
+	0x81, 0x3a, 0xc2, 0x61, 0x72, 0xd0, 0x48, 0x08, 0xb2, 0x7a, 0x67, 0x95, 0x57, 0x36, 0x2d, 0x06,
+	0x98, 0x52, 0x81, 0x72, 0x80, 0xd8, 0xba, 0xd4, 0xf0, 0xa4, 0x4f, 0xbf, 0xbd, 0x65, 0xb1, 0xe8,
+	0x34, 0xcb, 0x36, 0xe2, 0xeb, 0x62, 0x79, 0xbb, 0x1d, 0x35, 0xb3, 0x1d, 0xc2, 0x66, 0xc0, 0x80,
+	0x1a, 0x09, 0xbb, 0xe9, 0x22, 0x9a, 0x12, 0xe9, 0x4f, 0xb8, 0xb1, 0x5f, 0x00, 0x83, 0xf3, 0xde,
+	0xf1, 0xde, 0x16, 0x68, 0xf8, 0xc9, 0x76, 0xaf, 0xd9, 0xef, 0xa2, 0x44, 0x56, 0x93, 0x36, 0xe5,
+	0xb1, 0x6c, 0x04, 0x8b, 0x4b, 0x82, 0x1c, 0xad, 0x23, 0x3e, 0x13, 0x24, 0x00, 0xb4, 0xcc, 0xc2,
+	0xf7, 0x07, 0xed, 0x21, 0x99, 0x5e, 0xda, 0xa0, 0x2a, 0x93, 0xb0, 0xcc, 0x56, 0x78, 0x7f, 0x5c,
+	0x11, 0x13, 0x2c, 0xc6, 0xa9, 0x3f, 0x30, 0x4e, 0x1e, 0x87, 0x3c, 0x12, 0x2e, 0xa1, 0x92, 0x1c,
+	0xc2, 0xb1, 0x24, 0x0e, 0x1b, 0xce, 0x36, 0xb8, 0x40, 0xb2, 0x3c, 0x55, 0x43, 0x0d, 0x65, 0xaf,
+	0x96, 0x14, 0x96, 0x03, 0xc4, 0xc5, 0x42, 0x40, 0x03, 0xf6, 0x18, 0xc7, 0x54, 0xf6, 0x75, 0x11,
+	0x57, 0xa2, 0x19, 0x0c, 0x82, 0x66, 0x3b, 0xbe, 0x64, 0xbe, 0x37, 0x65, 0x6c, 0x1b, 0xe6, 0x06,
+	0xf6, 0xc0, 0x49, 0xd0, 0x09, 0x7a, 0xcd, 0x50, 0x5b, 0xb5, 0x0e, 0x10, 0x2d, 0x3c, 0x1e, 0x92,
+	0x46, 0x53, 0x56, 0x60, 0x0a, 0x8a, 0x96, 0x22, 0xac, 0x30, 0xd8, 0x03, 0x68, 0x18, 0x92, 0xd1,
+	0x00, 0x32, 0x26, 0x81, 0x28, 0x1b, 0x9a, 0x4a, 0x17, 0x6a, 0xf5, 0xa6, 0xb4, 0x0d, 0x6d, 0x01,
+	0xb1, 0x15, 0xb4, 0x3c, 0x50, 0x56, 0x3d, 0xba, 0x58, 0x13, 0xaa, 0x95, 0x04, 0x82, 0xfb, 0x30,
+	0x82, 0xad, 0x8e, 0xe3, 0x0e, 0x1c, 0xe2, 0xf4, 0x80, 0xaa, 0x84, 0x96, 0xad, 0x00, 0xed, 0xb9,
+	0xa8, 0x6c, 0x55, 0x90, 0x75, 0xfd, 0xe8, 0xbc, 0x1d, 0xc1, 0x49, 0x11, 0xd6, 0x70, 0x9a, 0xf0,
+	0xf3, 0xaa, 0xe4, 0x9b, 0x62, 0x35, 0x05, 0x86, 0xd3, 0x56, 0x08, 0xfb, 0xd5, 0x5a, 0x9b, 0xa1,
+	0xaf, 0xc6, 0x55, 0x83, 0x94, 0xad, 0xa2, 0x89, 0x3e, 0x1a, 0xb4, 0x02, 0x54, 0xd1, 0xb3, 0xb4,
+	0x0f, 0x36, 0x48, 0xbe, 0x0e, 0x46, 0x4c, 0xa8, 0xf4, 0xe8, 0x79, 0xdc, 0x69, 0x46, 0x6b, 0x73,
+	0x8e, 0x74, 0x43, 0xca, 0xf5, 0x5d, 0x0c, 0x24, 0xca, 0x66, 0x44, 0xb6, 0x5a, 0x70, 0xb9, 0x36,
+	0x4f, 0xe4, 0x96, 0x00, 0x88, 0x47, 0x86, 0xed, 0xc7, 0xd0, 0xf8, 0xda, 0x02, 0xd1, 0x96, 0x2e,
+	0x22, 0xd1, 0x8d, 0x06, 0x68, 0xc6, 0xaf, 0x49, 0x45, 0xe4, 0xaa, 0x84, 0xe4, 0xd0, 0x69, 0x9f,
+	0x86, 0x54, 0xb3, 0xa8, 0xc8, 0x41, 0x97, 0xbd, 0xdf, 0x2e, 0x28, 0x61, 0xcc, 0x84, 0x6b, 0x84,
+	0x2a, 0xe8, 0x17, 0x45, 0xb2, 0x8d, 0x7e, 0xaf, 0x73, 0xc9, 0x54, 0x2c, 0x14, 0xe8, 0x00, 0x20,
+	0xf2, 0x23, 0x62, 0x06, 0xcc, 0x47, 0x0b, 0x45, 0xf1, 0xfd, 0xb4, 0x06, 0x12, 0x12, 0xb4, 0x02,
+	0x24, 0xdd, 0x69, 0x37, 0x15, 0x4a, 0x49, 0xb5, 0xa2, 0x40, 0x84, 0x80, 0x36, 0x97, 0x1a, 0xbd,
+	0xc2, 0x28, 0x13, 0x46, 0x95, 0x61, 0x88, 0xe2, 0x6d, 0x8a, 0x25, 0x77, 0x80, 0x2c, 0xe0, 0x6e,
+	0x01, 0x91, 0x33, 0x0c, 0x68, 0x01, 0xd7, 0x74, 0xd6, 0x3d, 0xcf, 0xf9, 0xa6, 0xde, 0xfb, 0xd3,
+	0x32, 0x08, 0x22, 0x55, 0xd8, 0xea, 0xf4, 0xa3, 0xf0, 0x68, 0xd4, 0xed, 0x06, 0xc3, 0x1c, 0x46,
+	0x2b, 0x3c, 0x85, 0xd1, 0x8a, 0x2e, 0xa3, 0x21, 0xf9, 0x9f, 0x07, 0xa0, 0x05, 0xc9, 0x60, 0x54,
+	0x5c, 0x6a, 0x41, 0xc0, 0xf8, 0x9e, 0x6b, 0x42, 0x7f, 0xca, 0x88, 0xb2, 0x4f, 0x6c, 0x69, 0x70,
+	0x56, 0x30, 0x54, 0xf2, 0x04, 0x83, 0xcd, 0xd8, 0xd7, 0x52, 0x8c, 0x0d, 0x46, 0x1d, 0x36, 0x1a,
+	0x6a, 0x39, 0x35, 0xa1, 0x8c, 0x3a, 0x1b, 0x86, 0xe3, 0x49, 0xb3, 0x91, 0xe2, 0xd9, 0xb9, 0x3c,
+	0x26, 0xc2, 0x03, 0x21, 0xca, 0x41, 0x0b, 0x7b, 0x8a, 0x99, 0x28, 0x5b, 0x25, 0x77, 0x60, 0x2d,
+	0xa8, 0x2f, 0x52, 0xc6, 0x82, 0x94, 0xf1, 0xab, 0xee, 0x8e, 0xd8, 0x6b, 0x7f, 0x1b, 0x0b, 0xa0,
+	0xc1, 0x48, 0x41, 0x5b, 0x5f, 0x7a, 0xbf, 0x58, 0x10, 0x55, 0xab, 0x4e, 0x2e, 0x8b, 0x85, 0xad,
+	0x83, 0x83, 0xc3, 0xba, 0xbf, 0x71, 0xbc, 0xfb, 0x6e, 0xbd, 0xb1, 0xb5, 0x77, 0x70, 0x54, 0x9f,
+	0x7f, 0x0e, 0xc1, 0x7b, 0x07, 0x5b, 0x1b, 0x7b, 0x8d, 0x9d, 0x03, 0x7f, 0x4b, 0x83, 0x0b, 0xc0,
+	0x03, 0xd2, 0xaf, 0xdf, 0x3f, 0x38, 0xae, 0x3b, 0xf0, 0x22, 0xe8, 0xd5, 0xe9, 0x4d, 0xbf, 0xbe,
+	0xb1, 0x75, 0x8f, 0x21, 0x25, 0x50, 0x90, 0xf3, 0x3b, 0x0f, 0xf6, 0xb7, 0x77, 0xf7, 0xef, 0x36,
+	0xb6, 0x36, 0xf6, 0xb7, 0xea, 0x7b, 0xf5, 0xed, 0xf9, 0xb2, 0x9c, 0x11, 0x53, 0x1b, 0x9b, 0x1b,
+	0xfb, 0xdb, 0x07, 0xfb, 0x50, 0xac, 0x78, 0xff, 0x52, 0x10, 0xcb, 0x34, 0xea, 0x56, 0x9a, 0x41,
+	0x80, 0xf3, 0x9b, 0xfd, 0x3e, 0x08, 0xa8, 0xc0, 0x12, 0xf3, 0x36, 0x08, 0x89, 0x5f, 0x09, 0xd5,
+	0xd3, 0x3e, 0x1c, 0x33, 0x99, 0x3f, 0x04, 0x81, 0x76, 0x10, 0x82, 0xc4, 0xcf, 0xdb, 0xab, 0x30,
+	0x14, 0x7b, 0x54, 0x15, 0x4c, 0xa1, 0x00, 0x4b, 0x9f, 0x0c, 0xc3, 0xa0, 0x79, 0xce, 0x9c, 0xc1,
+	0x25, 0xf4, 0xe6, 0x68, 0xeb, 0xbc, 0x89, 0xab, 0x0f, 0x5b, 0x47, 0x14, 0x33, 0xe9, 0xcf, 0x31,
+	0x7c, 0x8b, 0xc1, 0x28, 0x4d, 0x82, 0x93, 0xa0, 0xd7, 0xea, 0xf7, 0x00, 0xe7, 0x1a, 0xe1, 0x24,
+	0x00, 0xef, 0x50, 0xac, 0xa4, 0xe7, 0xc7, 0xfc, 0xf5, 0x86, 0xc5, 0x5f, 0xca, 0x22, 0xab, 0x8d,
+	0xdf, 0x4d, 0x8b, 0xd7, 0x6a, 0x62, 0x8d, 0x11, 0xea, 0x8f, 0x41, 0xb8, 0x1e, 0x8d, 0x4e, 0x94,
+	0x1f, 0x06, 0x34, 0xa5, 0xf7, 0xcd, 0x0a, 0xd8, 0x19, 0x56, 0xe5, 0x03, 0x12, 0x92, 0xf2, 0x87,
+	0xe1, 0x3c, 0x02, 0x22, 0xb0, 0xc1, 0x6d, 0xb0, 0xbd, 0x91, 0x62, 0x67, 0x38, 0xc9, 0x38, 0x58,
+	0x72, 0x5b, 0xcc, 0x12, 0xd9, 0xb4, 0xcc, 0x77, 0x45, 0xfa, 0xee, 0x8a, 0x61, 0x42, 0x1b, 0xa9,
+	0x6f, 0xe4, 0x67, 0xc4, 0x2c, 0x4b, 0x31, 0xdd, 0x4a, 0x69, 0xac, 0x73, 0x08, 0x3f, 0x77, 0x91,
+	0xe5, 0x86, 0x98, 0x37, 0x62, 0x50, 0x37, 0x50, 0xbe, 0xaa, 0x81, 0x0c, 0xba, 0xfc, 0x31, 0xb1,
+	0xa4, 0xe5, 0xbf, 0xb3, 0x0a, 0xd7, 0xa8, 0x99, 0x25, 0x6e, 0xe6, 0x50, 0xa1, 0xa8, 0x15, 0x83,
+	0x76, 0x72, 0xbf, 0x91, 0xf7, 0xc5, 0xca, 0xe9, 0xa8, 0xd3, 0xb9, 0x04, 0x0d, 0x15, 0xf5, 0x3b,
+	0x8f, 0xad, 0xb5, 0x99, 0xb8, 0x6a, 0x50, 0x63, 0x3e, 0x02, 0xc5, 0xa8, 0x4c, 0xeb, 0x0a, 0x71,
+	0xf3, 0x2b, 0xee, 0xc7, 0xd6, 0x0e, 0xde, 0x56, 0xff, 0x59, 0xc6, 0xf6, 0xb7, 0x0a, 0x42, 0x24,
+	0x40, 0x64, 0x3f, 0x60, 0xe1, 0xfd, 0xc6, 0xd6, 0xbd, 0x8d, 0xfd, 0xfd, 0xfa, 0x1e, 0xf0, 0xaf,
+	0x14, 0xb3, 0xc4, 0x89, 0xdb, 0x06, 0x56, 0x40, 0xd8, 0xc6, 0x96, 0xe2, 0x72, 0x86, 0x15, 0x91,
+	0x4d, 0x77, 0xf7, 0x53, 0xd0, 0x12, 0x88, 0xe4, 0x25, 0x68, 0x8e, 0x98, 0xd7, 0x69, 0xb7, 0x0c,
+	0x22, 0x72, 0x65, 0xe7, 0xc1, 0xde, 0xde, 0x7b, 0x0d, 0xbf, 0x7e, 0x74, 0xb0, 0xf7, 0xae, 0xd5,
+	0x7e, 0x65, 0x73, 0x4a, 0x09, 0x72, 0x18, 0xbb, 0xf7, 0x5f, 0x65, 0x51, 0x46, 0x33, 0x72, 0xbc,
+	0xc9, 0x69, 0x9f, 0x0c, 0x4a, 0x19, 0x87, 0x24, 0x1d, 0xbb, 0x95, 0x61, 0xa1, 0x8c, 0x2f, 0x0b,
+	0x92, 0xd4, 0x83, 0x9d, 0xf0, 0x98, 0x16, 0xcf, 0xd4, 0x23, 0x04, 0xc5, 0x38, 0x9e, 0xcd, 0xe8,
+	0x6b, 0x16, 0xe3, 0xba, 0xac, 0xeb, 0xe8, 0xcb, 0x89, 0xa4, 0x8e, 0xbe, 0x83, 0x11, 0xb5, 0x7b,
+	0x27, 0x60, 0xb8, 0xb6, 0x48, 0x6c, 0x83, 0xea, 0xe7, 0x22, 0x32, 0xf9, 0x80, 0xd4, 0x09, 0xea,
+	0x78, 0x25, 0xa4, 0x13, 0x80, 0xbc, 0x03, 0x47, 0x88, 0xcb, 0x5e, 0xd3, 0x96, 0xcc, 0x09, 0x59,
+	0x85, 0xc3, 0xdb, 0x47, 0x50, 0x49, 0x7b, 0x97, 0xa0, 0xc9, 0xb7, 0xc4, 0x1a, 0x9a, 0x31, 0x43,
+	0x54, 0xcd, 0xe4, 0x12, 0x02, 0xb2, 0xd0, 0x66, 0x4e, 0x95, 0x66, 0x34, 0xb6, 0x1e, 0x9d, 0x3c,
+	0x67, 0xfd, 0x28, 0x6a, 0x0f, 0x40, 0x39, 0xf4, 0x1a, 0x60, 0xb5, 0xc3, 0xc9, 0x65, 0x9a, 0xd4,
+	0x51, 0x06, 0x8e, 0x8a, 0x26, 0x81, 0xf5, 0xb0, 0x91, 0x5e, 0xdc, 0xee, 0xb0, 0xdd, 0x95, 0x57,
+	0x45, 0xd6, 0x62, 0x27, 0x18, 0xc0, 0xe1, 0x12, 0xed, 0x79, 0x65, 0x72, 0x59, 0x10, 0x54, 0x84,
+	0x9d, 0x20, 0x02, 0xfb, 0x13, 0x41, 0x3d, 0x34, 0xb8, 0xb0, 0x29, 0x07, 0x66, 0x99, 0x4a, 0xf3,
+	0x63, 0x4d, 0xa5, 0x85, 0x94, 0xa9, 0xf4, 0x39, 0x31, 0xa9, 0x17, 0x0a, 0xe9, 0xf9, 0xc1, 0xfe,
+	0x3b, 0xfb, 0x07, 0x0f, 0xf7, 0x1b, 0x47, 0xef, 0xed, 0x6f, 0x01, 0x3d, 0xcf, 0x89, 0x2a, 0x53,
+	0x29, 0x01, 0x0a, 0x88, 0x72, 0xb8, 0x71, 0x74, 0x64, 0x20, 0x45, 0x4f, 0xa2, 0x0b, 0x25, 0xa2,
+	0xd3, 0x8b, 0x71, 0x84, 0xbe, 0x01, 0x6a, 0x2c, 0x81, 0x25, 0x27, 0xe1, 0x01, 0x02, 0x52, 0x27,
+	0x61, 0x3a, 0xf6, 0xa8, 0x1a, 0x6f, 0x55, 0x2c, 0x63, 0x31, 0x2b, 0x62, 0x7f, 0xbe, 0x20, 0xa6,
+	0x4c, 0xcd, 0x15, 0xd4, 0xad, 0xdd, 0xf3, 0x45, 0x22, 0x87, 0x9a, 0xd5, 0x05, 0x7d, 0x79, 0x9b,
+	0xfe, 0x75, 0x4e, 0xcf, 0x53, 0x06, 0x84, 0x93, 0x3d, 0xac, 0xd7, 0xfd, 0xc6, 0xc1, 0xfe, 0xde,
+	0xee, 0x3e, 0x6a, 0x63, 0x9c, 0x2c, 0x01, 0x76, 0x76, 0x08, 0x52, 0xf0, 0xe6, 0x31, 0x6c, 0x15,
+	0xef, 0xf6, 0x4e, 0xfb, 0x7a, 0xaa, 0xbf, 0x5a, 0xc6, 0x38, 0x13, 0x83, 0x78, 0xa6, 0x60, 0x90,
+	0xb4, 0x5b, 0xd0, 0x2a, 0x18, 0x30, 0x0d, 0xc7, 0x95, 0x94, 0x06, 0xe3, 0x79, 0x16, 0x4e, 0xb0,
+	0x81, 0x0e, 0x0e, 0xa8, 0x02, 0x50, 0xf6, 0x12, 0x52, 0xa1, 0x96, 0x85, 0x46, 0x61, 0x29, 0x8f,
+	0x56, 0x6e, 0x1d, 0x52, 0x1c, 0xc2, 0x5d, 0x29, 0x1c, 0xf1, 0xb9, 0x2e, 0xaf, 0x0a, 0xb9, 0x4b,
+	0xb5, 0x84, 0x7b, 0x52, 0x51, 0x06, 0xb9, 0x01, 0x64, 0x3c, 0xee, 0xd7, 0x94, 0xe1, 0x95, 0xf6,
+	0xb8, 0x5b, 0x5e, 0xfb, 0xc9, 0x8c, 0xd7, 0x1e, 0x0d, 0x33, 0xa0, 0x2d, 0xa0, 0xf1, 0xb8, 0xdf,
+	0x20, 0x03, 0x92, 0xb8, 0x18, 0xd4, 0x79, 0x0a, 0x4c, 0xf1, 0x05, 0x58, 0xcd, 0x5e, 0x18, 0x13,
+	0x27, 0x83, 0x0c, 0xe0, 0x22, 0xd2, 0x34, 0xa1, 0x28, 0x73, 0x18, 0xce, 0xf6, 0xaa, 0x84, 0x07,
+	0xf3, 0xd1, 0xb0, 0x1d, 0x01, 0x07, 0x22, 0x94, 0x7e, 0x83, 0xc6, 0x5d, 0x3e, 0x41, 0x27, 0xf6,
+	0x79, 0x18, 0xb4, 0xe0, 0x8c, 0x85, 0xf4, 0xad, 0x82, 0x01, 0x8a, 0xef, 0xf2, 0x2b, 0xb1, 0xef,
+	0xc7, 0x30, 0x63, 0x20, 0x33, 0x62, 0x3b, 0xa0, 0x26, 0x2e, 0x62, 0x7b, 0xb8, 0x20, 0x69, 0xdd,
+	0xa6, 0x98, 0x6f, 0xc6, 0xcf, 0xaf, 0xf4, 0xbe, 0x46, 0x5e, 0x07, 0x13, 0xdc, 0x60, 0x6b, 0xe0,
+	0x86, 0x98, 0x52, 0x2b, 0x13, 0x9d, 0x07, 0xec, 0x08, 0x99, 0x24, 0xc0, 0xd1, 0x79, 0x80, 0x36,
+	0x93, 0xb3, 0xd8, 0xca, 0xb7, 0x54, 0x25, 0xd8, 0x3d, 0xb5, 0xd6, 0xaf, 0x88, 0x59, 0x1d, 0x36,
+	0x89, 0x1a, 0x9d, 0xf0, 0x34, 0xd6, 0xfe, 0x4d, 0x80, 0x92, 0x03, 0x6a, 0x0f, 0x60, 0xde, 0x3e,
+	0x98, 0x95, 0x6a, 0x1c, 0x07, 0x40, 0x21, 0xdc, 0xf5, 0xa7, 0xf3, 0xce, 0x03, 0x63, 0x02, 0x45,
+	0x2e, 0xa6, 0xe7, 0x1b, 0xcb, 0x86, 0x0c, 0x0e, 0x6e, 0x90, 0x8d, 0x72, 0xed, 0x45, 0xe5, 0xe9,
+	0x38, 0x30, 0x5c, 0xd5, 0x68, 0xd4, 0x6c, 0xea, 0xc0, 0x17, 0xec, 0x28, 0x17, 0xbd, 0xdf, 0x87,
+	0xc3, 0x19, 0xb5, 0xa6, 0x4f, 0x34, 0x6c, 0x7b, 0xbe, 0xf9, 0x5d, 0x0c, 0x73, 0xba, 0x69, 0x7b,
+	0x96, 0x81, 0x8b, 0x6c, 0x6b, 0x54, 0x15, 0xbe, 0x7b, 0x67, 0x62, 0x39, 0xe3, 0x4c, 0xfc, 0xe7,
+	0x02, 0xac, 0x27, 0x59, 0x5a, 0x71, 0x10, 0x8f, 0x22, 0x9e, 0xfe, 0x8f, 0xc2, 0x40, 0xc9, 0xb2,
+	0x67, 0x26, 0xe4, 0x81, 0x8e, 0xb3, 0x69, 0x5c, 0x64, 0xf9, 0x39, 0x58, 0x3c, 0x8b, 0x3c, 0xd8,
+	0xbc, 0xbb, 0xae, 0x67, 0x99, 0xa1, 0x1c, 0xb4, 0x10, 0xed, 0x0f, 0xe4, 0xdb, 0x74, 0x3c, 0x03,
+	0xeb, 0x08, 0x9b, 0x65, 0xbb, 0xee, 0x7a, 0x8e, 0x75, 0x68, 0x3e, 0xb7, 0xd0, 0x37, 0x27, 0x51,
+	0x45, 0x20, 0xdc, 0xbb, 0x2b, 0x66, 0x9c, 0x91, 0x3a, 0x4e, 0xd2, 0x69, 0xe5, 0x24, 0xcd, 0xf8,
+	0xd4, 0x8b, 0x59, 0x9f, 0xba, 0xf7, 0x47, 0x25, 0x21, 0x91, 0xda, 0x52, 0xdb, 0x89, 0x4e, 0x84,
+	0x7e, 0xcb, 0x71, 0x09, 0x61, 0xb8, 0x35, 0x01, 0x81, 0xb0, 0x96, 0x56, 0x51, 0x87, 0x1d, 0x94,
+	0x55, 0x92, 0x53, 0x83, 0x62, 0x91, 0x8f, 0x1e, 0x7c, 0x48, 0x60, 0xe7, 0x97, 0xda, 0xb7, 0xdc,
+	0x3a, 0x54, 0x7d, 0x83, 0x11, 0xc6, 0x34, 0x82, 0x58, 0x3b, 0x8d, 0x74, 0x39, 0x4d, 0x20, 0xd7,
+	0x9e, 0x4a, 0x20, 0x13, 0x69, 0x02, 0xb1, 0xdd, 0x16, 0x93, 0xae, 0xdb, 0x02, 0x8e, 0xbe, 0xe8,
+	0x48, 0x46, 0xdf, 0x47, 0xa3, 0x8b, 0xbd, 0xb3, 0x8f, 0xc8, 0x01, 0xa2, 0x4d, 0xc1, 0x87, 0xa5,
+	0xc4, 0x37, 0x22, 0x94, 0x4d, 0x91, 0x86, 0xa3, 0xbc, 0x4e, 0x5c, 0xd3, 0x55, 0x1a, 0x6c, 0x02,
+	0x40, 0x6f, 0x12, 0x3a, 0x9e, 0xd1, 0x9c, 0x60, 0x6a, 0x81, 0x83, 0xd1, 0x34, 0x8d, 0x29, 0x5b,
+	0xe1, 0xfd, 0x63, 0x41, 0xcc, 0xe3, 0x9e, 0x39, 0x74, 0xfd, 0x96, 0x20, 0xb6, 0x7a, 0x46, 0xb2,
+	0x76, 0x70, 0x3f, 0x3c, 0x55, 0xbf, 0x29, 0xa6, 0xa8, 0x41, 0x34, 0xfc, 0x99, 0xa8, 0xd7, 0x5c,
+	0xa2, 0x4e, 0x24, 0x1a, 0x7c, 0x9c, 0x20, 0x5b, 0x24, 0xfd, 0xf7, 0x70, 0xc8, 0xe6, 0x61, 0x7e,
+	0xcf, 0xbe, 0xd3, 0x9a, 0x15, 0x50, 0x57, 0xa4, 0x98, 0xc4, 0xce, 0x41, 0x9f, 0x75, 0xd1, 0x41,
+	0x8d, 0x0a, 0xdc, 0xf1, 0x9b, 0xa6, 0xc1, 0xa8, 0x8d, 0x49, 0x78, 0x47, 0xa0, 0x67, 0x3a, 0x0d,
+	0x5d, 0xcb, 0x61, 0xeb, 0xbc, 0x2a, 0x94, 0x61, 0xa0, 0x8e, 0xce, 0x42, 0x56, 0xb4, 0xaa, 0x80,
+	0x0e, 0x62, 0x9e, 0x50, 0xea, 0xa4, 0xee, 0xfd, 0xe5, 0xb4, 0x58, 0xcd, 0x54, 0x99, 0x3c, 0x17,
+	0x76, 0x08, 0x82, 0x09, 0x7b, 0xd2, 0x37, 0x6e, 0x8e, 0x82, 0xed, 0x2b, 0x74, 0xaa, 0xe4, 0x99,
+	0x58, 0xce, 0x3b, 0x79, 0x45, 0x94, 0x80, 0x52, 0xbd, 0xf3, 0xba, 0x4b, 0x03, 0xe9, 0x0e, 0x35,
+	0xdc, 0x96, 0x02, 0xf9, 0xed, 0xc9, 0x73, 0xb1, 0x66, 0x4c, 0x17, 0x56, 0x17, 0x96, 0x79, 0x83,
+	0x7d, 0x7d, 0xfc, 0x29, 0x7d, 0x39, 0x07, 0x7b, 0x7f, 0x6c, 0x6b, 0xf2, 0x52, 0xbc, 0xa0, 0xeb,
+	0x48, 0x1f, 0x64, 0xfb, 0x2b, 0x3f, 0xd3, 0xdc, 0xc8, 0x65, 0xe1, 0x76, 0xfa, 0x94, 0x86, 0xe5,
+	0x57, 0xc4, 0xca, 0x45, 0xd0, 0x8e, 0xf5, 0xb0, 0x2c, 0xc3, 0xa1, 0x42, 0x5d, 0xde, 0x79, 0x4a,
+	0x97, 0x0f, 0xd5, 0xc7, 0x8e, 0x92, 0x1c, 0xd3, 0x62, 0xed, 0x6f, 0x0b, 0x62, 0xd6, 0x6d, 0x07,
+	0xc9, 0x94, 0x85, 0x87, 0x16, 0xa2, 0xda, 0xfc, 0x4c, 0x81, 0xb3, 0x9e, 0xc2, 0x62, 0x9e, 0xa7,
+	0xd0, 0xf6, 0xcf, 0x95, 0x9e, 0xe6, 0x78, 0x2f, 0x3f, 0x9b, 0xe3, 0xbd, 0x92, 0xe7, 0x78, 0xaf,
+	0xfd, 0x4f, 0x41, 0xc8, 0x2c, 0x2d, 0xc9, 0xbb, 0xe6, 0x84, 0xcb, 0x32, 0xe9, 0x87, 0x9e, 0x8d,
+	0x1e, 0xf5, 0xda, 0xe9, 0xaf, 0x91, 0x31, 0x6c, 0xa1, 0x63, 0x9b, 0x5b, 0x60, 0x24, 0xe7, 0x54,
+	0xa5, 0x42, 0x01, 0xe5, 0xa7, 0x87, 0x02, 0x2a, 0x4f, 0x0f, 0x05, 0x5c, 0x4b, 0x87, 0x02, 0x6a,
+	0xdf, 0x00, 0x93, 0x28, 0x67, 0xd3, 0xbf, 0x7f, 0x13, 0xc7, 0x6d, 0x72, 0x64, 0x41, 0x91, 0xb7,
+	0xc9, 0x06, 0xd6, 0x7e, 0x5a, 0xcc, 0x38, 0x84, 0xfe, 0xfd, 0xeb, 0x3f, 0x6d, 0x31, 0x2a, 0x3a,
+	0x73, 0x60, 0xb5, 0xff, 0x28, 0x0a, 0x99, 0x65, 0xb6, 0xff, 0xd7, 0x31, 0x64, 0xd7, 0xa9, 0x94,
+	0xb3, 0x4e, 0xff, 0xa7, 0x7a, 0x00, 0xf4, 0x38, 0x27, 0xc5, 0x59, 0x0e, 0x6a, 0x45, 0x31, 0xd9,
+	0x0a, 0xb4, 0x99, 0xdd, 0x38, 0xcc, 0xa4, 0x93, 0x5c, 0x64, 0x29, 0xc3, 0x54, 0x38, 0x06, 0x53,
+	0xed, 0x54, 0x92, 0xdd, 0xa6, 0x6a, 0x4a, 0xeb, 0x95, 0xdf, 0x2a, 0x88, 0xe5, 0x54, 0x45, 0x92,
+	0x0a, 0xa3, 0x54, 0x87, 0xab, 0x4f, 0x5c, 0x20, 0x8e, 0xdf, 0x98, 0x19, 0x29, 0x6a, 0xcb, 0x56,
+	0xe0, 0xfa, 0x58, 0x66, 0x49, 0x6a, 0xd5, 0xf3, 0xaa, 0xd0, 0x45, 0xc0, 0x3b, 0x9b, 0x1a, 0xf8,
+	0xa9, 0x4a, 0xde, 0xb3, 0x2b, 0x92, 0x60, 0xb8, 0x3b, 0x64, 0x5d, 0x44, 0x8b, 0xd2, 0x51, 0x53,
+	0xee, 0x78, 0x73, 0xeb, 0xbc, 0x6f, 0x01, 0x99, 0x7e, 0x61, 0x14, 0x0e, 0x2f, 0x29, 0xdd, 0xc5,
+	0x78, 0xce, 0x57, 0xd3, 0x3e, 0x09, 0x0c, 0x42, 0xbf, 0x13, 0x5e, 0xea, 0xc4, 0xa9, 0x62, 0x92,
+	0x38, 0xf5, 0xbc, 0x10, 0xe4, 0x64, 0xd2, 0x39, 0x34, 0x64, 0xc9, 0x01, 0x44, 0x35, 0x98, 0x9b,
+	0xdb, 0x54, 0x7e, 0x7a, 0x6e, 0x53, 0xe5, 0x29, 0xb9, 0x4d, 0xcf, 0x9e, 0x5c, 0xf5, 0xba, 0xa8,
+	0xd2, 0xd8, 0x1a, 0xe7, 0x20, 0xfd, 0x31, 0x53, 0x0e, 0x49, 0x6a, 0xde, 0x4e, 0xf2, 0xb9, 0x87,
+	0x67, 0x30, 0x31, 0xd4, 0x3f, 0x31, 0x85, 0x61, 0xd1, 0x59, 0x13, 0x43, 0x32, 0x3a, 0x53, 0xa8,
+	0x70, 0x45, 0xa6, 0xd0, 0x37, 0x8b, 0xa2, 0x74, 0xaf, 0x3f, 0xb0, 0x23, 0x52, 0x05, 0x37, 0x22,
+	0xc5, 0x7a, 0xaa, 0x61, 0xd4, 0x10, 0x8b, 0x2f, 0x07, 0x08, 0xc6, 0xf4, 0x2c, 0x2c, 0x2f, 0x3a,
+	0x15, 0x40, 0x2f, 0x5f, 0x04, 0xc3, 0x96, 0xa2, 0xa3, 0xcd, 0xe2, 0x5a, 0xc1, 0x4f, 0xd5, 0x80,
+	0xb9, 0x55, 0x32, 0x02, 0x9d, 0x10, 0xb0, 0x88, 0x46, 0x21, 0x45, 0xc0, 0x2f, 0xd9, 0x1f, 0xc2,
+	0x25, 0x24, 0x53, 0xf7, 0x7b, 0x65, 0xd2, 0x2b, 0xb6, 0xcc, 0xab, 0x42, 0x9d, 0x89, 0x5b, 0x43,
+	0x68, 0xec, 0xf0, 0xd4, 0x65, 0xdb, 0x7d, 0x35, 0xe9, 0xe6, 0x03, 0xfc, 0x7b, 0x41, 0x54, 0x68,
+	0x6d, 0x50, 0xc4, 0x28, 0xbe, 0x32, 0x41, 0x29, 0x5a, 0x13, 0x10, 0x31, 0x29, 0x30, 0x88, 0x35,
+	0x3b, 0xad, 0xb1, 0x68, 0x26, 0x64, 0xa7, 0x36, 0xbe, 0x24, 0xa6, 0x54, 0xc9, 0xa4, 0xf0, 0x11,
+	0x4a, 0x02, 0x04, 0x0d, 0x55, 0x3e, 0xef, 0x0f, 0xb4, 0x4d, 0x24, 0x74, 0x1c, 0xb7, 0x3f, 0xf0,
+	0x09, 0x9e, 0x8c, 0x07, 0xdb, 0x53, 0xd3, 0x52, 0x9a, 0x2e, 0x0d, 0x46, 0x5d, 0x6f, 0x9a, 0xb5,
+	0x97, 0x29, 0x05, 0xf5, 0x6e, 0x89, 0xb9, 0x7d, 0xb0, 0x43, 0x2c, 0x5f, 0xda, 0x58, 0x1e, 0xf2,
+	0x7e, 0xa6, 0x20, 0x26, 0x35, 0x32, 0x0c, 0xa5, 0x8c, 0x06, 0x4c, 0xea, 0x78, 0x62, 0xf2, 0x37,
+	0x10, 0xcf, 0x27, 0x0c, 0x94, 0xf8, 0xe4, 0x33, 0x49, 0x8c, 0x59, 0xed, 0x31, 0x49, 0x6c, 0x35,
+	0x33, 0xdc, 0x94, 0x89, 0x93, 0x82, 0x7a, 0x7f, 0x50, 0x10, 0x33, 0x4e, 0x1f, 0x78, 0xc0, 0x25,
+	0xef, 0xab, 0x3a, 0x7c, 0xf0, 0xf6, 0xd8, 0x20, 0x7b, 0xa3, 0x8b, 0xae, 0x9f, 0xd2, 0xf8, 0xfd,
+	0x4a, 0xb6, 0xdf, 0xef, 0x13, 0x62, 0x2a, 0x49, 0x3e, 0x2d, 0x3b, 0x92, 0x1c, 0x7b, 0xd4, 0x99,
+	0x29, 0x09, 0x12, 0xb6, 0xd3, 0xec, 0x77, 0xfa, 0x43, 0x0e, 0xac, 0xaa, 0x02, 0x70, 0x63, 0xd5,
+	0xc2, 0xc7, 0x61, 0xf4, 0xc2, 0xf8, 0xa2, 0x3f, 0x7c, 0xa4, 0xdd, 0xa5, 0x5c, 0x34, 0xb9, 0x59,
+	0xc5, 0x24, 0x37, 0xcb, 0xfb, 0x1b, 0x98, 0x28, 0xd2, 0x20, 0x4c, 0xf3, 0xb0, 0xdf, 0x69, 0x37,
+	0x2f, 0x69, 0xef, 0x35, 0xb9, 0xb1, 0x3c, 0xd2, 0xb4, 0xe8, 0x82, 0x91, 0xea, 0xf5, 0xf9, 0x96,
+	0x59, 0xd4, 0x94, 0x91, 0x87, 0x91, 0x03, 0x4e, 0x82, 0x88, 0xd9, 0x82, 0x55, 0xab, 0x03, 0x44,
+	0x4e, 0x43, 0x00, 0x39, 0xe0, 0xbb, 0xed, 0x4e, 0xa7, 0xad, 0x70, 0x95, 0xe1, 0x95, 0x57, 0x85,
+	0x7d, 0xb6, 0xda, 0x51, 0x70, 0x92, 0x04, 0x0b, 0x4d, 0xd9, 0xfb, 0xf3, 0xa2, 0xa8, 0xea, 0xb8,
+	0x4e, 0xeb, 0x2c, 0xe4, 0xc8, 0x36, 0x99, 0xb6, 0x46, 0xc8, 0x58, 0x10, 0x5d, 0xef, 0x18, 0xc3,
+	0x16, 0x24, 0xbd, 0xe5, 0xa5, 0xec, 0x96, 0xa3, 0x53, 0x15, 0x96, 0xfe, 0x75, 0xb2, 0xba, 0x55,
+	0x54, 0x3c, 0x01, 0xe8, 0xda, 0x3b, 0x54, 0x5b, 0x49, 0x6a, 0x09, 0x70, 0x65, 0x1c, 0xfc, 0x4d,
+	0x20, 0x65, 0xd5, 0x0c, 0xed, 0x09, 0x07, 0xbe, 0x96, 0x2c, 0x79, 0x6a, 0xf6, 0xcb, 0x77, 0x30,
+	0xf5, 0x97, 0x77, 0xf4, 0x97, 0x93, 0x4f, 0xfb, 0x52, 0x63, 0x7a, 0x77, 0x4d, 0x7a, 0xc1, 0xdd,
+	0x61, 0x30, 0x38, 0xd7, 0x5c, 0x0a, 0x5b, 0x04, 0xa7, 0xe8, 0xce, 0x08, 0xce, 0x10, 0xa3, 0x1e,
+	0xde, 0xec, 0x18, 0xa1, 0x2f, 0x97, 0x0f, 0xd8, 0x79, 0x55, 0x5e, 0xcb, 0x64, 0x82, 0x52, 0x43,
+	0x20, 0xa8, 0x2b, 0xd8, 0x91, 0xd6, 0x0a, 0xf9, 0x2c, 0xac, 0x50, 0x80, 0xf8, 0x2a, 0x21, 0x6c,
+	0x9d, 0x3e, 0x89, 0xca, 0x54, 0xb4, 0x0e, 0xaa, 0x7c, 0x85, 0x80, 0x02, 0x05, 0xa1, 0x29, 0x81,
+	0xe2, 0x6a, 0x14, 0xf4, 0x1e, 0xf7, 0x76, 0x5b, 0x78, 0xcf, 0x61, 0x5f, 0xf1, 0x80, 0xed, 0xcb,
+	0xff, 0xb9, 0x12, 0x30, 0x4e, 0x02, 0x46, 0xd9, 0x70, 0x86, 0x03, 0x6e, 0xb4, 0xda, 0x41, 0x37,
+	0x8c, 0xc3, 0x21, 0xd3, 0x7d, 0x0a, 0x8a, 0x78, 0xc1, 0x63, 0x30, 0x13, 0x46, 0x31, 0xf0, 0xc1,
+	0xd9, 0x30, 0x54, 0x06, 0x04, 0x2a, 0x1d, 0x07, 0x8a, 0x78, 0x98, 0x3f, 0x68, 0xe1, 0x29, 0x0a,
+	0x4a, 0x41, 0xb5, 0x67, 0x5e, 0xad, 0x51, 0x39, 0xf1, 0xcc, 0xab, 0x15, 0x49, 0x4b, 0xb5, 0x4a,
+	0x8e, 0x54, 0x7b, 0x43, 0xac, 0x28, 0xf9, 0xc5, 0x9c, 0xde, 0x48, 0x11, 0xd6, 0x98, 0x5a, 0xf4,
+	0x47, 0xe1, 0x98, 0x35, 0x4b, 0x44, 0xed, 0xaf, 0x29, 0xaf, 0x57, 0xc1, 0xcf, 0xc0, 0x11, 0x97,
+	0xdc, 0x4f, 0x36, 0xae, 0xca, 0xbb, 0xc8, 0xc0, 0x09, 0x17, 0x33, 0x27, 0x6d, 0xdc, 0x29, 0xc6,
+	0x4d, 0xc1, 0xbd, 0x19, 0x51, 0x3d, 0x8a, 0x41, 0xf1, 0xf0, 0xa6, 0xcc, 0x8a, 0x69, 0x55, 0xe4,
+	0xcc, 0xb8, 0x1b, 0xe2, 0x3a, 0x51, 0xd1, 0x71, 0x1f, 0xc8, 0xb4, 0x7f, 0x76, 0xe9, 0xc4, 0x89,
+	0xfe, 0x0e, 0x0e, 0x52, 0x4e, 0x6d, 0x12, 0x8b, 0xa7, 0x43, 0xaf, 0x8e, 0xf5, 0x29, 0xc2, 0x5b,
+	0xb0, 0x84, 0xab, 0x42, 0x54, 0x0e, 0xca, 0x07, 0x1c, 0xf1, 0xdb, 0x10, 0x73, 0x7a, 0x64, 0xfa,
+	0x43, 0x45, 0x85, 0x6b, 0x59, 0x2a, 0xe4, 0xef, 0x67, 0xf9, 0x03, 0xdd, 0xc4, 0x67, 0x38, 0x7f,
+	0x45, 0x45, 0x9f, 0xb5, 0x8f, 0xc3, 0x04, 0xf3, 0xed, 0x93, 0x8e, 0x1e, 0x41, 0xd3, 0x00, 0x23,
+	0xef, 0x97, 0x0a, 0x42, 0x24, 0xa3, 0xa3, 0xac, 0x07, 0xa3, 0x20, 0xd4, 0xad, 0x25, 0x4b, 0x19,
+	0xbc, 0x2c, 0xa6, 0x4d, 0x7c, 0x29, 0xd1, 0x39, 0x55, 0x0d, 0x43, 0x63, 0x14, 0x6c, 0xc0, 0xb3,
+	0x4e, 0xff, 0x84, 0x14, 0x36, 0xa5, 0x5a, 0x46, 0x9c, 0x1f, 0x38, 0xab, 0xc0, 0x3b, 0x0c, 0x4d,
+	0x14, 0x54, 0xd9, 0x52, 0x50, 0xde, 0x2f, 0x17, 0x4d, 0x7c, 0x21, 0x99, 0xf3, 0x58, 0x2e, 0x03,
+	0xf3, 0x3a, 0x2d, 0x4e, 0xc7, 0xb8, 0xf3, 0xc9, 0x9b, 0x77, 0xf8, 0x54, 0x67, 0xc3, 0xdb, 0x62,
+	0x76, 0xa8, 0xe4, 0x95, 0x16, 0x66, 0xe5, 0x2b, 0x84, 0xd9, 0xcc, 0xd0, 0xd1, 0x62, 0x3f, 0x00,
+	0xa4, 0xdd, 0x82, 0xd3, 0x53, 0xdc, 0xa6, 0xe3, 0x1e, 0x99, 0x10, 0x4a, 0x04, 0xcf, 0x59, 0x70,
+	0xd2, 0xec, 0xb0, 0x4a, 0x9c, 0x93, 0x69, 0x30, 0xd9, 0x52, 0x4e, 0xc0, 0x88, 0xe8, 0xfd, 0xae,
+	0x0e, 0x65, 0xb8, 0x7b, 0x38, 0x7e, 0x45, 0xec, 0xd9, 0x15, 0x53, 0xb3, 0xfb, 0x08, 0x87, 0x15,
+	0x5a, 0xfa, 0x4c, 0x59, 0xb2, 0x72, 0x9d, 0x5a, 0x1c, 0x06, 0x72, 0x97, 0xb4, 0xfc, 0x2c, 0x4b,
+	0x8a, 0xce, 0xde, 0x09, 0xb0, 0xe4, 0xee, 0x71, 0xd6, 0x17, 0x31, 0x82, 0x49, 0x86, 0xd6, 0xc5,
+	0x2b, 0xf2, 0xc1, 0x72, 0x35, 0xf7, 0x4c, 0x5a, 0x73, 0x7f, 0x5e, 0xdc, 0x20, 0x8f, 0xc6, 0x10,
+	0x38, 0x6f, 0x88, 0xcc, 0x08, 0x44, 0x46, 0x6a, 0xba, 0xdf, 0x8b, 0xcf, 0xb5, 0x18, 0xbb, 0x0a,
+	0x85, 0x8e, 0x8e, 0x78, 0xe4, 0x51, 0x46, 0x37, 0x5b, 0x1a, 0x4a, 0xba, 0x65, 0x2b, 0xbc, 0x4f,
+	0x8b, 0x29, 0x73, 0x16, 0xc1, 0x93, 0x10, 0x98, 0xa9, 0x7c, 0x60, 0x29, 0x38, 0x79, 0x73, 0x3c,
+	0x73, 0x3f, 0x41, 0xf0, 0xbe, 0x31, 0x29, 0x26, 0x76, 0x7b, 0x8f, 0xfb, 0xed, 0x26, 0x45, 0x3d,
+	0xba, 0x61, 0xb7, 0xaf, 0x53, 0xc3, 0xf1, 0x37, 0x2e, 0x05, 0xe5, 0x42, 0x0e, 0x62, 0x0e, 0x5b,
+	0xe8, 0x22, 0x1a, 0x08, 0xc3, 0xe4, 0x8a, 0x87, 0x62, 0x1d, 0x0b, 0x82, 0x07, 0x88, 0xa1, 0x7d,
+	0x45, 0x83, 0x4b, 0x49, 0x6e, 0x7d, 0xc5, 0xca, 0xad, 0xa7, 0x18, 0x99, 0xca, 0x50, 0xe3, 0x14,
+	0x26, 0x5d, 0xa4, 0x03, 0xcf, 0x30, 0x54, 0x9e, 0x28, 0x32, 0x35, 0x26, 0xf8, 0xc0, 0x63, 0x03,
+	0xd1, 0x1c, 0x51, 0x1f, 0x28, 0x1c, 0x25, 0x7c, 0x6d, 0x10, 0x9a, 0x6e, 0xe9, 0x33, 0xdf, 0x94,
+	0xa2, 0xf9, 0x14, 0x18, 0x25, 0x34, 0x28, 0x17, 0x2d, 0x48, 0xd5, 0x1c, 0x84, 0xba, 0xc2, 0x92,
+	0x86, 0x5b, 0xc7, 0x24, 0x95, 0xae, 0xaa, 0x8f, 0x49, 0x48, 0x28, 0x41, 0xa7, 0x73, 0x12, 0x80,
+	0x41, 0x48, 0x76, 0xe5, 0xb4, 0x72, 0x27, 0x3a, 0x40, 0xca, 0x31, 0x4b, 0x76, 0x93, 0x62, 0xb3,
+	0x65, 0xdf, 0x06, 0x01, 0x91, 0x3b, 0x07, 0xd0, 0xd9, 0x31, 0x07, 0x50, 0x1b, 0xc9, 0x8e, 0xc4,
+	0xcc, 0xb9, 0x91, 0x18, 0x25, 0x34, 0x39, 0x80, 0x35, 0x4f, 0xbd, 0x25, 0x00, 0xd4, 0xa6, 0xbc,
+	0x60, 0x0a, 0x61, 0x81, 0x10, 0x1c, 0x18, 0xec, 0xfa, 0x24, 0x1e, 0x5b, 0x06, 0x01, 0xf0, 0x86,
+	0x34, 0xa7, 0x27, 0x03, 0xc3, 0x36, 0xf4, 0x6f, 0x0a, 0x34, 0xa9, 0x74, 0x54, 0x07, 0x86, 0x6b,
+	0x63, 0xca, 0xc4, 0x44, 0x4b, 0x6a, 0x47, 0x1d, 0x20, 0x1c, 0xbd, 0xd1, 0xf1, 0x0f, 0x73, 0x58,
+	0xa6, 0x04, 0x86, 0x1b, 0x3c, 0x67, 0x26, 0x56, 0xfd, 0x3f, 0x46, 0x6d, 0x42, 0x5f, 0x61, 0x62,
+	0xc3, 0xb8, 0x02, 0x0d, 0xe6, 0xd6, 0x68, 0x6d, 0x05, 0x96, 0xab, 0xec, 0xbb, 0x40, 0xf9, 0x40,
+	0x2c, 0x39, 0xbb, 0xa0, 0x05, 0xe8, 0x2a, 0xf5, 0xf3, 0x72, 0xaa, 0x9f, 0x1d, 0x46, 0xc5, 0x13,
+	0x04, 0x4b, 0xd3, 0xdc, 0xcf, 0xbd, 0x4f, 0x8a, 0x69, 0x7b, 0x4c, 0x72, 0x52, 0x94, 0x31, 0x73,
+	0x69, 0xfe, 0x39, 0x59, 0x15, 0x13, 0x47, 0xf5, 0xe3, 0x63, 0xcc, 0x3f, 0x2c, 0xc8, 0x69, 0x31,
+	0x69, 0xb2, 0x11, 0x8b, 0x98, 0xcc, 0x21, 0xb3, 0x3d, 0x50, 0x8e, 0xd3, 0xc6, 0xde, 0xde, 0xe6,
+	0xc6, 0xd6, 0x3b, 0x8d, 0x8d, 0xed, 0x6d, 0xbf, 0x71, 0xe8, 0x1f, 0xbc, 0xbb, 0xbb, 0x0d, 0x9f,
+	0x3c, 0x07, 0xc2, 0xfb, 0xa3, 0x6e, 0xdd, 0x7e, 0xfd, 0x61, 0xe3, 0xe1, 0xee, 0xf1, 0x7e, 0xfd,
+	0xe8, 0xa8, 0x71, 0xf8, 0x60, 0xf3, 0x9d, 0xfa, 0x7b, 0x8d, 0x7b, 0x1b, 0x47, 0xf7, 0xa0, 0xaf,
+	0xd7, 0xc4, 0x2b, 0x59, 0x54, 0xc0, 0x3b, 0xae, 0x6f, 0x3b, 0x98, 0x45, 0x2f, 0x16, 0x12, 0xba,
+	0xe7, 0xf1, 0x1b, 0x9f, 0x45, 0xc2, 0xc2, 0x05, 0x87, 0x85, 0x73, 0x58, 0xa9, 0x98, 0xcf, 0x4a,
+	0x57, 0x12, 0x9c, 0x57, 0x17, 0xd5, 0x43, 0xeb, 0xf2, 0x15, 0x49, 0x14, 0x7d, 0xed, 0x8a, 0xa5,
+	0x90, 0x05, 0xb1, 0x86, 0x53, 0xb4, 0x87, 0xe3, 0xfd, 0x5e, 0x41, 0xdd, 0x5f, 0x31, 0xc3, 0x57,
+	0x7d, 0xe3, 0x4d, 0x31, 0xed, 0xb5, 0x4a, 0x52, 0x9c, 0x1d, 0x18, 0xe2, 0xd0, 0x50, 0x1a, 0xfd,
+	0xd3, 0x53, 0xa0, 0x73, 0x4e, 0xf5, 0x72, 0x60, 0x28, 0x0e, 0xd0, 0xa0, 0x44, 0xe3, 0xac, 0xad,
+	0x7a, 0x88, 0x38, 0xe5, 0x2b, 0x03, 0x47, 0xa5, 0x36, 0x0c, 0x31, 0x67, 0xc2, 0xc8, 0x31, 0x53,
+	0x36, 0x99, 0xd8, 0xe9, 0x55, 0xbe, 0x85, 0xa1, 0x39, 0x6e, 0xd7, 0x95, 0xd7, 0x1a, 0xd3, 0xd4,
+	0xa3, 0x5e, 0xa0, 0x23, 0x96, 0x33, 0x68, 0xa5, 0xa3, 0xb2, 0x15, 0x18, 0x55, 0x3e, 0x6d, 0x0f,
+	0xd3, 0xe8, 0x25, 0x42, 0xcf, 0xa9, 0xf1, 0x1e, 0x8a, 0x45, 0x4d, 0xc2, 0x96, 0x25, 0xe9, 0x6e,
+	0x62, 0xe1, 0x69, 0x52, 0xa3, 0x98, 0x95, 0x1a, 0xde, 0x77, 0x40, 0xed, 0xf2, 0x4e, 0x67, 0x2e,
+	0xf0, 0xa9, 0x7d, 0x76, 0x60, 0x20, 0xc1, 0xec, 0xfb, 0x57, 0x24, 0x62, 0x58, 0x4f, 0x64, 0xb4,
+	0x41, 0x29, 0x4f, 0x1b, 0xe0, 0x55, 0x95, 0x20, 0x3e, 0x27, 0xc7, 0x01, 0x68, 0x32, 0xfc, 0x8d,
+	0xce, 0x47, 0x74, 0x73, 0x29, 0xad, 0x43, 0x2e, 0xae, 0xbc, 0xab, 0x8a, 0xca, 0xb8, 0xc9, 0x5e,
+	0x55, 0x84, 0x35, 0xa0, 0x01, 0x34, 0x12, 0x2f, 0x56, 0x02, 0x40, 0xca, 0x55, 0x05, 0x12, 0x67,
+	0x7c, 0x4b, 0x22, 0x81, 0xe0, 0x35, 0x19, 0x4a, 0x02, 0x53, 0xad, 0x9a, 0xc0, 0x25, 0x67, 0xbe,
+	0x27, 0xe0, 0x84, 0x22, 0x78, 0x00, 0x69, 0x8a, 0x60, 0x54, 0xdf, 0xd4, 0x63, 0x36, 0xee, 0x76,
+	0xd8, 0x81, 0xb3, 0xd7, 0x46, 0xa7, 0x93, 0x6e, 0x1f, 0xce, 0x07, 0x39, 0x75, 0x7c, 0x78, 0xf8,
+	0x82, 0x58, 0xde, 0x50, 0x59, 0xc2, 0xdf, 0xaf, 0xe4, 0x13, 0x0c, 0xd1, 0xa6, 0x9b, 0xe4, 0xce,
+	0x76, 0xc4, 0xc2, 0x76, 0x78, 0x32, 0x3a, 0xdb, 0x03, 0x66, 0xe8, 0x58, 0xd7, 0xe1, 0xa2, 0xf3,
+	0xfe, 0x05, 0x33, 0x26, 0xfd, 0x46, 0x87, 0x70, 0x07, 0x71, 0x1a, 0xd1, 0x20, 0x6c, 0xea, 0xdb,
+	0x50, 0x04, 0x39, 0x02, 0x80, 0xf7, 0x86, 0x90, 0x76, 0x3b, 0xbc, 0x5e, 0xa8, 0xfc, 0x47, 0x27,
+	0x8d, 0xe8, 0x32, 0x8a, 0xc3, 0xae, 0xbe, 0xe6, 0x65, 0x83, 0xbc, 0x45, 0xb1, 0x70, 0x37, 0x8c,
+	0xb7, 0x37, 0x51, 0x34, 0x9b, 0xe5, 0xf9, 0x95, 0x82, 0x98, 0x07, 0x10, 0x90, 0x3a, 0x61, 0x51,
+	0x1d, 0xdd, 0xc6, 0xd2, 0x10, 0x73, 0xab, 0x4b, 0x03, 0x90, 0xbf, 0x91, 0xe7, 0xe1, 0x48, 0x11,
+	0x31, 0xa1, 0x9b, 0xb2, 0xbe, 0x2a, 0x72, 0x32, 0x6a, 0x3e, 0x0a, 0xe3, 0x88, 0xd9, 0xcc, 0x06,
+	0x21, 0x99, 0xe0, 0xb1, 0x8e, 0xaf, 0x7b, 0x72, 0x5a, 0x69, 0x02, 0xf1, 0x2e, 0x84, 0xb4, 0x47,
+	0x99, 0xa4, 0xd0, 0x9d, 0xb6, 0x81, 0x95, 0xac, 0x4f, 0x95, 0xef, 0x3e, 0x0d, 0x96, 0x3f, 0x02,
+	0xed, 0xeb, 0xa1, 0xea, 0xf3, 0x99, 0xbe, 0xd4, 0x95, 0x9e, 0xa8, 0x6f, 0xa1, 0x62, 0x24, 0x61,
+	0x13, 0x34, 0xd0, 0x68, 0xb0, 0x1d, 0xc4, 0x01, 0xda, 0xb1, 0x7a, 0x89, 0xfe, 0xb0, 0x20, 0x56,
+	0x34, 0x4c, 0x61, 0xdc, 0x0f, 0xe3, 0x00, 0x78, 0x2c, 0xc0, 0xc9, 0xb4, 0x4e, 0x1a, 0x3a, 0x5d,
+	0x4c, 0x79, 0x03, 0x2c, 0x08, 0x8a, 0x2a, 0x4a, 0x32, 0xcb, 0x64, 0x73, 0x81, 0x09, 0x9b, 0xa9,
+	0xc0, 0x49, 0xda, 0xc0, 0xe4, 0xb6, 0x45, 0x1a, 0x8c, 0x1b, 0x94, 0x64, 0xb3, 0x29, 0x67, 0x58,
+	0x02, 0xf0, 0x5a, 0x62, 0xd1, 0x1d, 0xef, 0xd6, 0xf9, 0xa8, 0x47, 0xde, 0x3d, 0x1c, 0xb4, 0xb9,
+	0xe7, 0x8d, 0x13, 0xf8, 0xb4, 0x98, 0xec, 0xf2, 0x64, 0xf8, 0x40, 0xf6, 0xbc, 0x5e, 0xab, 0xdc,
+	0x19, 0xfb, 0x06, 0xdd, 0xfb, 0x98, 0x98, 0x06, 0x7e, 0x82, 0x45, 0xe2, 0x6b, 0xc0, 0xe8, 0xad,
+	0x0d, 0x30, 0xbd, 0xfa, 0xab, 0xc6, 0x5b, 0x4b, 0xd5, 0xde, 0x7f, 0x17, 0xc5, 0x35, 0x85, 0x89,
+	0xe4, 0x81, 0x77, 0xc8, 0xdb, 0x3d, 0x95, 0x15, 0xc2, 0x44, 0x6a, 0x81, 0x32, 0x92, 0xb1, 0x98,
+	0x23, 0x19, 0xd9, 0xe3, 0xa1, 0x2f, 0x2a, 0xb1, 0xf8, 0x73, 0x60, 0x57, 0xaf, 0x50, 0xca, 0xb1,
+	0x9f, 0x58, 0xac, 0x6a, 0x7c, 0x5a, 0xe8, 0xb3, 0x20, 0xb4, 0x41, 0xb9, 0x76, 0xf1, 0x84, 0x92,
+	0x97, 0x19, 0xbb, 0x38, 0x63, 0xff, 0x4e, 0x3e, 0x83, 0xfd, 0xab, 0xdc, 0x20, 0x57, 0xd9, 0xbf,
+	0xe2, 0x19, 0xec, 0x5f, 0x4c, 0xc3, 0xdd, 0x09, 0x81, 0x84, 0xf1, 0x64, 0xa5, 0x09, 0xf9, 0xdb,
+	0xc0, 0xeb, 0x2c, 0x94, 0x4c, 0x9d, 0x7c, 0xd9, 0x39, 0x41, 0xe6, 0x5e, 0x0d, 0x82, 0x79, 0xd0,
+	0xb9, 0xce, 0x44, 0x30, 0x38, 0xdc, 0xe2, 0x00, 0x71, 0x1e, 0x3a, 0x84, 0x0d, 0x87, 0x38, 0xde,
+	0x14, 0x1b, 0xa4, 0x83, 0x20, 0xe8, 0xb1, 0xa5, 0x2d, 0x29, 0xf8, 0xa6, 0xec, 0xfd, 0x45, 0x41,
+	0x2c, 0x58, 0x03, 0x66, 0xb6, 0x7f, 0x5b, 0x68, 0xe1, 0xaa, 0xc2, 0x19, 0x05, 0x87, 0x9d, 0xd3,
+	0x73, 0xf1, 0x1d, 0x64, 0xda, 0x4c, 0x20, 0x48, 0xec, 0x22, 0x1a, 0x75, 0x59, 0x54, 0xd9, 0x20,
+	0x24, 0xa4, 0x8b, 0x30, 0x7c, 0x64, 0x50, 0x94, 0xb8, 0x72, 0x60, 0x94, 0xb8, 0x85, 0xe7, 0x51,
+	0x83, 0xa4, 0x44, 0x96, 0x0b, 0xf4, 0xfe, 0xba, 0x24, 0x16, 0x95, 0xdd, 0xca, 0x6e, 0x1b, 0x73,
+	0xd7, 0xf3, 0x9a, 0xf2, 0xa4, 0x28, 0x01, 0x7f, 0xef, 0x39, 0x9f, 0xcb, 0xf2, 0x53, 0xcf, 0xe8,
+	0x0c, 0x31, 0x09, 0x7b, 0x7a, 0x2f, 0xa6, 0x31, 0x21, 0xb7, 0xa1, 0x03, 0x09, 0x53, 0x7c, 0xff,
+	0xdd, 0x81, 0x66, 0x77, 0xac, 0x94, 0xb7, 0x63, 0x57, 0xec, 0x47, 0x9e, 0x93, 0xbf, 0x92, 0xef,
+	0xe4, 0xbf, 0x23, 0x96, 0xd0, 0xfc, 0xd3, 0xe1, 0x2e, 0x27, 0xcc, 0x53, 0xf6, 0x73, 0xeb, 0xf4,
+	0x37, 0x56, 0xde, 0x0c, 0xd6, 0x47, 0x7c, 0x9d, 0x2b, 0xb7, 0x4e, 0x7b, 0x4b, 0xad, 0x28, 0xe8,
+	0x64, 0xe2, 0x2d, 0x4d, 0xa0, 0xd8, 0x76, 0xb3, 0x13, 0x06, 0xc3, 0x06, 0x5f, 0x1b, 0x50, 0xf1,
+	0xd0, 0x88, 0x13, 0x89, 0x73, 0xeb, 0xf0, 0x6d, 0x8c, 0xa8, 0xd9, 0x1f, 0x84, 0x18, 0x06, 0x77,
+	0xb7, 0x91, 0x75, 0xf7, 0xa7, 0xc4, 0x22, 0x90, 0xd9, 0x76, 0xd8, 0x6c, 0x47, 0xd6, 0x0b, 0x1f,
+	0xa9, 0x00, 0x41, 0x21, 0x1d, 0x20, 0xf0, 0xbe, 0x5e, 0x12, 0x55, 0xeb, 0xbb, 0xa7, 0xe1, 0xbb,
+	0x52, 0xab, 0x98, 0x96, 0x5a, 0x2f, 0xe9, 0xbb, 0x56, 0x74, 0x25, 0x97, 0xf6, 0xb4, 0xe0, 0xdb,
+	0x20, 0x0a, 0x97, 0xf0, 0x5a, 0x3f, 0xee, 0x77, 0x46, 0xdd, 0x30, 0x09, 0x97, 0x94, 0xfd, 0xbc,
+	0x2a, 0xd4, 0x50, 0xfd, 0x4e, 0xab, 0xe1, 0x52, 0x8b, 0x12, 0x8a, 0xd9, 0x0a, 0xa4, 0x0a, 0x04,
+	0xda, 0x7c, 0xae, 0x1c, 0xc8, 0x69, 0x30, 0xbd, 0xf7, 0x12, 0x5e, 0xa4, 0xda, 0x55, 0x36, 0x63,
+	0xb6, 0x02, 0xdb, 0x45, 0xa0, 0xdd, 0x2e, 0x5f, 0xd9, 0x4b, 0x81, 0xe9, 0xbe, 0xca, 0x60, 0xd0,
+	0x69, 0xc3, 0xd9, 0x42, 0xe5, 0x8e, 0xeb, 0x22, 0x9d, 0x8c, 0xc2, 0x20, 0x02, 0xb1, 0x2d, 0x94,
+	0xfa, 0x51, 0x25, 0xef, 0x9e, 0x58, 0x72, 0xb7, 0xce, 0x64, 0xc5, 0x4d, 0xb5, 0x34, 0x30, 0xf5,
+	0x08, 0x8b, 0x85, 0xef, 0x27, 0x48, 0xf8, 0x84, 0xc5, 0xda, 0x8e, 0x5a, 0x43, 0xcc, 0xa2, 0x00,
+	0xab, 0xb5, 0x3f, 0xbc, 0xb4, 0x48, 0x01, 0x76, 0x69, 0x18, 0xab, 0xdb, 0x29, 0x1c, 0x4b, 0x4a,
+	0x20, 0xc8, 0x6c, 0x98, 0x75, 0x49, 0xb5, 0x6c, 0x35, 0xe9, 0x72, 0xe6, 0x04, 0xc6, 0x9e, 0x3e,
+	0xe7, 0x1c, 0xf3, 0xaa, 0x4a, 0xf8, 0x46, 0x62, 0x0f, 0x1f, 0x93, 0x55, 0xac, 0x5c, 0x68, 0x29,
+	0xa8, 0xf7, 0x0f, 0x05, 0x31, 0x97, 0x0c, 0x52, 0x5d, 0x90, 0x70, 0xc8, 0x8a, 0x0f, 0x2f, 0x09,
+	0x59, 0x69, 0xa2, 0x6c, 0xe3, 0x69, 0x86, 0xc7, 0x66, 0x41, 0x48, 0x41, 0x71, 0x09, 0x14, 0x0c,
+	0x13, 0x93, 0x0d, 0x52, 0xc9, 0x93, 0x78, 0x8e, 0xe2, 0x33, 0x21, 0x97, 0x68, 0xb3, 0xe0, 0x17,
+	0x7e, 0xa5, 0xa4, 0x81, 0x2e, 0xea, 0x83, 0xc8, 0x04, 0x41, 0xe9, 0x20, 0x62, 0x47, 0xc8, 0x27,
+	0xd5, 0xfa, 0xe8, 0xb2, 0xf7, 0xab, 0x05, 0x71, 0x3d, 0x67, 0xe1, 0x79, 0x23, 0xb7, 0xc5, 0xc2,
+	0xa9, 0xa9, 0xd4, 0x8b, 0xa3, 0x36, 0x74, 0x45, 0x6f, 0xa8, 0xbb, 0x20, 0x7e, 0xf6, 0x03, 0x73,
+	0xaa, 0x54, 0xcb, 0xed, 0xe4, 0x37, 0x67, 0x2b, 0xbc, 0x07, 0x62, 0x75, 0xa3, 0x49, 0x37, 0x71,
+	0xa0, 0x09, 0x47, 0xcb, 0x7e, 0x18, 0x42, 0xf0, 0xbe, 0x53, 0x14, 0xd5, 0x3d, 0x0c, 0x5f, 0x0d,
+	0xd5, 0xab, 0x1c, 0x57, 0x6f, 0xdc, 0x27, 0x9c, 0xdb, 0x2d, 0x37, 0x75, 0xd8, 0x2c, 0xf9, 0xfe,
+	0x36, 0xfd, 0x9b, 0xdc, 0x6f, 0xc1, 0xad, 0xe4, 0x27, 0xa5, 0x2c, 0xad, 0x60, 0x83, 0x9c, 0x6d,
+	0x28, 0xbb, 0xdb, 0x80, 0xa3, 0x19, 0x86, 0xa7, 0xe1, 0x30, 0xd4, 0x19, 0x7b, 0x70, 0x2c, 0x30,
+	0x80, 0x6c, 0xf2, 0xe0, 0xb5, 0x1c, 0x5b, 0xc2, 0xfb, 0xcd, 0x82, 0x98, 0x32, 0xa3, 0x92, 0x8b,
+	0x62, 0xee, 0x60, 0x7f, 0xeb, 0xde, 0xc6, 0xee, 0x7e, 0xc3, 0xaf, 0x6f, 0xd5, 0x77, 0xdf, 0xe5,
+	0x6b, 0x36, 0x1a, 0x78, 0x54, 0xdf, 0xdf, 0x56, 0xb7, 0x8c, 0xf8, 0x7e, 0x1b, 0x5d, 0x84, 0x9b,
+	0x2f, 0xca, 0x05, 0x31, 0xa3, 0x21, 0xfa, 0xaa, 0xeb, 0x94, 0xa8, 0x1c, 0x3d, 0xac, 0xd7, 0x0f,
+	0xe7, 0xcb, 0x78, 0x73, 0x67, 0xe7, 0xc0, 0x7f, 0xb8, 0xe1, 0x6f, 0x37, 0x76, 0xea, 0xf5, 0xf9,
+	0x8a, 0xba, 0xa6, 0xf4, 0xde, 0xfd, 0xfa, 0xfe, 0x31, 0x36, 0x79, 0x3c, 0x7f, 0x0d, 0x6f, 0xdc,
+	0x69, 0x08, 0xf7, 0xbc, 0x3d, 0x3f, 0x01, 0xb2, 0x62, 0x2d, 0xbb, 0xad, 0x4c, 0x66, 0x1f, 0x17,
+	0x13, 0xf8, 0xbc, 0x5c, 0x3b, 0x4c, 0x4b, 0x0b, 0x6b, 0xc1, 0x7d, 0x8d, 0xe2, 0x7d, 0x5e, 0x88,
+	0xad, 0xf6, 0xb0, 0x39, 0x6a, 0xc7, 0xef, 0xa8, 0x5b, 0x76, 0x63, 0x52, 0x59, 0xa0, 0x86, 0xd2,
+	0xbf, 0x13, 0x37, 0x3b, 0x17, 0xbd, 0x6f, 0x94, 0xc4, 0x0d, 0xa6, 0xdb, 0x7b, 0x00, 0xda, 0xed,
+	0xc5, 0xf8, 0x90, 0xd3, 0xc0, 0xd0, 0x59, 0x5d, 0x2c, 0xe9, 0xec, 0xe5, 0x46, 0x53, 0x75, 0x65,
+	0x52, 0x25, 0x92, 0x58, 0x56, 0x32, 0x08, 0x3f, 0x17, 0x1d, 0xd5, 0xa5, 0x81, 0xdb, 0xb4, 0xa1,
+	0x46, 0x93, 0x5b, 0x47, 0x17, 0x9a, 0x34, 0x9c, 0xcd, 0x56, 0x25, 0xb2, 0xd2, 0xe0, 0x67, 0x79,
+	0xa9, 0x48, 0x7e, 0x56, 0xd4, 0x40, 0x24, 0x9c, 0xf5, 0xf1, 0x33, 0xf6, 0x99, 0x71, 0x7c, 0x0c,
+	0x57, 0x45, 0x49, 0x94, 0x2b, 0x30, 0x70, 0x06, 0xa6, 0xd6, 0x9e, 0x01, 0x1b, 0x20, 0x79, 0x75,
+	0xa4, 0xc8, 0x34, 0x9c, 0x67, 0xa0, 0x6c, 0x8f, 0x34, 0xd8, 0xfb, 0x4e, 0x49, 0xdc, 0xcc, 0xdf,
+	0x06, 0xa6, 0x8b, 0xef, 0xd3, 0x3e, 0x6c, 0xaa, 0xc7, 0x34, 0x38, 0x57, 0x7e, 0xf6, 0xce, 0x2d,
+	0x57, 0x74, 0xe5, 0xf6, 0x7d, 0x7b, 0x43, 0x3d, 0x14, 0xc6, 0x5f, 0xd2, 0xed, 0x06, 0x37, 0x18,
+	0x61, 0xca, 0xf2, 0x48, 0x4c, 0x9f, 0x06, 0xed, 0xce, 0x68, 0x18, 0x36, 0x9a, 0x18, 0xc1, 0x2a,
+	0x53, 0x2f, 0xeb, 0xcf, 0xd2, 0xcb, 0x8e, 0xfa, 0x6e, 0x0b, 0xc3, 0xf0, 0x4e, 0x23, 0xde, 0x2d,
+	0x71, 0x4d, 0x0d, 0x41, 0x0a, 0x71, 0xcd, 0xaf, 0x1f, 0x3d, 0xb8, 0x8f, 0x0c, 0x3c, 0x29, 0xca,
+	0x3b, 0x1b, 0xbb, 0x78, 0xd7, 0x15, 0xa0, 0xca, 0xeb, 0x3b, 0x5f, 0xf4, 0xfe, 0xa4, 0x00, 0x5c,
+	0x99, 0xb4, 0x24, 0x9f, 0x17, 0xd7, 0x8f, 0xeb, 0xf7, 0x0f, 0x0f, 0xfc, 0x0d, 0xff, 0x3d, 0x7d,
+	0x7d, 0xb5, 0x81, 0xdf, 0x3d, 0xf0, 0xb1, 0x91, 0x9a, 0x58, 0x49, 0xaa, 0xf7, 0x0f, 0xb6, 0xeb,
+	0xa6, 0xae, 0x80, 0x75, 0x87, 0x75, 0xff, 0xfe, 0xc6, 0x3e, 0xb2, 0xaf, 0x53, 0x57, 0xc4, 0x66,
+	0x93, 0xba, 0x74, 0xb3, 0x25, 0xbc, 0x51, 0xaf, 0xef, 0x34, 0xee, 0xd7, 0x7f, 0xfc, 0xb8, 0x81,
+	0x17, 0xfa, 0x40, 0x62, 0xac, 0x89, 0x25, 0x0d, 0xd6, 0x62, 0x81, 0xbc, 0xc2, 0x15, 0xef, 0xa6,
+	0xa8, 0xb1, 0x23, 0xf0, 0x24, 0xc4, 0xe5, 0x21, 0x05, 0x92, 0xb8, 0x4f, 0x2a, 0x62, 0xca, 0x40,
+	0xe5, 0x5b, 0x42, 0x90, 0x36, 0x69, 0x58, 0x4f, 0xf5, 0xe8, 0xd8, 0xae, 0xc1, 0xb2, 0x2e, 0x1d,
+	0x5a, 0xd8, 0x78, 0xb0, 0x4c, 0xe8, 0xc2, 0x09, 0xbc, 0x65, 0xe0, 0x0e, 0xae, 0x96, 0x1e, 0xa5,
+	0x14, 0x2e, 0xc3, 0x11, 0xd7, 0x90, 0xb4, 0xfb, 0x92, 0x4a, 0x06, 0xee, 0xe0, 0xea, 0x76, 0x2b,
+	0x29, 0x5c, 0xdd, 0x2e, 0xe8, 0x4b, 0x4b, 0x36, 0x38, 0x2c, 0x97, 0xad, 0x20, 0x33, 0x33, 0xe1,
+	0xc3, 0x38, 0x31, 0x07, 0x01, 0x3b, 0x53, 0xe1, 0xb4, 0x8d, 0xea, 0x8e, 0xf2, 0xdd, 0x94, 0xb5,
+	0x9f, 0xad, 0x70, 0xda, 0x36, 0xd8, 0x53, 0x0a, 0x3b, 0x53, 0x81, 0x12, 0xc9, 0x68, 0x50, 0xbc,
+	0x38, 0x2b, 0xd4, 0x99, 0xcf, 0x86, 0x21, 0x8e, 0xc3, 0x2b, 0x55, 0x65, 0x8f, 0xd9, 0x30, 0xb4,
+	0xc7, 0x74, 0x99, 0x6f, 0x2a, 0xa9, 0xe8, 0x56, 0x0a, 0x6a, 0xe3, 0xb5, 0xe8, 0x05, 0x40, 0x8a,
+	0x70, 0x59, 0x78, 0x0a, 0xea, 0xd5, 0xed, 0xab, 0xa7, 0x55, 0x31, 0xc1, 0x0a, 0x0c, 0x38, 0x21,
+	0x61, 0x22, 0xd2, 0x84, 0x46, 0xb3, 0x21, 0x8b, 0x15, 0xf1, 0x2d, 0x87, 0xbd, 0xdd, 0xfd, 0x77,
+	0x54, 0xb1, 0xe4, 0x6d, 0x0b, 0x79, 0x3f, 0x68, 0x06, 0xc3, 0x7e, 0xbf, 0x77, 0x18, 0x0e, 0xbb,
+	0xed, 0x88, 0xce, 0x1d, 0xe8, 0xef, 0xa0, 0xa0, 0xbf, 0x76, 0xcd, 0xa8, 0x92, 0x7e, 0xc1, 0x87,
+	0x85, 0xce, 0x94, 0x16, 0x24, 0x5e, 0x2c, 0x16, 0x37, 0x83, 0x47, 0xa1, 0x6e, 0x49, 0xab, 0x9c,
+	0xb7, 0x45, 0x75, 0x60, 0x1a, 0xd5, 0x6a, 0x50, 0x5f, 0xea, 0xc9, 0x76, 0xeb, 0xdb, 0xd8, 0x68,
+	0x7b, 0x40, 0x35, 0x09, 0xbb, 0x84, 0xb6, 0x6d, 0x90, 0x07, 0x82, 0xdc, 0xed, 0x95, 0x25, 0x2c,
+	0xa6, 0x91, 0x31, 0x8c, 0xc7, 0x6f, 0xca, 0xe8, 0x6e, 0x45, 0xf7, 0xb1, 0xfe, 0x66, 0x77, 0xdb,
+	0xb0, 0xe6, 0x67, 0xc4, 0x6a, 0xa6, 0x86, 0x1b, 0x84, 0xfd, 0xb5, 0xfa, 0x55, 0x13, 0x01, 0x1a,
+	0xb0, 0x61, 0xde, 0xdb, 0x62, 0x55, 0xf9, 0x8d, 0x93, 0x06, 0xac, 0xab, 0x6c, 0xf6, 0x4c, 0x0a,
+	0xd9, 0x99, 0xfc, 0xb0, 0x76, 0x48, 0xdb, 0x1f, 0x27, 0xe9, 0xc7, 0x2d, 0xaa, 0xd3, 0x39, 0x52,
+	0xba, 0xe8, 0x5d, 0x8a, 0x09, 0xb6, 0x3e, 0xe4, 0xac, 0x28, 0x9a, 0x96, 0xe1, 0x17, 0x06, 0x8c,
+	0x3b, 0xc1, 0x09, 0xbf, 0xfe, 0x30, 0xe5, 0xab, 0x02, 0x5d, 0xca, 0x55, 0x69, 0xc8, 0x89, 0x3d,
+	0x07, 0xf3, 0xb0, 0x61, 0xd9, 0x60, 0x41, 0x39, 0x27, 0x58, 0xe0, 0x1d, 0x8a, 0xa5, 0x2d, 0x04,
+	0x84, 0x3c, 0x00, 0x3d, 0x55, 0xd3, 0x6f, 0xe1, 0xaa, 0x7e, 0x8b, 0xd9, 0x7e, 0xbd, 0x0d, 0xb1,
+	0x9c, 0x6a, 0xd1, 0xb8, 0x72, 0x27, 0x02, 0x05, 0x4a, 0x3d, 0x81, 0xa1, 0x11, 0x75, 0xb5, 0x8e,
+	0x18, 0x30, 0x3c, 0x1d, 0x31, 0x48, 0xc0, 0x49, 0xc4, 0x80, 0xbf, 0x4c, 0x47, 0x0c, 0x74, 0xcb,
+	0xa6, 0x1e, 0xcc, 0x33, 0xb9, 0x03, 0xe7, 0xff, 0xd4, 0x6c, 0xd3, 0xab, 0x5e, 0x53, 0x81, 0x5e,
+	0x6b, 0x8e, 0xa6, 0xec, 0x7d, 0x4e, 0x2c, 0x3a, 0x2d, 0x7c, 0xd7, 0xb3, 0x7b, 0x57, 0xac, 0xd1,
+	0xcb, 0x74, 0x23, 0x38, 0xcd, 0x74, 0x53, 0x0f, 0xa4, 0xd1, 0x33, 0x63, 0x9c, 0x5f, 0x36, 0xed,
+	0xd3, 0x6f, 0xba, 0x8f, 0xa9, 0xad, 0xfd, 0x19, 0xb6, 0xe7, 0xb5, 0x4b, 0xb7, 0x94, 0xb8, 0x74,
+	0x31, 0xe0, 0x91, 0xd3, 0x2e, 0xfb, 0x31, 0x3e, 0x2f, 0x5e, 0x30, 0xda, 0xcc, 0xc1, 0xb0, 0x5d,
+	0x1a, 0xa8, 0x95, 0x48, 0x29, 0xa9, 0x75, 0x9c, 0xf1, 0x2d, 0x88, 0xf7, 0x0e, 0x58, 0xde, 0xf6,
+	0x87, 0x1f, 0x66, 0xac, 0xb7, 0x3e, 0x2b, 0xaa, 0xd6, 0x13, 0x76, 0x72, 0x55, 0x2c, 0xe6, 0x05,
+	0x71, 0x9f, 0xc3, 0x07, 0x6f, 0x72, 0x42, 0xb6, 0x85, 0x3b, 0xbf, 0x56, 0x12, 0xb3, 0xea, 0x76,
+	0x82, 0x7a, 0x27, 0x18, 0xba, 0xbe, 0x2f, 0x26, 0xf8, 0x9d, 0x67, 0xb9, 0xcc, 0x4b, 0xef, 0xbe,
+	0x2c, 0x5d, 0x5b, 0x49, 0x83, 0x79, 0x6d, 0x16, 0x7f, 0xf6, 0x1f, 0xff, 0xed, 0xd7, 0x8b, 0x33,
+	0xb2, 0xba, 0xfe, 0xf8, 0xf5, 0xf5, 0xb3, 0xb0, 0x87, 0x4f, 0x2f, 0xcb, 0x9f, 0x10, 0x22, 0x79,
+	0x01, 0x59, 0xae, 0x99, 0xa0, 0x64, 0xea, 0x69, 0xe7, 0xda, 0xf5, 0x9c, 0x1a, 0x6e, 0xf7, 0x3a,
+	0xb5, 0xbb, 0xe8, 0xcd, 0x62, 0xbb, 0x6d, 0xa8, 0x57, 0xcf, 0x21, 0xbf, 0x55, 0xb8, 0x25, 0x5b,
+	0x62, 0xda, 0x7e, 0xe0, 0x58, 0x6a, 0x63, 0x21, 0xe7, 0x79, 0xe5, 0xda, 0x8d, 0xdc, 0x3a, 0x9d,
+	0x05, 0x47, 0x7d, 0x2c, 0x7b, 0xf3, 0xd8, 0xc7, 0x88, 0x30, 0x92, 0x5e, 0x3a, 0x62, 0xd6, 0x7d,
+	0xc7, 0x58, 0xde, 0xb4, 0x1c, 0x8d, 0x99, 0x57, 0x94, 0x6b, 0xcf, 0x8f, 0xa9, 0xe5, 0xbe, 0x9e,
+	0xa7, 0xbe, 0x56, 0x3d, 0x89, 0x7d, 0x35, 0x09, 0x47, 0xbf, 0xa2, 0x0c, 0xbd, 0xdd, 0xf9, 0xa7,
+	0x8f, 0x83, 0x46, 0xd2, 0xa9, 0x9b, 0xf2, 0x2b, 0x62, 0xc6, 0xb9, 0x3e, 0x22, 0xf5, 0x34, 0xf2,
+	0x6e, 0x9b, 0xd4, 0x6e, 0xe6, 0x57, 0x72, 0xc7, 0x2f, 0x50, 0xc7, 0x6b, 0x72, 0x05, 0x3b, 0x66,
+	0x61, 0xb3, 0x4e, 0x51, 0x11, 0xf5, 0x6a, 0xc0, 0x23, 0x35, 0xcf, 0xe4, 0xca, 0x87, 0x33, 0xcf,
+	0xcc, 0x15, 0x11, 0x67, 0x9e, 0xd9, 0x7b, 0x22, 0xde, 0x4d, 0xea, 0x6e, 0x45, 0x2e, 0xd9, 0xdd,
+	0x99, 0x94, 0xca, 0x90, 0xde, 0x79, 0xb0, 0x9f, 0xfd, 0x95, 0xcf, 0x1b, 0xc2, 0xca, 0x7b, 0x0e,
+	0xd8, 0x90, 0x48, 0xf6, 0x4d, 0x60, 0x6f, 0x8d, 0xba, 0x92, 0x92, 0xb6, 0xcf, 0x7e, 0xf5, 0x57,
+	0x7e, 0x49, 0x4c, 0x99, 0xf7, 0x2b, 0xe5, 0xaa, 0xf5, 0x68, 0xa8, 0xfd, 0xa8, 0x66, 0x6d, 0x2d,
+	0x5b, 0x91, 0x47, 0x18, 0x76, 0xcb, 0x48, 0x18, 0x0f, 0x45, 0xd5, 0x7a, 0xa3, 0x52, 0x5e, 0x37,
+	0x89, 0xb7, 0xe9, 0x77, 0x30, 0x6b, 0xb5, 0xbc, 0x2a, 0xee, 0x62, 0x81, 0xba, 0xa8, 0xca, 0x29,
+	0xa2, 0x3d, 0x7c, 0xc2, 0x52, 0xee, 0x89, 0x65, 0x23, 0x66, 0xbe, 0x9b, 0x25, 0xca, 0x79, 0x05,
+	0xf9, 0x13, 0x05, 0x30, 0x3b, 0x26, 0xf5, 0x7b, 0xa3, 0x72, 0x25, 0xff, 0xdd, 0xd4, 0xda, 0x6a,
+	0x06, 0xce, 0x02, 0xf9, 0x3d, 0x21, 0x92, 0x07, 0x31, 0x0d, 0x03, 0x67, 0x1e, 0xd8, 0x34, 0xbb,
+	0x93, 0x7d, 0x3d, 0xd3, 0x5b, 0xa1, 0x09, 0xce, 0x4b, 0x62, 0xe0, 0x5e, 0x78, 0xa1, 0x5f, 0xc8,
+	0xf9, 0xb2, 0xa8, 0x5a, 0x6f, 0x62, 0x9a, 0xe5, 0xcb, 0xbe, 0xa7, 0x69, 0x96, 0x2f, 0xe7, 0x09,
+	0x4d, 0xaf, 0x46, 0xad, 0x2f, 0x79, 0x73, 0xd8, 0x3a, 0xbe, 0x79, 0xd9, 0x55, 0x08, 0xb8, 0x41,
+	0xe7, 0x62, 0xc6, 0x79, 0xf8, 0xd2, 0x70, 0x4f, 0xde, 0xb3, 0x9a, 0x86, 0x7b, 0x72, 0xdf, 0xca,
+	0xd4, 0xe4, 0xec, 0x2d, 0x60, 0x3f, 0x8f, 0x09, 0xc5, 0xea, 0xe9, 0x8b, 0xa2, 0x6a, 0x3d, 0x62,
+	0x29, 0xad, 0x9b, 0xda, 0xa9, 0xe7, 0x2b, 0xcd, 0x5c, 0xf2, 0xde, 0xbc, 0x5c, 0xa2, 0x3e, 0x66,
+	0x3d, 0x22, 0x05, 0x7a, 0x38, 0x04, 0xdb, 0xfe, 0x8a, 0x98, 0x75, 0x9f, 0xb5, 0x34, 0x7c, 0x99,
+	0xfb, 0x40, 0xa6, 0xe1, 0xcb, 0x31, 0x6f, 0x61, 0x32, 0x49, 0xdf, 0x5a, 0x34, 0x9d, 0xac, 0x7f,
+	0xc0, 0x81, 0x8f, 0x27, 0xf2, 0x0b, 0x28, 0x7c, 0xf8, 0xa9, 0x19, 0xb9, 0x6a, 0x51, 0xad, 0xfd,
+	0x20, 0x8d, 0xe1, 0x97, 0xcc, 0xab, 0x34, 0x2e, 0x31, 0xab, 0xa7, 0x4f, 0x5a, 0x62, 0xd1, 0x10,
+	0xb3, 0x79, 0x3a, 0x26, 0x32, 0x73, 0xc8, 0x7d, 0xa1, 0xa6, 0x36, 0x9f, 0xae, 0xd5, 0xc3, 0x96,
+	0xd6, 0xb0, 0x23, 0xdd, 0x2c, 0x10, 0x39, 0xe9, 0x2d, 0x7a, 0x37, 0xc6, 0xd2, 0x5b, 0xf6, 0xd3,
+	0x32, 0x96, 0xde, 0x72, 0x9e, 0x97, 0x49, 0xeb, 0xad, 0xb8, 0x8d, 0x6d, 0xf4, 0xc4, 0x5c, 0xea,
+	0x42, 0xa4, 0xe1, 0xbd, 0xfc, 0x1b, 0xe4, 0xb5, 0x17, 0xae, 0xbe, 0x47, 0xe9, 0x8a, 0x43, 0x2d,
+	0x06, 0xd7, 0xf5, 0x85, 0xff, 0x9f, 0x14, 0xd3, 0xf6, 0x03, 0x86, 0xd2, 0x16, 0x18, 0xe9, 0x9e,
+	0x6e, 0xe4, 0xd6, 0xb9, 0x24, 0x24, 0xa7, 0xed, 0x6e, 0x90, 0x84, 0xdc, 0x17, 0xdc, 0x12, 0xd1,
+	0x9e, 0xf7, 0x70, 0x5d, 0x22, 0xda, 0x73, 0x9f, 0x7d, 0x73, 0xf7, 0xc2, 0xcc, 0x45, 0x65, 0xd6,
+	0xca, 0xc7, 0x62, 0x25, 0xb1, 0x91, 0xac, 0x57, 0xc0, 0x22, 0xf9, 0x62, 0xce, 0xdb, 0x60, 0xce,
+	0xae, 0x5f, 0x1f, 0xfb, 0x78, 0x98, 0xab, 0xbc, 0x4c, 0x97, 0x36, 0x05, 0x7c, 0x51, 0xcc, 0x59,
+	0xb7, 0x9c, 0xf1, 0x15, 0x26, 0xc3, 0x86, 0xd9, 0xf7, 0x34, 0x6a, 0x79, 0xb1, 0x42, 0x6f, 0x95,
+	0x3a, 0x59, 0xf0, 0x9c, 0xc5, 0x43, 0x16, 0xdc, 0x12, 0x55, 0xfb, 0x06, 0xf5, 0x15, 0xed, 0xae,
+	0x5a, 0x55, 0xf6, 0x73, 0x10, 0x30, 0xc0, 0xdf, 0xc0, 0x27, 0xbc, 0xed, 0xfb, 0xc8, 0x4e, 0xde,
+	0x7a, 0xaa, 0x9d, 0x35, 0xbb, 0xce, 0x6e, 0xc8, 0xf3, 0x69, 0x90, 0x7b, 0xb7, 0x7e, 0xcc, 0x59,
+	0x89, 0x0f, 0x1c, 0x3f, 0xf1, 0xed, 0xf4, 0x73, 0xde, 0x4f, 0xd2, 0x08, 0xf6, 0x9b, 0x23, 0x4f,
+	0x60, 0x70, 0x7f, 0x50, 0x10, 0xb3, 0x6e, 0xe2, 0x8d, 0x21, 0x91, 0xdc, 0x14, 0x1f, 0x43, 0x22,
+	0x63, 0xb2, 0x75, 0xbe, 0x48, 0xa3, 0x3c, 0xbe, 0xe5, 0x3b, 0xa3, 0xe4, 0x37, 0x05, 0x3f, 0xdc,
+	0x68, 0xe5, 0x5b, 0xea, 0x01, 0x7e, 0x9d, 0x0d, 0x26, 0x2d, 0xdd, 0x95, 0xde, 0x5e, 0xfb, 0xf5,
+	0xf9, 0xd7, 0x0a, 0x30, 0xcf, 0x2f, 0xab, 0x17, 0xc6, 0xf9, 0x5b, 0xa2, 0x92, 0x67, 0xfd, 0xde,
+	0x7b, 0x85, 0xe6, 0xf4, 0x82, 0x77, 0xdd, 0x99, 0x53, 0xda, 0x2a, 0xd8, 0x50, 0xa3, 0xe3, 0x87,
+	0xe3, 0x13, 0xb5, 0x96, 0x79, 0x4c, 0x7e, 0xfc, 0x20, 0xbb, 0x6a, 0x90, 0x8c, 0xee, 0x90, 0xf2,
+	0x33, 0x36, 0xe3, 0xdd, 0xa2, 0xb1, 0xbe, 0xe2, 0xbd, 0x38, 0x76, 0xac, 0xeb, 0x94, 0xef, 0x80,
+	0x23, 0x3e, 0x14, 0x22, 0xc9, 0xdc, 0x94, 0xa9, 0xcc, 0x41, 0xc3, 0x94, 0xd9, 0xe4, 0x4e, 0x97,
+	0x5f, 0x74, 0x82, 0x21, 0xb6, 0xf8, 0x25, 0x25, 0xce, 0x76, 0x75, 0xce, 0xa1, 0x6d, 0x1a, 0xb9,
+	0x29, 0x96, 0x8e, 0x69, 0x94, 0x6e, 0xdf, 0x11, 0x66, 0x26, 0x81, 0xf1, 0x81, 0x98, 0xd9, 0xeb,
+	0xf7, 0x1f, 0x8d, 0x06, 0x26, 0xe9, 0xdc, 0xcd, 0x6c, 0xc3, 0x44, 0xd0, 0x5a, 0x6a, 0x16, 0xde,
+	0x4b, 0xd4, 0x54, 0x4d, 0xae, 0x59, 0x4d, 0xad, 0x7f, 0x90, 0x64, 0x86, 0x3e, 0x91, 0x81, 0x58,
+	0x30, 0x72, 0xcb, 0x0c, 0xbc, 0xe6, 0x36, 0xe3, 0x48, 0xab, 0x74, 0x17, 0x8e, 0x88, 0xd2, 0xa3,
+	0x75, 0x44, 0xd4, 0xa1, 0x98, 0xde, 0x0e, 0xd1, 0xfd, 0xc5, 0xf9, 0x3c, 0x8b, 0xc9, 0xc0, 0x4d,
+	0x22, 0x50, 0x6d, 0xc6, 0x01, 0xba, 0x7a, 0x63, 0x10, 0x5c, 0x0e, 0xc3, 0xaf, 0x82, 0xbe, 0x56,
+	0x99, 0x42, 0x4f, 0xb4, 0xde, 0xd0, 0x99, 0x79, 0x8e, 0xde, 0x48, 0xa5, 0xf2, 0x39, 0x7a, 0x23,
+	0x93, 0xca, 0xe7, 0x2c, 0xb5, 0xce, 0x0c, 0x84, 0xa3, 0xcf, 0x42, 0x26, 0xfb, 0xcf, 0x88, 0xf1,
+	0x71, 0x39, 0x83, 0xb5, 0x97, 0xc6, 0x23, 0xb8, 0xbd, 0xdd, 0x72, 0x7b, 0x3b, 0x12, 0x33, 0xdb,
+	0xa1, 0x5a, 0x2c, 0x75, 0xb3, 0x2d, 0xf5, 0x4a, 0xa7, 0x7d, 0x6f, 0x2e, 0x2d, 0xc0, 0xa9, 0xce,
+	0x35, 0x3f, 0xe8, 0x5a, 0x19, 0x90, 0x62, 0x15, 0x34, 0xbe, 0xbe, 0xca, 0x66, 0x0c, 0xe0, 0xd4,
+	0xdd, 0xb6, 0x5a, 0xce, 0x4d, 0x38, 0x97, 0x66, 0xa8, 0xb5, 0x75, 0x8c, 0x55, 0x29, 0xe1, 0xd4,
+	0x68, 0xb7, 0x9e, 0xc8, 0x1f, 0xa7, 0xc6, 0xcd, 0x5d, 0xda, 0x15, 0xeb, 0x06, 0x94, 0xdd, 0xf8,
+	0x5c, 0x0a, 0x9e, 0xd7, 0x32, 0x5e, 0x1c, 0xb1, 0x0c, 0xb1, 0x9e, 0xa8, 0x5a, 0x57, 0xc0, 0x0d,
+	0x03, 0x65, 0xaf, 0xca, 0x1b, 0x06, 0xca, 0xb9, 0x31, 0xee, 0xbd, 0x46, 0xfd, 0x78, 0xf2, 0xa5,
+	0xa4, 0x1f, 0x75, 0x4b, 0x3c, 0xe9, 0x69, 0xfd, 0x83, 0xa0, 0x1b, 0x3f, 0x81, 0xb3, 0x0c, 0x3e,
+	0xc5, 0x67, 0x5f, 0xd7, 0x4b, 0x2c, 0xfa, 0xf4, 0xcd, 0x3e, 0xb3, 0x58, 0x56, 0x95, 0x6b, 0xe5,
+	0xab, 0xae, 0xc8, 0x92, 0xfa, 0x94, 0x10, 0x78, 0xe1, 0x6c, 0x3b, 0xc0, 0xbf, 0xb4, 0x94, 0xc8,
+	0xda, 0xe4, 0x4a, 0x5a, 0x22, 0xbf, 0xac, 0x7b, 0x69, 0x30, 0x9e, 0xe5, 0xb4, 0x15, 0xa1, 0x68,
+	0x42, 0x13, 0xd7, 0xd8, 0x5b, 0x6b, 0x66, 0x41, 0x72, 0x6e, 0xae, 0x01, 0x0f, 0x6e, 0x08, 0x91,
+	0xa4, 0x7f, 0x9a, 0x03, 0x4d, 0x26, 0xb3, 0xd4, 0x88, 0xbd, 0x9c, 0x5c, 0xd1, 0x77, 0x85, 0x48,
+	0x72, 0x2c, 0x4d, 0x13, 0x99, 0xe4, 0x50, 0xd3, 0x44, 0x36, 0x21, 0xd3, 0x35, 0x3a, 0x5b, 0x27,
+	0x11, 0xb5, 0xb4, 0x27, 0x66, 0xdd, 0x14, 0x4a, 0xa3, 0x82, 0x73, 0x33, 0x2b, 0xcd, 0x44, 0x73,
+	0xb2, 0x15, 0x49, 0xd8, 0x4c, 0x25, 0x69, 0x6a, 0xab, 0x49, 0x6a, 0x86, 0x13, 0x6e, 0x37, 0x76,
+	0x46, 0x26, 0x79, 0xcc, 0x9b, 0xa7, 0x21, 0x0a, 0x39, 0x89, 0x43, 0xa4, 0x8c, 0xb0, 0xb6, 0x58,
+	0x54, 0xcb, 0x68, 0x8c, 0x26, 0x75, 0xd3, 0x40, 0x0b, 0xac, 0x6c, 0x02, 0x97, 0x91, 0x39, 0xb9,
+	0x59, 0x41, 0x8e, 0x67, 0x07, 0x79, 0x4a, 0x5d, 0x83, 0x40, 0x05, 0xd2, 0x14, 0xd3, 0x76, 0xd6,
+	0x89, 0xe9, 0x23, 0x27, 0x8b, 0xc8, 0xf4, 0x91, 0x97, 0xa6, 0xa2, 0x8f, 0x87, 0x52, 0xea, 0x59,
+	0xac, 0x9b, 0x84, 0x14, 0x50, 0xb3, 0x0b, 0x99, 0xb4, 0x08, 0x23, 0xdd, 0xc6, 0x65, 0xaa, 0x18,
+	0xe9, 0x36, 0x36, 0xa3, 0xc2, 0x5b, 0xa6, 0x3e, 0xe7, 0x3c, 0x41, 0x47, 0xd2, 0x8b, 0x76, 0xdc,
+	0x3c, 0xc7, 0x39, 0x3d, 0x12, 0xf3, 0xe9, 0xe8, 0xb8, 0x7c, 0xc1, 0x75, 0x6f, 0xa6, 0xb3, 0x21,
+	0x6a, 0x2f, 0x8e, 0xad, 0xcf, 0x3b, 0x5c, 0x07, 0x06, 0x4b, 0xfe, 0x94, 0x98, 0x73, 0xa2, 0x91,
+	0xfd, 0xa1, 0xfc, 0xc8, 0x33, 0x04, 0x2b, 0x6b, 0xde, 0x95, 0x48, 0x34, 0x28, 0x32, 0x51, 0xf6,
+	0xac, 0x53, 0x5d, 0x12, 0xd7, 0x93, 0xfa, 0xea, 0xcb, 0xf8, 0x98, 0x9f, 0x39, 0xda, 0x99, 0x1a,
+	0xb2, 0xca, 0xa6, 0xed, 0xd0, 0x85, 0xd9, 0xee, 0x9c, 0x28, 0x8a, 0xd9, 0xee, 0xbc, 0x58, 0x87,
+	0x6b, 0x91, 0xe8, 0x28, 0x87, 0x3a, 0x44, 0xcf, 0xa5, 0xc2, 0x19, 0xe6, 0x40, 0x97, 0x1f, 0x00,
+	0x31, 0x07, 0xba, 0x31, 0x51, 0x10, 0xd7, 0xe9, 0xa4, 0xbb, 0x5a, 0xc7, 0xdb, 0x41, 0x17, 0x62,
+	0x3e, 0x1d, 0xbe, 0x30, 0x1b, 0x3d, 0x26, 0x28, 0x52, 0x7b, 0x71, 0x6c, 0x3d, 0x77, 0xe7, 0x51,
+	0x77, 0x37, 0x6f, 0xd5, 0x9c, 0xee, 0x3e, 0xb0, 0xc2, 0x26, 0x4f, 0x80, 0x6b, 0x66, 0x9c, 0xa0,
+	0x81, 0xf1, 0x77, 0xe4, 0x05, 0x27, 0x8c, 0xbf, 0x23, 0x37, 0xce, 0xe0, 0xae, 0xa4, 0x76, 0xfc,
+	0xe3, 0x4a, 0xb2, 0xc9, 0xa1, 0xe3, 0x07, 0x8e, 0xc9, 0x91, 0x8a, 0x35, 0x38, 0x26, 0x47, 0x3a,
+	0xe0, 0xe0, 0x9a, 0x1c, 0xba, 0x07, 0x79, 0x22, 0xaa, 0x56, 0x60, 0xc0, 0x68, 0xa1, 0x6c, 0xb8,
+	0xc1, 0x88, 0xbf, 0x9c, 0x38, 0x82, 0xeb, 0xad, 0xd1, 0x6d, 0xaf, 0xe3, 0x39, 0x03, 0xa7, 0x10,
+	0xf3, 0x5f, 0xb5, 0x71, 0x1c, 0xf1, 0x2f, 0xda, 0x4e, 0xc0, 0x9c, 0xa8, 0x82, 0x61, 0xfc, 0xf1,
+	0xe1, 0x01, 0xa7, 0xd7, 0x26, 0xa1, 0x58, 0x3e, 0xa2, 0x27, 0x62, 0x75, 0x4c, 0xf0, 0x40, 0x7e,
+	0x34, 0xcd, 0x36, 0xb9, 0xc1, 0x85, 0x9a, 0xbe, 0x99, 0xeb, 0xd4, 0x7a, 0x1f, 0xa1, 0x5e, 0x9f,
+	0x97, 0x37, 0x32, 0xbd, 0xda, 0xc6, 0xe7, 0xe6, 0xc7, 0xbe, 0xf8, 0xd1, 0xb3, 0x76, 0x7c, 0x3e,
+	0x3a, 0xb9, 0xdd, 0xec, 0x77, 0xd7, 0x3b, 0xda, 0xc5, 0xcc, 0x97, 0xd6, 0xd7, 0x3b, 0xbd, 0xd6,
+	0x3a, 0xb5, 0x7e, 0x72, 0x8d, 0xfe, 0xa6, 0xe4, 0x27, 0xff, 0x17, 0x1a, 0xe4, 0x28, 0xb6, 0x85,
+	0x72, 0x00, 0x00,
 }
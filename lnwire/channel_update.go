@@ -2,6 +2,7 @@ package lnwire
 
 import (
 	"bytes"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
 
@@ -181,3 +182,30 @@ func (a *ChannelUpdate) DataToSign() ([]byte, error) {
 
 	return w.Bytes(), nil
 }
+
+// crc32cTable is the table used to compute the Castagnoli CRC32 checksum of
+// a ChannelUpdate.
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Checksum returns the CRC32C checksum of the ChannelUpdate, which covers all
+// of its fields except for the signature and timestamp. Two updates with the
+// same checksum thus carry the same channel policy, allowing nodes to detect
+// keep-alive updates without fetching them.
+func (a *ChannelUpdate) Checksum() (uint32, error) {
+	var w bytes.Buffer
+	err := writeElements(&w,
+		a.ChainHash[:],
+		a.ShortChannelID,
+		a.Flags,
+		a.TimeLockDelta,
+		a.HtlcMinimumMsat,
+		a.BaseFee,
+		a.FeeRate,
+		a.ExtraOpaqueData,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return crc32.Checksum(w.Bytes(), crc32cTable), nil
+}
@@ -0,0 +1,100 @@
+package lnwire
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	// CustomTypeStart is the first message type of the custom range.
+	// Message types within this range aren't defined by the protocol, and
+	// can be used by applications to build their own protocols on top of
+	// existing peer connections.
+	CustomTypeStart MessageType = 32768
+
+	// MaxCustomPayload is the maximum size of the data carried by a
+	// custom message, which leaves room for the message type within a
+	// single transport message.
+	MaxCustomPayload = MaxMessagePayload - 2
+)
+
+// IsCustomType returns true if the given message type is within the custom
+// range.
+func IsCustomType(msgType MessageType) bool {
+	return msgType >= CustomTypeStart
+}
+
+// Custom is a message of a type within the custom range. As its contents
+// aren't defined by the protocol, the data it carries is left uninterpreted.
+type Custom struct {
+	// Type is the type of the message, which must be within the custom
+	// range.
+	Type MessageType
+
+	// Data is the opaque payload of the message.
+	Data []byte
+}
+
+// NewCustom returns a new Custom message of the given type, carrying the given
+// data.
+func NewCustom(msgType MessageType, data []byte) (*Custom, error) {
+	if !IsCustomType(msgType) {
+		return nil, fmt.Errorf("message type %d is not within the "+
+			"custom range, which starts at %d", msgType,
+			CustomTypeStart)
+	}
+	if len(data) > MaxCustomPayload {
+		return nil, fmt.Errorf("custom message data is %d bytes, "+
+			"maximum is %d bytes", len(data), MaxCustomPayload)
+	}
+
+	return &Custom{
+		Type: msgType,
+		Data: data,
+	}, nil
+}
+
+// A compile time check to ensure Custom implements the lnwire.Message
+// interface.
+var _ Message = (*Custom)(nil)
+
+// Decode deserializes a serialized Custom message stored in the passed
+// io.Reader observing the specified protocol version. As the message isn't
+// length prefixed, the remainder of the reader is consumed.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Decode(r io.Reader, pver uint32) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	c.Data = data
+	return nil
+}
+
+// Encode serializes the target Custom message into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Encode(w io.Writer, pver uint32) error {
+	_, err := w.Write(c.Data)
+	return err
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MsgType() MessageType {
+	return c.Type
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for a Custom
+// complete message observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MaxPayloadLength(uint32) uint32 {
+	return MaxCustomPayload
+}
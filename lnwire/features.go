@@ -46,6 +46,16 @@ const (
 	// efficient network view reconciliation.
 	GossipQueriesOptional FeatureBit = 7
 
+	// GossipQueriesExRequired is a feature bit that indicates that the
+	// receiving peer MUST know of the extended gossip queries, which allow
+	// nodes to request the timestamps and checksums of the latest channel
+	// updates of each channel within a channel range query.
+	GossipQueriesExRequired FeatureBit = 10
+
+	// GossipQueriesExOptional is an optional feature bit that signals that
+	// the setting peer knows of the extended gossip queries.
+	GossipQueriesExOptional FeatureBit = 11
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -72,6 +82,8 @@ var LocalFeatures = map[FeatureBit]string{
 	InitialRoutingSync:      "initial-routing-sync",
 	GossipQueriesRequired:   "gossip-queries-required",
 	GossipQueriesOptional:   "gossip-queries-optional",
+	GossipQueriesExRequired: "gossip-queries-ex-required",
+	GossipQueriesExOptional: "gossip-queries-ex-optional",
 }
 
 // GlobalFeatures is a mapping of known global feature bits to a descriptive
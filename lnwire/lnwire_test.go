@@ -183,13 +183,52 @@ func TestMaxOutPointIndex(t *testing.T) {
 func TestEmptyMessageUnknownType(t *testing.T) {
 	t.Parallel()
 
-	fakeType := MessageType(math.MaxUint16)
+	fakeType := CustomTypeStart - 1
 	if _, err := makeEmptyMessage(fakeType); err == nil {
 		t.Fatalf("should not be able to make an empty message of an " +
 			"unknown type")
 	}
 }
 
+// TestCustomMessage tests that messages of types within the custom range can
+// be written and read back, and that custom messages can't be created for
+// types outside of it.
+func TestCustomMessage(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewCustom(CustomTypeStart-1, nil); err == nil {
+		t.Fatalf("expected creating custom message of type %v to fail",
+			CustomTypeStart-1)
+	}
+	tooLarge := make([]byte, MaxCustomPayload+1)
+	if _, err := NewCustom(CustomTypeStart, tooLarge); err == nil {
+		t.Fatalf("expected creating oversized custom message to fail")
+	}
+
+	for _, msgType := range []MessageType{
+		CustomTypeStart, CustomTypeStart + 1, math.MaxUint16,
+	} {
+		msg, err := NewCustom(msgType, []byte{1, 2, 3})
+		if err != nil {
+			t.Fatalf("unable to create custom message: %v", err)
+		}
+
+		var b bytes.Buffer
+		if _, err := WriteMessage(&b, msg, 0); err != nil {
+			t.Fatalf("unable to write custom message: %v", err)
+		}
+		newMsg, err := ReadMessage(&b, 0)
+		if err != nil {
+			t.Fatalf("unable to read custom message: %v", err)
+		}
+
+		if !reflect.DeepEqual(msg, newMsg) {
+			t.Fatalf("custom messages don't match: expected %v, "+
+				"got %v", spew.Sdump(msg), spew.Sdump(newMsg))
+		}
+	}
+}
+
 // TestLightningWireProtocol uses the testing/quick package to create a series
 // of fuzz tests to attempt to break a primary scenario which is implemented as
 // property based testing scenario.
@@ -741,10 +780,34 @@ func TestLightningWireProtocol(t *testing.T) {
 				req.EncodingType = EncodingSortedPlain
 			}
 
+			// With a 50/50 chance, we'll also include the
+			// timestamps and checksums of each channel. As these
+			// take up additional space, we'll include fewer
+			// channels in that case.
+			withExtensions := r.Int31()%2 == 0
+
 			numChanIDs := rand.Int31n(5000)
+			if withExtensions {
+				numChanIDs = rand.Int31n(2000)
+			}
 			for i := int32(0); i < numChanIDs; i++ {
 				req.ShortChanIDs = append(req.ShortChanIDs,
 					NewShortChanIDFromInt(uint64(r.Int63())))
+
+				if !withExtensions {
+					continue
+				}
+
+				req.Timestamps = append(req.Timestamps,
+					ChanUpdateTimestamps{
+						Timestamp1: r.Uint32(),
+						Timestamp2: r.Uint32(),
+					})
+				req.Checksums = append(req.Checksums,
+					ChanUpdateChecksums{
+						Checksum1: r.Uint32(),
+						Checksum2: r.Uint32(),
+					})
 			}
 
 			v[0] = reflect.ValueOf(req)
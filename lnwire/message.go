@@ -116,6 +116,9 @@ func (t MessageType) String() string {
 	case MsgGossipTimestampRange:
 		return "GossipTimestampRange"
 	default:
+		if IsCustomType(t) {
+			return fmt.Sprintf("Custom(%d)", uint16(t))
+		}
 		return "<unknown>"
 	}
 }
@@ -217,6 +220,13 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 	case MsgGossipTimestampRange:
 		msg = &GossipTimestampRange{}
 	default:
+		// Messages of types within the custom range are decoded as
+		// opaque custom messages, allowing applications to handle
+		// them.
+		if IsCustomType(msgType) {
+			return &Custom{Type: msgType}, nil
+		}
+
 		return nil, &UnknownMessage{msgType}
 	}
 
@@ -1,6 +1,7 @@
 package lnwire
 
 import (
+	"bytes"
 	"io"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -24,6 +25,11 @@ type QueryChannelRange struct {
 	// NumBlocks is the number of blocks beyond the first block that short
 	// channel ID's should be sent for.
 	NumBlocks uint32
+
+	// QueryOptions is an optional bitfield requesting additional
+	// information about each channel within the replies. This must only
+	// be set if the receiver signals the GossipQueriesEx feature bit.
+	QueryOptions QueryOption
 }
 
 // NewQueryChannelRange creates a new empty QueryChannelRange message.
@@ -40,11 +46,30 @@ var _ Message = (*QueryChannelRange)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) Decode(r io.Reader, pver uint32) error {
-	return readElements(r,
+	err := readElements(r,
 		q.ChainHash[:],
 		&q.FirstBlockHeight,
 		&q.NumBlocks,
 	)
+	if err != nil {
+		return err
+	}
+
+	// Any remaining bytes make up a TLV stream, which may contain the
+	// query options.
+	records, err := readTLVStream(r, queryOptionType)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		queryOptions, err := readBigSize(bytes.NewReader(record.value))
+		if err != nil {
+			return err
+		}
+		q.QueryOptions = QueryOption(queryOptions)
+	}
+
+	return nil
 }
 
 // Encode serializes the target QueryChannelRange into the passed io.Writer
@@ -52,11 +77,28 @@ func (q *QueryChannelRange) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) Encode(w io.Writer, pver uint32) error {
-	return writeElements(w,
+	err := writeElements(w,
 		q.ChainHash[:],
 		q.FirstBlockHeight,
 		q.NumBlocks,
 	)
+	if err != nil {
+		return err
+	}
+
+	// We'll only include the query options if any have been set, in
+	// order to remain compatible with peers that don't understand them.
+	if q.QueryOptions == 0 {
+		return nil
+	}
+
+	var queryOptions bytes.Buffer
+	err = writeBigSize(&queryOptions, uint64(q.QueryOptions))
+	if err != nil {
+		return err
+	}
+
+	return writeTLVRecord(w, queryOptionType, queryOptions.Bytes())
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -72,6 +114,6 @@ func (q *QueryChannelRange) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) MaxPayloadLength(uint32) uint32 {
-	// 32 + 4 + 4
-	return 40
+	// 32 + 4 + 4 + (1 + 1 + 9)
+	return 51
 }
@@ -0,0 +1,316 @@
+package lnwire
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// QueryOption is a bitfield that allows the sender of a QueryChannelRange
+// message to request additional information about each channel within the
+// replies. These options are only understood by peers that signal the
+// GossipQueriesEx feature bit.
+type QueryOption uint64
+
+const (
+	// QueryOptionTimestamps requests the timestamps of the latest
+	// ChannelUpdates of both directions of each channel.
+	QueryOptionTimestamps QueryOption = 1 << 0
+
+	// QueryOptionChecksums requests the checksums of the latest
+	// ChannelUpdates of both directions of each channel.
+	QueryOptionChecksums QueryOption = 1 << 1
+)
+
+// Has returns whether the given option is set.
+func (q QueryOption) Has(option QueryOption) bool {
+	return q&option == option
+}
+
+const (
+	// queryOptionType is the TLV type of the query options within a
+	// QueryChannelRange message.
+	queryOptionType = 1
+
+	// timestampsType is the TLV type of the ChannelUpdate timestamps
+	// within a ReplyChannelRange message.
+	timestampsType = 1
+
+	// checksumsType is the TLV type of the ChannelUpdate checksums within
+	// a ReplyChannelRange message.
+	checksumsType = 3
+)
+
+// ChanUpdateTimestamps houses the timestamps of the latest ChannelUpdates of
+// both directions of a channel. A zero timestamp denotes that the sender
+// doesn't know of any ChannelUpdate for the direction.
+type ChanUpdateTimestamps struct {
+	// Timestamp1 is the timestamp of the ChannelUpdate of the first node.
+	Timestamp1 uint32
+
+	// Timestamp2 is the timestamp of the ChannelUpdate of the second
+	// node.
+	Timestamp2 uint32
+}
+
+// ChanUpdateChecksums houses the checksums of the latest ChannelUpdates of
+// both directions of a channel, as computed by ChannelUpdate.Checksum. A zero
+// checksum denotes that the sender doesn't know of any ChannelUpdate for the
+// direction.
+type ChanUpdateChecksums struct {
+	// Checksum1 is the checksum of the ChannelUpdate of the first node.
+	Checksum1 uint32
+
+	// Checksum2 is the checksum of the ChannelUpdate of the second node.
+	Checksum2 uint32
+}
+
+// tlvRecord is a single type-length-value record of a TLV stream.
+type tlvRecord struct {
+	recordType uint64
+	value      []byte
+}
+
+// readBigSize reads a BigSize encoded integer from the passed io.Reader. Each
+// integer must be minimally encoded.
+func readBigSize(r io.Reader) (uint64, error) {
+	var discriminant [1]byte
+	if _, err := io.ReadFull(r, discriminant[:]); err != nil {
+		return 0, err
+	}
+
+	var (
+		numBytes int
+		min      uint64
+	)
+	switch discriminant[0] {
+	case 0xfd:
+		numBytes, min = 2, 0xfd
+	case 0xfe:
+		numBytes, min = 4, 0x10000
+	case 0xff:
+		numBytes, min = 8, 0x100000000
+	default:
+		return uint64(discriminant[0]), nil
+	}
+
+	var b [8]byte
+	if _, err := io.ReadFull(r, b[8-numBytes:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+
+	v := binary.BigEndian.Uint64(b[:])
+	if v < min {
+		return 0, fmt.Errorf("bigsize %d is not minimally encoded", v)
+	}
+
+	return v, nil
+}
+
+// writeBigSize writes the given integer to the passed io.Writer using the
+// minimal BigSize encoding.
+func writeBigSize(w io.Writer, v uint64) error {
+	var b [9]byte
+	switch {
+	case v < 0xfd:
+		b[0] = uint8(v)
+		_, err := w.Write(b[:1])
+		return err
+
+	case v <= 0xffff:
+		b[0] = 0xfd
+		binary.BigEndian.PutUint16(b[1:3], uint16(v))
+		_, err := w.Write(b[:3])
+		return err
+
+	case v <= 0xffffffff:
+		b[0] = 0xfe
+		binary.BigEndian.PutUint32(b[1:5], uint32(v))
+		_, err := w.Write(b[:5])
+		return err
+
+	default:
+		b[0] = 0xff
+		binary.BigEndian.PutUint64(b[1:9], v)
+		_, err := w.Write(b[:9])
+		return err
+	}
+}
+
+// writeTLVRecord writes a single TLV record to the passed io.Writer.
+func writeTLVRecord(w io.Writer, recordType uint64, value []byte) error {
+	if err := writeBigSize(w, recordType); err != nil {
+		return err
+	}
+	if err := writeBigSize(w, uint64(len(value))); err != nil {
+		return err
+	}
+
+	_, err := w.Write(value)
+	return err
+}
+
+// readTLVStream reads the remainder of the passed io.Reader as a TLV stream.
+// The records must be sorted by strictly increasing type. Records with an
+// unknown odd type are skipped, while records with an unknown even type result
+// in an error, as they must be understood by the receiver.
+func readTLVStream(r io.Reader, knownTypes ...uint64) ([]tlvRecord, error) {
+	stream, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[uint64]struct{}, len(knownTypes))
+	for _, t := range knownTypes {
+		known[t] = struct{}{}
+	}
+
+	var (
+		records  []tlvRecord
+		lastType uint64
+		reader   = bytes.NewReader(stream)
+	)
+	for i := 0; reader.Len() > 0; i++ {
+		recordType, err := readBigSize(reader)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && recordType <= lastType {
+			return nil, fmt.Errorf("tlv type %d isn't greater "+
+				"than last type %d", recordType, lastType)
+		}
+		lastType = recordType
+
+		length, err := readBigSize(reader)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(reader.Len()) {
+			return nil, fmt.Errorf("tlv record of type %d with "+
+				"length %d exceeds stream", recordType, length)
+		}
+
+		value := make([]byte, length)
+		if _, err := io.ReadFull(reader, value); err != nil {
+			return nil, err
+		}
+
+		if _, ok := known[recordType]; !ok {
+			if recordType%2 == 0 {
+				return nil, fmt.Errorf("unknown required tlv "+
+					"type %d", recordType)
+			}
+			continue
+		}
+
+		records = append(records, tlvRecord{
+			recordType: recordType,
+			value:      value,
+		})
+	}
+
+	return records, nil
+}
+
+// encodeChanUpdateTimestamps encodes the given set of timestamps using the
+// passed encoding type. The encoding type is prepended to the result.
+func encodeChanUpdateTimestamps(encodingType ShortChanIDEncoding,
+	timestamps []ChanUpdateTimestamps) ([]byte, error) {
+
+	var plain bytes.Buffer
+	for _, timestamp := range timestamps {
+		err := writeElements(&plain, timestamp.Timestamp1,
+			timestamp.Timestamp2)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := writeElements(&buf, encodingType); err != nil {
+		return nil, err
+	}
+
+	switch encodingType {
+	case EncodingSortedPlain:
+		if _, err := buf.Write(plain.Bytes()); err != nil {
+			return nil, err
+		}
+
+	case EncodingSortedZlib:
+		zlibWriter := zlib.NewWriter(&buf)
+		if _, err := zlibWriter.Write(plain.Bytes()); err != nil {
+			return nil, err
+		}
+		if err := zlibWriter.Close(); err != nil {
+			return nil, fmt.Errorf("unable to finalize "+
+				"compression: %v", err)
+		}
+
+	default:
+		return nil, ErrUnknownShortChanIDEncoding(encodingType)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decodeChanUpdateTimestamps decodes the expected number of timestamps from
+// the given value, which is prefixed by its encoding type.
+func decodeChanUpdateTimestamps(value []byte,
+	numTimestamps int) ([]ChanUpdateTimestamps, error) {
+
+	if len(value) == 0 {
+		return nil, fmt.Errorf("no timestamp encoding type specified")
+	}
+
+	encodingType := ShortChanIDEncoding(value[0])
+	body := value[1:]
+
+	var r io.Reader
+	switch encodingType {
+	case EncodingSortedPlain:
+		if len(body) != numTimestamps*8 {
+			return nil, fmt.Errorf("expected %d timestamps, got "+
+				"%d bytes", numTimestamps, len(body))
+		}
+		r = bytes.NewReader(body)
+
+	case EncodingSortedZlib:
+		// As with the short channel ID's, we'll only decode a single
+		// zlib payload at a time, and bound the amount of data we'll
+		// decompress.
+		zlibDecodeMtx.Lock()
+		defer zlibDecodeMtx.Unlock()
+
+		limitedDecompressor, err := zlib.NewReader(&io.LimitedReader{
+			R: bytes.NewReader(body),
+			N: maxZlibBufSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create zlib "+
+				"reader: %v", err)
+		}
+		r = limitedDecompressor
+
+	default:
+		return nil, ErrUnknownShortChanIDEncoding(encodingType)
+	}
+
+	timestamps := make([]ChanUpdateTimestamps, numTimestamps)
+	for i := range timestamps {
+		err := readElements(r, &timestamps[i].Timestamp1,
+			&timestamps[i].Timestamp2)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read timestamps "+
+				"#%d: %v", i, err)
+		}
+	}
+
+	return timestamps, nil
+}
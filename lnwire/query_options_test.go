@@ -0,0 +1,153 @@
+package lnwire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestBigSizeEncoding ensures that integers are minimally encoded using the
+// BigSize encoding, and that non-minimal encodings are rejected.
+func TestBigSizeEncoding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   uint64
+		encoded []byte
+	}{
+		{0, []byte{0x00}},
+		{0xfc, []byte{0xfc}},
+		{0xfd, []byte{0xfd, 0x00, 0xfd}},
+		{0xffff, []byte{0xfd, 0xff, 0xff}},
+		{0x10000, []byte{0xfe, 0x00, 0x01, 0x00, 0x00}},
+		{0xffffffff, []byte{0xfe, 0xff, 0xff, 0xff, 0xff}},
+		{
+			0x100000000,
+			[]byte{0xff, 0, 0, 0, 0x01, 0, 0, 0, 0},
+		},
+	}
+
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := writeBigSize(&b, test.value); err != nil {
+			t.Fatalf("unable to encode %d: %v", test.value, err)
+		}
+		if !bytes.Equal(b.Bytes(), test.encoded) {
+			t.Fatalf("expected %x for %d, got %x", test.encoded,
+				test.value, b.Bytes())
+		}
+
+		value, err := readBigSize(bytes.NewReader(test.encoded))
+		if err != nil {
+			t.Fatalf("unable to decode %x: %v", test.encoded, err)
+		}
+		if value != test.value {
+			t.Fatalf("expected %d, got %d", test.value, value)
+		}
+	}
+
+	// A value that fits within a single byte mustn't be encoded using
+	// more bytes.
+	_, err := readBigSize(bytes.NewReader([]byte{0xfd, 0x00, 0xfc}))
+	if err == nil {
+		t.Fatalf("expected non-minimal encoding to be rejected")
+	}
+}
+
+// TestQueryChannelRangeUnknownRecords ensures that unknown odd TLV records
+// within a QueryChannelRange are ignored, while unknown even records are
+// rejected.
+func TestQueryChannelRangeUnknownRecords(t *testing.T) {
+	t.Parallel()
+
+	query := QueryChannelRange{
+		FirstBlockHeight: 100,
+		NumBlocks:        200,
+		QueryOptions:     QueryOptionTimestamps | QueryOptionChecksums,
+	}
+
+	var b bytes.Buffer
+	if err := query.Encode(&b, 0); err != nil {
+		t.Fatalf("unable to encode query: %v", err)
+	}
+	encoded := b.Bytes()
+
+	// Appending an unknown odd record should still allow us to decode
+	// the query.
+	var withOdd bytes.Buffer
+	withOdd.Write(encoded)
+	if err := writeTLVRecord(&withOdd, 5, []byte{0x01}); err != nil {
+		t.Fatalf("unable to write record: %v", err)
+	}
+
+	var decoded QueryChannelRange
+	if err := decoded.Decode(&withOdd, 0); err != nil {
+		t.Fatalf("unable to decode query: %v", err)
+	}
+	if !reflect.DeepEqual(query, decoded) {
+		t.Fatalf("expected query %v, got %v", query, decoded)
+	}
+	if !decoded.QueryOptions.Has(QueryOptionChecksums) {
+		t.Fatalf("expected checksums to be requested")
+	}
+
+	// An unknown even record, however, should be rejected.
+	var withEven bytes.Buffer
+	withEven.Write(encoded)
+	if err := writeTLVRecord(&withEven, 4, []byte{0x01}); err != nil {
+		t.Fatalf("unable to write record: %v", err)
+	}
+	if err := decoded.Decode(&withEven, 0); err == nil {
+		t.Fatalf("expected unknown even record to be rejected")
+	}
+}
+
+// TestReplyChannelRangeSortsExtensions ensures that the timestamps and
+// checksums of a ReplyChannelRange are sorted along with the short channel
+// ID's they belong to.
+func TestReplyChannelRangeSortsExtensions(t *testing.T) {
+	t.Parallel()
+
+	for _, encoding := range []ShortChanIDEncoding{
+		EncodingSortedPlain, EncodingSortedZlib,
+	} {
+		reply := ReplyChannelRange{
+			EncodingType: encoding,
+			ShortChanIDs: []ShortChannelID{
+				NewShortChanIDFromInt(3),
+				NewShortChanIDFromInt(1),
+				NewShortChanIDFromInt(2),
+			},
+			Timestamps: []ChanUpdateTimestamps{
+				{Timestamp1: 3}, {Timestamp1: 1}, {Timestamp1: 2},
+			},
+			Checksums: []ChanUpdateChecksums{
+				{Checksum2: 3}, {Checksum2: 1}, {Checksum2: 2},
+			},
+		}
+
+		var b bytes.Buffer
+		if err := reply.Encode(&b, 0); err != nil {
+			t.Fatalf("unable to encode reply: %v", err)
+		}
+
+		var decoded ReplyChannelRange
+		if err := decoded.Decode(&b, 0); err != nil {
+			t.Fatalf("unable to decode reply: %v", err)
+		}
+
+		for i, chanID := range decoded.ShortChanIDs {
+			id := uint32(chanID.ToUint64())
+			if id != uint32(i+1) {
+				t.Fatalf("expected sorted short chan ids, "+
+					"got %v", decoded.ShortChanIDs)
+			}
+			if decoded.Timestamps[i].Timestamp1 != id {
+				t.Fatalf("timestamp for chan %d not sorted", id)
+			}
+			if decoded.Checksums[i].Checksum2 != id {
+				t.Fatalf("checksum for chan %d not sorted", id)
+			}
+		}
+	}
+}
@@ -1,13 +1,21 @@
 package lnwire
 
-import "io"
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+)
 
 // ReplyChannelRange is the response to the QueryChannelRange message. It
 // includes the original query, and the next streaming chunk of encoded short
 // channel ID's as the response. We'll also include a byte that indicates if
-// this is the last query in the message.
+// this is the last query in the message. If the query requested them, the
+// timestamps and checksums of the latest channel updates of each channel are
+// included as well.
 type ReplyChannelRange struct {
-	// QueryChannelRange is the corresponding query to this response.
+	// QueryChannelRange is the corresponding query to this response. Its
+	// query options aren't included within the response.
 	QueryChannelRange
 
 	// Complete denotes if this is the conclusion of the set of streaming
@@ -21,6 +29,16 @@ type ReplyChannelRange struct {
 
 	// ShortChanIDs is a slice of decoded short channel ID's.
 	ShortChanIDs []ShortChannelID
+
+	// Timestamps is an optional set of the timestamps of the latest
+	// ChannelUpdates of each channel, in the same order as the
+	// ShortChanIDs. It's encoded using the same EncodingType.
+	Timestamps []ChanUpdateTimestamps
+
+	// Checksums is an optional set of the checksums of the latest
+	// ChannelUpdates of each channel, in the same order as the
+	// ShortChanIDs.
+	Checksums []ChanUpdateChecksums
 }
 
 // NewReplyChannelRange creates a new empty ReplyChannelRange message.
@@ -37,18 +55,61 @@ var _ Message = (*ReplyChannelRange)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *ReplyChannelRange) Decode(r io.Reader, pver uint32) error {
-	err := c.QueryChannelRange.Decode(r, pver)
+	err := readElements(r,
+		c.ChainHash[:],
+		&c.FirstBlockHeight,
+		&c.NumBlocks,
+		&c.Complete,
+	)
 	if err != nil {
 		return err
 	}
 
-	if err := readElements(r, &c.Complete); err != nil {
+	c.EncodingType, c.ShortChanIDs, err = decodeShortChanIDs(r)
+	if err != nil {
 		return err
 	}
 
-	c.EncodingType, c.ShortChanIDs, err = decodeShortChanIDs(r)
+	// Any remaining bytes make up a TLV stream, which may contain the
+	// timestamps and checksums of each channel.
+	records, err := readTLVStream(r, timestampsType, checksumsType)
+	if err != nil {
+		return err
+	}
 
-	return err
+	numChans := len(c.ShortChanIDs)
+	for _, record := range records {
+		switch record.recordType {
+		case timestampsType:
+			c.Timestamps, err = decodeChanUpdateTimestamps(
+				record.value, numChans,
+			)
+			if err != nil {
+				return err
+			}
+
+		case checksumsType:
+			if len(record.value) != numChans*8 {
+				return fmt.Errorf("expected %d checksums, "+
+					"got %d bytes", numChans,
+					len(record.value))
+			}
+
+			c.Checksums = make([]ChanUpdateChecksums, numChans)
+			reader := bytes.NewReader(record.value)
+			for i := range c.Checksums {
+				err := readElements(reader,
+					&c.Checksums[i].Checksum1,
+					&c.Checksums[i].Checksum2,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
 }
 
 // Encode serializes the target ReplyChannelRange into the passed io.Writer
@@ -56,15 +117,106 @@ func (c *ReplyChannelRange) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *ReplyChannelRange) Encode(w io.Writer, pver uint32) error {
-	if err := c.QueryChannelRange.Encode(w, pver); err != nil {
+	numChans := len(c.ShortChanIDs)
+	if len(c.Timestamps) != 0 && len(c.Timestamps) != numChans {
+		return fmt.Errorf("expected %d timestamps, got %d", numChans,
+			len(c.Timestamps))
+	}
+	if len(c.Checksums) != 0 && len(c.Checksums) != numChans {
+		return fmt.Errorf("expected %d checksums, got %d", numChans,
+			len(c.Checksums))
+	}
+
+	err := writeElements(w,
+		c.ChainHash[:],
+		c.FirstBlockHeight,
+		c.NumBlocks,
+		c.Complete,
+	)
+	if err != nil {
 		return err
 	}
 
-	if err := writeElements(w, c.Complete); err != nil {
+	// The short channel ID's will be sorted when encoded, so we'll sort
+	// the timestamps and checksums along with them to ensure they remain
+	// in the same order.
+	sort.Sort(replyChannelRangeSorter{c})
+
+	err = encodeShortChanIDs(w, c.EncodingType, c.ShortChanIDs)
+	if err != nil {
 		return err
 	}
 
-	return encodeShortChanIDs(w, c.EncodingType, c.ShortChanIDs)
+	if len(c.Timestamps) != 0 {
+		timestamps, err := encodeChanUpdateTimestamps(
+			c.EncodingType, c.Timestamps,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = writeTLVRecord(w, timestampsType, timestamps)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(c.Checksums) != 0 {
+		var checksums bytes.Buffer
+		for _, checksum := range c.Checksums {
+			err := writeElements(&checksums, checksum.Checksum1,
+				checksum.Checksum2)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = writeTLVRecord(w, checksumsType, checksums.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replyChannelRangeSorter sorts the short channel ID's of a ReplyChannelRange
+// in ascending order, along with their timestamps and checksums.
+type replyChannelRangeSorter struct {
+	*ReplyChannelRange
+}
+
+// Len returns the number of short channel ID's.
+//
+// NOTE: This is part of the sort.Interface interface.
+func (s replyChannelRangeSorter) Len() int {
+	return len(s.ShortChanIDs)
+}
+
+// Less returns whether the short channel ID at index i is smaller than the one
+// at index j.
+//
+// NOTE: This is part of the sort.Interface interface.
+func (s replyChannelRangeSorter) Less(i, j int) bool {
+	return s.ShortChanIDs[i].ToUint64() < s.ShortChanIDs[j].ToUint64()
+}
+
+// Swap swaps the short channel ID's at indexes i and j, along with their
+// timestamps and checksums.
+//
+// NOTE: This is part of the sort.Interface interface.
+func (s replyChannelRangeSorter) Swap(i, j int) {
+	s.ShortChanIDs[i], s.ShortChanIDs[j] = s.ShortChanIDs[j],
+		s.ShortChanIDs[i]
+
+	if len(s.Timestamps) != 0 {
+		s.Timestamps[i], s.Timestamps[j] = s.Timestamps[j],
+			s.Timestamps[i]
+	}
+	if len(s.Checksums) != 0 {
+		s.Checksums[i], s.Checksums[j] = s.Checksums[j],
+			s.Checksums[i]
+	}
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -1,8 +1,12 @@
 package macaroons
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/peer"
@@ -13,6 +17,30 @@ import (
 	"golang.org/x/net/context"
 )
 
+const (
+	// CondMethod is the caveat condition that restricts a macaroon to a
+	// set of RPC methods.
+	CondMethod = "method"
+
+	// CondMaxPayment is the caveat condition that restricts the amount of
+	// a single payment made using a macaroon.
+	CondMaxPayment = "maxpayment"
+
+	// CondBudget is the caveat condition that restricts the cumulative
+	// amount spent using a macaroon.
+	CondBudget = "budget"
+
+	// CondAccount is the caveat condition that binds a macaroon to a
+	// virtual account, from which all payments made using the macaroon
+	// are debited.
+	CondAccount = "account"
+
+	// budgetNonceLen is the length of the random nonce that makes each
+	// budget caveat unique, such that its spending can be tracked
+	// independently.
+	budgetNonceLen = 8
+)
+
 // Constraint type adds a layer of indirection over macaroon caveats.
 type Constraint func(*macaroon.Macaroon) error
 
@@ -88,3 +116,217 @@ func IPLockChecker() (string, checkers.Func) {
 		return nil
 	}
 }
+
+// MethodConstraint restricts the macaroon to the given set of RPC methods,
+// identified by their full gRPC method names, e.g.
+// /lnrpc.Lightning/GetInfo.
+func MethodConstraint(methods ...string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if len(methods) == 0 {
+			return fmt.Errorf("at least one method must be specified")
+		}
+		for _, method := range methods {
+			if !strings.HasPrefix(method, "/") ||
+				strings.ContainsAny(method, " \t") {
+
+				return fmt.Errorf("invalid method name %q", method)
+			}
+		}
+
+		caveat := checkers.Condition(CondMethod, strings.Join(methods, " "))
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// MethodChecker accepts the RPC method being called from the validation
+// context and checks that it's among the methods the macaroon is restricted
+// to. It is of the `Checker` type.
+func MethodChecker() (string, checkers.Func) {
+	return CondMethod, func(ctx context.Context, cond, arg string) error {
+		method, ok := ctx.Value(methodContextKey{}).(string)
+		if !ok {
+			return fmt.Errorf("unable to get method from context")
+		}
+
+		for _, allowed := range strings.Fields(arg) {
+			if allowed == method {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("macaroon not valid for method %v", method)
+	}
+}
+
+// MaxPaymentConstraint restricts the amount of each payment made using the
+// macaroon to the given amount in millisatoshi, excluding fees.
+func MaxPaymentConstraint(maxMsat uint64) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		caveat := checkers.Condition(
+			CondMaxPayment, strconv.FormatUint(maxMsat, 10),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// MaxPaymentChecker ensures that the payment limit of the macaroon is well
+// formed, and that the RPC method being called enforces it. The limit itself
+// is enforced when authorizing a payment, as that's when its amount is known.
+// It is of the `Checker` type.
+func MaxPaymentChecker() (string, checkers.Func) {
+	return CondMaxPayment, func(ctx context.Context, _, arg string) error {
+		if _, err := strconv.ParseUint(arg, 10, 64); err != nil {
+			return err
+		}
+
+		return checkPaymentMethod(ctx)
+	}
+}
+
+// BudgetConstraint restricts the cumulative amount spent by payments made
+// using the macaroon, including fees, to the given amount in millisatoshi.
+// If expiry is non-zero, no payments can be made using the macaroon after
+// that time. Each budget is tracked separately, even when adding the same
+// budget to several macaroons.
+func BudgetConstraint(budgetMsat uint64,
+	expiry time.Time) func(*macaroon.Macaroon) error {
+
+	return func(mac *macaroon.Macaroon) error {
+		var nonce [budgetNonceLen]byte
+		if _, err := rand.Read(nonce[:]); err != nil {
+			return err
+		}
+
+		var expiryUnix int64
+		if !expiry.IsZero() {
+			expiryUnix = expiry.Unix()
+		}
+
+		caveat := checkers.Condition(CondBudget, fmt.Sprintf("%d %d %x",
+			budgetMsat, expiryUnix, nonce[:]))
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// BudgetChecker ensures that the spending budget of the macaroon is well
+// formed, and that the RPC method being called enforces it. The budget itself
+// is enforced when authorizing a payment, as that's when its amount is known.
+// It is of the `Checker` type.
+func BudgetChecker() (string, checkers.Func) {
+	return CondBudget, func(ctx context.Context, _, arg string) error {
+		if _, _, err := parseBudget(arg); err != nil {
+			return err
+		}
+
+		return checkPaymentMethod(ctx)
+	}
+}
+
+// paymentMethods is the set of RPC methods a macaroon carrying a payment
+// limit or budget may call. These are the only methods that check payments
+// against the limits of the macaroon. Any other method could be used to spend
+// the funds of the node without being accounted for, or to mint a macaroon
+// without the limits.
+var paymentMethods = map[string]struct{}{
+	"/lnrpc.Lightning/SendPayment":     {},
+	"/lnrpc.Lightning/SendPaymentSync": {},
+	"/lnrpc.Lightning/SendToRoute":     {},
+	"/lnrpc.Lightning/SendToRouteSync": {},
+}
+
+// checkPaymentMethod ensures that the RPC method being called, as found in
+// the validation context, enforces the payment limits of a macaroon.
+func checkPaymentMethod(ctx context.Context) error {
+	method, ok := ctx.Value(methodContextKey{}).(string)
+	if !ok {
+		return fmt.Errorf("unable to get method from context")
+	}
+	if _, ok := paymentMethods[method]; !ok {
+		return fmt.Errorf("macaroon with payment limits not valid for "+
+			"method %v", method)
+	}
+
+	return nil
+}
+
+// AccountConstraint binds the macaroon to the virtual account with the given
+// ID. Payments made using the macaroon are debited from the account, while
+// invoices created using it credit the account once settled.
+func AccountConstraint(accountID uint64) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		caveat := checkers.Condition(
+			CondAccount, strconv.FormatUint(accountID, 10),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// accountMethods is the set of RPC methods a macaroon bound to a virtual
+// account may call. These either charge or credit the account, only expose
+// the account itself, or don't touch any funds at all. Any other method could
+// be used to spend the funds of the node directly, or to mint a macaroon
+// without the account caveat.
+var accountMethods = map[string]struct{}{
+	"/lnrpc.Lightning/SendPayment":     {},
+	"/lnrpc.Lightning/SendPaymentSync": {},
+	"/lnrpc.Lightning/SendToRoute":     {},
+	"/lnrpc.Lightning/SendToRouteSync": {},
+	"/lnrpc.Lightning/AddInvoice":      {},
+	"/lnrpc.Lightning/ListAccounts":    {},
+	"/lnrpc.Lightning/DecodePayReq":    {},
+}
+
+// AccountChecker ensures that the account the macaroon is bound to is well
+// formed, and that the RPC method being called is aware of accounts. The
+// account itself is used by the RPC server when creating invoices and
+// authorizing payments. It is of the `Checker` type.
+func AccountChecker() (string, checkers.Func) {
+	return CondAccount, func(ctx context.Context, _, arg string) error {
+		if _, err := strconv.ParseUint(arg, 10, 64); err != nil {
+			return err
+		}
+
+		method, ok := ctx.Value(methodContextKey{}).(string)
+		if !ok {
+			return fmt.Errorf("unable to get method from context")
+		}
+		if _, ok := accountMethods[method]; !ok {
+			return fmt.Errorf("macaroon bound to an account not "+
+				"valid for method %v", method)
+		}
+
+		return nil
+	}
+}
+
+// parseBudget parses the argument of a budget caveat, returning the budget
+// in millisatoshi and its expiry. A zero expiry means the budget doesn't
+// expire.
+func parseBudget(arg string) (uint64, time.Time, error) {
+	fields := strings.Fields(arg)
+	if len(fields) != 3 {
+		return 0, time.Time{}, fmt.Errorf("invalid budget caveat %q",
+			arg)
+	}
+
+	budget, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	expiryUnix, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	nonce, err := hex.DecodeString(fields[2])
+	if err != nil || len(nonce) != budgetNonceLen {
+		return 0, time.Time{}, fmt.Errorf("invalid budget nonce %q",
+			fields[2])
+	}
+
+	var expiry time.Time
+	if expiryUnix != 0 {
+		expiry = time.Unix(expiryUnix, 0)
+	}
+
+	return budget, expiry, nil
+}
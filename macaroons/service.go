@@ -23,6 +23,37 @@ var (
 	DBFilename = "macaroons.db"
 )
 
+// methodContextKey is the key under which the full name of the RPC method
+// being called is stored within the context used to validate a macaroon.
+type methodContextKey struct{}
+
+// rootKeyIDContextKey is the key under which the root key ID to bake a
+// macaroon with is stored within a context.
+type rootKeyIDContextKey struct{}
+
+// ContextWithRootKeyID returns a copy of the passed context that instructs
+// the root key store to bake macaroons using the root key with the given ID.
+func ContextWithRootKeyID(ctx context.Context,
+	rootKeyID []byte) context.Context {
+
+	return context.WithValue(ctx, rootKeyIDContextKey{}, rootKeyID)
+}
+
+// RootKeyIDFromContext returns the root key ID stored within the passed
+// context, or the default root key ID if none is set.
+func RootKeyIDFromContext(ctx context.Context) []byte {
+	if ctx == nil {
+		return DefaultRootKeyID
+	}
+
+	rootKeyID, ok := ctx.Value(rootKeyIDContextKey{}).([]byte)
+	if !ok || len(rootKeyID) == 0 {
+		return DefaultRootKeyID
+	}
+
+	return rootKeyID
+}
+
 // Service encapsulates bakery.Bakery and adds a Close() method that zeroes the
 // root key service encryption keys, as well as utility methods to validate a
 // macaroon against the bakery and gRPC middleware for macaroon-based auth.
@@ -117,7 +148,12 @@ func (svc *Service) UnaryServerInterceptor(
 				"required for method", info.FullMethod)
 		}
 
-		err := svc.ValidateMacaroon(ctx, permissionMap[info.FullMethod])
+		validationCtx := context.WithValue(
+			ctx, methodContextKey{}, info.FullMethod,
+		)
+		err := svc.ValidateMacaroon(
+			validationCtx, permissionMap[info.FullMethod],
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -139,8 +175,11 @@ func (svc *Service) StreamServerInterceptor(
 				"for method", info.FullMethod)
 		}
 
+		validationCtx := context.WithValue(
+			ss.Context(), methodContextKey{}, info.FullMethod,
+		)
 		err := svc.ValidateMacaroon(
-			ss.Context(), permissionMap[info.FullMethod],
+			validationCtx, permissionMap[info.FullMethod],
 		)
 		if err != nil {
 			return err
@@ -157,13 +196,28 @@ func (svc *Service) StreamServerInterceptor(
 func (svc *Service) ValidateMacaroon(ctx context.Context,
 	requiredPermissions []bakery.Op) error {
 
+	mac, err := macaroonFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check the method being called against the permitted operation and
+	// the expiration time and IP address and return the result.
+	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
+	_, err = authChecker.Allow(ctx, requiredPermissions...)
+	return err
+}
+
+// macaroonFromContext extracts the macaroon encoded as request metadata
+// using the key "macaroon" from the passed context.
+func macaroonFromContext(ctx context.Context) (*macaroon.Macaroon, error) {
 	// Get macaroon bytes from context and unmarshal into macaroon.
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return fmt.Errorf("unable to get metadata from context")
+		return nil, fmt.Errorf("unable to get metadata from context")
 	}
 	if len(md["macaroon"]) != 1 {
-		return fmt.Errorf("expected 1 macaroon, got %d",
+		return nil, fmt.Errorf("expected 1 macaroon, got %d",
 			len(md["macaroon"]))
 	}
 
@@ -172,19 +226,15 @@ func (svc *Service) ValidateMacaroon(ctx context.Context,
 	// representation.
 	macBytes, err := hex.DecodeString(md["macaroon"][0])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mac := &macaroon.Macaroon{}
 	err = mac.UnmarshalBinary(macBytes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Check the method being called against the permitted operation and
-	// the expiration time and IP address and return the result.
-	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
-	_, err = authChecker.Allow(ctx, requiredPermissions...)
-	return err
+	return mac, nil
 }
 
 // Close closes the database that underlies the RootKeyStore and zeroes the
@@ -198,3 +248,18 @@ func (svc *Service) Close() error {
 func (svc *Service) CreateUnlock(password *[]byte) error {
 	return svc.rks.CreateUnlock(password)
 }
+
+// ListMacaroonIDs returns all the root key IDs that currently have a root key
+// stored.
+func (svc *Service) ListMacaroonIDs(ctx context.Context) ([][]byte, error) {
+	return svc.rks.ListMacaroonIDs(ctx)
+}
+
+// DeleteMacaroonID removes the root key with the given ID, revoking all
+// macaroons that were baked using it. The deleted ID is returned, or nil if
+// no root key existed for it.
+func (svc *Service) DeleteMacaroonID(ctx context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	return svc.rks.DeleteMacaroonID(ctx, rootKeyID)
+}
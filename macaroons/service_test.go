@@ -7,12 +7,15 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	macaroon "gopkg.in/macaroon.v2"
 )
 
 var (
@@ -134,3 +137,381 @@ func TestValidateMacaroon(t *testing.T) {
 		t.Fatalf("Error validating the macaroon: %v", err)
 	}
 }
+
+// TestRevokeMacaroonID tests that macaroons baked using a custom root key ID
+// are valid until that root key ID is deleted.
+func TestRevokeMacaroonID(t *testing.T) {
+	// First, initialize the service and unlock it.
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir, macaroons.IPLockChecker)
+	defer service.Close()
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	// Then, bake a macaroon using a custom root key ID.
+	rootKeyID := []byte("1")
+	ctx := macaroons.ContextWithRootKeyID(context.Background(), rootKeyID)
+	macaroon, err := service.Oven.NewMacaroon(ctx, bakery.LatestVersion,
+		nil, testOperation)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	macaroonBinary, err := macaroon.M().MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macaroonBinary),
+	})
+	mockContext := metadata.NewIncomingContext(context.Background(), md)
+
+	err = service.ValidateMacaroon(mockContext, []bakery.Op{testOperation})
+	if err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+
+	// The custom root key ID should be listed as being in use.
+	ids, err := service.ListMacaroonIDs(context.Background())
+	if err != nil {
+		t.Fatalf("Error listing macaroon IDs: %v", err)
+	}
+	if len(ids) != 1 || string(ids[0]) != string(rootKeyID) {
+		t.Fatalf("Expected root key IDs [%s], got %s", rootKeyID, ids)
+	}
+
+	// Once the root key ID is deleted, the macaroon should no longer be
+	// valid.
+	deleted, err := service.DeleteMacaroonID(
+		context.Background(), rootKeyID,
+	)
+	if err != nil {
+		t.Fatalf("Error deleting macaroon ID: %v", err)
+	}
+	if string(deleted) != string(rootKeyID) {
+		t.Fatalf("Expected deleted ID %s, got %s", rootKeyID, deleted)
+	}
+
+	err = service.ValidateMacaroon(mockContext, []bakery.Op{testOperation})
+	if err == nil {
+		t.Fatalf("Expected validation of revoked macaroon to fail")
+	}
+}
+
+// setupTestService creates a new unlocked macaroon service that checks the
+// given caveats, backed by a temporary directory. The returned function must
+// be called to clean up.
+func setupTestService(t *testing.T,
+	checks ...macaroons.Checker) (*macaroons.Service, func()) {
+
+	tempDir := setupTestRootKeyStorage(t)
+	service, err := macaroons.NewService(tempDir, checks...)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		service.Close()
+		os.RemoveAll(tempDir)
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	return service, func() {
+		service.Close()
+		os.RemoveAll(tempDir)
+	}
+}
+
+// bakeConstrainedMacaroon bakes a new macaroon granting the test operation,
+// applies the given constraints, and returns an incoming context carrying it.
+func bakeConstrainedMacaroon(t *testing.T, service *macaroons.Service,
+	constraints ...macaroons.Constraint) context.Context {
+
+	mac, err := service.Oven.NewMacaroon(nil, bakery.LatestVersion,
+		nil, testOperation)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	constrainedMac, err := macaroons.AddConstraints(mac.M(), constraints...)
+	if err != nil {
+		t.Fatalf("Error adding constraints: %v", err)
+	}
+
+	return macaroonContext(t, constrainedMac)
+}
+
+// macaroonContext returns an incoming context carrying the given macaroon.
+func macaroonContext(t *testing.T, mac *macaroon.Macaroon) context.Context {
+	macaroonBinary, err := mac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macaroonBinary),
+	})
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// TestMethodCaveat tests that a macaroon restricted to a set of RPC methods
+// can only be used to call those.
+func TestMethodCaveat(t *testing.T) {
+	service, cleanUp := setupTestService(t, macaroons.MethodChecker)
+	defer cleanUp()
+
+	const (
+		allowedMethod = "/lnrpc.Lightning/GetInfo"
+		deniedMethod  = "/lnrpc.Lightning/SendCoins"
+	)
+
+	ctx := bakeConstrainedMacaroon(
+		t, service, macaroons.MethodConstraint(allowedMethod),
+	)
+
+	interceptor := service.UnaryServerInterceptor(map[string][]bakery.Op{
+		allowedMethod: {testOperation},
+		deniedMethod:  {testOperation},
+	})
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	_, err := interceptor(
+		ctx, nil, &grpc.UnaryServerInfo{FullMethod: allowedMethod},
+		handler,
+	)
+	if err != nil {
+		t.Fatalf("Error calling allowed method: %v", err)
+	}
+
+	_, err = interceptor(
+		ctx, nil, &grpc.UnaryServerInfo{FullMethod: deniedMethod},
+		handler,
+	)
+	if err == nil {
+		t.Fatalf("Expected calling denied method to fail")
+	}
+
+	// Restricting a macaroon to no methods at all isn't possible.
+	_, err = macaroons.AddConstraints(
+		createDummyMacaroon(t), macaroons.MethodConstraint(),
+	)
+	if err == nil {
+		t.Fatalf("Expected empty method constraint to fail")
+	}
+}
+
+// TestPaymentCaveats tests that payments are checked against the maximum
+// payment amount and spending budget of a macaroon, and that the amount spent
+// is tracked correctly.
+func TestPaymentCaveats(t *testing.T) {
+	service, cleanUp := setupTestService(
+		t, macaroons.MaxPaymentChecker, macaroons.BudgetChecker,
+	)
+	defer cleanUp()
+
+	constraints := []macaroons.Constraint{
+		macaroons.MaxPaymentConstraint(1000),
+		macaroons.BudgetConstraint(3000, time.Time{}),
+	}
+	ctx := bakeConstrainedMacaroon(t, service, constraints...)
+
+	// The macaroon should only be valid for methods that enforce its
+	// limits, so it can't be used to spend the node's funds in other ways
+	// or to bake a macaroon without the limits.
+	const allowedMethod = "/lnrpc.Lightning/SendPaymentSync"
+	deniedMethods := []string{
+		"/lnrpc.Lightning/SendCoins",
+		"/lnrpc.Lightning/BakeMacaroon",
+	}
+	perms := map[string][]bakery.Op{
+		allowedMethod: {testOperation},
+	}
+	for _, method := range deniedMethods {
+		perms[method] = []bakery.Op{testOperation}
+	}
+	interceptor := service.UnaryServerInterceptor(perms)
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	_, err := interceptor(
+		ctx, nil, &grpc.UnaryServerInfo{FullMethod: allowedMethod},
+		handler,
+	)
+	if err != nil {
+		t.Fatalf("Error calling allowed method: %v", err)
+	}
+
+	for _, method := range deniedMethods {
+		_, err = interceptor(
+			ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+			handler,
+		)
+		if err == nil {
+			t.Fatalf("Expected calling %v to fail", method)
+		}
+	}
+
+	// Each caveat on its own should restrict the methods as well.
+	for _, constraint := range constraints {
+		limitedCtx := bakeConstrainedMacaroon(t, service, constraint)
+		_, err = interceptor(
+			limitedCtx, nil,
+			&grpc.UnaryServerInfo{FullMethod: deniedMethods[0]},
+			handler,
+		)
+		if err == nil {
+			t.Fatalf("Expected calling %v to fail",
+				deniedMethods[0])
+		}
+	}
+
+	// A payment exceeding the maximum amount should be rejected.
+	_, err = service.AuthorizePayment(ctx, 1001, 1001)
+	if err != macaroons.ErrMaxPaymentExceeded {
+		t.Fatalf("Received %v instead of ErrMaxPaymentExceeded", err)
+	}
+
+	// A payment of the maximum amount should be allowed, reserving its
+	// maximum cost including fees from the budget. Once settled, only the
+	// amount actually spent should count towards the budget.
+	settle, err := service.AuthorizePayment(ctx, 1000, 2000)
+	if err != nil {
+		t.Fatalf("Error authorizing payment: %v", err)
+	}
+	if err := settle(1500); err != nil {
+		t.Fatalf("Error settling payment: %v", err)
+	}
+
+	// With 1500 msat spent, a payment that may cost up to 2000 msat
+	// should exceed the budget.
+	_, err = service.AuthorizePayment(ctx, 1000, 2000)
+	if err != macaroons.ErrBudgetExceeded {
+		t.Fatalf("Received %v instead of ErrBudgetExceeded", err)
+	}
+
+	// A failed payment shouldn't count towards the budget.
+	settle, err = service.AuthorizePayment(ctx, 1000, 1500)
+	if err != nil {
+		t.Fatalf("Error authorizing payment: %v", err)
+	}
+	if err := settle(0); err != nil {
+		t.Fatalf("Error settling payment: %v", err)
+	}
+
+	// A payment that ends up costing more than reserved should have the
+	// excess charged to the budget as well.
+	settle, err = service.AuthorizePayment(ctx, 1000, 1000)
+	if err != nil {
+		t.Fatalf("Error authorizing payment: %v", err)
+	}
+	if err := settle(1500); err != nil {
+		t.Fatalf("Error settling payment: %v", err)
+	}
+
+	// The budget should now be exhausted.
+	_, err = service.AuthorizePayment(ctx, 1, 1)
+	if err != macaroons.ErrBudgetExceeded {
+		t.Fatalf("Received %v instead of ErrBudgetExceeded", err)
+	}
+
+	// A macaroon constrained by the same budget separately should have
+	// its own budget.
+	otherCtx := bakeConstrainedMacaroon(t, service, constraints...)
+	if _, err := service.AuthorizePayment(otherCtx, 1000, 3000); err != nil {
+		t.Fatalf("Error authorizing payment: %v", err)
+	}
+
+	// Once a budget has expired, no more payments can be made.
+	expiredCtx := bakeConstrainedMacaroon(
+		t, service, macaroons.BudgetConstraint(
+			3000, time.Now().Add(-time.Hour),
+		),
+	)
+	_, err = service.AuthorizePayment(expiredCtx, 1, 1)
+	if err != macaroons.ErrBudgetExpired {
+		t.Fatalf("Received %v instead of ErrBudgetExpired", err)
+	}
+}
+
+// TestAccountCaveat tests that the account a macaroon is bound to can be
+// extracted from the request context, that a macaroon can't be bound to
+// several accounts, and that a macaroon bound to an account can only call
+// methods that are aware of accounts.
+func TestAccountCaveat(t *testing.T) {
+	service, cleanUp := setupTestService(t, macaroons.AccountChecker)
+	defer cleanUp()
+
+	// A macaroon without an account caveat isn't bound to any account.
+	ctx := bakeConstrainedMacaroon(t, service)
+	_, bound, err := macaroons.AccountFromContext(ctx)
+	if err != nil {
+		t.Fatalf("Error getting account: %v", err)
+	}
+	if bound {
+		t.Fatalf("Expected macaroon not to be bound to an account")
+	}
+
+	// Adding the same account twice is allowed, as it doesn't change the
+	// account the macaroon is bound to.
+	ctx = bakeConstrainedMacaroon(
+		t, service, macaroons.AccountConstraint(7),
+		macaroons.AccountConstraint(7),
+	)
+	accountID, bound, err := macaroons.AccountFromContext(ctx)
+	if err != nil {
+		t.Fatalf("Error getting account: %v", err)
+	}
+	if !bound || accountID != 7 {
+		t.Fatalf("Expected account 7, got %v (bound=%v)", accountID,
+			bound)
+	}
+
+	// The macaroon should only be valid for methods that are aware of
+	// accounts, so it can't be used to spend the node's funds directly or
+	// to bake a macaroon without the account caveat.
+	const (
+		allowedMethod = "/lnrpc.Lightning/SendPaymentSync"
+		deniedMethod  = "/lnrpc.Lightning/BakeMacaroon"
+	)
+	interceptor := service.UnaryServerInterceptor(map[string][]bakery.Op{
+		allowedMethod: {testOperation},
+		deniedMethod:  {testOperation},
+	})
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	_, err = interceptor(
+		ctx, nil, &grpc.UnaryServerInfo{FullMethod: allowedMethod},
+		handler,
+	)
+	if err != nil {
+		t.Fatalf("Error calling allowed method: %v", err)
+	}
+
+	_, err = interceptor(
+		ctx, nil, &grpc.UnaryServerInfo{FullMethod: deniedMethod},
+		handler,
+	)
+	if err == nil {
+		t.Fatalf("Expected calling denied method to fail")
+	}
+
+	// A macaroon bound to two different accounts should be rejected.
+	ctx = bakeConstrainedMacaroon(
+		t, service, macaroons.AccountConstraint(7),
+		macaroons.AccountConstraint(8),
+	)
+	_, _, err = macaroons.AccountFromContext(ctx)
+	if err != macaroons.ErrAccountMismatch {
+		t.Fatalf("Received %v instead of ErrAccountMismatch", err)
+	}
+}
@@ -0,0 +1,274 @@
+package macaroons
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+
+	"golang.org/x/net/context"
+)
+
+var (
+	// ErrMaxPaymentExceeded is returned when a payment exceeds the maximum
+	// payment amount the macaroon is restricted to.
+	ErrMaxPaymentExceeded = fmt.Errorf("payment exceeds the maximum " +
+		"amount allowed by the macaroon")
+
+	// ErrBudgetExceeded is returned when a payment would exceed the
+	// spending budget of the macaroon.
+	ErrBudgetExceeded = fmt.Errorf("payment exceeds the remaining " +
+		"spending budget of the macaroon")
+
+	// ErrAccountMismatch is returned when a macaroon is bound to more
+	// than one virtual account.
+	ErrAccountMismatch = fmt.Errorf("macaroon is bound to more than one " +
+		"account")
+
+	// ErrBudgetExpired is returned when attempting to make a payment
+	// using a macaroon whose spending budget has expired.
+	ErrBudgetExpired = fmt.Errorf("spending budget of the macaroon has " +
+		"expired")
+)
+
+// spendingBudget is a spending budget found among the caveats of a macaroon.
+type spendingBudget struct {
+	// key identifies the budget within the spending bucket. It commits
+	// to both the macaroon's ID and the budget caveat.
+	key [sha256.Size]byte
+
+	// limit is the budget in millisatoshi.
+	limit uint64
+}
+
+// AuthorizePayment checks whether the caveats of the macaroon within the
+// passed context allow a payment of amt millisatoshi, which may spend up to
+// maxSpend millisatoshi including fees. If the macaroon is restricted by any
+// spending budgets, maxSpend is reserved from each of them.
+//
+// The returned function must be called once the payment has completed, with
+// the amount actually spent including fees, or zero if the payment failed.
+// Any amount reserved but not spent is then released again, while any amount
+// spent in excess of the reservation is charged to the budgets.
+//
+// NOTE: The macaroon is expected to have already been validated using
+// ValidateMacaroon.
+func (svc *Service) AuthorizePayment(ctx context.Context, amt,
+	maxSpend uint64) (func(spent uint64) error, error) {
+
+	mac, err := macaroonFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var budgets []spendingBudget
+	for _, caveat := range mac.Caveats() {
+		// Only first-party caveats can restrict payments.
+		if len(caveat.VerificationId) != 0 {
+			continue
+		}
+
+		cond, arg, err := checkers.ParseCaveat(string(caveat.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		switch cond {
+		case CondMaxPayment:
+			maxPayment, err := strconv.ParseUint(arg, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			if amt > maxPayment {
+				return nil, ErrMaxPaymentExceeded
+			}
+
+		case CondBudget:
+			limit, expiry, err := parseBudget(arg)
+			if err != nil {
+				return nil, err
+			}
+			if !expiry.IsZero() && time.Now().After(expiry) {
+				return nil, ErrBudgetExpired
+			}
+
+			// The budget is identified by both the macaroon's ID
+			// and the caveat, which includes a random nonce.
+			keyPreimage := make(
+				[]byte, 0, len(mac.Id())+len(caveat.Id),
+			)
+			keyPreimage = append(keyPreimage, mac.Id()...)
+			keyPreimage = append(keyPreimage, caveat.Id...)
+
+			budgets = append(budgets, spendingBudget{
+				key:   sha256.Sum256(keyPreimage),
+				limit: limit,
+			})
+		}
+	}
+
+	// Without any budgets, there's nothing to keep track of.
+	if len(budgets) == 0 {
+		return func(uint64) error { return nil }, nil
+	}
+
+	if err := svc.rks.reserveSpending(budgets, maxSpend); err != nil {
+		return nil, err
+	}
+
+	return func(spent uint64) error {
+		switch {
+		// The excess has already been spent, so it's charged even if
+		// that exceeds the budgets.
+		case spent > maxSpend:
+			return svc.rks.chargeSpending(budgets, spent-maxSpend)
+
+		case spent < maxSpend:
+			return svc.rks.releaseSpending(budgets, maxSpend-spent)
+
+		default:
+			return nil
+		}
+	}, nil
+}
+
+// AccountFromContext returns the ID of the virtual account the macaroon
+// within the passed context is bound to. The returned boolean is false if the
+// macaroon isn't bound to any account.
+//
+// NOTE: The macaroon is expected to have already been validated using
+// ValidateMacaroon.
+func AccountFromContext(ctx context.Context) (uint64, bool, error) {
+	mac, err := macaroonFromContext(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var (
+		accountID uint64
+		bound     bool
+	)
+	for _, caveat := range mac.Caveats() {
+		if len(caveat.VerificationId) != 0 {
+			continue
+		}
+
+		cond, arg, err := checkers.ParseCaveat(string(caveat.Id))
+		if err != nil {
+			return 0, false, err
+		}
+		if cond != CondAccount {
+			continue
+		}
+
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return 0, false, err
+		}
+
+		// Restricting a macaroon further can't move it to another
+		// account, so conflicting account caveats are rejected.
+		if bound && id != accountID {
+			return 0, false, ErrAccountMismatch
+		}
+		accountID, bound = id, true
+	}
+
+	return accountID, bound, nil
+}
+
+// reserveSpending atomically adds the given amount to the amount spent of
+// each of the budgets, failing if any of them would be exceeded.
+func (r *RootKeyStorage) reserveSpending(budgets []spendingBudget,
+	amt uint64) error {
+
+	return r.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(spendingBucketName)
+
+		for _, budget := range budgets {
+			spent := readSpent(bucket, budget.key[:])
+			if amt > budget.limit || spent > budget.limit-amt {
+				return ErrBudgetExceeded
+			}
+
+			err := writeSpent(bucket, budget.key[:], spent+amt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// chargeSpending adds the given amount to the amount spent of each of the
+// budgets, regardless of their limits. The amount spent saturates instead of
+// overflowing.
+func (r *RootKeyStorage) chargeSpending(budgets []spendingBudget,
+	amt uint64) error {
+
+	return r.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(spendingBucketName)
+
+		for _, budget := range budgets {
+			spent := readSpent(bucket, budget.key[:])
+			if amt > math.MaxUint64-spent {
+				spent = math.MaxUint64 - amt
+			}
+
+			err := writeSpent(bucket, budget.key[:], spent+amt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// releaseSpending subtracts the given amount from the amount spent of each of
+// the budgets.
+func (r *RootKeyStorage) releaseSpending(budgets []spendingBudget,
+	amt uint64) error {
+
+	return r.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(spendingBucketName)
+
+		for _, budget := range budgets {
+			spent := readSpent(bucket, budget.key[:])
+			if amt > spent {
+				spent = amt
+			}
+
+			err := writeSpent(bucket, budget.key[:], spent-amt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// readSpent returns the amount spent of the budget with the given key.
+func readSpent(bucket *bbolt.Bucket, key []byte) uint64 {
+	spentBytes := bucket.Get(key)
+	if len(spentBytes) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(spentBytes)
+}
+
+// writeSpent stores the amount spent of the budget with the given key.
+func writeSpent(bucket *bbolt.Bucket, key []byte, spent uint64) error {
+	var spentBytes [8]byte
+	binary.BigEndian.PutUint64(spentBytes[:], spent)
+
+	return bucket.Put(key, spentBytes[:])
+}
@@ -1,6 +1,7 @@
 package macaroons
 
 import (
+	"bytes"
 	"crypto/rand"
 	"fmt"
 	"io"
@@ -21,11 +22,15 @@ var (
 	// rootKeyBucketName is the name of the root key store bucket.
 	rootKeyBucketName = []byte("macrootkeys")
 
-	// defaultRootKeyID is the ID of the default root key. The first is
-	// just 0, to emulate the memory storage that comes with bakery.
-	//
-	// TODO(aakselrod): Add support for key rotation.
-	defaultRootKeyID = []byte("0")
+	// spendingBucketName is the name of the bucket that tracks the amount
+	// spent using macaroons restricted by a spending budget.
+	spendingBucketName = []byte("macspending")
+
+	// DefaultRootKeyID is the ID of the default root key. The first is
+	// just 0, to emulate the memory storage that comes with bakery. It is
+	// used whenever no other root key ID is requested, and can't be
+	// deleted.
+	DefaultRootKeyID = []byte("0")
 
 	// encryptedKeyID is the name of the database key that stores the
 	// encryption key, encrypted with a salted + hashed password. The
@@ -42,6 +47,10 @@ var (
 
 	// ErrPasswordRequired specifies that a nil password has been passed.
 	ErrPasswordRequired = fmt.Errorf("a non-nil password is required")
+
+	// ErrDeletionForbidden is used when attempting to delete the default
+	// root key ID, or the encryption key.
+	ErrDeletionForbidden = fmt.Errorf("the specified ID cannot be deleted")
 )
 
 // RootKeyStorage implements the bakery.RootKeyStorage interface.
@@ -57,6 +66,11 @@ func NewRootKeyStorage(db *bbolt.DB) (*RootKeyStorage, error) {
 	// If the store's bucket doesn't exist, create it.
 	err := db.Update(func(tx *bbolt.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(rootKeyBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(spendingBucketName)
 		return err
 	})
 	if err != nil {
@@ -148,14 +162,21 @@ func (r *RootKeyStorage) Get(_ context.Context, id []byte) ([]byte, error) {
 }
 
 // RootKey implements the RootKey method for the bakery.RootKeyStorage
-// interface.
-// TODO(aakselrod): Add support for key rotation.
-func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
+// interface. The root key ID is read from the passed context, falling back to
+// the default root key ID if none is set. If no root key exists yet for the
+// ID, a new one is created.
+func (r *RootKeyStorage) RootKey(ctx context.Context) ([]byte, []byte, error) {
 	if r.encKey == nil {
 		return nil, nil, ErrStoreLocked
 	}
+
+	id := RootKeyIDFromContext(ctx)
+	if bytes.Equal(id, encryptedKeyID) {
+		return nil, nil, fmt.Errorf("root key id %s is reserved",
+			string(id))
+	}
+
 	var rootKey []byte
-	id := defaultRootKeyID
 	err := r.Update(func(tx *bbolt.Tx) error {
 		ns := tx.Bucket(rootKeyBucketName)
 		dbKey := ns.Get(id)
@@ -193,6 +214,77 @@ func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
 	return rootKey, id, nil
 }
 
+// ListMacaroonIDs returns all the root key IDs that currently have a root key
+// stored.
+func (r *RootKeyStorage) ListMacaroonIDs(_ context.Context) ([][]byte, error) {
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	var rootKeyIDs [][]byte
+	err := r.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		return bucket.ForEach(func(k, _ []byte) error {
+			// The encryption key is stored within the same
+			// bucket, but isn't a root key.
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			id := make([]byte, len(k))
+			copy(id, k)
+			rootKeyIDs = append(rootKeyIDs, id)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rootKeyIDs, nil
+}
+
+// DeleteMacaroonID removes the root key with the given ID, which invalidates
+// all macaroons derived from it. The default root key ID can't be deleted.
+// The deleted ID is returned, or nil if no root key existed for it.
+func (r *RootKeyStorage) DeleteMacaroonID(_ context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	if len(rootKeyID) == 0 {
+		return nil, fmt.Errorf("root key id cannot be empty")
+	}
+	if bytes.Equal(rootKeyID, DefaultRootKeyID) ||
+		bytes.Equal(rootKeyID, encryptedKeyID) {
+
+		return nil, ErrDeletionForbidden
+	}
+
+	var deleted []byte
+	err := r.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		if bucket.Get(rootKeyID) == nil {
+			return nil
+		}
+
+		if err := bucket.Delete(rootKeyID); err != nil {
+			return err
+		}
+
+		deleted = rootKeyID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
 // Close closes the underlying database and zeroes the encryption key stored
 // in memory.
 func (r *RootKeyStorage) Close() error {
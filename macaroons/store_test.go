@@ -2,9 +2,11 @@ package macaroons_test
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"os"
 	"path"
+	"reflect"
 	"testing"
 
 	"github.com/coreos/bbolt"
@@ -135,3 +137,101 @@ func TestStore(t *testing.T) {
 			rootID, id)
 	}
 }
+
+// TestStoreRootKeyIDs tests that root keys are created for the root key ID
+// found within the context, and that they can be listed and deleted.
+func TestStoreRootKeyIDs(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "macaroonstore-")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := bbolt.Open(path.Join(tempDir, "weks.db"), 0600,
+		bbolt.DefaultOptions)
+	if err != nil {
+		t.Fatalf("Error opening store DB: %v", err)
+	}
+
+	store, err := macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("Error creating root key store: %v", err)
+	}
+	defer store.Close()
+
+	_, err = store.ListMacaroonIDs(nil)
+	if err != macaroons.ErrStoreLocked {
+		t.Fatalf("Received %v instead of ErrStoreLocked", err)
+	}
+
+	pw := []byte("weks")
+	err = store.CreateUnlock(&pw)
+	if err != nil {
+		t.Fatalf("Error creating store encryption key: %v", err)
+	}
+
+	// Without a root key ID within the context, the default root key
+	// should be used.
+	defaultKey, id, err := store.RootKey(nil)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if !bytes.Equal(id, macaroons.DefaultRootKeyID) {
+		t.Fatalf("Root ID doesn't match: expected %v, got %v",
+			macaroons.DefaultRootKeyID, id)
+	}
+
+	// Requesting another root key ID should result in a new root key.
+	customID := []byte("1")
+	ctx := macaroons.ContextWithRootKeyID(context.Background(), customID)
+	customKey, id, err := store.RootKey(ctx)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if !bytes.Equal(id, customID) {
+		t.Fatalf("Root ID doesn't match: expected %v, got %v",
+			customID, id)
+	}
+	if bytes.Equal(customKey, defaultKey) {
+		t.Fatalf("Expected distinct root keys for distinct IDs")
+	}
+
+	// Both IDs should be listed, but not the encryption key.
+	ids, err := store.ListMacaroonIDs(nil)
+	if err != nil {
+		t.Fatalf("Error listing macaroon IDs: %v", err)
+	}
+	expIDs := [][]byte{macaroons.DefaultRootKeyID, customID}
+	if !reflect.DeepEqual(ids, expIDs) {
+		t.Fatalf("Expected root key IDs %s, got %s", expIDs, ids)
+	}
+
+	// The default root key ID can't be deleted.
+	_, err = store.DeleteMacaroonID(nil, macaroons.DefaultRootKeyID)
+	if err != macaroons.ErrDeletionForbidden {
+		t.Fatalf("Received %v instead of ErrDeletionForbidden", err)
+	}
+
+	deleted, err := store.DeleteMacaroonID(nil, customID)
+	if err != nil {
+		t.Fatalf("Error deleting macaroon ID: %v", err)
+	}
+	if !bytes.Equal(deleted, customID) {
+		t.Fatalf("Deleted ID doesn't match: expected %v, got %v",
+			customID, deleted)
+	}
+
+	// Deleting it again should be a no-op, and its root key should be
+	// gone.
+	deleted, err = store.DeleteMacaroonID(nil, customID)
+	if err != nil {
+		t.Fatalf("Error deleting macaroon ID: %v", err)
+	}
+	if deleted != nil {
+		t.Fatalf("Expected nothing to be deleted, got %v", deleted)
+	}
+	if _, err := store.Get(nil, customID); err == nil {
+		t.Fatalf("Expected root key of deleted ID to be gone")
+	}
+}
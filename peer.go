@@ -121,6 +121,14 @@ type peer struct {
 	// Start().
 	startTime time.Time
 
+	// eventMtx guards the fields below, which ensure that the peer going
+	// offline is dispatched, and recorded within its connection history,
+	// if and only if the peer coming online was.
+	eventMtx        sync.Mutex
+	onlineNotified  bool
+	offlineNotified bool
+	historyRecorded bool
+
 	inbound bool
 
 	// sendQueue is the channel which is used to queue outgoing to be
@@ -340,19 +348,15 @@ func (p *peer) initGossipSync() {
 		srvrLog.Infof("Negotiated chan series queries with %x",
 			p.pubKeyBytes[:])
 
-		// We'll only request channel updates from the remote peer if
-		// its enabled in the config, or we're already getting updates
-		// from enough peers.
-		//
-		// TODO(roasbeef): craft s.t. we only get updates from a few
-		// peers
-		recvUpdates := !cfg.NoChanUpdates
-
 		// Register the this peer's for gossip syncer with the gossiper.
 		// This is blocks synchronously to ensure the gossip syncer is
 		// registered with the gossiper before attempting to read
 		// messages from the remote peer.
-		p.server.authGossiper.InitSyncState(p, recvUpdates)
+		p.server.authGossiper.InitSyncState(
+			p, p.remoteLocalFeatures.HasFeature(
+				lnwire.GossipQueriesExOptional,
+			),
+		)
 
 	// If the remote peer has the initial sync feature bit set, then we'll
 	// being the synchronization protocol to exchange authenticated channel
@@ -447,11 +451,15 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 		// routing policy into a forwarding policy.
 		var forwardingPolicy *htlcswitch.ForwardingPolicy
 		if selfPolicy != nil {
+			defaultPolicy := p.server.cc.routingPolicy
 			forwardingPolicy = &htlcswitch.ForwardingPolicy{
-				MinHTLC:       selfPolicy.MinHTLC,
-				BaseFee:       selfPolicy.FeeBaseMSat,
-				FeeRate:       selfPolicy.FeeProportionalMillionths,
-				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+				MinHTLC:            selfPolicy.MinHTLC,
+				BaseFee:            selfPolicy.FeeBaseMSat,
+				FeeRate:            selfPolicy.FeeProportionalMillionths,
+				TimeLockDelta:      uint32(selfPolicy.TimeLockDelta),
+				MaxForwardAmt:      defaultPolicy.MaxForwardAmt,
+				MaxPendingForwards: defaultPolicy.MaxPendingForwards,
+				MaxCltvDelta:       defaultPolicy.MaxCltvDelta,
 			}
 		} else {
 			peerLog.Warnf("Unable to find our forwarding policy "+
@@ -1108,6 +1116,29 @@ out:
 
 			discStream.AddMsg(msg)
 
+		case *lnwire.Custom:
+			// As required by BOLT 1, we'll fail the connection
+			// upon an even message type we don't understand,
+			// which is any even custom type no subscriber claimed.
+			if msg.Type%2 == 0 &&
+				!p.server.isCustomTypeClaimed(msg.Type) {
+
+				p.Disconnect(fmt.Errorf("unknown even custom "+
+					"message type %v", uint16(msg.Type)))
+				break out
+			}
+
+			err := p.server.customMessageServer.SendUpdate(
+				&CustomMessage{
+					Peer: p.pubKeyBytes,
+					Msg:  msg,
+				},
+			)
+			if err != nil {
+				peerLog.Errorf("Unable to dispatch custom "+
+					"message from %v: %v", p, err)
+			}
+
 		default:
 			peerLog.Errorf("unknown message %v received from peer "+
 				"%v", uint16(msg.MsgType()), p)
@@ -1265,6 +1296,9 @@ func messageSummary(msg lnwire.Message) string {
 			time.Unix(int64(msg.FirstTimestamp), 0),
 			msg.TimestampRange)
 
+	case *lnwire.Custom:
+		return fmt.Sprintf("type=%d, data_len=%d", uint16(msg.Type),
+			len(msg.Data))
 	}
 
 	return ""
@@ -1642,10 +1676,13 @@ out:
 			fwdMinHtlc := lnChan.FwdMinHtlc()
 			defaultPolicy := p.server.cc.routingPolicy
 			forwardingPolicy := &htlcswitch.ForwardingPolicy{
-				MinHTLC:       fwdMinHtlc,
-				BaseFee:       defaultPolicy.BaseFee,
-				FeeRate:       defaultPolicy.FeeRate,
-				TimeLockDelta: defaultPolicy.TimeLockDelta,
+				MinHTLC:            fwdMinHtlc,
+				BaseFee:            defaultPolicy.BaseFee,
+				FeeRate:            defaultPolicy.FeeRate,
+				TimeLockDelta:      defaultPolicy.TimeLockDelta,
+				MaxForwardAmt:      defaultPolicy.MaxForwardAmt,
+				MaxPendingForwards: defaultPolicy.MaxPendingForwards,
+				MaxCltvDelta:       defaultPolicy.MaxCltvDelta,
 			}
 
 			// Create the link and add it to the switch.
@@ -4,13 +4,17 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/autopilot"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tor"
 )
 
@@ -59,13 +63,31 @@ func (c *chanController) OpenChannel(target *btcec.PublicKey,
 	case <-updateStream:
 		return nil
 	case <-c.server.quit:
-		return nil
+		return fmt.Errorf("server shutting down")
 	}
 }
 
+// CloseChannel attempts to cooperatively close the target channel. This
+// function will un-block once the closing transaction has been broadcast.
 func (c *chanController) CloseChannel(chanPoint *wire.OutPoint) error {
-	return nil
+	feePerKw, err := c.server.cc.feeEstimator.EstimateFeePerKW(6)
+	if err != nil {
+		return err
+	}
+
+	updateChan, errChan := c.server.htlcSwitch.CloseLink(
+		chanPoint, htlcswitch.CloseRegular, feePerKw,
+	)
+	select {
+	case err := <-errChan:
+		return err
+	case <-updateChan:
+		return nil
+	case <-c.server.quit:
+		return fmt.Errorf("server shutting down")
+	}
 }
+
 func (c *chanController) SpliceIn(chanPoint *wire.OutPoint,
 	amt btcutil.Amount) (*autopilot.Channel, error) {
 	return nil, nil
@@ -83,7 +105,9 @@ var _ autopilot.ChannelController = (*chanController)(nil)
 // autopilot.Agent instance based on the passed configuration struct. The agent
 // and all interfaces needed to drive it won't be launched before the Manager's
 // StartAgent method is called.
-func initAutoPilot(svr *server, cfg *autoPilotConfig) *autopilot.ManagerCfg {
+func initAutoPilot(svr *server, cfg *autoPilotConfig) (
+	*autopilot.ManagerCfg, error) {
+
 	atplLog.Infof("Instantiating autopilot with cfg: %v", spew.Sdump(cfg))
 
 	// Set up the constraints the autopilot heuristics must adhere to.
@@ -95,18 +119,22 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) *autopilot.ManagerCfg {
 		MaxPendingOpens: 10,
 	}
 
-	// First, we'll create the preferential attachment heuristic,
-	// initialized with the passed auto pilot configuration parameters.
-	prefAttachment := autopilot.NewConstrainedPrefAttachment(
-		atplConstraints,
+	// First, we'll create the combined heuristic, blending the available
+	// heuristics using the configured weights. The weights were already
+	// validated when loading the config.
+	heuristic, err := autopilot.NewCombinedHeuristic(
+		atplConstraints, cfg.Heuristic,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	// With the heuristic itself created, we can now populate the remainder
 	// of the items that the autopilot agent needs to perform its duties.
 	self := svr.identityPriv.PubKey()
 	pilotCfg := autopilot.Config{
 		Self:      self,
-		Heuristic: prefAttachment,
+		Heuristic: heuristic,
 		ChanController: &chanController{
 			server:   svr,
 			private:  cfg.Private,
@@ -177,6 +205,40 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) *autopilot.ManagerCfg {
 		DisconnectPeer: svr.DisconnectPeer,
 	}
 
+	// If enabled, we'll also configure the channel pruner that closes
+	// inactive and unprofitable channels while the agent is active.
+	var prunerCfg *autopilot.PrunerConfig
+	if cfg.Prune {
+		prunerCfg = &autopilot.PrunerConfig{
+			Ticker:               ticker.New(cfg.PruneInterval),
+			Period:               cfg.PrunePeriod,
+			MinUptime:            cfg.PruneMinUptime,
+			MinFeesEarned:        cfg.PruneMinFees,
+			MaxClosesPerInterval: cfg.PruneMaxCloses,
+			DryRun:               cfg.PruneDryRun,
+			FetchChannels: func() ([]*autopilot.PrunableChannel,
+				error) {
+
+				_, bestHeight, err := svr.cc.chainIO.GetBestBlock()
+				if err != nil {
+					return nil, err
+				}
+
+				return fetchPrunableChannels(
+					svr.chanDB, uint32(bestHeight),
+				)
+			},
+			ForwardingEvents: func(start, end time.Time) (
+				[]channeldb.ForwardingEvent, error) {
+
+				return fetchForwardingEvents(svr.chanDB, start, end)
+			},
+			FetchPeerHistories: svr.chanDB.FetchPeerHistories,
+			ChanController:     pilotCfg.ChanController,
+			Now:                time.Now,
+		}
+	}
+
 	// Create and return the autopilot.ManagerCfg that administrates this
 	// agent-pilot instance.
 	return &autopilot.ManagerCfg{
@@ -205,5 +267,47 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) *autopilot.ManagerCfg {
 		},
 		SubscribeTransactions: svr.cc.wallet.SubscribeTransactions,
 		SubscribeTopology:     svr.chanRouter.SubscribeTopology,
+		PrunerCfg:             prunerCfg,
+	}, nil
+}
+
+// fetchPrunableChannels returns all open channels within the database in the
+// form expected by the autopilot channel pruner. The age of each channel is
+// estimated from the number of blocks its funding transaction has been
+// confirmed for at the given height.
+func fetchPrunableChannels(chanDB *channeldb.DB,
+	bestHeight uint32) ([]*autopilot.PrunableChannel, error) {
+
+	openChannels, err := chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make([]*autopilot.PrunableChannel, 0, len(openChannels))
+	for _, channel := range openChannels {
+		// Channels that are borked, or whose commitment has already
+		// been broadcast, are on their way out already.
+		if channel.ChanStatus() != channeldb.Default {
+			continue
+		}
+
+		var age time.Duration
+		confHeight := channel.ShortChanID().BlockHeight
+		if bestHeight > confHeight {
+			age = time.Duration(bestHeight-confHeight) *
+				activeNetParams.TargetTimePerBlock
+		}
+
+		channels = append(channels, &autopilot.PrunableChannel{
+			ChanPoint:       channel.FundingOutpoint,
+			ChanID:          channel.ShortChanID(),
+			Node:            autopilot.NewNodeID(channel.IdentityPub),
+			Capacity:        channel.Capacity,
+			IsInitiator:     channel.IsInitiator,
+			NumPendingHTLCs: len(channel.LocalCommitment.Htlcs),
+			Age:             age,
+		})
 	}
+
+	return channels, nil
 }
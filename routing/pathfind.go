@@ -9,9 +9,9 @@ import (
 	"container/heap"
 
 	"github.com/btcsuite/btcd/btcec"
-	"github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -418,7 +418,7 @@ func edgeWeight(lockedAmt lnwire.MilliSatoshi, fee lnwire.MilliSatoshi,
 type graphParams struct {
 	// tx can be set to an existing db transaction. If not set, a new
 	// transaction will be started.
-	tx *bbolt.Tx
+	tx kvdb.Tx
 
 	// graph is the ChannelGraph to be used during path finding.
 	graph *channeldb.ChannelGraph
@@ -489,7 +489,7 @@ func findPath(g *graphParams, r *restrictParams,
 	// also returns the source node, so there is no need to add the source
 	// node explicitly.
 	distance := make(map[Vertex]nodeWithDist)
-	if err := g.graph.ForEachNode(tx, func(_ *bbolt.Tx,
+	if err := g.graph.ForEachNode(tx, func(_ kvdb.Tx,
 		node *channeldb.LightningNode) error {
 		// TODO(roasbeef): with larger graph can just use disk seeks
 		// with a visited map
@@ -696,7 +696,7 @@ func findPath(g *graphParams, r *restrictParams,
 		// examine all the incoming edges (channels) from this node to
 		// further our graph traversal.
 		pivot := Vertex(bestNode.PubKeyBytes)
-		err := bestNode.ForEachChannel(tx, func(tx *bbolt.Tx,
+		err := bestNode.ForEachChannel(tx, func(tx kvdb.Tx,
 			edgeInfo *channeldb.ChannelEdgeInfo,
 			_, inEdge *channeldb.ChannelEdgePolicy) error {
 
@@ -798,11 +798,15 @@ func findPath(g *graphParams, r *restrictParams,
 // will be ignored by our modified Dijkstra's algorithm. With this approach, we
 // make our inner path finding algorithm aware of our k-shortest paths
 // algorithm, rather than attempting to use an unmodified path finding
-// algorithm in a block box manner.
-func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
+// algorithm in a block box manner. An optional set of additional edges, such as
+// those derived from routing hints, can be passed in to assist in reaching
+// destinations that aren't publicly advertised.
+func findPaths(tx kvdb.Tx, graph *channeldb.ChannelGraph,
 	source *channeldb.LightningNode, target *btcec.PublicKey,
 	amt lnwire.MilliSatoshi, feeLimit lnwire.MilliSatoshi, numPaths uint32,
-	bandwidthHints map[uint64]lnwire.MilliSatoshi) ([][]*channeldb.ChannelEdgePolicy, error) {
+	bandwidthHints map[uint64]lnwire.MilliSatoshi,
+	additionalEdges map[Vertex][]*channeldb.ChannelEdgePolicy) (
+	[][]*channeldb.ChannelEdgePolicy, error) {
 
 	ignoredEdges := make(map[edgeLocator]struct{})
 	ignoredVertexes := make(map[Vertex]struct{})
@@ -819,9 +823,10 @@ func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 	// satoshis along the path before fees are calculated.
 	startingPath, err := findPath(
 		&graphParams{
-			tx:             tx,
-			graph:          graph,
-			bandwidthHints: bandwidthHints,
+			tx:              tx,
+			graph:           graph,
+			additionalEdges: additionalEdges,
+			bandwidthHints:  bandwidthHints,
 		},
 		&restrictParams{
 			ignoredNodes: ignoredVertexes,
@@ -903,9 +908,10 @@ func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 			// shortest path from the spur node to the destination.
 			spurPath, err := findPath(
 				&graphParams{
-					tx:             tx,
-					graph:          graph,
-					bandwidthHints: bandwidthHints,
+					tx:              tx,
+					graph:           graph,
+					additionalEdges: additionalEdges,
+					bandwidthHints:  bandwidthHints,
 				},
 				&restrictParams{
 					ignoredNodes: ignoredVertexes,
@@ -13,11 +13,11 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -77,7 +77,7 @@ type ChannelGraphSource interface {
 	IsPublicNode(node Vertex) (bool, error)
 
 	// IsKnownEdge returns true if the graph source already knows of the
-	// passed channel ID.
+	// passed channel ID either as a live or zombie edge.
 	IsKnownEdge(chanID lnwire.ShortChannelID) bool
 
 	// IsStaleEdgePolicy returns true if the graph source has a channel
@@ -86,6 +86,10 @@ type ChannelGraphSource interface {
 	IsStaleEdgePolicy(chanID lnwire.ShortChannelID, timestamp time.Time,
 		flags lnwire.ChanUpdateFlag) bool
 
+	// MarkEdgeLive clears an edge from our zombie index, deeming it as
+	// live.
+	MarkEdgeLive(chanID lnwire.ShortChannelID) error
+
 	// ForAllOutgoingChannels is used to iterate over all channels
 	// emanating from the "source" node which is the center of the
 	// star-graph.
@@ -615,7 +619,7 @@ func (r *ChannelRouter) syncGraphWithChain() error {
 // been updated since our zombie horizon. We do this periodically to keep a
 // health, lively routing table.
 func (r *ChannelRouter) pruneZombieChans() error {
-	var chansToPrune []wire.OutPoint
+	var chansToPrune []*channeldb.ChannelEdgeInfo
 	chanExpiry := r.cfg.ChannelPruneExpiry
 
 	log.Infof("Examining Channel Graph for zombie channels")
@@ -660,7 +664,7 @@ func (r *ChannelRouter) pruneZombieChans() error {
 
 			// TODO(roasbeef): add ability to delete single
 			// directional edge
-			chansToPrune = append(chansToPrune, info.ChannelPoint)
+			chansToPrune = append(chansToPrune, info)
 
 			// As we're detecting this as a zombie channel, we'll
 			// add this to the set of recently rejected items so we
@@ -683,11 +687,24 @@ func (r *ChannelRouter) pruneZombieChans() error {
 	log.Infof("Pruning %v Zombie Channels", len(chansToPrune))
 
 	// With the set zombie-like channels obtained, we'll do another pass to
-	// delete al zombie channels from the channel graph.
+	// delete al zombie channels from the channel graph. Each of them is
+	// first added to the zombie index, such that we won't re-learn the
+	// channel from the announcements of our peers unless it's revived by
+	// one of its nodes.
 	for _, chanToPrune := range chansToPrune {
-		log.Tracef("Pruning zombie chan ChannelPoint(%v)", chanToPrune)
+		log.Tracef("Pruning zombie chan ChannelPoint(%v)",
+			chanToPrune.ChannelPoint)
+
+		err := r.cfg.Graph.MarkEdgeZombie(
+			chanToPrune.ChannelID, chanToPrune.NodeKey1Bytes,
+			chanToPrune.NodeKey2Bytes,
+		)
+		if err != nil {
+			return fmt.Errorf("Unable to mark zombie chan %v: %v",
+				chanToPrune.ChannelID, err)
+		}
 
-		err := r.cfg.Graph.DeleteChannelEdge(&chanToPrune)
+		err = r.cfg.Graph.DeleteChannelEdge(&chanToPrune.ChannelPoint)
 		if err != nil {
 			return fmt.Errorf("Unable to prune zombie "+
 				"chans: %v", err)
@@ -1006,6 +1023,15 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 		}
 		r.rejectMtx.RUnlock()
 
+		// If the channel was previously pruned as a zombie, then we
+		// won't add it back to the graph until it has been revived by
+		// a fresh update from one of its nodes.
+		isZombie, _, _ := r.cfg.Graph.IsZombieEdge(msg.ChannelID)
+		if isZombie {
+			return newErrf(ErrIgnored, "Ignoring msg for zombie "+
+				"chan_id=%v", msg.ChannelID)
+		}
+
 		// Prior to processing the announcement we first check if we
 		// already know of this channel, if so, then we can exit early.
 		_, _, exists, err := r.cfg.Graph.HasChannelEdge(msg.ChannelID)
@@ -1381,10 +1407,12 @@ func pathsToFeeSortedRoutes(source Vertex, paths [][]*channeldb.ChannelEdgePolic
 // within its inner loop.  Once we have a set of candidate routes, we calculate
 // the required fee and time lock values running backwards along the route. The
 // route that will be ranked the highest is the one with the lowest cumulative
-// fee along the route.
+// fee along the route. An optional set of routing hints can be provided in
+// order to find routes to destinations that are only reachable through
+// private channels.
 func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	amt, feeLimit lnwire.MilliSatoshi, numPaths uint32,
-	finalExpiry ...uint16) ([]*Route, error) {
+	routeHints [][]HopHint, finalExpiry ...uint16) ([]*Route, error) {
 
 	var finalCLTVDelta uint16
 	if len(finalExpiry) == 0 {
@@ -1398,17 +1426,22 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 
 	// Before attempting to perform a series of graph traversals to find
 	// the k-shortest paths to the destination, we'll first consult our
-	// path cache
+	// path cache. Routes computed with the aid of routing hints depend on
+	// more than just the amount and destination, so they bypass the cache
+	// entirely.
 	rt := newRouteTuple(amt, dest)
-	r.routeCacheMtx.RLock()
-	routes, ok := r.routeCache[rt]
-	r.routeCacheMtx.RUnlock()
-
-	// If we already have a cached route, and it contains at least the
-	// number of paths requested, then we'll return it directly as there's
-	// no need to repeat the computation.
-	if ok && uint32(len(routes)) >= numPaths {
-		return routes, nil
+	useCache := len(routeHints) == 0
+	if useCache {
+		r.routeCacheMtx.RLock()
+		routes, ok := r.routeCache[rt]
+		r.routeCacheMtx.RUnlock()
+
+		// If we already have a cached route, and it contains at least
+		// the number of paths requested, then we'll return it directly
+		// as there's no need to repeat the computation.
+		if ok && uint32(len(routes)) >= numPaths {
+			return routes, nil
+		}
 	}
 
 	// If we don't have a set of routes cached, we'll query the graph for a
@@ -1417,15 +1450,27 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	// returned.
 
 	// We can short circuit the routing by opportunistically checking to
-	// see if the target vertex event exists in the current graph.
-	targetVertex := NewVertex(target)
-	if _, exists, err := r.cfg.Graph.HasLightningNode(targetVertex); err != nil {
-		return nil, err
-	} else if !exists {
-		log.Debugf("Target %x is not in known graph", dest)
-		return nil, newErrf(ErrTargetNotInNetwork, "target not found")
+	// see if the target vertex event exists in the current graph. If we
+	// were given routing hints, then the target may be a private node that
+	// we don't know of, so we'll skip this check.
+	if len(routeHints) == 0 {
+		targetVertex := NewVertex(target)
+		_, exists, err := r.cfg.Graph.HasLightningNode(targetVertex)
+		if err != nil {
+			return nil, err
+		} else if !exists {
+			log.Debugf("Target %x is not in known graph", dest)
+			return nil, newErrf(
+				ErrTargetNotInNetwork, "target not found",
+			)
+		}
 	}
 
+	// Any routing hints provided will be converted into a set of
+	// additional edges, indexed by their starting node, that path finding
+	// can use in addition to the edges within our graph.
+	additionalEdges := generateHintEdges(routeHints, target)
+
 	// We'll also fetch the current block height so we can properly
 	// calculate the required HTLC time locks within the route.
 	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
@@ -1454,7 +1499,7 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	// our source to the destination.
 	shortestPaths, err := findPaths(
 		tx, r.cfg.Graph, r.selfNode, target, amt, feeLimit, numPaths,
-		bandwidthHints,
+		bandwidthHints, additionalEdges,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -1485,9 +1530,11 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 
 	// Populate the cache with this set of fresh routes so we can reuse
 	// them in the future.
-	r.routeCacheMtx.Lock()
-	r.routeCache[rt] = validRoutes
-	r.routeCacheMtx.Unlock()
+	if useCache {
+		r.routeCacheMtx.Lock()
+		r.routeCache[rt] = validRoutes
+		r.routeCacheMtx.Unlock()
+	}
 
 	return validRoutes, nil
 }
@@ -2188,7 +2235,7 @@ func (r *ChannelRouter) FetchLightningNode(node Vertex) (*channeldb.LightningNod
 //
 // NOTE: This method is part of the ChannelGraphSource interface.
 func (r *ChannelRouter) ForEachNode(cb func(*channeldb.LightningNode) error) error {
-	return r.cfg.Graph.ForEachNode(nil, func(_ *bbolt.Tx, n *channeldb.LightningNode) error {
+	return r.cfg.Graph.ForEachNode(nil, func(_ kvdb.Tx, n *channeldb.LightningNode) error {
 		return cb(n)
 	})
 }
@@ -2200,7 +2247,7 @@ func (r *ChannelRouter) ForEachNode(cb func(*channeldb.LightningNode) error) err
 func (r *ChannelRouter) ForAllOutgoingChannels(cb func(*channeldb.ChannelEdgeInfo,
 	*channeldb.ChannelEdgePolicy) error) error {
 
-	return r.selfNode.ForEachChannel(nil, func(_ *bbolt.Tx, c *channeldb.ChannelEdgeInfo,
+	return r.selfNode.ForEachChannel(nil, func(_ kvdb.Tx, c *channeldb.ChannelEdgeInfo,
 		e, _ *channeldb.ChannelEdgePolicy) error {
 
 		if e == nil {
@@ -2256,12 +2303,17 @@ func (r *ChannelRouter) IsPublicNode(node Vertex) (bool, error) {
 }
 
 // IsKnownEdge returns true if the graph source already knows of the passed
-// channel ID.
+// channel ID either as a live or zombie edge.
 //
 // NOTE: This method is part of the ChannelGraphSource interface.
 func (r *ChannelRouter) IsKnownEdge(chanID lnwire.ShortChannelID) bool {
 	_, _, exists, _ := r.cfg.Graph.HasChannelEdge(chanID.ToUint64())
-	return exists
+	if exists {
+		return true
+	}
+
+	isZombie, _, _ := r.cfg.Graph.IsZombieEdge(chanID.ToUint64())
+	return isZombie
 }
 
 // IsStaleEdgePolicy returns true if the graph soruce has a channel edge for
@@ -2280,8 +2332,15 @@ func (r *ChannelRouter) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 	}
 
 	// If we don't know of the edge, then it means it's fresh (thus not
-	// stale).
+	// stale), unless it was pruned as a zombie. In that case, only an
+	// update that's more recent than our zombie horizon is able to revive
+	// it, as we'd otherwise prune it right away.
 	if !exists {
+		isZombie, _, _ := r.cfg.Graph.IsZombieEdge(chanID.ToUint64())
+		if isZombie {
+			return time.Since(timestamp) >= r.cfg.ChannelPruneExpiry
+		}
+
 		return false
 	}
 
@@ -2304,3 +2363,17 @@ func (r *ChannelRouter) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 
 	return false
 }
+
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live.
+//
+// NOTE: This method is part of the ChannelGraphSource interface.
+func (r *ChannelRouter) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
+	// The channel was added to our reject cache when it was pruned, so
+	// we'll remove it to ensure its announcement will be accepted once
+	// received again.
+	r.rejectMtx.Lock()
+	delete(r.rejectCache, chanID.ToUint64())
+	r.rejectMtx.Unlock()
+
+	return r.cfg.Graph.MarkEdgeLive(chanID.ToUint64())
+}
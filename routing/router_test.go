@@ -184,7 +184,7 @@ func TestFindRoutesFeeSorting(t *testing.T) {
 	target := ctx.aliases["luoji"]
 	routes, err := ctx.router.FindRoutes(
 		target, paymentAmt, noFeeLimit, defaultNumRoutes,
-		DefaultFinalCLTVDelta,
+		nil, DefaultFinalCLTVDelta,
 	)
 	if err != nil {
 		t.Fatalf("unable to find any routes: %v", err)
@@ -243,7 +243,7 @@ func TestFindRoutesWithFeeLimit(t *testing.T) {
 
 	routes, err := ctx.router.FindRoutes(
 		target, paymentAmt, feeLimit, defaultNumRoutes,
-		DefaultFinalCLTVDelta,
+		nil, DefaultFinalCLTVDelta,
 	)
 	if err != nil {
 		t.Fatalf("unable to find any routes: %v", err)
@@ -271,6 +271,86 @@ func TestFindRoutesWithFeeLimit(t *testing.T) {
 	}
 }
 
+// TestFindRoutesWithHopHints asserts that the FindRoutes method within the
+// channel router is able to find routes to a private destination that isn't
+// part of the graph when provided with a set of routing hints.
+func TestFindRoutesWithHopHints(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll create a new private node that isn't known to the graph. The
+	// only way to reach it is through a private channel with luo ji.
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate private key: %v", err)
+	}
+	target := privKey.PubKey()
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+
+	// Without any hints, the router shouldn't be able to find a route to
+	// the private node.
+	_, err = ctx.router.FindRoutes(
+		target, paymentAmt, noFeeLimit, defaultNumRoutes,
+		nil, DefaultFinalCLTVDelta,
+	)
+	if !IsError(err, ErrTargetNotInNetwork) {
+		t.Fatalf("expected ErrTargetNotInNetwork, instead got: %v",
+			err)
+	}
+
+	// Now, we'll provide a hint for the private channel between luo ji
+	// and the target.
+	const privateChanID = 1337
+	hopHint := HopHint{
+		NodeID:                    ctx.aliases["luoji"],
+		ChannelID:                 privateChanID,
+		FeeBaseMSat:               1000,
+		FeeProportionalMillionths: 0,
+		CLTVExpiryDelta:           144,
+	}
+	routes, err := ctx.router.FindRoutes(
+		target, paymentAmt, noFeeLimit, defaultNumRoutes,
+		[][]HopHint{{hopHint}}, DefaultFinalCLTVDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to find any routes: %v", err)
+	}
+
+	// There are two routes from roasbeef to luo ji within the graph, so
+	// we expect both of them to be extended by the private channel.
+	if len(routes) != 2 {
+		t.Fatalf("2 routes should've been selected, instead %v were: %v",
+			len(routes), spew.Sdump(routes))
+	}
+	for _, route := range routes {
+		lastHop := route.Hops[len(route.Hops)-1]
+		if lastHop.ChannelID != privateChanID {
+			t.Fatalf("expected last hop through channel %v, "+
+				"got %v", privateChanID, lastHop.ChannelID)
+		}
+		if !bytes.Equal(lastHop.PubKeyBytes[:],
+			target.SerializeCompressed()) {
+
+			t.Fatalf("expected route to terminate at the " +
+				"private node")
+		}
+
+		// The hint's base fee should be paid to luo ji.
+		if route.TotalFees < lnwire.MilliSatoshi(hopHint.FeeBaseMSat) {
+			t.Fatalf("route doesn't pay the hop hint's fee: %v",
+				spew.Sdump(route))
+		}
+	}
+}
+
 // TestSendPaymentRouteFailureFallback tests that when sending a payment, if
 // one of the target routes is seen as unavailable, then the next route in the
 // queue is used instead. This process should continue until either a payment
@@ -1220,7 +1300,7 @@ func TestAddEdgeUnknownVertexes(t *testing.T) {
 	targetNode := priv2.PubKey()
 	routes, err := ctx.router.FindRoutes(
 		targetNode, paymentAmt, noFeeLimit, defaultNumRoutes,
-		DefaultFinalCLTVDelta,
+		nil, DefaultFinalCLTVDelta,
 	)
 	if err != nil {
 		t.Fatalf("unable to find any routes: %v", err)
@@ -1265,7 +1345,7 @@ func TestAddEdgeUnknownVertexes(t *testing.T) {
 	// updated.
 	routes, err = ctx.router.FindRoutes(
 		targetNode, paymentAmt, noFeeLimit, defaultNumRoutes,
-		DefaultFinalCLTVDelta,
+		nil, DefaultFinalCLTVDelta,
 	)
 	if err != nil {
 		t.Fatalf("unable to find any routes: %v", err)
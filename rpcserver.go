@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
@@ -12,6 +13,7 @@ import (
 	"math"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -24,12 +26,15 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcwallet/waddrmgr"
-	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/lightningnetwork/lnd/accounting"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -66,6 +71,16 @@ var (
 
 	defaultAccount uint32 = waddrmgr.DefaultAccountNum
 
+	// errMacaroonDisabled is returned when macaroon related RPCs are
+	// called while lnd was started with --no-macaroons.
+	errMacaroonDisabled = fmt.Errorf("macaroon authentication disabled, " +
+		"remove --no-macaroons flag to enable")
+
+	// errAccountMacaroon is returned when a macaroon bound to a virtual
+	// account is used to manage accounts.
+	errAccountMacaroon = fmt.Errorf("macaroons bound to an account " +
+		"can't be used to manage accounts")
+
 	// readPermissions is a slice of all entities that allow read
 	// permissions for authorization purposes, all lowercase.
 	readPermissions = []bakery.Op{
@@ -97,6 +112,10 @@ var (
 			Entity: "invoices",
 			Action: "read",
 		},
+		{
+			Entity: "macaroon",
+			Action: "read",
+		},
 	}
 
 	// writePermissions is a slice of all entities that allow write
@@ -134,6 +153,22 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		},
+		{
+			Entity: "macaroon",
+			Action: "generate",
+		},
+		{
+			Entity: "macaroon",
+			Action: "write",
+		},
+
+		// Backups of the channel database contain all channel
+		// secrets, so we'll only grant them to admin macaroons rather
+		// than alongside the other read permissions.
+		{
+			Entity: "backup",
+			Action: "read",
+		},
 	}
 
 	// invoicePermissions is a slice of all the entities that allows a user
@@ -225,6 +260,10 @@ var (
 			Entity: "peers",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SubscribePeerEvents": {{
+			Entity: "peers",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/WalletBalance": {{
 			Entity: "onchain",
 			Action: "read",
@@ -245,6 +284,10 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SubscribeChannelEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -325,6 +368,14 @@ var (
 			Entity: "info",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/GetDBStats": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/BackupDatabase": {{
+			Entity: "backup",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/DecodePayReq": {{
 			Entity: "offchain",
 			Action: "read",
@@ -341,6 +392,57 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/AccountingReport": {{
+			Entity: "onchain",
+			Action: "read",
+		}, {
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/FeeDecisions": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/HtlcInterceptor": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeHtlcEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/BakeMacaroon": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
+		"/lnrpc.Lightning/ListMacaroonIDs": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/DeleteMacaroonID": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/CreateAccount": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListAccounts": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/FundAccount": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SendCustomMessage": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeCustomMessages": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 )
 
@@ -379,6 +481,10 @@ type rpcServer struct {
 	// connect to the main gRPC server to proxy all incoming requests.
 	tlsCfg *tls.Config
 
+	// macService is the macaroon service used to bake new macaroons and
+	// manage their root keys. It will be nil if macaroons are disabled.
+	macService *macaroons.Service
+
 	quit chan struct{}
 }
 
@@ -469,6 +575,7 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		tlsCfg:         tlsCfg,
 		grpcServer:     grpcServer,
 		server:         s,
+		macService:     macService,
 		quit:           make(chan struct{}, 1),
 	}
 	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
@@ -1770,6 +1877,14 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 		Peers: make([]*lnrpc.Peer, 0, len(serverPeers)),
 	}
 
+	// We'll report the connection history of each peer, which tracks
+	// their flaps and uptime.
+	peerHistories, err := r.server.chanDB.FetchPeerHistories()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
 	for _, serverPeer := range serverPeers {
 		var (
 			satSent int64
@@ -1787,6 +1902,24 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 			satRecv += int64(c.TotalMSatReceived.ToSatoshis())
 		}
 
+		// We'll also report the type of gossip sync we're currently
+		// performing with the peer, if any.
+		var lnrpcSyncType lnrpc.Peer_SyncType
+		syncType, ok := r.server.authGossiper.SyncManager().PeerSyncType(
+			routing.Vertex(serverPeer.pubKeyBytes),
+		)
+		if ok {
+			switch syncType {
+			case discovery.ActiveSync:
+				lnrpcSyncType = lnrpc.Peer_ACTIVE_SYNC
+			case discovery.PassiveSync:
+				lnrpcSyncType = lnrpc.Peer_PASSIVE_SYNC
+			default:
+				return nil, fmt.Errorf("unhandled sync type %v",
+					syncType)
+			}
+		}
+
 		nodePub := serverPeer.addr.IdentityKey.SerializeCompressed()
 		peer := &lnrpc.Peer{
 			PubKey:    hex.EncodeToString(nodePub),
@@ -1797,6 +1930,28 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 			SatSent:   satSent,
 			SatRecv:   satRecv,
 			PingTime:  serverPeer.PingTime(),
+			SyncType:  lnrpcSyncType,
+		}
+
+		// Finally, we'll report the gossip rate limiting statistics
+		// of the peer, if it has ever exceeded our rate limit.
+		gossipStats, ok := r.server.authGossiper.PeerGossipStats(
+			routing.Vertex(serverPeer.pubKeyBytes),
+		)
+		if ok {
+			peer.NumRateLimitedUpdates = gossipStats.NumRateLimitedUpdates
+			peer.GossipBanScore = gossipStats.BanScore
+			if gossipStats.Banned(time.Now()) {
+				peer.GossipBannedUntil =
+					gossipStats.BannedUntil.Unix()
+			}
+		}
+
+		if history, ok := peerHistories[serverPeer.pubKeyBytes]; ok {
+			peer.FlapCount = history.FlapCount
+			peer.LastFlapNs = history.LastFlap.UnixNano()
+			peer.Uptime = int64(history.TotalUptime(now).Seconds())
+			peer.Lifetime = int64(history.Lifetime(now).Seconds())
 		}
 
 		resp.Peers = append(resp.Peers, peer)
@@ -2100,62 +2255,78 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 			continue
 		}
 
-		nodePub := dbChannel.RemotePub
-		nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
-
-		var closeType lnrpc.ChannelCloseSummary_ClosureType
 		switch dbChannel.CloseType {
 		case channeldb.CooperativeClose:
 			if filterResults && !in.Cooperative {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE
 		case channeldb.LocalForceClose:
 			if filterResults && !in.LocalForce {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE
 		case channeldb.RemoteForceClose:
 			if filterResults && !in.RemoteForce {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE
 		case channeldb.BreachClose:
 			if filterResults && !in.Breach {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_BREACH_CLOSE
 		case channeldb.FundingCanceled:
 			if filterResults && !in.FundingCanceled {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_FUNDING_CANCELED
 		case channeldb.Abandoned:
 			if filterResults && !in.Abandoned {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_ABANDONED
-		}
-
-		channel := &lnrpc.ChannelCloseSummary{
-			Capacity:          int64(dbChannel.Capacity),
-			RemotePubkey:      nodeID,
-			CloseHeight:       dbChannel.CloseHeight,
-			CloseType:         closeType,
-			ChannelPoint:      dbChannel.ChanPoint.String(),
-			ChanId:            dbChannel.ShortChanID.ToUint64(),
-			SettledBalance:    int64(dbChannel.SettledBalance),
-			TimeLockedBalance: int64(dbChannel.TimeLockedBalance),
-			ChainHash:         dbChannel.ChainHash.String(),
-			ClosingTxHash:     dbChannel.ClosingTXID.String(),
 		}
 
+		channel := createRPCClosedChannel(dbChannel)
 		resp.Channels = append(resp.Channels, channel)
 	}
 
 	return resp, nil
 }
 
+// createRPCClosedChannel creates an *lnrpc.ChannelCloseSummary from the close
+// summary of a channel stored within the database.
+func createRPCClosedChannel(
+	dbChannel *channeldb.ChannelCloseSummary) *lnrpc.ChannelCloseSummary {
+
+	nodePub := dbChannel.RemotePub
+	nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
+
+	var closeType lnrpc.ChannelCloseSummary_ClosureType
+	switch dbChannel.CloseType {
+	case channeldb.CooperativeClose:
+		closeType = lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE
+	case channeldb.LocalForceClose:
+		closeType = lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE
+	case channeldb.RemoteForceClose:
+		closeType = lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE
+	case channeldb.BreachClose:
+		closeType = lnrpc.ChannelCloseSummary_BREACH_CLOSE
+	case channeldb.FundingCanceled:
+		closeType = lnrpc.ChannelCloseSummary_FUNDING_CANCELED
+	case channeldb.Abandoned:
+		closeType = lnrpc.ChannelCloseSummary_ABANDONED
+	}
+
+	return &lnrpc.ChannelCloseSummary{
+		Capacity:          int64(dbChannel.Capacity),
+		RemotePubkey:      nodeID,
+		CloseHeight:       dbChannel.CloseHeight,
+		CloseType:         closeType,
+		ChannelPoint:      dbChannel.ChanPoint.String(),
+		ChanId:            dbChannel.ShortChanID.ToUint64(),
+		SettledBalance:    int64(dbChannel.SettledBalance),
+		TimeLockedBalance: int64(dbChannel.TimeLockedBalance),
+		ChainHash:         dbChannel.ChainHash.String(),
+		ClosingTxHash:     dbChannel.ClosingTXID.String(),
+	}
+}
+
 // ListChannels returns a description of all the open channels that this node
 // is a participant in.
 func (r *rpcServer) ListChannels(ctx context.Context,
@@ -2173,8 +2344,6 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 
 	resp := &lnrpc.ListChannelsResponse{}
 
-	graph := r.server.chanDB.ChannelGraph()
-
 	dbChannels, err := r.server.chanDB.FetchAllOpenChannels()
 	if err != nil {
 		return nil, err
@@ -2183,16 +2352,18 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 	rpcsLog.Infof("[listchannels] fetched %v channels from DB",
 		len(dbChannels))
 
+	// The uptime of each channel is that of its peer, as tracked within
+	// the peer's connection history.
+	peerHistories, err := r.server.chanDB.FetchPeerHistories()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
 	for _, dbChannel := range dbChannels {
 		nodePub := dbChannel.IdentityPub
-		nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
 		chanPoint := dbChannel.FundingOutpoint
 
-		// With the channel point known, retrieve the network channel
-		// ID from the database.
-		var chanID uint64
-		chanID, _ = graph.ChannelID(&chanPoint)
-
 		var peerOnline bool
 		if _, err := r.server.FindPeer(nodePub); err == nil {
 			peerOnline = true
@@ -2225,60 +2396,13 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 			continue
 		}
 
-		// As this is required for display purposes, we'll calculate
-		// the weight of the commitment transaction. We also add on the
-		// estimated weight of the witness to calculate the weight of
-		// the transaction if it were to be immediately unilaterally
-		// broadcast.
-		localCommit := dbChannel.LocalCommitment
-		utx := btcutil.NewTx(localCommit.CommitTx)
-		commitBaseWeight := blockchain.GetTransactionWeight(utx)
-		commitWeight := commitBaseWeight + lnwallet.WitnessCommitmentTxWeight
+		channel := r.createRPCOpenChannel(dbChannel, isActive)
 
-		localBalance := localCommit.LocalBalance
-		remoteBalance := localCommit.RemoteBalance
-
-		// As an artifact of our usage of mSAT internally, either party
-		// may end up in a state where they're holding a fractional
-		// amount of satoshis which can't be expressed within the
-		// actual commitment output. Since we round down when going
-		// from mSAT -> SAT, we may at any point be adding an
-		// additional SAT to miners fees. As a result, we display a
-		// commitment fee that accounts for this externally.
-		var sumOutputs btcutil.Amount
-		for _, txOut := range localCommit.CommitTx.TxOut {
-			sumOutputs += btcutil.Amount(txOut.Value)
-		}
-		externalCommitFee := dbChannel.Capacity - sumOutputs
-
-		channel := &lnrpc.Channel{
-			Active:                isActive,
-			Private:               !isPublic,
-			RemotePubkey:          nodeID,
-			ChannelPoint:          chanPoint.String(),
-			ChanId:                chanID,
-			Capacity:              int64(dbChannel.Capacity),
-			LocalBalance:          int64(localBalance.ToSatoshis()),
-			RemoteBalance:         int64(remoteBalance.ToSatoshis()),
-			CommitFee:             int64(externalCommitFee),
-			CommitWeight:          commitWeight,
-			FeePerKw:              int64(localCommit.FeePerKw),
-			TotalSatoshisSent:     int64(dbChannel.TotalMSatSent.ToSatoshis()),
-			TotalSatoshisReceived: int64(dbChannel.TotalMSatReceived.ToSatoshis()),
-			NumUpdates:            localCommit.CommitHeight,
-			PendingHtlcs:          make([]*lnrpc.HTLC, len(localCommit.Htlcs)),
-			CsvDelay:              uint32(dbChannel.LocalChanCfg.CsvDelay),
-		}
-
-		for i, htlc := range localCommit.Htlcs {
-			var rHash [32]byte
-			copy(rHash[:], htlc.RHash[:])
-			channel.PendingHtlcs[i] = &lnrpc.HTLC{
-				Incoming:         htlc.Incoming,
-				Amount:           int64(htlc.Amt.ToSatoshis()),
-				HashLock:         rHash[:],
-				ExpirationHeight: htlc.RefundTimeout,
-			}
+		var peerKey [33]byte
+		copy(peerKey[:], nodePub.SerializeCompressed())
+		if history, ok := peerHistories[peerKey]; ok {
+			channel.Uptime = int64(history.TotalUptime(now).Seconds())
+			channel.Lifetime = int64(history.Lifetime(now).Seconds())
 		}
 
 		resp.Channels = append(resp.Channels, channel)
@@ -2287,6 +2411,198 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 	return resp, nil
 }
 
+// createRPCOpenChannel creates an *lnrpc.Channel from the given open channel
+// stored within the database.
+func (r *rpcServer) createRPCOpenChannel(dbChannel *channeldb.OpenChannel,
+	isActive bool) *lnrpc.Channel {
+
+	nodePub := dbChannel.IdentityPub
+	nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
+	chanPoint := dbChannel.FundingOutpoint
+	isPublic := dbChannel.ChannelFlags&lnwire.FFAnnounceChannel != 0
+
+	// With the channel point known, retrieve the network channel ID from
+	// the database.
+	var chanID uint64
+	chanID, _ = r.server.chanDB.ChannelGraph().ChannelID(&chanPoint)
+
+	// As this is required for display purposes, we'll calculate
+	// the weight of the commitment transaction. We also add on the
+	// estimated weight of the witness to calculate the weight of
+	// the transaction if it were to be immediately unilaterally
+	// broadcast.
+	localCommit := dbChannel.LocalCommitment
+	utx := btcutil.NewTx(localCommit.CommitTx)
+	commitBaseWeight := blockchain.GetTransactionWeight(utx)
+	commitWeight := commitBaseWeight + lnwallet.WitnessCommitmentTxWeight
+
+	localBalance := localCommit.LocalBalance
+	remoteBalance := localCommit.RemoteBalance
+
+	// As an artifact of our usage of mSAT internally, either party
+	// may end up in a state where they're holding a fractional
+	// amount of satoshis which can't be expressed within the
+	// actual commitment output. Since we round down when going
+	// from mSAT -> SAT, we may at any point be adding an
+	// additional SAT to miners fees. As a result, we display a
+	// commitment fee that accounts for this externally.
+	var sumOutputs btcutil.Amount
+	for _, txOut := range localCommit.CommitTx.TxOut {
+		sumOutputs += btcutil.Amount(txOut.Value)
+	}
+	externalCommitFee := dbChannel.Capacity - sumOutputs
+
+	channel := &lnrpc.Channel{
+		Active:                isActive,
+		Private:               !isPublic,
+		RemotePubkey:          nodeID,
+		ChannelPoint:          chanPoint.String(),
+		ChanId:                chanID,
+		Capacity:              int64(dbChannel.Capacity),
+		LocalBalance:          int64(localBalance.ToSatoshis()),
+		RemoteBalance:         int64(remoteBalance.ToSatoshis()),
+		CommitFee:             int64(externalCommitFee),
+		CommitWeight:          commitWeight,
+		FeePerKw:              int64(localCommit.FeePerKw),
+		TotalSatoshisSent:     int64(dbChannel.TotalMSatSent.ToSatoshis()),
+		TotalSatoshisReceived: int64(dbChannel.TotalMSatReceived.ToSatoshis()),
+		NumUpdates:            localCommit.CommitHeight,
+		PendingHtlcs:          make([]*lnrpc.HTLC, len(localCommit.Htlcs)),
+		CsvDelay:              uint32(dbChannel.LocalChanCfg.CsvDelay),
+	}
+
+	for i, htlc := range localCommit.Htlcs {
+		var rHash [32]byte
+		copy(rHash[:], htlc.RHash[:])
+		channel.PendingHtlcs[i] = &lnrpc.HTLC{
+			Incoming:         htlc.Incoming,
+			Amount:           int64(htlc.Amt.ToSatoshis()),
+			HashLock:         rHash[:],
+			ExpirationHeight: htlc.RefundTimeout,
+		}
+	}
+
+	return channel
+}
+
+// SubscribeChannelEvents returns a uni-directional stream (server -> client)
+// for notifying the client of changes to the state of our channels. Events
+// include new pending, open, active, inactive, closed and fully resolved
+// channels.
+func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
+	updateStream lnrpc.Lightning_SubscribeChannelEventsServer) error {
+
+	channelEventSub, err := r.server.channelNotifier.SubscribeChannelEvents()
+	if err != nil {
+		return err
+	}
+	defer channelEventSub.Cancel()
+
+	for {
+		select {
+		// A new update has been sent by the channel notifier, we'll
+		// marshal it into the form expected by the gRPC client, then
+		// send it off to the client.
+		case e := <-channelEventSub.Updates():
+			var update *lnrpc.ChannelEventUpdate
+			switch event := e.(type) {
+			case *channelnotifier.PendingOpenChannelEvent:
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_PENDING_OPEN_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_PendingOpenChannel{
+						PendingOpenChannel: &lnrpc.PendingUpdate{
+							Txid:        event.ChannelPoint.Hash[:],
+							OutputIndex: event.ChannelPoint.Index,
+						},
+					},
+				}
+
+			case *channelnotifier.OpenChannelEvent:
+				channel := r.createRPCOpenChannel(
+					event.Channel, false,
+				)
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_OPEN_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_OpenChannel{
+						OpenChannel: channel,
+					},
+				}
+
+			case *channelnotifier.ActiveChannelEvent:
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_ACTIVE_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_ActiveChannel{
+						ActiveChannel: marshallChannelPoint(
+							event.ChannelPoint,
+						),
+					},
+				}
+
+			case *channelnotifier.InactiveChannelEvent:
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_INACTIVE_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_InactiveChannel{
+						InactiveChannel: marshallChannelPoint(
+							event.ChannelPoint,
+						),
+					},
+				}
+
+			case *channelnotifier.ClosedChannelEvent:
+				closedChannel := createRPCClosedChannel(
+					event.CloseSummary,
+				)
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_CLOSED_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_ClosedChannel{
+						ClosedChannel: closedChannel,
+					},
+				}
+
+			case *channelnotifier.FullyResolvedChannelEvent:
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_FULLY_RESOLVED_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_FullyResolvedChannel{
+						FullyResolvedChannel: marshallChannelPoint(
+							event.ChannelPoint,
+						),
+					},
+				}
+
+			default:
+				return fmt.Errorf("unexpected channel event update: %v",
+					event)
+			}
+
+			if err := updateStream.Send(update); err != nil {
+				return err
+			}
+
+		case <-channelEventSub.Quit():
+			return ErrServerShuttingDown
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallChannelPoint converts a channel outpoint into the form expected by
+// the gRPC service.
+func marshallChannelPoint(chanPoint *wire.OutPoint) *lnrpc.ChannelPoint {
+	return &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: chanPoint.Hash[:],
+		},
+		OutputIndex: chanPoint.Index,
+	}
+}
+
 // savePayment saves a successfully completed payment to the database for
 // historical record keeping.
 func (r *rpcServer) savePayment(route *routing.Route,
@@ -2342,19 +2658,46 @@ type rpcPaymentRequest struct {
 	routes []*routing.Route
 }
 
+// maxFeeLimit is the maximum fee limit of a payment, which is the total
+// supply of bitcoin. Fee limits are capped at this value so that adding them
+// to the payment amount can't overflow.
+var maxFeeLimit = lnwire.NewMSatFromSatoshis(btcutil.MaxSatoshi)
+
 // calculateFeeLimit returns the fee limit in millisatoshis. If a percentage
 // based fee limit has been requested, we'll factor in the ratio provided with
-// the amount of the payment.
+// the amount of the payment. Negative fee limits are treated as zero, and fee
+// limits are capped at maxFeeLimit.
 func calculateFeeLimit(feeLimit *lnrpc.FeeLimit,
 	amount lnwire.MilliSatoshi) lnwire.MilliSatoshi {
 
 	switch feeLimit.GetLimit().(type) {
 	case *lnrpc.FeeLimit_Fixed:
-		return lnwire.NewMSatFromSatoshis(
-			btcutil.Amount(feeLimit.GetFixed()),
-		)
+		fixed := btcutil.Amount(feeLimit.GetFixed())
+		switch {
+		case fixed < 0:
+			return 0
+		case fixed > btcutil.MaxSatoshi:
+			return maxFeeLimit
+		}
+
+		return lnwire.NewMSatFromSatoshis(fixed)
+
 	case *lnrpc.FeeLimit_Percent:
-		return amount * lnwire.MilliSatoshi(feeLimit.GetPercent()) / 100
+		percent := feeLimit.GetPercent()
+		switch {
+		case percent <= 0:
+			return 0
+		case uint64(amount) > math.MaxUint64/uint64(percent):
+			return maxFeeLimit
+		}
+
+		limit := amount * lnwire.MilliSatoshi(percent) / 100
+		if limit > maxFeeLimit {
+			return maxFeeLimit
+		}
+
+		return limit
+
 	default:
 		// If a fee limit was not specified, we'll use the payment's
 		// amount as an upper bound in order to avoid payment attempts
@@ -2370,7 +2713,7 @@ func calculateFeeLimit(feeLimit *lnrpc.FeeLimit,
 func (r *rpcServer) SendPayment(stream lnrpc.Lightning_SendPaymentServer) error {
 	var lock sync.Mutex
 
-	return r.sendPayment(&paymentStream{
+	return r.sendPayment(stream.Context(), &paymentStream{
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
@@ -2398,7 +2741,7 @@ func (r *rpcServer) SendPayment(stream lnrpc.Lightning_SendPaymentServer) error
 func (r *rpcServer) SendToRoute(stream lnrpc.Lightning_SendToRouteServer) error {
 	var lock sync.Mutex
 
-	return r.sendPayment(&paymentStream{
+	return r.sendPayment(stream.Context(), &paymentStream{
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
@@ -2609,14 +2952,115 @@ type paymentIntentResponse struct {
 	Err      error
 }
 
+// macaroonAccount returns the ID of the virtual account the macaroon used to
+// make the call is bound to. The returned boolean is false if the macaroon
+// isn't bound to any account, or macaroons are disabled.
+func (r *rpcServer) macaroonAccount(ctx context.Context) (uint64, bool, error) {
+	if r.macService == nil {
+		return 0, false, nil
+	}
+
+	return macaroons.AccountFromContext(ctx)
+}
+
+// authorizePayment ensures that the payment intent is allowed by the caveats
+// of the macaroon used to make the call, reserving its maximum cost from any
+// spending budgets the macaroon is restricted by, and from the balance of the
+// account it is bound to. The returned function must be called with the
+// amount actually spent once the payment has completed.
+func (r *rpcServer) authorizePayment(ctx context.Context,
+	payIntent *rpcPaymentIntent) (func(spent uint64) error, error) {
+
+	// If macaroons are disabled, payments are unrestricted.
+	if r.macService == nil {
+		return func(uint64) error { return nil }, nil
+	}
+
+	// The payment amount is checked against any maximum payment amount,
+	// while its maximum cost including fees is reserved from any budgets.
+	amt := payIntent.msat
+	maxSpend := payIntent.msat + payIntent.feeLimit
+	if maxSpend < payIntent.msat {
+		return nil, fmt.Errorf("payment amount of %v with fee limit "+
+			"of %v overflows", payIntent.msat, payIntent.feeLimit)
+	}
+	if len(payIntent.routes) > 0 {
+		amt, maxSpend = 0, 0
+		for _, route := range payIntent.routes {
+			if route.TotalAmount-route.TotalFees > amt {
+				amt = route.TotalAmount - route.TotalFees
+			}
+			if route.TotalAmount > maxSpend {
+				maxSpend = route.TotalAmount
+			}
+		}
+	}
+
+	accountID, hasAccount, err := r.macaroonAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settleBudgets, err := r.macService.AuthorizePayment(
+		ctx, uint64(amt), uint64(maxSpend),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAccount {
+		return settleBudgets, nil
+	}
+
+	// The maximum cost of the payment is debited from the account up
+	// front, such that concurrent payments can't overdraw it. Once the
+	// payment has completed, the amount not spent is credited back, while
+	// any amount spent in excess of it is debited as well.
+	_, err = r.server.chanDB.DebitAccount(accountID, maxSpend)
+	if err != nil {
+		if err := settleBudgets(0); err != nil {
+			rpcsLog.Errorf("Unable to release macaroon spending "+
+				"reservation: %v", err)
+		}
+		return nil, err
+	}
+
+	return func(spent uint64) error {
+		var err error
+		switch {
+		case spent < uint64(maxSpend):
+			_, err = r.server.chanDB.CreditAccount(
+				accountID, maxSpend-lnwire.MilliSatoshi(spent),
+			)
+		case spent > uint64(maxSpend):
+			_, err = r.server.chanDB.DebitAccount(
+				accountID, lnwire.MilliSatoshi(spent)-maxSpend,
+			)
+		}
+		if err != nil {
+			return err
+		}
+
+		return settleBudgets(spent)
+	}, nil
+}
+
 // dispatchPaymentIntent attempts to fully dispatch an RPC payment intent.
 // We'll either pass the payment as a whole to the channel router, or give it a
 // pre-built route. The first error this method returns denotes if we were
 // unable to save the payment. The second error returned denotes if the payment
 // didn't succeed.
-func (r *rpcServer) dispatchPaymentIntent(
+func (r *rpcServer) dispatchPaymentIntent(ctx context.Context,
 	payIntent *rpcPaymentIntent) (*paymentIntentResponse, error) {
 
+	// Before dispatching the payment, we'll make sure the macaroon used
+	// to make the call allows it.
+	settleSpend, err := r.authorizePayment(ctx, payIntent)
+	if err != nil {
+		return &paymentIntentResponse{
+			Err: err,
+		}, nil
+	}
+
 	// Construct a payment request to send to the channel router. If the
 	// payment is successful, the route chosen will be returned. Otherwise,
 	// we'll get a non-nil error.
@@ -2657,8 +3101,14 @@ func (r *rpcServer) dispatchPaymentIntent(
 	}
 
 	// If the route failed, then we'll return a nil save err, but a non-nil
-	// routing err.
+	// routing err. As nothing was spent, the amount reserved from the
+	// macaroon's spending budgets and account is released.
 	if routerErr != nil {
+		if err := settleSpend(0); err != nil {
+			rpcsLog.Errorf("Unable to release macaroon spending "+
+				"reservation: %v", err)
+		}
+
 		return &paymentIntentResponse{
 			Err: routerErr,
 		}, nil
@@ -2673,9 +3123,16 @@ func (r *rpcServer) dispatchPaymentIntent(
 		amt = payIntent.msat
 	}
 
+	// Only the amount actually spent, including fees, is counted towards
+	// the macaroon's spending budgets and debited from its account.
+	if err := settleSpend(uint64(route.TotalAmount)); err != nil {
+		rpcsLog.Errorf("Unable to settle macaroon spending "+
+			"reservation: %v", err)
+	}
+
 	// Save the completed payment to the database for record keeping
 	// purposes.
-	err := r.savePayment(route, amt, preImage[:])
+	err = r.savePayment(route, amt, preImage[:])
 	if err != nil {
 		// We weren't able to save the payment, so we return the save
 		// err, but a nil routing err.
@@ -2693,7 +3150,9 @@ func (r *rpcServer) dispatchPaymentIntent(
 // the write end of the stream. Responses will also be streamed back to the
 // client via the write end of the stream. This method is by both SendToRoute
 // and SendPayment as the logic is virtually identical.
-func (r *rpcServer) sendPayment(stream *paymentStream) error {
+func (r *rpcServer) sendPayment(ctx context.Context,
+	stream *paymentStream) error {
+
 	payChan := make(chan *rpcPaymentIntent)
 	errChan := make(chan error, 1)
 
@@ -2804,7 +3263,7 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 				}()
 
 				resp, saveErr := r.dispatchPaymentIntent(
-					payIntent,
+					ctx, payIntent,
 				)
 
 				switch {
@@ -2908,7 +3367,7 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 
 	// With the payment validated, we'll now attempt to dispatch the
 	// payment.
-	resp, saveErr := r.dispatchPaymentIntent(&payIntent)
+	resp, saveErr := r.dispatchPaymentIntent(ctx, &payIntent)
 	switch {
 	case saveErr != nil:
 		return nil, saveErr
@@ -2933,6 +3392,18 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 func (r *rpcServer) AddInvoice(ctx context.Context,
 	invoice *lnrpc.Invoice) (*lnrpc.AddInvoiceResponse, error) {
 
+	// We'll make sure the account the macaroon used to make the call is
+	// bound to exists before creating the invoice on its behalf.
+	accountID, hasAccount, err := r.macaroonAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hasAccount {
+		if _, err := r.server.chanDB.FetchAccount(accountID); err != nil {
+			return nil, err
+		}
+	}
+
 	var paymentPreimage [32]byte
 
 	switch {
@@ -3004,20 +3475,58 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		options = append(options, zpay32.Amount(amtMSat))
 	}
 
-	// If specified, add a fallback address to the payment request.
-	if len(invoice.FallbackAddr) > 0 {
+	// Depending on the fallback address policy, we'll either add the
+	// provided fallback address to the payment request, or a new address
+	// from our wallet.
+	var fallbackAddr btcutil.Address
+	switch invoice.FallbackAddrPolicy {
+	case lnrpc.Invoice_FALLBACK_ADDR_PROVIDED:
+		if len(invoice.FallbackAddr) == 0 {
+			break
+		}
+
 		addr, err := btcutil.DecodeAddress(invoice.FallbackAddr,
 			activeNetParams.Params)
 		if err != nil {
 			return nil, fmt.Errorf("invalid fallback address: %v",
 				err)
 		}
-		options = append(options, zpay32.FallbackAddr(addr))
+		fallbackAddr = addr
+
+	case lnrpc.Invoice_FALLBACK_ADDR_NEW_WITNESS_PUBKEY_HASH,
+		lnrpc.Invoice_FALLBACK_ADDR_NEW_NESTED_PUBKEY_HASH:
+
+		if len(invoice.FallbackAddr) > 0 {
+			return nil, fmt.Errorf("fallback address cannot be " +
+				"provided when requesting a new one")
+		}
+
+		addrType := lnwallet.WitnessPubKey
+		if invoice.FallbackAddrPolicy ==
+			lnrpc.Invoice_FALLBACK_ADDR_NEW_NESTED_PUBKEY_HASH {
+
+			addrType = lnwallet.NestedWitnessPubKey
+		}
+
+		addr, err := r.server.cc.wallet.NewAddress(addrType, false)
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate fallback "+
+				"address: %v", err)
+		}
+		fallbackAddr = addr
+
+	default:
+		return nil, fmt.Errorf("unknown fallback address policy: %v",
+			invoice.FallbackAddrPolicy)
+	}
+	if fallbackAddr != nil {
+		options = append(options, zpay32.FallbackAddr(fallbackAddr))
 	}
 
 	// If expiry is set, specify it. If it is not provided, no expiry time
 	// will be explicitly added to this payment request, which will imply
 	// the default 3600 seconds.
+	expiry := zpay32.DefaultInvoiceExpiry
 	if invoice.Expiry > 0 {
 
 		// We'll ensure that the specified expiry is restricted to sane
@@ -3032,7 +3541,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 				float64(expSeconds), maxExpiry.Seconds())
 		}
 
-		expiry := time.Duration(invoice.Expiry) * time.Second
+		expiry = time.Duration(invoice.Expiry) * time.Second
 		options = append(options, zpay32.Expiry(expiry))
 	}
 
@@ -3050,20 +3559,38 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 
 	// We'll use our current default CLTV value unless one was specified as
 	// an option on the command line when creating an invoice.
+	var finalCltvDelta uint64
 	switch {
 	case invoice.CltvExpiry > math.MaxUint16:
 		return nil, fmt.Errorf("CLTV delta of %v is too large, max "+
 			"accepted is: %v", invoice.CltvExpiry, math.MaxUint16)
 	case invoice.CltvExpiry != 0:
-		options = append(options,
-			zpay32.CLTVExpiry(invoice.CltvExpiry))
+		finalCltvDelta = invoice.CltvExpiry
 	default:
 		// TODO(roasbeef): assumes set delta between versions
 		defaultDelta := cfg.Bitcoin.TimeLockDelta
 		if registeredChains.PrimaryChain() == litecoinChain {
 			defaultDelta = cfg.Litecoin.TimeLockDelta
 		}
-		options = append(options, zpay32.CLTVExpiry(uint64(defaultDelta)))
+		finalCltvDelta = uint64(defaultDelta)
+	}
+	options = append(options, zpay32.CLTVExpiry(finalCltvDelta))
+
+	// Any route hints provided by the caller are included as is.
+	routeHints, err := unmarshallRouteHints(invoice.RouteHints)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route hints: %v", err)
+	}
+
+	// If the caller restricted the channels considered for route hints,
+	// we'll only select hints among those.
+	if len(invoice.HintChanIds) > 0 && !invoice.Private {
+		return nil, fmt.Errorf("hint channels can only be specified " +
+			"for private invoices")
+	}
+	hintChans := make(map[uint64]struct{}, len(invoice.HintChanIds))
+	for _, chanID := range invoice.HintChanIds {
+		hintChans[chanID] = struct{}{}
 	}
 
 	// If we were requested to include routing hints in the invoice, then
@@ -3092,6 +3619,13 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 				continue
 			}
 
+			// Skip any channel that isn't among the channels the
+			// caller wishes to be hinted, if restricted.
+			chanID := channel.ShortChanID().ToUint64()
+			if _, ok := hintChans[chanID]; len(hintChans) > 0 && !ok {
+				continue
+			}
+
 			// Make sure the counterparty has enough balance in the
 			// channel for our amount. We do this in order to reduce
 			// payment errors when attempting to use this channel
@@ -3144,7 +3678,6 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 			}
 
 			// Fetch the policies for each end of the channel.
-			chanID := channel.ShortChanID().ToUint64()
 			info, p1, p2, err := graph.FetchChannelEdgesByID(chanID)
 			if err != nil {
 				rpcsLog.Errorf("Unable to fetch the routing "+
@@ -3181,16 +3714,19 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 				CLTVExpiryDelta: remotePolicy.TimeLockDelta,
 			}
 
-			// Include the route hint in our set of options that
-			// will be used when creating the invoice.
-			routeHint := []routing.HopHint{hint}
-			options = append(options, zpay32.RouteHint(routeHint))
+			// Include the route hint in our set of route hints
+			// that will be used when creating the invoice.
+			routeHints = append(routeHints, []routing.HopHint{hint})
 
 			numHints++
 		}
 
 	}
 
+	for _, routeHint := range routeHints {
+		options = append(options, zpay32.RouteHint(routeHint))
+	}
+
 	// Create and encode the payment request as a bech32 (zpay32) string.
 	creationDate := time.Now()
 	payReq, err := zpay32.NewInvoice(
@@ -3209,7 +3745,30 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		return nil, err
 	}
 
-	newInvoice := &channeldb.Invoice{
+	// We'll store the parameters we encoded within the payment request
+	// along side the invoice.
+	params := &channeldb.PaymentRequestParams{
+		FinalCltvDelta: uint32(finalCltvDelta),
+		Expiry:         expiry,
+	}
+	if fallbackAddr != nil {
+		params.FallbackAddr = fallbackAddr.String()
+	}
+	for _, routeHint := range routeHints {
+		hopHints := make([]channeldb.HopHint, 0, len(routeHint))
+		for _, h := range routeHint {
+			hopHints = append(hopHints, channeldb.HopHint{
+				NodeID:                    h.NodeID,
+				ChannelID:                 h.ChannelID,
+				FeeBaseMSat:               h.FeeBaseMSat,
+				FeeProportionalMillionths: h.FeeProportionalMillionths,
+				CLTVExpiryDelta:           h.CLTVExpiryDelta,
+			})
+		}
+		params.RouteHints = append(params.RouteHints, hopHints)
+	}
+
+	newInvoice := &channeldb.Invoice{
 		CreationDate:   creationDate,
 		Memo:           []byte(invoice.Memo),
 		Receipt:        invoice.Receipt,
@@ -3217,6 +3776,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		Terms: channeldb.ContractTerm{
 			Value: amtMSat,
 		},
+		Params: params,
 	}
 	copy(newInvoice.Terms.PaymentPreimage[:], paymentPreimage[:])
 
@@ -3232,6 +3792,17 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		return nil, err
 	}
 
+	// If the macaroon used to make the call is bound to an account, the
+	// invoice is associated with it, such that the account is credited
+	// once the invoice is settled. The payment request is only returned
+	// after this succeeds, so the invoice can't be paid before.
+	if hasAccount {
+		err := r.server.chanDB.AddAccountInvoice(accountID, rHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &lnrpc.AddInvoiceResponse{
 		RHash:          rHash[:],
 		PaymentRequest: payReqString,
@@ -3277,6 +3848,19 @@ func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 	satAmt := invoice.Terms.Value.ToSatoshis()
 	satAmtPaid := invoice.AmtPaid.ToSatoshis()
 
+	var state lnrpc.Invoice_InvoiceState
+	switch invoice.Terms.State {
+	case channeldb.ContractOpen:
+		state = lnrpc.Invoice_OPEN
+	case channeldb.ContractSettled:
+		state = lnrpc.Invoice_SETTLED
+	case channeldb.ContractCanceled:
+		state = lnrpc.Invoice_CANCELED
+	default:
+		return nil, fmt.Errorf("unknown invoice state %v",
+			invoice.Terms.State)
+	}
+
 	return &lnrpc.Invoice{
 		Memo:            string(invoice.Memo[:]),
 		Receipt:         invoice.Receipt[:],
@@ -3285,7 +3869,7 @@ func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 		Value:           int64(satAmt),
 		CreationDate:    invoice.CreationDate.Unix(),
 		SettleDate:      settleDate,
-		Settled:         invoice.Terms.Settled,
+		Settled:         invoice.Terms.State == channeldb.ContractSettled,
 		PaymentRequest:  paymentRequest,
 		DescriptionHash: descHash,
 		Expiry:          expiry,
@@ -3298,6 +3882,7 @@ func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 		AmtPaidSat:      int64(satAmtPaid),
 		AmtPaidMsat:     int64(invoice.AmtPaid),
 		AmtPaid:         int64(invoice.AmtPaid),
+		State:           state,
 	}, nil
 }
 
@@ -3331,6 +3916,41 @@ func createRPCRouteHints(routeHints [][]routing.HopHint) []*lnrpc.RouteHint {
 	return res
 }
 
+// unmarshallRouteHints takes in a set of route hints in their lnrpc form and
+// converts them into the type expected by the router.
+func unmarshallRouteHints(rpcHints []*lnrpc.RouteHint) ([][]routing.HopHint,
+	error) {
+
+	routeHints := make([][]routing.HopHint, 0, len(rpcHints))
+	for _, rpcHint := range rpcHints {
+		routeHint := make([]routing.HopHint, 0, len(rpcHint.HopHints))
+		for _, rpcHop := range rpcHint.HopHints {
+			pubKeyBytes, err := hex.DecodeString(rpcHop.NodeId)
+			if err != nil {
+				return nil, err
+			}
+			pubKey, err := btcec.ParsePubKey(
+				pubKeyBytes, btcec.S256(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			routeHint = append(routeHint, routing.HopHint{
+				NodeID:                    pubKey,
+				ChannelID:                 rpcHop.ChanId,
+				FeeBaseMSat:               rpcHop.FeeBaseMsat,
+				FeeProportionalMillionths: rpcHop.FeeProportionalMillionths,
+				CLTVExpiryDelta:           uint16(rpcHop.CltvExpiryDelta),
+			})
+		}
+
+		routeHints = append(routeHints, routeHint)
+	}
+
+	return routeHints, nil
+}
+
 // LookupInvoice attempts to look up an invoice according to its payment hash.
 // The passed payment hash *must* be exactly 32 bytes, if not an error is
 // returned.
@@ -3423,7 +4043,7 @@ func (r *rpcServer) ListInvoices(ctx context.Context,
 }
 
 // SubscribeInvoices returns a uni-directional stream (server -> client) for
-// notifying the client of newly added/settled invoices.
+// notifying the client of newly added/settled/canceled invoices.
 func (r *rpcServer) SubscribeInvoices(req *lnrpc.InvoiceSubscription,
 	updateStream lnrpc.Lightning_SubscribeInvoicesServer) error {
 
@@ -3454,6 +4074,16 @@ func (r *rpcServer) SubscribeInvoices(req *lnrpc.InvoiceSubscription,
 				return err
 			}
 
+		case canceledInvoice := <-invoiceClient.CanceledInvoices:
+			rpcInvoice, err := createRPCInvoice(canceledInvoice)
+			if err != nil {
+				return err
+			}
+
+			if err := updateStream.Send(rpcInvoice); err != nil {
+				return err
+			}
+
 		case <-r.quit:
 			return nil
 		}
@@ -3566,7 +4196,7 @@ func (r *rpcServer) DescribeGraph(ctx context.Context,
 	// First iterate through all the known nodes (connected or unconnected
 	// within the graph), collating their current state into the RPC
 	// response.
-	err := graph.ForEachNode(nil, func(_ *bbolt.Tx, node *channeldb.LightningNode) error {
+	err := graph.ForEachNode(nil, func(_ kvdb.Tx, node *channeldb.LightningNode) error {
 		nodeAddrs := make([]*lnrpc.NodeAddress, 0)
 		for _, addr := range node.Addresses {
 			nodeAddr := &lnrpc.NodeAddress{
@@ -3718,7 +4348,7 @@ func (r *rpcServer) GetNodeInfo(ctx context.Context,
 		numChannels   uint32
 		totalCapacity btcutil.Amount
 	)
-	if err := node.ForEachChannel(nil, func(_ *bbolt.Tx, edge *channeldb.ChannelEdgeInfo,
+	if err := node.ForEachChannel(nil, func(_ kvdb.Tx, edge *channeldb.ChannelEdgeInfo,
 		_, _ *channeldb.ChannelEdgePolicy) error {
 
 		numChannels++
@@ -3752,6 +4382,96 @@ func (r *rpcServer) GetNodeInfo(ctx context.Context,
 	}, nil
 }
 
+// routeQuery houses the parameters of a QueryRoutes request, after they've
+// been resolved from either the request itself or its payment request.
+type routeQuery struct {
+	target         *btcec.PublicKey
+	amt            lnwire.MilliSatoshi
+	finalCLTVDelta uint16
+	routeHints     [][]routing.HopHint
+}
+
+// unmarshallRouteQuery resolves the destination, amount, final CLTV delta and
+// routing hints of a QueryRoutes request. If a payment request is set, these
+// are taken from the decoded invoice, otherwise from the request fields.
+func unmarshallRouteQuery(in *lnrpc.QueryRoutesRequest) (*routeQuery, error) {
+	query := &routeQuery{}
+
+	// If a payment request was provided, then the destination, amount,
+	// final CLTV delta and any routing hints will be taken from the
+	// decoded invoice.
+	if in.PaymentRequest != "" {
+		payReq, err := zpay32.Decode(
+			in.PaymentRequest, activeNetParams.Params,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		query.target = payReq.Destination
+		query.finalCLTVDelta = uint16(payReq.MinFinalCLTVExpiry())
+		query.routeHints = payReq.RouteHints
+
+		// If the amount was not included in the invoice, then we'll
+		// use the amount specified within the request.
+		if payReq.MilliSat == nil {
+			if in.Amt == 0 {
+				return nil, errors.New("amount must be " +
+					"specified when querying routes for a " +
+					"zero amount invoice")
+			}
+
+			query.amt = lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(in.Amt),
+			)
+		} else {
+			query.amt = *payReq.MilliSat
+		}
+
+		// If a public key was also specified, then we'll ensure it
+		// matches the destination of the invoice.
+		targetBytes := query.target.SerializeCompressed()
+		if in.PubKey != "" &&
+			in.PubKey != hex.EncodeToString(targetBytes) {
+
+			return nil, fmt.Errorf("destination %v doesn't match "+
+				"payment request destination %x", in.PubKey,
+				targetBytes)
+		}
+	} else {
+		// First parse the hex-encoded public key into a full public
+		// key object we can properly manipulate.
+		pubKeyBytes, err := hex.DecodeString(in.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		query.target, err = btcec.ParsePubKey(
+			pubKeyBytes, btcec.S256(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		query.amt = lnwire.NewMSatFromSatoshis(btcutil.Amount(in.Amt))
+	}
+
+	// An explicitly set final CLTV delta will take precedence over the one
+	// within the payment request.
+	if in.FinalCltvDelta != 0 {
+		query.finalCLTVDelta = uint16(in.FinalCltvDelta)
+	}
+
+	// Any explicit routing hints will be used in addition to those
+	// contained within the payment request.
+	extraHints, err := unmarshallRouteHints(in.RouteHints)
+	if err != nil {
+		return nil, err
+	}
+	query.routeHints = append(query.routeHints, extraHints...)
+
+	return query, nil
+}
+
 // QueryRoutes attempts to query the daemons' Channel Router for a possible
 // route to a target destination capable of carrying a specific amount of
 // satoshis within the route's flow. The retuned route contains the full
@@ -3764,13 +4484,7 @@ func (r *rpcServer) GetNodeInfo(ctx context.Context,
 func (r *rpcServer) QueryRoutes(ctx context.Context,
 	in *lnrpc.QueryRoutesRequest) (*lnrpc.QueryRoutesResponse, error) {
 
-	// First parse the hex-encoded public key into a full public key object
-	// we can properly manipulate.
-	pubKeyBytes, err := hex.DecodeString(in.PubKey)
-	if err != nil {
-		return nil, err
-	}
-	pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+	query, err := unmarshallRouteQuery(in)
 	if err != nil {
 		return nil, err
 	}
@@ -3778,14 +4492,13 @@ func (r *rpcServer) QueryRoutes(ctx context.Context,
 	// Currently, within the bootstrap phase of the network, we limit the
 	// largest payment size allotted to (2^32) - 1 mSAT or 4.29 million
 	// satoshis.
-	amt := btcutil.Amount(in.Amt)
-	amtMSat := lnwire.NewMSatFromSatoshis(amt)
-	if amtMSat > maxPaymentMSat {
+	if query.amt > maxPaymentMSat {
 		return nil, fmt.Errorf("payment of %v is too large, max payment "+
-			"allowed is %v", amt, maxPaymentMSat.ToSatoshis())
+			"allowed is %v", query.amt.ToSatoshis(),
+			maxPaymentMSat.ToSatoshis())
 	}
 
-	feeLimit := calculateFeeLimit(in.FeeLimit, amtMSat)
+	feeLimit := calculateFeeLimit(in.FeeLimit, query.amt)
 
 	// numRoutes will default to 10 if not specified explicitly.
 	numRoutesIn := uint32(in.NumRoutes)
@@ -3800,14 +4513,15 @@ func (r *rpcServer) QueryRoutes(ctx context.Context,
 		routes  []*routing.Route
 		findErr error
 	)
-	if in.FinalCltvDelta == 0 {
+	if query.finalCLTVDelta == 0 {
 		routes, findErr = r.server.chanRouter.FindRoutes(
-			pubKey, amtMSat, feeLimit, numRoutesIn,
+			query.target, query.amt, feeLimit, numRoutesIn,
+			query.routeHints,
 		)
 	} else {
 		routes, findErr = r.server.chanRouter.FindRoutes(
-			pubKey, amtMSat, feeLimit, numRoutesIn,
-			uint16(in.FinalCltvDelta),
+			query.target, query.amt, feeLimit, numRoutesIn,
+			query.routeHints, query.finalCLTVDelta,
 		)
 	}
 	if findErr != nil {
@@ -4012,7 +4726,7 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 	// network, tallying up the total number of nodes, and also gathering
 	// each node so we can measure the graph diameter and degree stats
 	// below.
-	if err := graph.ForEachNode(nil, func(tx *bbolt.Tx, node *channeldb.LightningNode) error {
+	if err := graph.ForEachNode(nil, func(tx kvdb.Tx, node *channeldb.LightningNode) error {
 		// Increment the total number of nodes with each iteration.
 		numNodes++
 
@@ -4022,7 +4736,7 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 		// through the db transaction from the outer view so we can
 		// re-use it within this inner view.
 		var outDegree uint32
-		if err := node.ForEachChannel(tx, func(_ *bbolt.Tx,
+		if err := node.ForEachChannel(tx, func(_ kvdb.Tx,
 			edge *channeldb.ChannelEdgeInfo, _, _ *channeldb.ChannelEdgePolicy) error {
 
 			// Bump up the out degree for this node for each
@@ -4312,6 +5026,119 @@ func (r *rpcServer) DebugLevel(ctx context.Context,
 	return &lnrpc.DebugLevelResponse{}, nil
 }
 
+// GetDBStats returns the size of the channel database, along with a breakdown
+// of its contents by the subsystem storing them.
+func (r *rpcServer) GetDBStats(ctx context.Context,
+	req *lnrpc.GetDBStatsRequest) (*lnrpc.GetDBStatsResponse, error) {
+
+	rpcsLog.Debugf("[getdbstats]")
+
+	stats, err := r.server.chanDB.FetchStats()
+	if err != nil {
+		return nil, err
+	}
+
+	subsystems := []struct {
+		name  string
+		stats channeldb.BucketStats
+	}{
+		{"graph", stats.Graph},
+		{"open_channels", stats.OpenChannels},
+		{"revocation_logs", stats.RevocationLogs},
+		{"closed_channels", stats.ClosedChannels},
+		{"forwarding_packages", stats.ForwardingPackages},
+		{"invoices", stats.Invoices},
+		{"payments", stats.Payments},
+		{"forwarding_log", stats.ForwardingLog},
+	}
+
+	resp := &lnrpc.GetDBStatsResponse{
+		FileSizeBytes: stats.FileSize,
+	}
+	for _, subsystem := range subsystems {
+		rpcStats := &lnrpc.DBSubsystemStats{
+			Subsystem:  subsystem.name,
+			NumKeys:    subsystem.stats.NumKeys,
+			NumBuckets: subsystem.stats.NumBuckets,
+			SizeBytes:  subsystem.stats.Size,
+		}
+		resp.Subsystems = append(resp.Subsystems, rpcStats)
+	}
+
+	return resp, nil
+}
+
+// backupChunkSize is the maximum number of bytes sent within a single message
+// of the BackupDatabase stream.
+const backupChunkSize = 64 * 1024
+
+// backupStreamWriter is an io.Writer that sends all data written to it over a
+// BackupDatabase stream, split into chunks of at most backupChunkSize bytes.
+type backupStreamWriter struct {
+	stream lnrpc.Lightning_BackupDatabaseServer
+}
+
+// Write sends the passed data over the stream.
+func (w *backupStreamWriter) Write(p []byte) (int, error) {
+	var n int
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > backupChunkSize {
+			chunk = chunk[:backupChunkSize]
+		}
+
+		err := w.stream.Send(&lnrpc.DatabaseBackupChunk{
+			Data: chunk,
+		})
+		if err != nil {
+			return n, err
+		}
+
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+
+	return n, nil
+}
+
+// BackupDatabase streams a consistent, point-in-time backup of the entire
+// channel database, preceded by a header holding the database version and the
+// best block known to the node. The metadata of the backup is sent within the
+// last message of the stream.
+func (r *rpcServer) BackupDatabase(req *lnrpc.BackupDatabaseRequest,
+	stream lnrpc.Lightning_BackupDatabaseServer) error {
+
+	rpcsLog.Debugf("[backupdb]")
+
+	bestHash, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(
+		&backupStreamWriter{stream: stream}, backupChunkSize,
+	)
+	header, err := r.server.chanDB.Backup(w, bestHash, uint32(bestHeight))
+	if err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	rpcsLog.Infof("Sent database backup at height=%v, db_version=%v",
+		header.BestHeight, header.DBVersion)
+
+	return stream.Send(&lnrpc.DatabaseBackupChunk{
+		Metadata: &lnrpc.DatabaseBackupMetadata{
+			DbVersion:       header.DBVersion,
+			BestBlockHeight: header.BestHeight,
+			BestBlockHash:   header.BestHash.String(),
+			Timestamp:       header.Timestamp.Unix(),
+		},
+	})
+}
+
 // DecodePayReq takes an encoded payment request string and attempts to decode
 // it, returning a full description of the conditions encoded within the
 // payment request.
@@ -4393,7 +5220,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 	}
 
 	var feeReports []*lnrpc.ChannelFeeReport
-	err = selfNode.ForEachChannel(nil, func(_ *bbolt.Tx, chanInfo *channeldb.ChannelEdgeInfo,
+	err = selfNode.ForEachChannel(nil, func(_ kvdb.Tx, chanInfo *channeldb.ChannelEdgeInfo,
 		edgePolicy, _ *channeldb.ChannelEdgePolicy) error {
 
 		// Self node should always have policies for its channels.
@@ -4547,6 +5374,32 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 			Hash:  *txid,
 			Index: scope.ChanPoint.OutputIndex,
 		})
+
+	// If the request is targeting a peer, then we'll target all of the
+	// channels we currently have open with it.
+	case *lnrpc.PolicyUpdateRequest_PeerPubKey:
+		pubKeyBytes, err := hex.DecodeString(scope.PeerPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode peer pubkey: "+
+				"%v", err)
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse peer pubkey: "+
+				"%v", err)
+		}
+
+		channels, err := r.server.chanDB.FetchOpenChannels(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(channels) == 0 {
+			return nil, fmt.Errorf("no open channels with peer %x",
+				pubKeyBytes)
+		}
+		for _, channel := range channels {
+			targetChans = append(targetChans, channel.FundingOutpoint)
+		}
 	default:
 		return nil, fmt.Errorf("unknown scope: %v", scope)
 	}
@@ -4587,37 +5440,106 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		req.BaseFeeMsat, req.FeeRate, feeRateFixed, req.TimeLockDelta,
 		spew.Sdump(targetChans))
 
-	// With the scope resolved, we'll now send this to the
-	// AuthenticatedGossiper so it can propagate the new policy for our
+	// With the scope resolved, we'll now apply the new policy to our
 	// target channel(s).
-	err := r.server.authGossiper.PropagateChanPolicyUpdate(
-		chanPolicy, targetChans...,
-	)
+	err := r.server.updateChannelPolicy(chanPolicy, targetChans...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Finally, we'll apply the set of active links amongst the target
-	// channels.
-	//
-	// We create a partially policy as the logic won't overwrite a valid
-	// sub-policy with a "nil" one.
-	p := htlcswitch.ForwardingPolicy{
-		BaseFee:       baseFeeMsat,
-		FeeRate:       lnwire.MilliSatoshi(feeRateFixed),
-		TimeLockDelta: req.TimeLockDelta,
+	// The inbound forwarding limits aren't part of the policy we advertise
+	// to the network, so we'll only need to store and apply them within
+	// the switch.
+	limits := htlcswitch.InboundLimitsUpdate{
+		Clear:              req.ClearInboundLimits,
+		MaxForwardAmt:      lnwire.MilliSatoshi(req.MaxForwardAmtMsat),
+		MaxPendingForwards: req.MaxPendingForwards,
+		MaxCltvDelta:       req.MaxCltvDelta,
 	}
-	err = r.server.htlcSwitch.UpdateForwardingPolicies(p, targetChans...)
+	if !limits.Clear && limits.MaxForwardAmt == 0 &&
+		limits.MaxPendingForwards == 0 && limits.MaxCltvDelta == 0 {
+
+		return &lnrpc.PolicyUpdateResponse{}, nil
+	}
+
+	rpcsLog.Debugf("[updatechanpolicy] updating inbound limits "+
+		"clear=%v, max_forward_amt=%v, max_pending_forwards=%v, "+
+		"max_cltv_delta=%v", limits.Clear, limits.MaxForwardAmt,
+		limits.MaxPendingForwards, limits.MaxCltvDelta)
+
+	err = r.server.htlcSwitch.UpdateInboundLimits(limits, targetChans...)
 	if err != nil {
-		// If we're unable update the fees due to the links not being
-		// online, then we don't need to fail the call. We'll simply
-		// log the failure.
-		rpcsLog.Warnf("Unable to update link fees: %v", err)
+		return nil, err
 	}
 
 	return &lnrpc.PolicyUpdateResponse{}, nil
 }
 
+// FeeDecisions allows the caller to query the recent decisions made by the fee
+// manager when automatically adjusting the fees of our channels.
+func (r *rpcServer) FeeDecisions(ctx context.Context,
+	req *lnrpc.FeeDecisionsRequest) (*lnrpc.FeeDecisionsResponse, error) {
+
+	if r.server.feeManager == nil {
+		return nil, fmt.Errorf("fee manager isn't active")
+	}
+
+	// If the caller is only interested in a single channel, then we'll
+	// parse its channel point so we can filter the decisions.
+	var chanPoint *wire.OutPoint
+	if req.ChanPoint != "" {
+		var err error
+		chanPoint, err = parseOutPoint(req.ChanPoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	decisions := r.server.feeManager.Decisions(chanPoint)
+
+	resp := &lnrpc.FeeDecisionsResponse{
+		Decisions: make([]*lnrpc.FeeDecision, 0, len(decisions)),
+	}
+	for _, decision := range decisions {
+		resp.Decisions = append(resp.Decisions, &lnrpc.FeeDecision{
+			ChanPoint:         decision.ChanPoint.String(),
+			Timestamp:         decision.Timestamp.Unix(),
+			LocalRatio:        decision.LocalRatio,
+			ForwardVolumeMsat: uint64(decision.ForwardVolume),
+			OldBaseFeeMsat:    int64(decision.OldBaseFee),
+			OldFeePerMil:      int64(decision.OldFeeRate),
+			NewBaseFeeMsat:    int64(decision.NewBaseFee),
+			NewFeePerMil:      int64(decision.NewFeeRate),
+			Applied:           decision.Applied,
+			Reason:            decision.Reason,
+		})
+	}
+
+	return resp, nil
+}
+
+// parseOutPoint parses an outpoint from its string representation of the
+// form txid:index.
+func parseOutPoint(s string) (*wire.OutPoint, error) {
+	split := strings.Split(s, ":")
+	if len(split) != 2 {
+		return nil, fmt.Errorf("expected outpoint of the form "+
+			"txid:index, got %v", s)
+	}
+
+	txid, err := chainhash.NewHashFromStr(split[0])
+	if err != nil {
+		return nil, err
+	}
+	index, err := strconv.ParseUint(split[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode output index: %v",
+			err)
+	}
+
+	return wire.NewOutPoint(txid, uint32(index)), nil
+}
+
 // ForwardingHistory allows the caller to query the htlcswitch for a record of
 // all HTLC's forwarded within the target time range, and integer offset within
 // that time range. If no time-range is specified, then the first chunk of the
@@ -4708,3 +5630,687 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 
 	return resp, nil
 }
+
+// AccountingReport returns a ledger of all on-chain and off-chain activity of
+// the node within the target time range. Each entry references the wallet
+// transaction or channeldb record it was created from.
+func (r *rpcServer) AccountingReport(ctx context.Context,
+	req *lnrpc.AccountingReportRequest) (*lnrpc.AccountingReportResponse,
+	error) {
+
+	rpcsLog.Debugf("[accountingreport] start_time=%v, end_time=%v",
+		req.StartTime, req.EndTime)
+
+	// We'll flush any pending forwarding events to disk first, such that
+	// the routing fee income in the ledger is complete.
+	if err := r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
+		return nil, fmt.Errorf("unable to flush forwarding "+
+			"events: %v", err)
+	}
+
+	// If the time range wasn't set, then we'll return the ledger of the
+	// past 24 hours. If only the end time wasn't set, the ledger runs up
+	// until now.
+	now := time.Now()
+	startTime := now.Add(-time.Hour * 24)
+	endTime := now
+	if req.StartTime != 0 || req.EndTime != 0 {
+		startTime = time.Unix(int64(req.StartTime), 0)
+	}
+	if req.EndTime != 0 {
+		endTime = time.Unix(int64(req.EndTime), 0)
+	}
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("end time %v is before start time %v",
+			req.EndTime, req.StartTime)
+	}
+
+	entries, err := accounting.Ledger(&accounting.Config{
+		ChanDB:           r.server.chanDB,
+		ListTransactions: r.server.cc.wallet.ListTransactionDetails,
+		IsSweepTx:        r.server.sweeper.IsOurTx,
+		SweepTxFee:       r.server.sweeper.GetTxFee,
+		GetBlock:         r.server.cc.chainIO.GetBlock,
+	}, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.AccountingReportResponse{
+		Entries: make([]*lnrpc.LedgerEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		var entryType lnrpc.LedgerEntry_EntryType
+		switch entry.Type {
+		case accounting.OnChainReceive:
+			entryType = lnrpc.LedgerEntry_ONCHAIN_RECEIVE
+		case accounting.OnChainSend:
+			entryType = lnrpc.LedgerEntry_ONCHAIN_SEND
+		case accounting.ChannelOpen:
+			entryType = lnrpc.LedgerEntry_CHANNEL_OPEN
+		case accounting.ChannelClose:
+			entryType = lnrpc.LedgerEntry_CHANNEL_CLOSE
+		case accounting.Sweep:
+			entryType = lnrpc.LedgerEntry_SWEEP
+		case accounting.ForwardFee:
+			entryType = lnrpc.LedgerEntry_FORWARD_FEE
+		case accounting.PaymentSent:
+			entryType = lnrpc.LedgerEntry_PAYMENT_SENT
+		case accounting.PaymentReceived:
+			entryType = lnrpc.LedgerEntry_PAYMENT_RECEIVED
+		default:
+			return nil, fmt.Errorf("unknown ledger entry type %v",
+				entry.Type)
+		}
+
+		rpcEntry := &lnrpc.LedgerEntry{
+			Timestamp:  uint64(entry.Timestamp.Unix()),
+			Type:       entryType,
+			AmountMsat: entry.Amount,
+			FeeMsat:    uint64(entry.Fee),
+			Reference:  entry.Reference,
+		}
+		if entry.ChannelPoint != nil {
+			rpcEntry.ChannelPoint = entry.ChannelPoint.String()
+		}
+
+		resp.Entries = append(resp.Entries, rpcEntry)
+	}
+
+	return resp, nil
+}
+
+// HtlcInterceptor dispatches a bi-directional streaming RPC which allows an
+// external process to decide the fate of each HTLC forwarded through our node.
+// While the stream is open, every forwarded HTLC is held by the switch and sent
+// to the client, which responds with the HTLC's resolution.
+func (r *rpcServer) HtlcInterceptor(
+	stream lnrpc.Lightning_HtlcInterceptorServer) error {
+
+	var (
+		fwdChan = make(chan htlcswitch.InterceptedForward)
+		done    = make(chan struct{})
+	)
+
+	// The switch requires the interceptor to never block, so we'll hand
+	// each forward over to the main loop below from a new goroutine.
+	interceptor := func(fwd htlcswitch.InterceptedForward) {
+		go func() {
+			select {
+			case fwdChan <- fwd:
+			case <-done:
+			}
+		}()
+	}
+
+	err := r.server.htlcSwitch.RegisterInterceptor(interceptor)
+	if err != nil {
+		return err
+	}
+
+	// Once the stream exits, we'll unregister the interceptor, which will
+	// resume any forwards that haven't yet been resolved by the client.
+	defer r.server.htlcSwitch.UnregisterInterceptor()
+	defer close(done)
+
+	rpcsLog.Infof("HTLC interceptor client connected")
+
+	// We'll launch a goroutine to read the resolutions sent by the client.
+	// The goroutine will exit once the stream is closed, or the client
+	// sends an invalid resolution.
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			key := htlcswitch.CircuitKey{
+				ChanID: lnwire.NewShortChanIDFromInt(
+					resp.IncomingCircuitKey.GetChanId(),
+				),
+				HtlcID: resp.IncomingCircuitKey.GetHtlcId(),
+			}
+
+			// If the forward's intercept timeout expired before the
+			// client responded, then it has already been resumed.
+			fwd, ok := r.server.htlcSwitch.HeldForward(key)
+			if !ok {
+				rpcsLog.Warnf("Unable to resolve forward %v: "+
+					"%v", key, htlcswitch.ErrFwdNotHeld)
+				continue
+			}
+
+			err = resolveInterceptedForward(fwd, resp)
+			switch {
+			// The forward may also have timed out concurrently.
+			case err == htlcswitch.ErrFwdNotHeld:
+				rpcsLog.Warnf("Unable to resolve forward %v: "+
+					"%v", key, err)
+
+			case err != nil:
+				errChan <- fmt.Errorf("unable to resolve "+
+					"forward %v: %v", key, err)
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case fwd := <-fwdChan:
+			pkt := fwd.Packet()
+			err := stream.Send(&lnrpc.ForwardHtlcInterceptRequest{
+				IncomingCircuitKey: &lnrpc.CircuitKey{
+					ChanId: pkt.IncomingCircuit.ChanID.ToUint64(),
+					HtlcId: pkt.IncomingCircuit.HtlcID,
+				},
+				IncomingAmountMsat:      uint64(pkt.IncomingAmount),
+				IncomingExpiry:          pkt.IncomingExpiry,
+				PaymentHash:             pkt.Hash[:],
+				OutgoingRequestedChanId: pkt.OutgoingChanID.ToUint64(),
+				OutgoingAmountMsat:      uint64(pkt.OutgoingAmount),
+				OutgoingExpiry:          pkt.OutgoingExpiry,
+			})
+			if err != nil {
+				return err
+			}
+
+		case err := <-errChan:
+			if err == io.EOF {
+				rpcsLog.Infof("HTLC interceptor client " +
+					"disconnected")
+				return nil
+			}
+			return err
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// resolveInterceptedForward resolves the passed intercepted forward according
+// to the resolution sent by an HTLC interceptor client.
+func resolveInterceptedForward(fwd htlcswitch.InterceptedForward,
+	resp *lnrpc.ForwardHtlcInterceptResponse) error {
+
+	switch resp.Action {
+	case lnrpc.ForwardHtlcInterceptResponse_RESUME:
+		return fwd.Resume()
+
+	case lnrpc.ForwardHtlcInterceptResponse_FAIL:
+		var code lnwire.FailCode
+		switch resp.FailureCode {
+		case lnrpc.ForwardHtlcInterceptResponse_TEMPORARY_CHANNEL_FAILURE:
+			code = lnwire.CodeTemporaryChannelFailure
+		case lnrpc.ForwardHtlcInterceptResponse_TEMPORARY_NODE_FAILURE:
+			code = lnwire.CodeTemporaryNodeFailure
+		case lnrpc.ForwardHtlcInterceptResponse_PERMANENT_NODE_FAILURE:
+			code = lnwire.CodePermanentNodeFailure
+		case lnrpc.ForwardHtlcInterceptResponse_PERMANENT_CHANNEL_FAILURE:
+			code = lnwire.CodePermanentChannelFailure
+		case lnrpc.ForwardHtlcInterceptResponse_UNKNOWN_NEXT_PEER:
+			code = lnwire.CodeUnknownNextPeer
+		case lnrpc.ForwardHtlcInterceptResponse_UNKNOWN_PAYMENT_HASH:
+			code = lnwire.CodeUnknownPaymentHash
+		default:
+			return fmt.Errorf("unknown failure code: %v",
+				resp.FailureCode)
+		}
+
+		return fwd.Fail(code)
+
+	case lnrpc.ForwardHtlcInterceptResponse_SETTLE:
+		var preimage [32]byte
+		if len(resp.Preimage) != len(preimage) {
+			return fmt.Errorf("preimage must be %v bytes, got %v",
+				len(preimage), len(resp.Preimage))
+		}
+		copy(preimage[:], resp.Preimage)
+
+		return fwd.Settle(preimage)
+
+	default:
+		return fmt.Errorf("unknown action: %v", resp.Action)
+	}
+}
+
+// SubscribeHtlcEvents launches a streaming RPC that allows the caller to
+// receive notifications whenever an HTLC that arrived over one of our channels
+// is forwarded, settled or failed.
+func (r *rpcServer) SubscribeHtlcEvents(req *lnrpc.SubscribeHtlcEventsRequest,
+	updateStream lnrpc.Lightning_SubscribeHtlcEventsServer) error {
+
+	client, err := r.server.htlcSwitch.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case event := <-client.Events:
+			if err := updateStream.Send(marshallHtlcEvent(event)); err != nil {
+				return err
+			}
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// SubscribePeerEvents returns a uni-directional stream (server -> client)
+// for notifying the client of peers going online and offline.
+func (r *rpcServer) SubscribePeerEvents(req *lnrpc.PeerEventSubscription,
+	updateStream lnrpc.Lightning_SubscribePeerEventsServer) error {
+
+	client, err := r.server.SubscribePeerEvents()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			event := update.(*PeerEvent)
+
+			var eventType lnrpc.PeerEvent_EventType
+			switch event.Type {
+			case PeerOnline:
+				eventType = lnrpc.PeerEvent_PEER_ONLINE
+			case PeerOffline:
+				eventType = lnrpc.PeerEvent_PEER_OFFLINE
+			default:
+				return fmt.Errorf("unhandled peer event type %v",
+					event.Type)
+			}
+
+			err := updateStream.Send(&lnrpc.PeerEvent{
+				PubKey: hex.EncodeToString(event.PubKey[:]),
+				Type:   eventType,
+			})
+			if err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return ErrServerShuttingDown
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallHtlcEvent converts an HTLC event emitted by the switch into the form
+// expected by the gRPC service.
+func marshallHtlcEvent(event *htlcswitch.HtlcEvent) *lnrpc.HtlcEvent {
+	rpcEvent := &lnrpc.HtlcEvent{
+		IncomingChanId:   event.IncomingCircuit.ChanID.ToUint64(),
+		IncomingHtlcId:   event.IncomingCircuit.HtlcID,
+		OutgoingChanId:   event.OutgoingCircuit.ChanID.ToUint64(),
+		OutgoingHtlcId:   event.OutgoingCircuit.HtlcID,
+		IncomingAmtMsat:  uint64(event.IncomingAmt),
+		OutgoingAmtMsat:  uint64(event.OutgoingAmt),
+		IncomingTimelock: event.IncomingTimeout,
+		OutgoingTimelock: event.OutgoingTimeout,
+		TimestampNs:      uint64(event.Timestamp.UnixNano()),
+	}
+
+	switch event.Type {
+	case htlcswitch.HtlcEventForward:
+		rpcEvent.EventType = lnrpc.HtlcEvent_FORWARD
+	case htlcswitch.HtlcEventSettle:
+		rpcEvent.EventType = lnrpc.HtlcEvent_SETTLE
+	case htlcswitch.HtlcEventForwardFail:
+		rpcEvent.EventType = lnrpc.HtlcEvent_FORWARD_FAIL
+	case htlcswitch.HtlcEventLinkFail:
+		rpcEvent.EventType = lnrpc.HtlcEvent_LINK_FAIL
+		rpcEvent.FailureDetail = event.FailureDetail.String()
+	}
+
+	if event.FailureMessage != nil {
+		rpcEvent.FailureCode = uint32(event.FailureMessage.Code())
+		rpcEvent.FailureString = event.FailureMessage.Error()
+	}
+
+	return rpcEvent
+}
+
+// BakeMacaroon allows the creation of a new macaroon with custom read and
+// write permissions, derived from the root key with the given ID.
+func (r *rpcServer) BakeMacaroon(ctx context.Context,
+	req *lnrpc.BakeMacaroonRequest) (*lnrpc.BakeMacaroonResponse, error) {
+
+	rpcsLog.Debugf("[bakemacaroon]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon
+	// service is not initialized.
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	// We'll only bake macaroons with at least one permission, each of
+	// which must be required by at least one of our RPCs.
+	if len(req.Permissions) == 0 {
+		return nil, fmt.Errorf("permission list cannot be empty")
+	}
+	validOps := make(map[bakery.Op]struct{})
+	for _, ops := range permissions {
+		for _, op := range ops {
+			validOps[op] = struct{}{}
+		}
+	}
+	requestedPermissions := make([]bakery.Op, len(req.Permissions))
+	for i, perm := range req.Permissions {
+		op := bakery.Op{
+			Entity: perm.Entity,
+			Action: perm.Action,
+		}
+		if _, ok := validOps[op]; !ok {
+			return nil, fmt.Errorf("invalid permission %v:%v",
+				op.Entity, op.Action)
+		}
+
+		requestedPermissions[i] = op
+	}
+
+	// The root key ID is stored within the context, from where the root
+	// key store will pick it up when baking the macaroon.
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+	ctx = macaroons.ContextWithRootKeyID(ctx, rootKeyID)
+
+	newMac, err := r.macService.Oven.NewMacaroon(
+		ctx, bakery.LatestVersion, nil, requestedPermissions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	newMacBytes, err := newMac.M().MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[bakemacaroon] success")
+
+	return &lnrpc.BakeMacaroonResponse{
+		Macaroon: hex.EncodeToString(newMacBytes),
+	}, nil
+}
+
+// ListMacaroonIDs returns all root key IDs that are in use.
+func (r *rpcServer) ListMacaroonIDs(ctx context.Context,
+	req *lnrpc.ListMacaroonIDsRequest) (*lnrpc.ListMacaroonIDsResponse,
+	error) {
+
+	rpcsLog.Debugf("[listmacaroonids]")
+
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	rootKeyIDByteSlice, err := r.macService.ListMacaroonIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The root key IDs are stored as the decimal string representation
+	// of their integer value.
+	var rootKeyIDs []uint64
+	for _, value := range rootKeyIDByteSlice {
+		id, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		rootKeyIDs = append(rootKeyIDs, id)
+	}
+
+	return &lnrpc.ListMacaroonIDsResponse{RootKeyIds: rootKeyIDs}, nil
+}
+
+// DeleteMacaroonID removes the root key with the given ID, revoking all
+// macaroons that were baked using it.
+func (r *rpcServer) DeleteMacaroonID(ctx context.Context,
+	req *lnrpc.DeleteMacaroonIDRequest) (*lnrpc.DeleteMacaroonIDResponse,
+	error) {
+
+	rpcsLog.Debugf("[deletemacaroonid]")
+
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+	deletedID, err := r.macService.DeleteMacaroonID(ctx, rootKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeleteMacaroonIDResponse{
+		Deleted: deletedID != nil,
+	}, nil
+}
+
+// createRPCAccount converts a virtual account into its RPC representation.
+func createRPCAccount(account *channeldb.Account) *lnrpc.Account {
+	return &lnrpc.Account{
+		Id:           account.ID,
+		Label:        account.Label,
+		BalanceMsat:  uint64(account.Balance),
+		CreationDate: account.CreationDate.Unix(),
+	}
+}
+
+// checkAccountManager ensures that the macaroon used to make the call isn't
+// bound to a virtual account, as such a macaroon could otherwise be used to
+// fund its own account.
+func (r *rpcServer) checkAccountManager(ctx context.Context) error {
+	_, hasAccount, err := r.macaroonAccount(ctx)
+	if err != nil {
+		return err
+	}
+	if hasAccount {
+		return errAccountMacaroon
+	}
+
+	return nil
+}
+
+// CreateAccount creates a new virtual account with its own balance. A
+// macaroon bound to the account can only spend the balance of the account,
+// while invoices created using it credit the account once settled.
+func (r *rpcServer) CreateAccount(ctx context.Context,
+	req *lnrpc.CreateAccountRequest) (*lnrpc.CreateAccountResponse, error) {
+
+	rpcsLog.Debugf("[createaccount] label=%v, balance=%v", req.Label,
+		lnwire.MilliSatoshi(req.BalanceMsat))
+
+	if err := r.checkAccountManager(ctx); err != nil {
+		return nil, err
+	}
+
+	account, err := r.server.chanDB.AddAccount(
+		req.Label, lnwire.MilliSatoshi(req.BalanceMsat),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("Created account %v with balance %v", account.ID,
+		account.Balance)
+
+	return &lnrpc.CreateAccountResponse{
+		Account: createRPCAccount(account),
+	}, nil
+}
+
+// ListAccounts returns all virtual accounts along with their balances.
+func (r *rpcServer) ListAccounts(ctx context.Context,
+	req *lnrpc.ListAccountsRequest) (*lnrpc.ListAccountsResponse, error) {
+
+	rpcsLog.Debugf("[listaccounts]")
+
+	accounts, err := r.server.chanDB.FetchAllAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	// A macaroon bound to an account can only see its own account.
+	accountID, hasAccount, err := r.macaroonAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListAccountsResponse{}
+	for _, account := range accounts {
+		if hasAccount && account.ID != accountID {
+			continue
+		}
+
+		resp.Accounts = append(resp.Accounts, createRPCAccount(account))
+	}
+
+	return resp, nil
+}
+
+// FundAccount adds the given amount to the balance of a virtual account.
+func (r *rpcServer) FundAccount(ctx context.Context,
+	req *lnrpc.FundAccountRequest) (*lnrpc.FundAccountResponse, error) {
+
+	rpcsLog.Debugf("[fundaccount] id=%v, amt=%v", req.Id,
+		lnwire.MilliSatoshi(req.AmtMsat))
+
+	if err := r.checkAccountManager(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.AmtMsat == 0 {
+		return nil, fmt.Errorf("amount must be greater than zero")
+	}
+
+	account, err := r.server.chanDB.CreditAccount(
+		req.Id, lnwire.MilliSatoshi(req.AmtMsat),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("Funded account %v with %v, new balance %v", account.ID,
+		lnwire.MilliSatoshi(req.AmtMsat), account.Balance)
+
+	return &lnrpc.FundAccountResponse{
+		Account: createRPCAccount(account),
+	}, nil
+}
+
+// SendCustomMessage sends a message of a type within the odd custom range to
+// a connected peer.
+func (r *rpcServer) SendCustomMessage(ctx context.Context,
+	req *lnrpc.SendCustomMessageRequest) (*lnrpc.SendCustomMessageResponse,
+	error) {
+
+	rpcsLog.Debugf("[sendcustommessage] peer=%x, type=%v, data_len=%v",
+		req.Peer, req.Type, len(req.Data))
+
+	// Only odd message types can be sent, as peers that don't understand
+	// them will simply ignore them, rather than disconnecting.
+	if req.Type > math.MaxUint16 {
+		return nil, fmt.Errorf("message type %v exceeds the maximum "+
+			"message type %v", req.Type, math.MaxUint16)
+	}
+	msgType := lnwire.MessageType(req.Type)
+	if !lnwire.IsCustomType(msgType) || msgType%2 == 0 {
+		return nil, fmt.Errorf("message type %v is not an odd type "+
+			"within the custom range, which starts at %v",
+			req.Type, uint16(lnwire.CustomTypeStart))
+	}
+
+	peerKey, err := btcec.ParsePubKey(req.Peer, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse peer public key: %v",
+			err)
+	}
+
+	msg, err := lnwire.NewCustom(msgType, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.server.SendToPeer(peerKey, msg); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SendCustomMessageResponse{}, nil
+}
+
+// SubscribeCustomMessages launches a streaming RPC that delivers all custom
+// messages received from our peers to the caller. The even custom types in
+// the request are claimed for as long as the stream is active.
+func (r *rpcServer) SubscribeCustomMessages(
+	req *lnrpc.SubscribeCustomMessagesRequest,
+	updateStream lnrpc.Lightning_SubscribeCustomMessagesServer) error {
+
+	evenTypes := make([]lnwire.MessageType, 0, len(req.EvenTypes))
+	for _, evenType := range req.EvenTypes {
+		msgType := lnwire.MessageType(evenType)
+		if evenType > math.MaxUint16 || !lnwire.IsCustomType(msgType) ||
+			msgType%2 != 0 {
+
+			return fmt.Errorf("message type %v is not an even type "+
+				"within the custom range, which starts at %v",
+				evenType, uint16(lnwire.CustomTypeStart))
+		}
+		evenTypes = append(evenTypes, msgType)
+	}
+
+	client, err := r.server.SubscribeCustomMessages()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	releaseClaims := r.server.ClaimCustomTypes(evenTypes)
+	defer releaseClaims()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			customMsg := update.(*CustomMessage)
+
+			err := updateStream.Send(&lnrpc.CustomMessage{
+				Peer: customMsg.Peer[:],
+				Type: uint32(customMsg.Msg.Type),
+				Data: customMsg.Msg.Data,
+			})
+			if err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return ErrServerShuttingDown
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
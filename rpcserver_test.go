@@ -0,0 +1,154 @@
+// +build !rpctest
+
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// createPayReq returns an encoded payment request to the given destination,
+// created with the given options.
+func createPayReq(t *testing.T, privKey *btcec.PrivateKey,
+	options ...func(*zpay32.Invoice)) string {
+
+	invoice, err := zpay32.NewInvoice(
+		activeNetParams.Params, [32]byte{1}, time.Now(), options...,
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			return btcec.SignCompact(btcec.S256(), privKey, hash, true)
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	return payReq
+}
+
+// TestUnmarshallRouteQuery tests that the destination, amount, final CLTV
+// delta and routing hints of a QueryRoutes request are taken from the payment
+// request if one is set.
+func TestUnmarshallRouteQuery(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	hopKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	dest := hex.EncodeToString(privKey.PubKey().SerializeCompressed())
+	hopHint := routing.HopHint{
+		NodeID:          hopKey.PubKey(),
+		ChannelID:       12345,
+		CLTVExpiryDelta: 40,
+	}
+
+	payReq := createPayReq(
+		t, privKey, zpay32.Amount(50000), zpay32.CLTVExpiry(20),
+		zpay32.Description("test"),
+		zpay32.RouteHint([]routing.HopHint{hopHint}),
+	)
+	zeroAmtPayReq := createPayReq(
+		t, privKey, zpay32.Description("test"),
+	)
+
+	// A request with only a payment request should take all of its
+	// parameters from the invoice.
+	query, err := unmarshallRouteQuery(&lnrpc.QueryRoutesRequest{
+		PaymentRequest: payReq,
+	})
+	if err != nil {
+		t.Fatalf("unable to unmarshall query: %v", err)
+	}
+	if !query.target.IsEqual(privKey.PubKey()) {
+		t.Fatalf("expected target %v, got %x", dest,
+			query.target.SerializeCompressed())
+	}
+	if query.amt != 50000 {
+		t.Fatalf("expected amount of 50000 msat, got %v", query.amt)
+	}
+	if query.finalCLTVDelta != 20 {
+		t.Fatalf("expected final cltv delta of 20, got %v",
+			query.finalCLTVDelta)
+	}
+	if len(query.routeHints) != 1 || len(query.routeHints[0]) != 1 ||
+		query.routeHints[0][0].ChannelID != hopHint.ChannelID {
+
+		t.Fatalf("expected invoice route hint, got %v",
+			query.routeHints)
+	}
+
+	// An explicit final CLTV delta takes precedence, and explicit route
+	// hints are added to those of the invoice.
+	query, err = unmarshallRouteQuery(&lnrpc.QueryRoutesRequest{
+		PubKey:         dest,
+		PaymentRequest: payReq,
+		FinalCltvDelta: 144,
+		RouteHints: []*lnrpc.RouteHint{{
+			HopHints: []*lnrpc.HopHint{{
+				NodeId: hex.EncodeToString(
+					hopKey.PubKey().SerializeCompressed(),
+				),
+				ChanId:          54321,
+				CltvExpiryDelta: 40,
+			}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unable to unmarshall query: %v", err)
+	}
+	if query.finalCLTVDelta != 144 {
+		t.Fatalf("expected final cltv delta of 144, got %v",
+			query.finalCLTVDelta)
+	}
+	if len(query.routeHints) != 2 {
+		t.Fatalf("expected 2 route hints, got %v",
+			len(query.routeHints))
+	}
+
+	// A zero amount invoice requires the amount to be set within the
+	// request.
+	_, err = unmarshallRouteQuery(&lnrpc.QueryRoutesRequest{
+		PaymentRequest: zeroAmtPayReq,
+	})
+	if err == nil {
+		t.Fatalf("expected zero amount invoice without amount to fail")
+	}
+	query, err = unmarshallRouteQuery(&lnrpc.QueryRoutesRequest{
+		Amt:            100,
+		PaymentRequest: zeroAmtPayReq,
+	})
+	if err != nil {
+		t.Fatalf("unable to unmarshall query: %v", err)
+	}
+	if query.amt != lnwire.NewMSatFromSatoshis(100) {
+		t.Fatalf("expected amount of 100 sat, got %v", query.amt)
+	}
+
+	// Finally, a public key that doesn't match the invoice's destination
+	// should be rejected.
+	otherDest := hex.EncodeToString(hopKey.PubKey().SerializeCompressed())
+	_, err = unmarshallRouteQuery(&lnrpc.QueryRoutesRequest{
+		PubKey:         otherDest,
+		PaymentRequest: payReq,
+	})
+	if err == nil {
+		t.Fatalf("expected mismatching destination to fail")
+	}
+}
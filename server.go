@@ -22,14 +22,16 @@ import (
 	"github.com/btcsuite/btcd/connmgr"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	"github.com/coreos/bbolt"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/brontide"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/feemanager"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnpeer"
@@ -38,6 +40,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/nat"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/subscribe"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tor"
@@ -149,6 +152,8 @@ type server struct {
 
 	breachArbiter *breachArbiter
 
+	chanReaper *chanReaper
+
 	chanRouter *routing.ChannelRouter
 
 	authGossiper *discovery.AuthenticatedGossiper
@@ -157,6 +162,10 @@ type server struct {
 
 	sweeper *sweep.UtxoSweeper
 
+	// feeManager automatically adjusts the forwarding fees of our
+	// channels. It's only set if the fee manager is active.
+	feeManager *feemanager.Manager
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *htlcswitch.OnionProcessor
@@ -179,6 +188,24 @@ type server struct {
 	sentDisabled    map[wire.OutPoint]bool
 	sentDisabledMtx sync.Mutex
 
+	// customMessageServer dispatches the custom messages received from
+	// our peers to all subscribed clients.
+	customMessageServer *subscribe.Server
+
+	// customTypeClaims counts the number of subscribers that claimed each
+	// even custom message type. Peers sending an even custom type that
+	// isn't claimed are disconnected.
+	customTypeClaims   map[lnwire.MessageType]uint32
+	customTypeClaimMtx sync.RWMutex
+
+	// peerEventServer dispatches the online and offline events of our
+	// peers to all subscribed clients.
+	peerEventServer *subscribe.Server
+
+	// channelNotifier dispatches the lifecycle events of our channels to
+	// all subscribed clients.
+	channelNotifier *channelnotifier.ChannelNotifier
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -255,18 +282,27 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	copy(serializedPubKey[:], privKey.PubKey().SerializeCompressed())
 
 	// Initialize the sphinx router, placing it's persistent replay log in
-	// the same directory as the channel graph database.
-	graphDir := chanDB.Path()
+	// the same directory as the channel graph database. As the channel
+	// graph database may be stored by a remote backend, we derive the
+	// directory from our config rather than from the database itself.
+	graphDir := filepath.Join(
+		cfg.DataDir, defaultGraphSubDirname,
+		normalizeNetwork(activeNetParams.Name),
+	)
 	sharedSecretPath := filepath.Join(graphDir, "sphinxreplay.db")
 	replayLog := htlcswitch.NewDecayedLog(sharedSecretPath, cc.chainNotifier)
 	sphinxRouter := sphinx.NewRouter(privKey, activeNetParams.Params, replayLog)
 
+	canceledRetention := time.Duration(
+		cfg.DB.CanceledInvoiceRetentionDays,
+	) * 24 * time.Hour
+
 	s := &server{
 		chanDB:  chanDB,
 		cc:      cc,
 		sigPool: lnwallet.NewSigPool(runtime.NumCPU()*2, cc.signer),
 
-		invoices: newInvoiceRegistry(chanDB),
+		invoices: newInvoiceRegistry(chanDB, canceledRetention),
 
 		identityPriv: privKey,
 		nodeSigner:   newNodeSigner(privKey),
@@ -289,6 +325,10 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		outboundPeers:          make(map[string]*peer),
 		peerConnectedListeners: make(map[string][]chan<- lnpeer.Peer),
 		sentDisabled:           make(map[wire.OutPoint]bool),
+		customMessageServer:    subscribe.NewServer(),
+		customTypeClaims:       make(map[lnwire.MessageType]uint32),
+		peerEventServer:        subscribe.NewServer(),
+		channelNotifier:        channelnotifier.New(chanDB),
 
 		globalFeatures: lnwire.NewFeatureVector(globalFeatures,
 			lnwire.GlobalFeatures),
@@ -350,6 +390,14 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			htlcswitch.DefaultFwdEventInterval),
 		LogEventTicker: ticker.New(
 			htlcswitch.DefaultLogInterval),
+		DefaultInboundLimits: channeldb.InboundLimits{
+			MaxForwardAmt:      s.cc.routingPolicy.MaxForwardAmt,
+			MaxPendingForwards: s.cc.routingPolicy.MaxPendingForwards,
+			MaxCltvDelta:       s.cc.routingPolicy.MaxCltvDelta,
+		},
+		HoldReplayedForwards:  cfg.HoldReplayedHtlcs,
+		NotifyActiveChannel:   s.channelNotifier.NotifyActiveChannelEvent,
+		NotifyInactiveChannel: s.channelNotifier.NotifyInactiveChannelEvent,
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
@@ -579,12 +627,18 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		FindPeer: func(pub *btcec.PublicKey) (lnpeer.Peer, error) {
 			return s.FindPeer(pub)
 		},
-		NotifyWhenOnline: s.NotifyWhenOnline,
-		ProofMatureDelta: 0,
-		TrickleDelay:     time.Millisecond * time.Duration(cfg.TrickleDelay),
-		RetransmitDelay:  time.Minute * 30,
-		DB:               chanDB,
-		AnnSigner:        s.nodeSigner,
+		NotifyWhenOnline:      s.NotifyWhenOnline,
+		ProofMatureDelta:      0,
+		TrickleDelay:          time.Millisecond * time.Duration(cfg.TrickleDelay),
+		RetransmitDelay:       time.Minute * 30,
+		DB:                    chanDB,
+		AnnSigner:             s.nodeSigner,
+		RotateTicker:          ticker.New(cfg.SyncRotationInterval),
+		NumActiveSyncers:      cfg.NumGraphSyncPeers,
+		ChannelUpdateInterval: cfg.Gossip.ChannelUpdateInterval,
+		MaxChannelUpdateBurst: cfg.Gossip.MaxChannelUpdateBurst,
+		BanThreshold:          cfg.Gossip.BanThreshold,
+		BanDuration:           cfg.Gossip.BanDuration,
 	},
 		s.identityPriv.PubKey(),
 	)
@@ -728,7 +782,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		DisableChannel: func(op wire.OutPoint) error {
 			return s.announceChanStatus(op, true)
 		},
-		Sweeper: s.sweeper,
+		Sweeper:                    s.sweeper,
+		NotifyClosedChannel:        s.channelNotifier.NotifyClosedChannelEvent,
+		NotifyFullyResolvedChannel: s.channelNotifier.NotifyFullyResolvedChannelEvent,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
@@ -743,6 +799,18 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ContractBreaches:   contractBreaches,
 		Signer:             cc.wallet.Cfg.Signer,
 		Store:              newRetributionStore(chanDB),
+		NotifyFullyResolvedChannel: func(chanPoint wire.OutPoint) {
+			s.channelNotifier.NotifyFullyResolvedChannelEvent(
+				chanPoint,
+			)
+		},
+	})
+
+	s.chanReaper = newChanReaper(&ChanReaperConfig{
+		DB:                   chanDB,
+		Notifier:             cc.chainNotifier,
+		SafetyDepth:          cfg.DB.ReapDepth,
+		UnresolvedHtlcHashes: s.chainArb.UnresolvedHtlcHashes,
 	})
 
 	// Select the configuration and furnding parameters for Bitcoin or
@@ -888,6 +956,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			return s.htlcSwitch.UpdateShortChanID(cid)
 		},
+		NotifyPendingOpenChannelEvent: s.channelNotifier.NotifyPendingOpenChannelEvent,
+		NotifyOpenChannelEvent:        s.channelNotifier.NotifyOpenChannelEvent,
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit btcutil.Amount) btcutil.Amount {
 
@@ -939,9 +1009,55 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	}
 	s.connMgr = cmgr
 
+	// If the fee manager is active, then we'll create it now so it can
+	// take over the management of our channel fees once we start.
+	if cfg.FeeManager.Active {
+		s.feeManager, err = initFeeManager(s, cfg.FeeManager)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return s, nil
 }
 
+// updateChannelPolicy applies the passed routing policy to the target
+// channels. The new policy is first propagated to the network by the gossiper,
+// and then applied to the links of any active channels. If no channels are
+// specified, then the policy is applied to all of our channels.
+func (s *server) updateChannelPolicy(chanPolicy routing.ChannelPolicy,
+	targetChans ...wire.OutPoint) error {
+
+	// We'll send the new policy to the AuthenticatedGossiper so it can
+	// propagate it for our target channel(s).
+	err := s.authGossiper.PropagateChanPolicyUpdate(
+		chanPolicy, targetChans...,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Finally, we'll apply the set of active links amongst the target
+	// channels.
+	//
+	// We create a partially policy as the logic won't overwrite a valid
+	// sub-policy with a "nil" one.
+	p := htlcswitch.ForwardingPolicy{
+		BaseFee:       chanPolicy.BaseFee,
+		FeeRate:       lnwire.MilliSatoshi(chanPolicy.FeeRate),
+		TimeLockDelta: chanPolicy.TimeLockDelta,
+	}
+	err = s.htlcSwitch.UpdateForwardingPolicies(p, targetChans...)
+	if err != nil {
+		// If we're unable update the fees due to the links not being
+		// online, then we don't need to fail the call. We'll simply
+		// log the failure.
+		srvrLog.Warnf("Unable to update link fees: %v", err)
+	}
+
+	return nil
+}
+
 // Started returns true if the server has been started, and false otherwise.
 // NOTE: This function is safe for concurrent access.
 func (s *server) Started() bool {
@@ -968,6 +1084,26 @@ func (s *server) Start() error {
 		go s.watchExternalIP()
 	}
 
+	// Any peer sessions left open within the connection history, due to
+	// an unclean shutdown, are ended before we connect to any peers. We'll
+	// also prune the history of peers we no longer have channels with.
+	if err := s.chanDB.ResetPeerSessions(); err != nil {
+		return err
+	}
+	channels, err := s.chanDB.FetchAllChannels()
+	if err != nil {
+		return err
+	}
+	channelPeers := make(map[[33]byte]struct{}, len(channels))
+	for _, channel := range channels {
+		var pubKey [33]byte
+		copy(pubKey[:], channel.IdentityPub.SerializeCompressed())
+		channelPeers[pubKey] = struct{}{}
+	}
+	if err := s.chanDB.PrunePeerHistories(channelPeers); err != nil {
+		return err
+	}
+
 	// Start the notification server. This is used so channel management
 	// goroutines can be notified when a funding transaction reaches a
 	// sufficient number of confirmations, or when the input for the
@@ -997,6 +1133,9 @@ func (s *server) Start() error {
 	if err := s.breachArbiter.Start(); err != nil {
 		return err
 	}
+	if err := s.chanReaper.Start(); err != nil {
+		return err
+	}
 	if err := s.authGossiper.Start(); err != nil {
 		return err
 	}
@@ -1046,6 +1185,12 @@ func (s *server) Start() error {
 	s.wg.Add(1)
 	go s.watchChannelStatus()
 
+	if s.feeManager != nil {
+		if err := s.feeManager.Start(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1065,6 +1210,12 @@ func (s *server) Stop() error {
 		s.torController.Stop()
 	}
 
+	// The fee manager is stopped first as it relies on the gossiper and
+	// switch to apply its policy updates.
+	if s.feeManager != nil {
+		s.feeManager.Stop()
+	}
+
 	// Shutdown the wallet, funding manager, and the rpc server.
 	s.sigPool.Stop()
 	s.cc.chainNotifier.Stop()
@@ -1073,6 +1224,7 @@ func (s *server) Stop() error {
 	s.sphinx.Stop()
 	s.utxoNursery.Stop()
 	s.breachArbiter.Stop()
+	s.chanReaper.Stop()
 	s.authGossiper.Stop()
 	s.chainArb.Stop()
 	s.sweeper.Stop()
@@ -1082,6 +1234,9 @@ func (s *server) Stop() error {
 	s.cc.feeEstimator.Stop()
 	s.invoices.Stop()
 	s.fundingMgr.Stop()
+	s.customMessageServer.Stop()
+	s.peerEventServer.Stop()
+	s.channelNotifier.Stop()
 
 	// Disconnect from each active peers to ensure that
 	// peerTerminationWatchers signal completion to each peer.
@@ -1676,7 +1831,7 @@ func (s *server) establishPersistentConnections() error {
 	// TODO(roasbeef): instead iterate over link nodes and query graph for
 	// each of the nodes.
 	err = sourceNode.ForEachChannel(nil, func(
-		_ *bbolt.Tx,
+		_ kvdb.Tx,
 		_ *channeldb.ChannelEdgeInfo,
 		policy, _ *channeldb.ChannelEdgePolicy) error {
 
@@ -1890,6 +2045,168 @@ func (s *server) SendToPeer(target *btcec.PublicKey,
 	return nil
 }
 
+// CustomMessage is a message of a type within the custom range that was
+// received from one of our peers.
+type CustomMessage struct {
+	// Peer is the compressed public key of the peer the message was
+	// received from.
+	Peer [33]byte
+
+	// Msg is the custom message itself.
+	Msg *lnwire.Custom
+}
+
+// SubscribeCustomMessages returns a client that will receive all custom
+// messages received from our peers from now on, as *CustomMessage updates.
+func (s *server) SubscribeCustomMessages() (*subscribe.Client, error) {
+	return s.customMessageServer.Subscribe()
+}
+
+// ClaimCustomTypes claims the given even custom message types, such that
+// peers sending them are no longer disconnected. The returned function must
+// be called to release the claims once they're no longer needed.
+func (s *server) ClaimCustomTypes(msgTypes []lnwire.MessageType) func() {
+	s.customTypeClaimMtx.Lock()
+	for _, msgType := range msgTypes {
+		s.customTypeClaims[msgType]++
+	}
+	s.customTypeClaimMtx.Unlock()
+
+	var released uint32
+	return func() {
+		if !atomic.CompareAndSwapUint32(&released, 0, 1) {
+			return
+		}
+
+		s.customTypeClaimMtx.Lock()
+		defer s.customTypeClaimMtx.Unlock()
+
+		for _, msgType := range msgTypes {
+			s.customTypeClaims[msgType]--
+			if s.customTypeClaims[msgType] == 0 {
+				delete(s.customTypeClaims, msgType)
+			}
+		}
+	}
+}
+
+// isCustomTypeClaimed returns whether the given custom message type is
+// claimed by any subscriber.
+func (s *server) isCustomTypeClaimed(msgType lnwire.MessageType) bool {
+	s.customTypeClaimMtx.RLock()
+	defer s.customTypeClaimMtx.RUnlock()
+
+	_, ok := s.customTypeClaims[msgType]
+	return ok
+}
+
+// PeerEventType denotes the type of a PeerEvent.
+type PeerEventType uint8
+
+const (
+	// PeerOnline is emitted once a peer has connected and its connection
+	// has been set up successfully.
+	PeerOnline PeerEventType = iota
+
+	// PeerOffline is emitted once a peer that was online has
+	// disconnected.
+	PeerOffline
+)
+
+// String returns a human readable string for the event type.
+func (t PeerEventType) String() string {
+	switch t {
+	case PeerOnline:
+		return "online"
+	case PeerOffline:
+		return "offline"
+	default:
+		return "unknown"
+	}
+}
+
+// PeerEvent signals that one of our peers went online or offline.
+type PeerEvent struct {
+	// PubKey is the compressed public key of the peer.
+	PubKey [33]byte
+
+	// Type is the type of the event.
+	Type PeerEventType
+}
+
+// SubscribePeerEvents returns a client that will receive all online and
+// offline events of our peers from now on, as *PeerEvent updates.
+func (s *server) SubscribePeerEvents() (*subscribe.Client, error) {
+	return s.peerEventServer.Subscribe()
+}
+
+// peerOnline dispatches the event of the given peer coming online, unless it
+// has already been handled as offline. The event is only recorded within the
+// peer's connection history if we have channels with the peer, as the history
+// is otherwise of no use and would grow with every peer that connects to us.
+func (s *server) peerOnline(p *peer) {
+	p.eventMtx.Lock()
+	defer p.eventMtx.Unlock()
+
+	if p.offlineNotified {
+		return
+	}
+	p.onlineNotified = true
+
+	channels, err := s.chanDB.FetchOpenChannels(p.addr.IdentityKey)
+	if err != nil {
+		srvrLog.Errorf("Unable to fetch channels of peer %v: %v", p,
+			err)
+	}
+	if len(channels) > 0 {
+		_, err := s.chanDB.RecordPeerOnline(p.pubKeyBytes, time.Now())
+		if err != nil {
+			srvrLog.Errorf("Unable to record peer %v going online: "+
+				"%v", p, err)
+		} else {
+			p.historyRecorded = true
+		}
+	}
+
+	s.notifyPeerEvent(p, PeerOnline)
+}
+
+// peerOffline dispatches the event of the given peer going offline, and
+// records it within the peer's connection history, if it was handled as
+// online. Once called, the peer coming online will no longer be handled.
+func (s *server) peerOffline(p *peer) {
+	p.eventMtx.Lock()
+	defer p.eventMtx.Unlock()
+
+	p.offlineNotified = true
+	if !p.onlineNotified {
+		return
+	}
+
+	if p.historyRecorded {
+		_, err := s.chanDB.RecordPeerOffline(p.pubKeyBytes, time.Now())
+		if err != nil {
+			srvrLog.Errorf("Unable to record peer %v going offline: "+
+				"%v", p, err)
+		}
+	}
+
+	s.notifyPeerEvent(p, PeerOffline)
+}
+
+// notifyPeerEvent dispatches the given event of the peer to all subscribed
+// clients.
+func (s *server) notifyPeerEvent(p *peer, eventType PeerEventType) {
+	err := s.peerEventServer.SendUpdate(&PeerEvent{
+		PubKey: p.pubKeyBytes,
+		Type:   eventType,
+	})
+	if err != nil && err != subscribe.ErrServerShuttingDown {
+		srvrLog.Errorf("Unable to dispatch event of peer %v going "+
+			"%v: %v", p, eventType, err)
+	}
+}
+
 // NotifyWhenOnline can be called by other subsystems to get notified when a
 // particular peer comes online. The peer itself is sent across the peerChan.
 //
@@ -2343,6 +2660,7 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	// and also that we support the new gossip query features.
 	localFeatures.Set(lnwire.DataLossProtectOptional)
 	localFeatures.Set(lnwire.GossipQueriesOptional)
+	localFeatures.Set(lnwire.GossipQueriesExOptional)
 
 	// Now that we've established a connection, create a peer, and it to
 	// the set of currently active peers.
@@ -2431,8 +2749,13 @@ func (s *server) peerInitializer(p *peer) {
 		return
 	}
 
-	// Otherwise, signal to the peerTerminationWatcher that the peer startup
-	// was successful, and to begin watching the peer's wait group.
+	// Otherwise, the peer is now online. If it has already disconnected,
+	// this is a no-op, as the peerTerminationWatcher has handled it going
+	// offline.
+	s.peerOnline(p)
+
+	// Signal to the peerTerminationWatcher that the peer startup was
+	// successful, and to begin watching the peer's wait group.
 	close(ready)
 
 	pubStr := string(p.addr.IdentityKey.SerializeCompressed())
@@ -2469,6 +2792,11 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 
 	srvrLog.Debugf("Peer %v has been disconnected", p)
 
+	// If the peer was handled as online, it was online until now. This is
+	// also recorded if we're shutting down, such that the peer's uptime
+	// remains accurate.
+	s.peerOffline(p)
+
 	// If the server is exiting then we can bail out early ourselves as all
 	// the other sub-systems will already be shutting down.
 	if s.Stopped() {
@@ -0,0 +1,160 @@
+package subscribe
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// ErrServerShuttingDown is an error returned in case the server is in the
+// process of shutting down.
+var ErrServerShuttingDown = errors.New("subscription server shutting down")
+
+// Client is used to get notified about updates the caller has subscribed to.
+type Client struct {
+	// cancel should be called in case the client no longer wants to
+	// subscribe for updates from the server.
+	cancel func()
+
+	updates *queue.ConcurrentQueue
+	quit    chan struct{}
+}
+
+// Updates returns a read-only channel where the updates the client has
+// subscribed to will be delivered.
+func (c *Client) Updates() <-chan interface{} {
+	return c.updates.ChanOut()
+}
+
+// Quit is a channel that will be closed in case the server decides to no
+// longer deliver updates to this client, or the client is canceled.
+func (c *Client) Quit() <-chan struct{} {
+	return c.quit
+}
+
+// Cancel should be called in case the client no longer wants to subscribe for
+// updates from the server.
+func (c *Client) Cancel() {
+	c.cancel()
+}
+
+// Server is a struct that manages a set of subscriptions and their
+// corresponding clients. Any update will be delivered to all active clients,
+// in the order the updates were sent.
+type Server struct {
+	stopped uint32 // To be used atomically.
+
+	// clientCounter is used to assign a unique ID to each client. It must
+	// be used atomically.
+	clientCounter uint64
+
+	clientMtx sync.RWMutex
+	clients   map[uint64]*Client
+
+	quit chan struct{}
+}
+
+// NewServer returns a new Server that is immediately ready to accept
+// subscriptions and updates.
+func NewServer() *Server {
+	return &Server{
+		clients: make(map[uint64]*Client),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Stop stops the server, canceling all active clients.
+func (s *Server) Stop() error {
+	if !atomic.CompareAndSwapUint32(&s.stopped, 0, 1) {
+		return nil
+	}
+
+	close(s.quit)
+
+	s.clientMtx.Lock()
+	clients := s.clients
+	s.clients = make(map[uint64]*Client)
+	s.clientMtx.Unlock()
+
+	for _, client := range clients {
+		close(client.quit)
+		client.updates.Stop()
+	}
+
+	return nil
+}
+
+// Subscribe returns a Client that will receive updates any time the Server
+// is made aware of a new event.
+func (s *Server) Subscribe() (*Client, error) {
+	clientID := atomic.AddUint64(&s.clientCounter, 1)
+
+	client := &Client{
+		updates: queue.NewConcurrentQueue(20),
+		quit:    make(chan struct{}),
+	}
+	client.updates.Start()
+
+	var cancelled uint32
+	client.cancel = func() {
+		if !atomic.CompareAndSwapUint32(&cancelled, 0, 1) {
+			return
+		}
+
+		s.clientMtx.Lock()
+		_, active := s.clients[clientID]
+		delete(s.clients, clientID)
+		s.clientMtx.Unlock()
+
+		// If the client is no longer active, the server has already
+		// shut it down while stopping.
+		if !active {
+			return
+		}
+
+		close(client.quit)
+		client.updates.Stop()
+	}
+
+	// We'll check whether the server is shutting down while holding the
+	// lock, such that the client is either added before Stop takes over
+	// the active clients, or not at all.
+	s.clientMtx.Lock()
+	select {
+	case <-s.quit:
+		s.clientMtx.Unlock()
+		client.updates.Stop()
+		return nil, ErrServerShuttingDown
+	default:
+	}
+	s.clients[clientID] = client
+	s.clientMtx.Unlock()
+
+	return client, nil
+}
+
+// SendUpdate is called to send the passed update to all currently active
+// subscription clients.
+func (s *Server) SendUpdate(update interface{}) error {
+	select {
+	case <-s.quit:
+		return ErrServerShuttingDown
+	default:
+	}
+
+	s.clientMtx.RLock()
+	defer s.clientMtx.RUnlock()
+
+	for _, client := range s.clients {
+		select {
+		case client.updates.ChanIn() <- update:
+		case <-client.quit:
+		case <-s.quit:
+			return ErrServerShuttingDown
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package subscribe_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// TestSubscribe tests that updates are delivered in order to all active
+// clients, and that canceled clients no longer receive any.
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	server := subscribe.NewServer()
+	defer server.Stop()
+
+	const numClients = 3
+	var clients []*subscribe.Client
+	for i := 0; i < numClients; i++ {
+		client, err := server.Subscribe()
+		if err != nil {
+			t.Fatalf("unable to subscribe: %v", err)
+		}
+		clients = append(clients, client)
+	}
+
+	// Cancel the last client, such that it shouldn't receive any updates.
+	canceled := clients[numClients-1]
+	canceled.Cancel()
+	clients = clients[:numClients-1]
+
+	select {
+	case <-canceled.Quit():
+	default:
+		t.Fatalf("expected quit channel of canceled client to be closed")
+	}
+
+	// Send more updates than the client queues can buffer, to ensure
+	// sending doesn't block on slow clients.
+	const numUpdates = 100
+	for i := 0; i < numUpdates; i++ {
+		if err := server.SendUpdate(i); err != nil {
+			t.Fatalf("unable to send update: %v", err)
+		}
+	}
+
+	for _, client := range clients {
+		for i := 0; i < numUpdates; i++ {
+			select {
+			case update := <-client.Updates():
+				if update.(int) != i {
+					t.Fatalf("expected update %d, got %d",
+						i, update.(int))
+				}
+
+			case <-time.After(time.Second):
+				t.Fatalf("update %d not received", i)
+			}
+		}
+	}
+
+	// Once the server is stopped, all clients should be shut down, and no
+	// more subscriptions or updates should be accepted.
+	if err := server.Stop(); err != nil {
+		t.Fatalf("unable to stop server: %v", err)
+	}
+	for _, client := range clients {
+		select {
+		case <-client.Quit():
+		default:
+			t.Fatalf("expected quit channel of client to be closed")
+		}
+
+		// Canceling a client after the server stopped should be safe.
+		client.Cancel()
+	}
+
+	if _, err := server.Subscribe(); err != subscribe.ErrServerShuttingDown {
+		t.Fatalf("expected ErrServerShuttingDown, got %v", err)
+	}
+	if err := server.SendUpdate(0); err != subscribe.ErrServerShuttingDown {
+		t.Fatalf("expected ErrServerShuttingDown, got %v", err)
+	}
+}
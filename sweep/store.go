@@ -8,8 +8,9 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/coreos/bbolt"
+	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/kvdb"
 )
 
 var (
@@ -23,9 +24,11 @@ var (
 	lastTxKey = []byte("last-tx")
 
 	// txHashesBucketKey is the key that points to a bucket containing the
-	// hashes of all sweep txes that were published successfully.
+	// hashes of all sweep txes that were published successfully, along
+	// with the fee they pay. Txes migrated from the nursery, or published
+	// before the fee was recorded, map to an empty slice.
 	//
-	// maps: txHash -> empty slice
+	// maps: txHash -> fee
 	txHashesBucketKey = []byte("sweeper-tx-hashes")
 
 	// utxnChainPrefix is the bucket prefix for nursery buckets.
@@ -48,8 +51,14 @@ type SweeperStore interface {
 	// hash.
 	IsOurTx(hash chainhash.Hash) (bool, error)
 
-	// NotifyPublishTx signals that we are about to publish a tx.
-	NotifyPublishTx(*wire.MsgTx) error
+	// NotifyPublishTx signals that we are about to publish a tx, which
+	// pays the given fee.
+	NotifyPublishTx(*wire.MsgTx, btcutil.Amount) error
+
+	// GetTxFee returns the fee paid by the tx with the given hash, which
+	// must have been published by us. The returned boolean is false if
+	// the fee of the tx is unknown.
+	GetTxFee(hash chainhash.Hash) (btcutil.Amount, bool, error)
 
 	// GetLastPublishedTx returns the last tx that we called NotifyPublishTx
 	// for.
@@ -64,7 +73,7 @@ type sweeperStore struct {
 func NewSweeperStore(db *channeldb.DB, chainHash *chainhash.Hash) (
 	SweeperStore, error) {
 
-	err := db.Update(func(tx *bbolt.Tx) error {
+	err := db.Update(func(tx kvdb.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(
 			lastTxBucketKey,
 		)
@@ -98,7 +107,7 @@ func NewSweeperStore(db *channeldb.DB, chainHash *chainhash.Hash) (
 
 // migrateTxHashes migrates nursery finalized txes to the tx hashes bucket. This
 // is not implemented as a database migration, to keep the downgrade path open.
-func migrateTxHashes(tx *bbolt.Tx, txHashesBucket *bbolt.Bucket,
+func migrateTxHashes(tx kvdb.Tx, txHashesBucket kvdb.Bucket,
 	chainHash *chainhash.Hash) error {
 
 	log.Infof("Migrating UTXO nursery finalized TXIDs")
@@ -162,9 +171,12 @@ func migrateTxHashes(tx *bbolt.Tx, txHashesBucket *bbolt.Bucket,
 	return nil
 }
 
-// NotifyPublishTx signals that we are about to publish a tx.
-func (s *sweeperStore) NotifyPublishTx(sweepTx *wire.MsgTx) error {
-	return s.db.Update(func(tx *bbolt.Tx) error {
+// NotifyPublishTx signals that we are about to publish a tx, which pays the
+// given fee.
+func (s *sweeperStore) NotifyPublishTx(sweepTx *wire.MsgTx,
+	fee btcutil.Amount) error {
+
+	return s.db.Update(func(tx kvdb.Tx) error {
 		lastTxBucket := tx.Bucket(lastTxBucketKey)
 		if lastTxBucket == nil {
 			return errors.New("last tx bucket does not exist")
@@ -186,7 +198,10 @@ func (s *sweeperStore) NotifyPublishTx(sweepTx *wire.MsgTx) error {
 
 		hash := sweepTx.TxHash()
 
-		return txHashesBucket.Put(hash[:], []byte{})
+		var feeBytes [8]byte
+		byteOrder.PutUint64(feeBytes[:], uint64(fee))
+
+		return txHashesBucket.Put(hash[:], feeBytes[:])
 	})
 }
 
@@ -195,7 +210,7 @@ func (s *sweeperStore) NotifyPublishTx(sweepTx *wire.MsgTx) error {
 func (s *sweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
 	var sweepTx *wire.MsgTx
 
-	err := s.db.View(func(tx *bbolt.Tx) error {
+	err := s.db.View(func(tx kvdb.Tx) error {
 		lastTxBucket := tx.Bucket(lastTxBucketKey)
 		if lastTxBucket == nil {
 			return errors.New("last tx bucket does not exist")
@@ -226,7 +241,7 @@ func (s *sweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
 func (s *sweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
 	var ours bool
 
-	err := s.db.View(func(tx *bbolt.Tx) error {
+	err := s.db.View(func(tx kvdb.Tx) error {
 		txHashesBucket := tx.Bucket(txHashesBucketKey)
 		if txHashesBucket == nil {
 			return errors.New("tx hashes bucket does not exist")
@@ -243,5 +258,38 @@ func (s *sweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
 	return ours, nil
 }
 
+// GetTxFee returns the fee paid by the tx with the given hash, which must have
+// been published by us. The returned boolean is false if the fee of the tx is
+// unknown.
+func (s *sweeperStore) GetTxFee(hash chainhash.Hash) (btcutil.Amount, bool,
+	error) {
+
+	var (
+		fee   btcutil.Amount
+		known bool
+	)
+	err := s.db.View(func(tx kvdb.Tx) error {
+		txHashesBucket := tx.Bucket(txHashesBucketKey)
+		if txHashesBucket == nil {
+			return errors.New("tx hashes bucket does not exist")
+		}
+
+		feeBytes := txHashesBucket.Get(hash[:])
+		if len(feeBytes) != 8 {
+			return nil
+		}
+
+		fee = btcutil.Amount(byteOrder.Uint64(feeBytes))
+		known = true
+
+		return nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	return fee, known, nil
+}
+
 // Compile-time constraint to ensure sweeperStore implements SweeperStore.
 var _ SweeperStore = (*sweeperStore)(nil)
@@ -3,19 +3,20 @@ package sweep
 import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 )
 
 // MockSweeperStore is a mock implementation of sweeper store. This type is
 // exported, because it is currently used in nursery tests too.
 type MockSweeperStore struct {
 	lastTx  *wire.MsgTx
-	ourTxes map[chainhash.Hash]struct{}
+	ourTxes map[chainhash.Hash]btcutil.Amount
 }
 
 // NewMockSweeperStore returns a new instance.
 func NewMockSweeperStore() *MockSweeperStore {
 	return &MockSweeperStore{
-		ourTxes: make(map[chainhash.Hash]struct{}),
+		ourTxes: make(map[chainhash.Hash]btcutil.Amount),
 	}
 }
 
@@ -26,15 +27,27 @@ func (s *MockSweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
 	return ok, nil
 }
 
-// NotifyPublishTx signals that we are about to publish a tx.
-func (s *MockSweeperStore) NotifyPublishTx(tx *wire.MsgTx) error {
+// NotifyPublishTx signals that we are about to publish a tx, which pays the
+// given fee.
+func (s *MockSweeperStore) NotifyPublishTx(tx *wire.MsgTx,
+	fee btcutil.Amount) error {
+
 	txHash := tx.TxHash()
-	s.ourTxes[txHash] = struct{}{}
+	s.ourTxes[txHash] = fee
 	s.lastTx = tx
 
 	return nil
 }
 
+// GetTxFee returns the fee paid by the tx with the given hash, which must have
+// been published by us.
+func (s *MockSweeperStore) GetTxFee(hash chainhash.Hash) (btcutil.Amount,
+	bool, error) {
+
+	fee, ok := s.ourTxes[hash]
+	return fee, ok, nil
+}
+
 // GetLastPublishedTx returns the last tx that we called NotifyPublishTx
 // for.
 func (s *MockSweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
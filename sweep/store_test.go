@@ -90,7 +90,7 @@ func testStore(t *testing.T, createStore func() (SweeperStore, error)) {
 		},
 	})
 
-	err = store.NotifyPublishTx(&tx1)
+	err = store.NotifyPublishTx(&tx1, 100)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -103,7 +103,7 @@ func testStore(t *testing.T, createStore func() (SweeperStore, error)) {
 		},
 	})
 
-	err = store.NotifyPublishTx(&tx2)
+	err = store.NotifyPublishTx(&tx2, 200)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -141,6 +141,23 @@ func testStore(t *testing.T, createStore func() (SweeperStore, error)) {
 		t.Fatal("expected tx to be ours")
 	}
 
+	// Assert that the fee of both txes is known.
+	fee, known, err := store.GetTxFee(tx1.TxHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !known || fee != 100 {
+		t.Fatalf("expected fee of 100, got %v (known=%v)", fee, known)
+	}
+
+	fee, known, err = store.GetTxFee(tx2.TxHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !known || fee != 200 {
+		t.Fatalf("expected fee of 200, got %v (known=%v)", fee, known)
+	}
+
 	// An different hash should be reported on as not being ours.
 	var unknownHash chainhash.Hash
 	ours, err = store.IsOurTx(unknownHash)
@@ -150,4 +167,12 @@ func testStore(t *testing.T, createStore func() (SweeperStore, error)) {
 	if ours {
 		t.Fatal("expected tx to be not ours")
 	}
+
+	_, known, err = store.GetTxFee(unknownHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if known {
+		t.Fatal("expected fee of unknown tx to be unknown")
+	}
 }
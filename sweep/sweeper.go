@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
@@ -281,6 +282,21 @@ func (s *UtxoSweeper) SweepInput(input Input) (chan Result, error) {
 	return sweeperInput.resultChan, nil
 }
 
+// IsOurTx determines whether a tx was published by the sweeper, based on its
+// hash.
+func (s *UtxoSweeper) IsOurTx(hash chainhash.Hash) (bool, error) {
+	return s.cfg.Store.IsOurTx(hash)
+}
+
+// GetTxFee returns the fee paid by the sweep tx with the given hash. The
+// returned boolean is false if the tx wasn't published by us, or its fee is
+// unknown.
+func (s *UtxoSweeper) GetTxFee(hash chainhash.Hash) (btcutil.Amount, bool,
+	error) {
+
+	return s.cfg.Store.GetTxFee(hash)
+}
+
 // collector is the sweeper main loop. It processes new inputs, spend
 // notifications and counts down to publication of the sweep tx.
 func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
@@ -628,7 +644,17 @@ func (s *UtxoSweeper) sweep(inputs inputSet,
 	// publish, we loose track of this tx. Even republication on startup
 	// doesn't prevent this, because that call returns a double spend error
 	// then and would also not add the hash to the store.
-	err = s.cfg.Store.NotifyPublishTx(tx)
+	//
+	// The fee is recorded along with the tx, as the values of its inputs
+	// aren't known to the wallet.
+	var fee btcutil.Amount
+	for _, input := range inputs {
+		fee += btcutil.Amount(input.SignDesc().Output.Value)
+	}
+	for _, txOut := range tx.TxOut {
+		fee -= btcutil.Amount(txOut.Value)
+	}
+	err = s.cfg.Store.NotifyPublishTx(tx, fee)
 	if err != nil {
 		return fmt.Errorf("notify publish tx: %v", err)
 	}
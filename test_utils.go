@@ -336,8 +336,10 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 
 	chainArb := contractcourt.NewChainArbitrator(
 		contractcourt.ChainArbitratorConfig{
-			Notifier: notifier,
-			ChainIO:  chainIO,
+			Notifier:                   notifier,
+			ChainIO:                    chainIO,
+			NotifyClosedChannel:        func(wire.OutPoint) {},
+			NotifyFullyResolvedChannel: func(wire.OutPoint) {},
 		}, dbAlice,
 	)
 	chainArb.WatchNewChannel(aliceChannelState)
@@ -362,6 +364,8 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 			htlcswitch.DefaultFwdEventInterval),
 		LogEventTicker: ticker.New(
 			htlcswitch.DefaultLogInterval),
+		NotifyActiveChannel:   func(wire.OutPoint) {},
+		NotifyInactiveChannel: func(wire.OutPoint) {},
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, nil, nil, nil, err
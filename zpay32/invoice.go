@@ -20,6 +20,10 @@ const (
 	// mSatPerBtc is the number of millisatoshis in 1 BTC.
 	mSatPerBtc = 100000000000
 
+	// DefaultInvoiceExpiry is the expiry of invoices that don't specify
+	// an expiry explicitly.
+	DefaultInvoiceExpiry = 3600 * time.Second
+
 	// signatureBase32Len is the number of 5-bit groups needed to encode
 	// the 512 bit signature + 8 bit recovery ID.
 	signatureBase32Len = 104
@@ -465,7 +469,7 @@ func (invoice *Invoice) Expiry() time.Duration {
 	}
 
 	// If no expiry is set for this invoice, default is 3600 seconds.
-	return 3600 * time.Second
+	return DefaultInvoiceExpiry
 }
 
 // MinFinalCLTVExpiry returns the minimum final CLTV expiry delta as specified